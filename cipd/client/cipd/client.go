@@ -44,6 +44,7 @@ import (
 	"path/filepath"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/net/context"
@@ -56,6 +57,7 @@ import (
 	"github.com/luci/luci-go/cipd/client/cipd/common"
 	"github.com/luci/luci-go/cipd/client/cipd/internal"
 	"github.com/luci/luci-go/cipd/client/cipd/local"
+	"github.com/luci/luci-go/cipd/client/cipd/signing"
 	"github.com/luci/luci-go/cipd/version"
 )
 
@@ -109,6 +111,8 @@ var (
 	ErrEnsurePackagesFailed = errors.New("failed to update packages, see the log")
 	// ErrPackageNotFound is returned by DeletePackage if the package doesn't exist.
 	ErrPackageNotFound = errors.New("no such package")
+	// ErrVerifyRootFailed is returned by VerifyRoot if some packages couldn't be checked or repaired.
+	ErrVerifyRootFailed = errors.New("failed to verify or repair some packages, see the log")
 )
 
 var (
@@ -295,11 +299,17 @@ func (am ActionMap) Log(ctx context.Context) {
 //
 // It lists pins that were attempted to be installed, updated or removed, as
 // well as all errors.
+//
+// BytesDownloaded and BytesReused are the amount of instance data fetched from
+// the backend and copied from already deployed files when installing the pins.
+// Instances found in the instance cache count for neither.
 type Actions struct {
-	ToInstall common.PinSlice `json:"to_install,omitempty"` // pins to be installed
-	ToUpdate  []UpdatedPin    `json:"to_update,omitempty"`  // pins to be replaced
-	ToRemove  common.PinSlice `json:"to_remove,omitempty"`  // pins to be removed
-	Errors    []ActionError   `json:"errors,omitempty"`     // all individual errors
+	ToInstall       common.PinSlice `json:"to_install,omitempty"`       // pins to be installed
+	ToUpdate        []UpdatedPin    `json:"to_update,omitempty"`        // pins to be replaced
+	ToRemove        common.PinSlice `json:"to_remove,omitempty"`        // pins to be removed
+	Errors          []ActionError   `json:"errors,omitempty"`           // all individual errors
+	BytesDownloaded uint64          `json:"bytes_downloaded,omitempty"` // instance bytes fetched
+	BytesReused     uint64          `json:"bytes_reused,omitempty"`     // file bytes reused locally
 }
 
 // Empty is true if there are no actions specified.
@@ -320,6 +330,16 @@ type ActionError struct {
 	Error  JSONError  `json:"error,omitempty"`
 }
 
+// PackageDrift describes a deployed package whose files don't match the
+// package manifest.
+type PackageDrift struct {
+	Subdir   string            `json:"subdir"`
+	Pin      common.Pin        `json:"pin"`
+	Files    []local.FileDrift `json:"files,omitempty"`    // modified or missing files
+	Repaired bool              `json:"repaired,omitempty"` // true if the files were restored
+	Error    string            `json:"error,omitempty"`    // why the check or repair failed
+}
+
 // ReadSeekCloser is the interface that groups Reader, Seeker and Closer.
 type ReadSeekCloser interface {
 	io.Reader
@@ -455,6 +475,17 @@ type Client interface {
 	// If the update was only partially applied, returns both Actions and error.
 	EnsurePackages(ctx context.Context, pkgs common.PinSliceBySubdir, dryRun bool) (ActionMap, error)
 
+	// VerifyRoot checks that the files of all packages deployed in the site root
+	// still match their manifests.
+	//
+	// It returns the packages with modified or missing files. If repair is true,
+	// only these files are restored from the package instances (fetched as by
+	// FetchInstance), the rest of the site root is left untouched.
+	//
+	// If some packages couldn't be checked or repaired, returns both the drift
+	// and ErrVerifyRootFailed.
+	VerifyRoot(ctx context.Context, repair bool) ([]PackageDrift, error)
+
 	// IncrementCounter adds delta to the counter's value and updates its last
 	// updated timestamp.
 	//
@@ -469,6 +500,9 @@ type Client interface {
 type ClientOptions struct {
 	// ServiceURL is root URL of the backend service.
 	//
+	// A file:// URL points to an offline repository used instead of the backend
+	// service, see OfflineServiceURL.
+	//
 	// Default is ServiceURL const.
 	ServiceURL string
 
@@ -484,8 +518,23 @@ type ClientOptions struct {
 	//
 	// If empty, instances are not cached and tags are cached inside the site
 	// root. If both Root and CacheDir are empty, tag cache is disabled.
+	//
+	// The cache can be shared by several site roots and processes. Instances
+	// found in it are verified against their instance ID before being used.
 	CacheDir string
 
+	// CacheMaxInstances is the maximum number of instances kept in the cache.
+	//
+	// Least recently used instances are evicted first. Default is 100.
+	CacheMaxInstances int
+
+	// CacheMaxSize is the maximum total size in bytes of the instances kept in
+	// the cache.
+	//
+	// Least recently used instances are evicted first, but the last used one is
+	// always kept. Default is no limit.
+	CacheMaxSize int64
+
 	// AnonymousClient is http.Client that doesn't attach authentication headers.
 	//
 	// Will be used when talking to the Google Storage. We use signed URLs that do
@@ -506,6 +555,31 @@ type ClientOptions struct {
 	//
 	// Default is UserAgent const.
 	UserAgent string
+
+	// TrustedKeys are the keys package instances must be signed with.
+	//
+	// If not empty, FetchAndDeployInstance and EnsurePackages refuse to deploy
+	// instances without a valid signature by one of these keys. See the signing
+	// package.
+	TrustedKeys *signing.KeyRing
+
+	// DifferentialUpdates enables differential updates of deployed packages.
+	//
+	// When a package is updated, only the parts of the new instance holding
+	// files that differ from the deployed ones are fetched, with HTTP range
+	// requests. Unchanged files are copied from the site root if they still
+	// match the manifest of the deployed instance. Falls back to fetching the
+	// whole instance if the storage doesn't support range requests, or if the
+	// package was built without the FileHashes option and its manifest doesn't
+	// list the SHA1 hashes of its files.
+	//
+	// The new instance isn't verified against its instance ID, since that needs
+	// all of it, and is deployed as is. The hashes in its manifest come from the
+	// same unverified download: they only guard against reusing deployed files
+	// that were modified locally, not against a corrupted or tampered storage.
+	// Use it only with a trusted storage. For this reason differential updates
+	// are never used if TrustedKeys is set.
+	DifferentialUpdates bool
 }
 
 // LoadFromEnv loads supplied default values from an environment into opts.
@@ -549,6 +623,19 @@ func NewClient(opts ClientOptions) (Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("not a valid URL %q - %s", opts.ServiceURL, err)
 	}
+	if parsed.Scheme == "file" {
+		dir, err := offlineRepoDir(parsed)
+		if err != nil {
+			return nil, err
+		}
+		repo := newOfflineRepo(dir)
+		return &clientImpl{
+			ClientOptions: opts,
+			remote:        repo,
+			storage:       repo,
+			deployer:      local.NewDeployer(opts.Root),
+		}, nil
+	}
 	if parsed.Path != "" && parsed.Path != "/" {
 		return nil, fmt.Errorf("expecting a root URL, not %q", opts.ServiceURL)
 	}
@@ -563,6 +650,7 @@ func NewClient(opts ClientOptions) (Client, error) {
 		},
 		storage: &storageImpl{
 			chunkSize: uploadChunkSize,
+			blockSize: rangeBlockSize,
 			userAgent: opts.UserAgent,
 			client:    opts.AnonymousClient,
 		},
@@ -571,6 +659,11 @@ func NewClient(opts ClientOptions) (Client, error) {
 }
 
 type clientImpl struct {
+	// downloaded is the number of instance bytes fetched from the storage so
+	// far, updated atomically. Comes first to be 64-bit aligned on 32-bit
+	// platforms.
+	downloaded int64
+
 	ClientOptions
 
 	// batchLock protects guts of by BeginBatch/EndBatch implementation.
@@ -656,6 +749,7 @@ func (client *clientImpl) getInstanceCache(ctx context.Context) *internal.Instan
 		}
 		path := filepath.Join(client.CacheDir, "instances")
 		client.instanceCache = internal.NewInstanceCache(local.NewFileSystem(path, ""))
+		client.instanceCache.SetLimits(client.CacheMaxInstances, client.CacheMaxSize)
 		logging.Infof(ctx, "cipd: using instance cache at %q", path)
 	})
 	return client.instanceCache
@@ -1193,13 +1287,21 @@ func (client *clientImpl) fetchInstanceWithCache(ctx context.Context, pin common
 			logging.Warningf(ctx, "cipd: could not get %s from cache - %s", pin, err)
 
 		default:
+			// The cache may be shared with other processes, don't trust it blindly.
+			if err := verifyInstanceFile(file, pin); err != nil {
+				file.Close()
+				logging.Warningf(ctx, "cipd: evicting corrupted %s from cache - %s", pin, err)
+				if err := cache.Delete(ctx, pin, now); err != nil {
+					logging.Warningf(ctx, "cipd: failed to evict %s - %s", pin, err)
+				}
+				break
+			}
 			logging.Infof(ctx, "cipd: instance cache hit for %s", pin)
 			return file, nil
 		}
 
 		// Download the package into the cache. 'remoteFetchInstance' verifies the
-		// hash. When reading from the cache, we can skip the hash check (and we
-		// indeed do, see 'cipd: instance cache hit' case above).
+		// hash. Instances read from the cache are verified above.
 		err := cache.Put(ctx, pin, now, func(f *os.File) error {
 			return client.remoteFetchInstance(ctx, pin, f)
 		})
@@ -1229,6 +1331,23 @@ func (client *clientImpl) fetchInstanceWithCache(ctx context.Context, pin common
 	}
 }
 
+// verifyInstanceFile checks that the hash of the file matches the instance ID
+// and rewinds it.
+func verifyInstanceFile(f *os.File, pin common.Pin) error {
+	hash, err := common.HashForInstanceID(pin.InstanceID)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(hash, f); err != nil {
+		return err
+	}
+	if common.InstanceIDFromHash(hash) != pin.InstanceID {
+		return fmt.Errorf("package hash mismatch")
+	}
+	_, err = f.Seek(0, os.SEEK_SET)
+	return err
+}
+
 // remoteFetchInstance fetches the package file into 'output' and verifies its
 // hash along the way.
 func (client *clientImpl) remoteFetchInstance(ctx context.Context, pin common.Pin, output io.WriteSeeker) (err error) {
@@ -1254,22 +1373,49 @@ func (client *clientImpl) remoteFetchInstance(ctx context.Context, pin common.Pi
 	}
 	if common.InstanceIDFromHash(hash) != pin.InstanceID {
 		err = fmt.Errorf("package hash mismatch")
+		return
+	}
+	if size, err := output.Seek(0, os.SEEK_CUR); err == nil {
+		atomic.AddInt64(&client.downloaded, size)
 	}
 	return
 }
 
 func (client *clientImpl) FetchAndDeployInstance(ctx context.Context, subdir string, pin common.Pin) error {
+	_, err := client.fetchAndDeployInstance(ctx, subdir, pin)
+	return err
+}
+
+// fetchAndDeployInstance implements FetchAndDeployInstance.
+//
+// Returns the number of bytes reused from the deployed files by a differential
+// update.
+func (client *clientImpl) fetchAndDeployInstance(ctx context.Context, subdir string, pin common.Pin) (uint64, error) {
 	if err := common.ValidateSubdir(subdir); err != nil {
-		return err
+		return 0, err
 	}
 	if err := common.ValidatePin(pin); err != nil {
-		return err
+		return 0, err
+	}
+
+	// Check the signature before fetching anything.
+	if !client.TrustedKeys.Empty() {
+		if err := client.verifySignature(ctx, pin); err != nil {
+			return 0, err
+		}
+	} else if client.DifferentialUpdates {
+		switch reused, err := client.deployDifferential(ctx, subdir, pin); {
+		case err != nil:
+			logging.Warningf(ctx, "cipd: differential update to %s failed, fetching the whole instance - %s", pin, err)
+		case reused != 0:
+			return reused, nil
+		}
 	}
 
 	// Fetch the package (verifying its hash) and obtain a pointer to its data.
 	instanceFile, err := client.FetchInstance(ctx, pin)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	defer func() {
@@ -1282,7 +1428,7 @@ func (client *clientImpl) FetchAndDeployInstance(ctx context.Context, subdir str
 	// the hash already, so skip verification.
 	instance, err := local.OpenInstance(ctx, instanceFile, pin.InstanceID, local.SkipHashVerification)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	// Opportunistically clean up trashed files.
@@ -1290,7 +1436,83 @@ func (client *clientImpl) FetchAndDeployInstance(ctx context.Context, subdir str
 
 	// Deploy it. 'defer' will take care of removing the temp file if needed.
 	_, err = client.deployer.DeployInstance(ctx, subdir, instance)
-	return err
+	return 0, err
+}
+
+// deployDifferential updates a deployed package to the given instance, reusing
+// the deployed files identical to the files of the instance and fetching only
+// the other ones.
+//
+// Returns 0 without deploying anything if there's nothing to reuse, if the
+// instance is in the instance cache, if its manifest has no file hashes or if
+// the storage doesn't support range requests.
+func (client *clientImpl) deployDifferential(ctx context.Context, subdir string, pin common.Pin) (uint64, error) {
+	if _, err := client.deployer.CheckDeployed(ctx, subdir, pin.PackageName); err != nil {
+		return 0, nil // not deployed yet
+	}
+	if cache := client.getInstanceCache(ctx); cache != nil {
+		if file, err := cache.Get(ctx, pin, clock.Now(ctx)); err == nil {
+			file.Close()
+			return 0, nil
+		}
+	}
+
+	fetchInfo, err := client.remote.fetchInstance(ctx, pin)
+	if err != nil {
+		return 0, err
+	}
+	data, err := client.storage.openRanges(ctx, fetchInfo.fetchURL)
+	switch {
+	case err == errRangesUnsupported:
+		logging.Debugf(ctx, "cipd: the storage doesn't support range requests")
+		return 0, nil
+	case err != nil:
+		return 0, err
+	}
+	defer func() {
+		atomic.AddInt64(&client.downloaded, data.fetched())
+	}()
+
+	// The hash can't be verified without fetching everything, so the instance
+	// is trusted as fetched. Files are only reused if the manifest has their
+	// SHA1 hash, and all the files, reused or read from the zip, are checked
+	// against it when deploying, which catches local modifications only.
+	instance, err := local.OpenInstance(ctx, data, pin.InstanceID, local.SkipHashVerification)
+	if err != nil {
+		return 0, err
+	}
+	if name := instance.Pin().PackageName; name != pin.PackageName {
+		return 0, fmt.Errorf("the instance is for package %q", name)
+	}
+	instance, reused, err := client.deployer.ReuseDeployed(ctx, subdir, instance)
+	if err != nil || reused == 0 {
+		return 0, err
+	}
+
+	defer client.doBatchAwareOp(ctx, batchAwareOpCleanupTrash)
+	if _, err := client.deployer.DeployInstance(ctx, subdir, instance); err != nil {
+		return 0, err
+	}
+	logging.Infof(ctx, "cipd: deployed %s, fetched %d bytes and reused %d bytes", pin, data.fetched(), reused)
+	return reused, nil
+}
+
+// verifySignature checks the instance has a valid signature by one of the
+// trusted keys.
+func (client *clientImpl) verifySignature(ctx context.Context, pin common.Pin) error {
+	tags, err := client.remote.fetchTags(ctx, pin, nil)
+	if err != nil {
+		return err
+	}
+	values := make([]string, len(tags))
+	for i, t := range tags {
+		values[i] = t.Tag
+	}
+	if err := client.TrustedKeys.Verify(pin, values); err != nil {
+		return fmt.Errorf("refusing to deploy %s - %s", pin, err)
+	}
+	logging.Infof(ctx, "cipd: verified the signature of %s", pin)
+	return nil
 }
 
 func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.PinSliceBySubdir, dryRun bool) (aMap ActionMap, err error) {
@@ -1353,7 +1575,11 @@ func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.Pin
 			if !toDeploy[pin.PackageName] {
 				continue
 			}
-			err = client.FetchAndDeployInstance(ctx, subdir, pin)
+			downloaded := atomic.LoadInt64(&client.downloaded)
+			var reused uint64
+			reused, err = client.fetchAndDeployInstance(ctx, subdir, pin)
+			actions.BytesDownloaded += uint64(atomic.LoadInt64(&client.downloaded) - downloaded)
+			actions.BytesReused += reused
 			if err != nil {
 				logging.Errorf(ctx, "Failed to install %s - %s", pin, err)
 				hasErrors = true
@@ -1377,6 +1603,89 @@ func (client *clientImpl) EnsurePackages(ctx context.Context, allPins common.Pin
 	return
 }
 
+func (client *clientImpl) VerifyRoot(ctx context.Context, repair bool) ([]PackageDrift, error) {
+	client.BeginBatch(ctx)
+	defer client.EndBatch(ctx)
+
+	deployed, err := client.deployer.FindDeployed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	subdirs := make([]string, 0, len(deployed))
+	for subdir := range deployed {
+		subdirs = append(subdirs, subdir)
+	}
+	sort.Strings(subdirs)
+
+	hasErrors := false
+	out := []PackageDrift{}
+	for _, subdir := range subdirs {
+		for _, pin := range deployed[subdir] {
+			files, err := client.deployer.VerifyDeployed(ctx, subdir, pin.PackageName)
+			if err == nil && len(files) == 0 {
+				continue
+			}
+			drift := PackageDrift{Subdir: subdir, Pin: pin, Files: files}
+			if err == nil && repair {
+				err = client.repairDeployed(ctx, subdir, pin, files)
+				drift.Repaired = err == nil
+			}
+			if err != nil {
+				logging.Errorf(ctx, "Failed to verify %s - %s (subdir %q)", pin, err, subdir)
+				hasErrors = true
+				drift.Error = err.Error()
+			}
+			out = append(out, drift)
+		}
+	}
+
+	if hasErrors {
+		err = ErrVerifyRootFailed
+	}
+	return out, err
+}
+
+// repairDeployed restores the drifted files of a deployed package instance.
+func (client *clientImpl) repairDeployed(ctx context.Context, subdir string, pin common.Pin, drift []local.FileDrift) error {
+	if !client.TrustedKeys.Empty() {
+		if err := client.verifySignature(ctx, pin); err != nil {
+			return err
+		}
+	}
+
+	instanceFile, err := client.FetchInstance(ctx, pin)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := instanceFile.Close(); err != nil && err != os.ErrClosed {
+			logging.Warningf(ctx, "cipd: failed to close the package file - %s", err)
+		}
+	}()
+	instance, err := local.OpenInstance(ctx, instanceFile, pin.InstanceID, local.SkipHashVerification)
+	if err != nil {
+		return err
+	}
+
+	files := make([]string, len(drift))
+	for i, f := range drift {
+		files[i] = f.Name
+	}
+	if err := client.deployer.RepairDeployed(ctx, subdir, instance, files); err != nil {
+		return err
+	}
+
+	// Make sure it's all right now.
+	switch left, err := client.deployer.VerifyDeployed(ctx, subdir, pin.PackageName); {
+	case err != nil:
+		return err
+	case len(left) != 0:
+		return fmt.Errorf("%d file(s) still don't match the manifest after the repair", len(left))
+	}
+	logging.Infof(ctx, "Repaired %s", pin)
+	return nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Private structs and interfaces.
 
@@ -1409,6 +1718,10 @@ type remote interface {
 type storage interface {
 	upload(ctx context.Context, url string, data io.ReadSeeker) error
 	download(ctx context.Context, url string, output io.WriteSeeker, h hash.Hash) error
+
+	// openRanges opens a file for random access, fetching only the parts that
+	// are read. Returns errRangesUnsupported if the storage can't do it.
+	openRanges(ctx context.Context, url string) (rangeReader, error)
 }
 
 type registerInstanceResponse struct {
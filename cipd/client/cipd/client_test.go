@@ -6,6 +6,9 @@ package cipd
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"fmt"
 	"hash"
 	"io"
@@ -15,6 +18,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,6 +31,7 @@ import (
 	. "github.com/luci/luci-go/cipd/client/cipd/common"
 	"github.com/luci/luci-go/cipd/client/cipd/internal"
 	"github.com/luci/luci-go/cipd/client/cipd/local"
+	"github.com/luci/luci-go/cipd/client/cipd/signing"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -542,6 +547,26 @@ func TestFetch(t *testing.T) {
 			reader.Close()
 		})
 
+		Convey("FetchInstance (corrupted cache)", func() {
+			client.CacheDir = filepath.Join(tempDir, "instance_cache")
+
+			// Another process left garbage in the shared cache.
+			cache := internal.NewInstanceCache(local.NewFileSystem(filepath.Join(client.CacheDir, "instances"), ""))
+			err := cache.Put(ctx, inst.Pin(), clock.Now(ctx), func(f *os.File) error {
+				_, err := f.WriteString("garbage")
+				return err
+			})
+			So(err, ShouldBeNil)
+
+			// It is detected and the instance is fetched again.
+			reader, err := client.FetchInstance(ctx, inst.Pin())
+			So(err, ShouldBeNil)
+			defer reader.Close()
+			fetched, err := local.OpenInstance(ctx, reader, "", local.VerifyHash)
+			So(err, ShouldBeNil)
+			So(fetched.Pin(), ShouldResemble, inst.Pin())
+		})
+
 		Convey("FetchInstanceTo (no cache)", func() {
 			tempFile := filepath.Join(tempDir, "pkg")
 			out, err := os.OpenFile(tempFile, os.O_WRONLY|os.O_CREATE, 0666)
@@ -589,6 +614,162 @@ func TestFetch(t *testing.T) {
 	})
 }
 
+func TestFetchAndDeploySigned(t *testing.T) {
+	ctx := makeTestContext()
+
+	Convey("With trusted keys", t, func(c C) {
+		tempDir, err := ioutil.TempDir("", "cipd_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+
+		inst := buildInstanceInMemory(ctx, "testing/package", []local.File{
+			local.NewTestFile("file", "test data", false),
+		})
+		pin := inst.Pin()
+
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		So(err, ShouldBeNil)
+		signer, err := signing.NewSigner(key)
+		So(err, ShouldBeNil)
+		trusted := &signing.KeyRing{}
+		_, err = trusted.Add(&key.PublicKey)
+		So(err, ShouldBeNil)
+
+		tagsCall := func(tags ...string) expectedHTTPCall {
+			reply := make([]string, len(tags))
+			for i, t := range tags {
+				reply[i] = fmt.Sprintf(`{"tag": %q, "registered_by": "user:a@example.com", "registered_ts": "0"}`, t)
+			}
+			return expectedHTTPCall{
+				Method: "GET",
+				Path:   "/_ah/api/repo/v1/tags",
+				Query: url.Values{
+					"instance_id":  []string{pin.InstanceID},
+					"package_name": []string{pin.PackageName},
+				},
+				Reply: fmt.Sprintf(`{"status": "SUCCESS", "tags": [%s]}`, strings.Join(reply, ",")),
+			}
+		}
+
+		Convey("deploys signed instances", func() {
+			sig, err := signer.Sign(pin)
+			So(err, ShouldBeNil)
+			client := mockClient(c, tempDir, []expectedHTTPCall{
+				tagsCall("a:b", sig),
+				{
+					Method: "GET",
+					Path:   "/_ah/api/repo/v1/instance",
+					Query: url.Values{
+						"instance_id":  []string{pin.InstanceID},
+						"package_name": []string{pin.PackageName},
+					},
+					Reply: fmt.Sprintf(`{
+						"status": "SUCCESS",
+						"instance": {
+							"registered_by": "user:a@example.com",
+							"registered_ts": "0"
+						},
+						"fetch_url": "http://localhost/fetch/%s"
+					}`, pin.InstanceID),
+				},
+			})
+			r := inst.DataReader()
+			_, err = r.Seek(0, os.SEEK_SET)
+			So(err, ShouldBeNil)
+			blob, err := ioutil.ReadAll(r)
+			So(err, ShouldBeNil)
+			client.storage = &mockedStorage{c, map[string][]byte{
+				"http://localhost/fetch/" + pin.InstanceID: blob,
+			}}
+			client.TrustedKeys = trusted
+
+			So(client.FetchAndDeployInstance(ctx, "", pin), ShouldBeNil)
+			data, err := ioutil.ReadFile(filepath.Join(tempDir, "file"))
+			So(err, ShouldBeNil)
+			So(data, ShouldResemble, []byte("test data"))
+		})
+
+		Convey("refuses unsigned instances", func() {
+			client := mockClient(c, tempDir, []expectedHTTPCall{tagsCall("a:b")})
+			client.TrustedKeys = trusted
+
+			err := client.FetchAndDeployInstance(ctx, "", pin)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, signing.ErrUnsigned.Error())
+		})
+
+		Convey("refuses mis-signed instances", func() {
+			other := buildInstanceInMemory(ctx, "testing/package", []local.File{
+				local.NewTestFile("file", "other data", false),
+			})
+			sig, err := signer.Sign(other.Pin())
+			So(err, ShouldBeNil)
+			client := mockClient(c, tempDir, []expectedHTTPCall{tagsCall(sig)})
+			client.TrustedKeys = trusted
+
+			err = client.FetchAndDeployInstance(ctx, "", pin)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "invalid signature")
+		})
+	})
+}
+
+func TestVerifyRoot(t *testing.T) {
+	ctx := makeTestContext()
+
+	Convey("Mocking remote services", t, func(c C) {
+		tempDir, err := ioutil.TempDir("", "cipd_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+
+		inst := buildInstanceInMemory(ctx, "testing/package", []local.File{
+			local.NewTestFile("file", "test data", false),
+			local.NewTestFile("other", "other data", false),
+		})
+
+		client := mockClientForFetch(c, tempDir, []local.PackageInstance{inst})
+		So(client.FetchAndDeployInstance(ctx, "subdir", inst.Pin()), ShouldBeNil)
+
+		// Simulate a truncated file.
+		path, err := filepath.EvalSymlinks(filepath.Join(tempDir, "subdir", "file"))
+		So(err, ShouldBeNil)
+		So(os.Remove(path), ShouldBeNil)
+		So(ioutil.WriteFile(path, []byte("test"), 0444), ShouldBeNil)
+
+		expected := PackageDrift{
+			Subdir: "subdir",
+			Pin:    inst.Pin(),
+			Files: []local.FileDrift{
+				{Name: "file", Kind: local.DriftModified, Details: "size is 4 instead of 9"},
+			},
+		}
+
+		Convey("VerifyRoot reports drift", func() {
+			client := mockClientForFetch(c, tempDir, nil)
+			drift, err := client.VerifyRoot(ctx, false)
+			So(err, ShouldBeNil)
+			So(drift, ShouldResemble, []PackageDrift{expected})
+		})
+
+		Convey("VerifyRoot repairs drift", func() {
+			// Fetches the instance again.
+			client := mockClientForFetch(c, tempDir, []local.PackageInstance{inst})
+			drift, err := client.VerifyRoot(ctx, true)
+			So(err, ShouldBeNil)
+			expected.Repaired = true
+			So(drift, ShouldResemble, []PackageDrift{expected})
+
+			data, err := ioutil.ReadFile(filepath.Join(tempDir, "subdir", "file"))
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, "test data")
+
+			drift, err = client.VerifyRoot(ctx, false)
+			So(err, ShouldBeNil)
+			So(drift, ShouldResemble, []PackageDrift{})
+		})
+	})
+}
+
 func TestMaybeUpdateClient(t *testing.T) {
 	ctx := makeTestContext()
 
@@ -657,6 +838,14 @@ func TestEnsurePackages(t *testing.T) {
 			pil := func(insts ...local.PackageInstance) []local.PackageInstance {
 				return insts
 			}
+			size := func(insts ...local.PackageInstance) (total uint64) {
+				for _, inst := range insts {
+					n, err := inst.DataReader().Seek(0, os.SEEK_END)
+					So(err, ShouldBeNil)
+					total += uint64(n)
+				}
+				return
+			}
 
 			// Calls EnsurePackages, mocking fetch backend first. Backend will be mocked
 			// to serve only 'fetched' packages. callEnsure will ensure the state
@@ -698,7 +887,7 @@ func TestEnsurePackages(t *testing.T) {
 			actions, err = callEnsure(a1)
 			So(err, ShouldBeNil)
 			So(actions, ShouldResemble, ActionMap{
-				"": &Actions{ToInstall: PinSlice{a1.Pin()}},
+				"": &Actions{ToInstall: PinSlice{a1.Pin()}, BytesDownloaded: size(a1)},
 			})
 			So("file a 1", shouldHaveContent, "test data")
 			So(PinSliceBySubdir{
@@ -712,7 +901,7 @@ func TestEnsurePackages(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(actions, ShouldResemble, ActionMap{
 				"":       &Actions{ToRemove: PinSlice{a1.Pin()}},
-				"subdir": &Actions{ToInstall: PinSlice{a1.Pin()}},
+				"subdir": &Actions{ToInstall: PinSlice{a1.Pin()}, BytesDownloaded: size(a1)},
 			})
 			So("subdir/file a 1", shouldHaveContent, "test data")
 			So(PinSliceBySubdir{
@@ -733,7 +922,7 @@ func TestEnsurePackages(t *testing.T) {
 			actions, err = callEnsure(a1)
 			So(err, ShouldBeNil)
 			So(actions, ShouldResemble, ActionMap{
-				"": &Actions{ToInstall: PinSlice{a1.Pin()}},
+				"": &Actions{ToInstall: PinSlice{a1.Pin()}, BytesDownloaded: size(a1)},
 			})
 			So("subdir/file a 1", shouldHaveContent, "test data")
 			So("file a 1", shouldHaveContent, "test data")
@@ -752,7 +941,7 @@ func TestEnsurePackages(t *testing.T) {
 						From: a1.Pin(),
 						To:   a2.Pin(),
 					},
-				}},
+				}, BytesDownloaded: size(a2)},
 			})
 			So("file a 2", shouldHaveContent, "test data")
 			So(PinSliceBySubdir{
@@ -766,8 +955,9 @@ func TestEnsurePackages(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(actions, ShouldResemble, ActionMap{
 				"": &Actions{
-					ToInstall: PinSlice{b.Pin()},
-					ToRemove:  PinSlice{a2.Pin()},
+					ToInstall:       PinSlice{b.Pin()},
+					ToRemove:        PinSlice{a2.Pin()},
+					BytesDownloaded: size(b),
 				},
 			})
 			So("file b", shouldHaveContent, "test data")
@@ -806,7 +996,8 @@ func TestEnsurePackages(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(actions, ShouldResemble, ActionMap{
 				"": &Actions{
-					ToInstall: PinSlice{a1.Pin(), b.Pin()},
+					ToInstall:       PinSlice{a1.Pin(), b.Pin()},
+					BytesDownloaded: size(a1, b),
 				},
 			})
 			So("file a 1", shouldHaveContent, "test data")
@@ -815,6 +1006,35 @@ func TestEnsurePackages(t *testing.T) {
 				"": PinSlice{a1.Pin(), b.Pin()},
 			}, shouldBeDeployed)
 		})
+
+		Convey("EnsurePackages differential update", func(c C) {
+			// Incompressible data, to make the instances big.
+			blob := make([]byte, 100000)
+			_, err := rand.Read(blob)
+			So(err, ShouldBeNil)
+			a1 := buildInstanceWithHashes(ctx, "pkg/a", []local.File{
+				local.NewTestFile("big", string(blob), false),
+				local.NewTestFile("small", "data 1", false),
+			})
+			a2 := buildInstanceWithHashes(ctx, "pkg/a", []local.File{
+				local.NewTestFile("big", string(blob), false),
+				local.NewTestFile("small", "data 2", false),
+			})
+
+			client := mockClientForFetch(c, tempDir, []local.PackageInstance{a1})
+			_, err = client.EnsurePackages(ctx, PinSliceBySubdir{"": PinSlice{a1.Pin()}}, false)
+			So(err, ShouldBeNil)
+
+			client = mockClientForFetch(c, tempDir, []local.PackageInstance{a2})
+			client.DifferentialUpdates = true
+			actions, err := client.EnsurePackages(ctx, PinSliceBySubdir{"": PinSlice{a2.Pin()}}, false)
+			So(err, ShouldBeNil)
+			So(actions[""].BytesReused, ShouldEqual, len(blob))
+			So(actions[""].BytesDownloaded, ShouldBeGreaterThan, 0)
+			So(actions[""].BytesDownloaded, ShouldBeLessThan, len(blob)/10)
+			So("big", shouldHaveContent, string(blob))
+			So("small", shouldHaveContent, "data 2")
+		})
 	})
 }
 
@@ -823,13 +1043,27 @@ func TestEnsurePackages(t *testing.T) {
 // buildInstanceInMemory makes fully functional PackageInstance object that uses
 // memory buffer as a backing store.
 func buildInstanceInMemory(ctx context.Context, pkgName string, files []local.File) local.PackageInstance {
-	out := bytes.Buffer{}
-	err := local.BuildInstance(ctx, local.BuildInstanceOptions{
-		Input:            files,
-		Output:           &out,
-		PackageName:      pkgName,
-		CompressionLevel: 5,
+	return buildInstanceWithOpts(ctx, local.BuildInstanceOptions{
+		Input:       files,
+		PackageName: pkgName,
+	})
+}
+
+// buildInstanceWithHashes is like buildInstanceInMemory, but puts the hashes of
+// the files into the manifest, as needed by differential updates.
+func buildInstanceWithHashes(ctx context.Context, pkgName string, files []local.File) local.PackageInstance {
+	return buildInstanceWithOpts(ctx, local.BuildInstanceOptions{
+		Input:       files,
+		PackageName: pkgName,
+		FileHashes:  true,
 	})
+}
+
+func buildInstanceWithOpts(ctx context.Context, opts local.BuildInstanceOptions) local.PackageInstance {
+	out := bytes.Buffer{}
+	opts.Output = &out
+	opts.CompressionLevel = 5
+	err := local.BuildInstance(ctx, opts)
 	So(err, ShouldBeNil)
 	inst, err := local.OpenInstance(ctx, bytes.NewReader(out.Bytes()), "", local.VerifyHash)
 	So(err, ShouldBeNil)
@@ -904,6 +1138,28 @@ func (s *mockedStorage) upload(ctx context.Context, url string, data io.ReadSeek
 	return nil
 }
 
+func (s *mockedStorage) openRanges(ctx context.Context, url string) (rangeReader, error) {
+	blob, ok := s.data[url]
+	if !ok {
+		return nil, ErrDownloadError
+	}
+	return &mockedRangeReader{Reader: bytes.NewReader(blob)}, nil
+}
+
+// mockedRangeReader counts the bytes read with ReadAt.
+type mockedRangeReader struct {
+	*bytes.Reader
+	total int64
+}
+
+func (r *mockedRangeReader) ReadAt(p []byte, off int64) (int, error) {
+	n, err := r.Reader.ReadAt(p, off)
+	r.total += int64(n)
+	return n, err
+}
+
+func (r *mockedRangeReader) fetched() int64 { return r.total }
+
 ////////////////////////////////////////////////////////////////////////////////
 
 type expectedHTTPCall struct {
@@ -98,6 +98,21 @@ var badEnsureFiles = []struct {
 		"$ServiceURL may only be set once per file",
 	},
 
+	{
+		"bad platform",
+		"$VerifiedPlatform linux",
+		`bad platform "linux", expecting <os>-<arch>`,
+	},
+
+	{
+		"duplicate platform",
+		f(
+			"$VerifiedPlatform linux-amd64 mac-amd64",
+			"$VerifiedPlatform linux-amd64",
+		),
+		"$VerifiedPlatform linux-amd64 is listed more than once",
+	},
+
 	{
 		"bad setting",
 		"$nurbs thingy",
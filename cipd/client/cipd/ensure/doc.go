@@ -24,6 +24,9 @@
 // once per file. The following settings are allowed:
 //   - ServiceURL is the url for the cipd service. It can be used in lieu of
 //     the -service-url command line parameter.
+//   - VerifiedPlatform is a space separated list of `<os>-<arch>` platforms
+//     the file is resolved for by `cipd ensure-file-resolve`. Unlike other
+//     settings, it can be given multiple times.
 //
 // Directives
 //
@@ -69,12 +72,23 @@
 //
 // That's all there is to it.
 //
+// Lockfiles
+//
+// `cipd ensure-file-resolve` expands an ensure file for each of the platforms
+// listed with $VerifiedPlatform (or on the command line), resolves all the
+// versions to instance IDs and writes the result to a JSON lockfile next to the
+// ensure file, named `<ensure file>.lock`. When this lockfile exists,
+// `cipd ensure` deploys the instances it lists for the current platform instead
+// of resolving the versions again. It refuses to use a lockfile that was
+// resolved from a different version of the ensure file.
+//
 // Example
 //
 // Here is an example ensure file which demonstrates all the various features.
 //
 //   # This is an ensure file!
 //   $ServiceURL https://chrome-infra-packages.appspot.com/
+//   $VerifiedPlatform linux-amd64 mac-amd64 windows-386
 //
 //   # This is the cipd client itself
 //   infra/tools/cipd/${os}-${arch}  latest
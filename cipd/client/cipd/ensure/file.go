@@ -24,6 +24,10 @@ type File struct {
 	ServiceURL string
 
 	PackagesBySubdir map[string]PackageSlice
+
+	// VerifiedPlatforms are the platforms the file is expected to be resolved
+	// for by ResolvePlatforms.
+	VerifiedPlatforms []Platform
 }
 
 // ParseFile parses an ensure file from the given reader. See the package docs
@@ -205,6 +209,18 @@ func (f *File) Serialize(w io.Writer) (int, error) {
 			needsNLs = 2
 		}
 
+		if len(f.VerifiedPlatforms) != 0 {
+			platforms := make(PlatformSlice, len(f.VerifiedPlatforms))
+			copy(platforms, f.VerifiedPlatforms)
+			sort.Sort(platforms)
+			for _, p := range platforms {
+				maybeAddNL()
+				fmt.Fprintf(w, "$VerifiedPlatform %s", p)
+				needsNLs = 1
+			}
+			needsNLs = 2
+		}
+
 		keys := make(sort.StringSlice, 0, len(f.PackagesBySubdir))
 		for k := range f.PackagesBySubdir {
 			keys = append(keys, k)
@@ -24,7 +24,7 @@ var fileSerializationTests = []struct {
 
 	{
 		"ServiceURL",
-		&File{"https://something.example.com", nil},
+		&File{"https://something.example.com", nil, nil},
 		f(
 			"$ServiceURL https://something.example.com",
 		),
@@ -37,7 +37,7 @@ var fileSerializationTests = []struct {
 				PackageDef{"some/thing", "version", 0},
 				PackageDef{"some/other_thing", "latest", 0},
 			},
-		}},
+		}, nil},
 		f(
 			"some/other_thing@latest",
 			"some/thing@version",
@@ -54,7 +54,7 @@ var fileSerializationTests = []struct {
 			"path/to dir/with/spaces": {
 				PackageDef{"different/package", "some_tag:thingy", 0},
 			},
-		}},
+		}, nil},
 		f(
 			"$ServiceURL https://some.example.com",
 			"",
@@ -65,6 +65,23 @@ var fileSerializationTests = []struct {
 			"different/package@some_tag:thingy",
 		),
 	},
+
+	{
+		"verified platforms",
+		&File{"https://some.example.com", map[string]PackageSlice{
+			"": {
+				PackageDef{"some/thing/${platform}", "version", 0},
+			},
+		}, []Platform{{"mac", "amd64"}, {"linux", "amd64"}}},
+		f(
+			"$ServiceURL https://some.example.com",
+			"",
+			"$VerifiedPlatform linux-amd64",
+			"$VerifiedPlatform mac-amd64",
+			"",
+			"some/thing/${platform}@version",
+		),
+	},
 }
 
 func TestFileSerialization(t *testing.T) {
@@ -7,6 +7,7 @@ package ensure
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/luci/luci-go/cipd/client/cipd/common"
 )
@@ -40,10 +41,31 @@ func serviceURLParser(_ *itemParserState, f *File, val string) error {
 	return nil
 }
 
+func verifiedPlatformParser(_ *itemParserState, f *File, val string) error {
+	fields := strings.Fields(val)
+	if len(fields) == 0 {
+		return fmt.Errorf("expecting '$VerifiedPlatform <os>-<arch> ...'")
+	}
+	for _, field := range fields {
+		p, err := ParsePlatform(field)
+		if err != nil {
+			return err
+		}
+		for _, existing := range f.VerifiedPlatforms {
+			if existing == p {
+				return fmt.Errorf("$VerifiedPlatform %s is listed more than once", p)
+			}
+		}
+		f.VerifiedPlatforms = append(f.VerifiedPlatforms, p)
+	}
+	return nil
+}
+
 // itemParsers is the main way that the ensure file format is extended. If you
 // need to add a new setting or directive, please add an appropriate function
 // above and then add it to this map.
 var itemParsers = map[string]itemParser{
-	"@subdir":     subdirParser,
-	"$serviceurl": serviceURLParser,
+	"@subdir":           subdirParser,
+	"$serviceurl":       serviceURLParser,
+	"$verifiedplatform": verifiedPlatformParser,
 }
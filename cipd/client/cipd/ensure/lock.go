@@ -0,0 +1,199 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ensure
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+	"github.com/luci/luci-go/common/errors"
+)
+
+// Platform is an os and architecture pair, as used to expand the templates of
+// an ensure file.
+type Platform struct {
+	OS   string
+	Arch string
+}
+
+// ParsePlatform parses a platform in the form "<os>-<arch>", as returned by
+// Platform.String.
+func ParsePlatform(s string) (Platform, error) {
+	chunks := strings.SplitN(s, "-", 2)
+	if len(chunks) != 2 || chunks[0] == "" || chunks[1] == "" {
+		return Platform{}, errors.Reason("bad platform %(platform)q, expecting <os>-<arch>").
+			D("platform", s).Err()
+	}
+	return Platform{chunks[0], chunks[1]}, nil
+}
+
+// CurrentPlatform returns the platform of the host.
+func CurrentPlatform() Platform {
+	args := common.TemplateArgs()
+	return Platform{args["os"], args["arch"]}
+}
+
+func (p Platform) String() string {
+	return p.OS + "-" + p.Arch
+}
+
+// TemplateArgs returns the template args to expand an ensure file for this
+// platform.
+func (p Platform) TemplateArgs() map[string]string {
+	return map[string]string{
+		"os":       p.OS,
+		"arch":     p.Arch,
+		"platform": p.String(),
+	}
+}
+
+// PlatformSlice is a sortable slice of Platform.
+type PlatformSlice []Platform
+
+func (ps PlatformSlice) Len() int           { return len(ps) }
+func (ps PlatformSlice) Less(i, j int) bool { return ps[i].String() < ps[j].String() }
+func (ps PlatformSlice) Swap(i, j int)      { ps[i], ps[j] = ps[j], ps[i] }
+
+// LockFile is an ensure file resolved for several platforms.
+//
+// All the package templates are expanded and all the versions are resolved to
+// instance IDs, so deploying from a LockFile is reproducible.
+type LockFile struct {
+	ServiceURL string `json:"service_url,omitempty"`
+
+	// EnsureFileDigest is the digest of the ensure file the LockFile was
+	// resolved from, as returned by File.Digest.
+	EnsureFileDigest string `json:"ensure_file_digest"`
+
+	// PackagesByPlatform maps a platform, as returned by Platform.String, to
+	// the packages to install on it.
+	PackagesByPlatform map[string]common.PinSliceBySubdir `json:"packages_by_platform"`
+}
+
+// ResolvePlatforms expands the File for each of the platforms and resolves
+// all the versions with the provided VersionResolver.
+//
+// If platforms is empty, the platforms declared with $VerifiedPlatform are
+// used. The resolver is called only once per package and version.
+func (f *File) ResolvePlatforms(rslv VersionResolver, platforms []Platform) (*LockFile, error) {
+	if len(platforms) == 0 {
+		platforms = f.VerifiedPlatforms
+	}
+	if len(platforms) == 0 {
+		return nil, errors.New("no platform to resolve the ensure file for")
+	}
+
+	resolved := map[[2]string]common.Pin{}
+	cached := func(pkg, vers string) (common.Pin, error) {
+		k := [2]string{pkg, vers}
+		if pin, ok := resolved[k]; ok {
+			return pin, nil
+		}
+		pin, err := rslv(pkg, vers)
+		if err != nil {
+			return common.Pin{}, err
+		}
+		resolved[k] = pin
+		return pin, nil
+	}
+
+	ret := &LockFile{
+		ServiceURL:         f.ServiceURL,
+		EnsureFileDigest:   f.Digest(),
+		PackagesByPlatform: make(map[string]common.PinSliceBySubdir, len(platforms)),
+	}
+	for _, p := range platforms {
+		rf, err := f.ResolveWith(cached, p.TemplateArgs())
+		if err != nil {
+			return nil, errors.Annotate(err).
+				Reason("resolving for platform %(platform)s").
+				D("platform", p).
+				Err()
+		}
+		if rf.PackagesBySubdir == nil {
+			rf.PackagesBySubdir = common.PinSliceBySubdir{}
+		}
+		ret.PackagesByPlatform[p.String()] = rf.PackagesBySubdir
+	}
+	return ret, nil
+}
+
+// Digest returns a digest of the canonical form of the File.
+//
+// Comments and formatting don't change the digest.
+func (f *File) Digest() string {
+	h := sha256.New()
+	if _, err := f.Serialize(h); err != nil {
+		panic(err) // hash.Hash never fails
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ParseLockFile parses a LockFile written by LockFile.Serialize.
+func ParseLockFile(r io.Reader) (*LockFile, error) {
+	ret := &LockFile{}
+	if err := json.NewDecoder(r).Decode(ret); err != nil {
+		return nil, errors.Annotate(err).Reason("bad lockfile").Err()
+	}
+	for platform, pins := range ret.PackagesByPlatform {
+		if _, err := ParsePlatform(platform); err != nil {
+			return nil, errors.Annotate(err).Reason("bad lockfile").Err()
+		}
+		if err := pins.Validate(); err != nil {
+			return nil, errors.Annotate(err).
+				Reason("bad lockfile entry for platform %(platform)s").
+				D("platform", platform).
+				Err()
+		}
+		for _, slice := range pins {
+			for _, pin := range slice {
+				if err := common.ValidateInstanceID(pin.InstanceID); err != nil {
+					return nil, errors.Annotate(err).
+						Reason("bad lockfile entry for platform %(platform)s").
+						D("platform", platform).
+						Err()
+				}
+			}
+		}
+	}
+	return ret, nil
+}
+
+// Serialize writes the LockFile to an io.Writer.
+func (l *LockFile) Serialize(w io.Writer) error {
+	blob, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(blob, '\n'))
+	return err
+}
+
+// Platforms returns the platforms the LockFile was resolved for.
+func (l *LockFile) Platforms() []Platform {
+	ret := make(PlatformSlice, 0, len(l.PackagesByPlatform))
+	for k := range l.PackagesByPlatform {
+		if p, err := ParsePlatform(k); err == nil {
+			ret = append(ret, p)
+		}
+	}
+	sort.Sort(ret)
+	return ret
+}
+
+// ForPlatform returns the packages to install on the platform.
+func (l *LockFile) ForPlatform(p Platform) (*ResolvedFile, error) {
+	pins, ok := l.PackagesByPlatform[p.String()]
+	if !ok {
+		return nil, errors.Reason("the lockfile wasn't resolved for platform %(platform)s").
+			D("platform", p).Err()
+	}
+	return &ResolvedFile{l.ServiceURL, pins}, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package ensure
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLockFile(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given an ensure file", t, func() {
+		ef, err := ParseFile(bytes.NewBufferString(f(
+			"# A comment.",
+			"$ServiceURL https://cipd.example.com",
+			"$VerifiedPlatform linux-amd64 mac-amd64",
+			"$VerifiedPlatform windows-386",
+			"",
+			"some/tool/${platform} latest",
+			"some/posix/${os=linux,mac} latest",
+			"",
+			"@Subdir docs",
+			"some/docs latest",
+		)))
+		So(err, ShouldBeNil)
+		So(ef.VerifiedPlatforms, ShouldResemble, []Platform{
+			{"linux", "amd64"}, {"mac", "amd64"}, {"windows", "386"},
+		})
+
+		calls := map[string]int{}
+		resolver := func(pkg, vers string) (common.Pin, error) {
+			calls[pkg]++
+			return p(pkg, fmt.Sprintf("%040d", len(calls))), nil
+		}
+
+		Convey("ResolvePlatforms uses $VerifiedPlatform", func() {
+			lock, err := ef.ResolvePlatforms(resolver, nil)
+			So(err, ShouldBeNil)
+			So(lock.ServiceURL, ShouldEqual, "https://cipd.example.com")
+			So(lock.EnsureFileDigest, ShouldEqual, ef.Digest())
+			So(lock.Platforms(), ShouldResemble, []Platform{
+				{"linux", "amd64"}, {"mac", "amd64"}, {"windows", "386"},
+			})
+
+			// Each package is resolved only once.
+			for pkg, n := range calls {
+				So(fmt.Sprintf("%s: %d", pkg, n), ShouldEqual, pkg+": 1")
+			}
+			So(len(calls), ShouldEqual, 6)
+
+			win, err := lock.ForPlatform(Platform{"windows", "386"})
+			So(err, ShouldBeNil)
+			So(len(win.PackagesBySubdir[""]), ShouldEqual, 1)
+			So(win.PackagesBySubdir[""][0].PackageName, ShouldEqual, "some/tool/windows-386")
+			So(win.PackagesBySubdir["docs"], ShouldResemble,
+				lock.PackagesByPlatform["linux-amd64"]["docs"])
+
+			_, err = lock.ForPlatform(Platform{"linux", "arm64"})
+			So(err, ShouldErrLike, "wasn't resolved for platform linux-arm64")
+
+			Convey("round trips", func() {
+				buf := &bytes.Buffer{}
+				So(lock.Serialize(buf), ShouldBeNil)
+				parsed, err := ParseLockFile(buf)
+				So(err, ShouldBeNil)
+				So(parsed, ShouldResemble, lock)
+			})
+		})
+
+		Convey("ResolvePlatforms with explicit platforms", func() {
+			lock, err := ef.ResolvePlatforms(resolver, []Platform{{"linux", "armv6l"}})
+			So(err, ShouldBeNil)
+			So(lock.Platforms(), ShouldResemble, []Platform{{"linux", "armv6l"}})
+			So(len(lock.PackagesByPlatform["linux-armv6l"][""]), ShouldEqual, 2)
+		})
+
+		Convey("Digest ignores comments but not packages", func() {
+			same, err := ParseFile(bytes.NewBufferString(f(
+				"$ServiceURL https://cipd.example.com",
+				"$VerifiedPlatform windows-386 mac-amd64 linux-amd64",
+				"@Subdir docs",
+				"some/docs latest",
+				"@Subdir",
+				"some/posix/${os=linux,mac} latest",
+				"some/tool/${platform} latest",
+			)))
+			So(err, ShouldBeNil)
+			So(same.Digest(), ShouldEqual, ef.Digest())
+
+			ef.PackagesBySubdir[""][0].UnresolvedVersion = "stable"
+			So(same.Digest(), ShouldNotEqual, ef.Digest())
+		})
+	})
+
+	Convey("ResolvePlatforms needs platforms", t, func() {
+		ef, err := ParseFile(bytes.NewBufferString("some/pkg latest"))
+		So(err, ShouldBeNil)
+		_, err = ef.ResolvePlatforms(testResolver, nil)
+		So(err, ShouldErrLike, "no platform")
+	})
+
+	Convey("ParseLockFile rejects unresolved versions", t, func() {
+		_, err := ParseLockFile(bytes.NewBufferString(`{
+			"packages_by_platform": {
+				"linux-amd64": {"": [{"package": "some/pkg", "instance_id": "latest"}]}
+			}
+		}`))
+		So(err, ShouldErrLike, "bad lockfile entry for platform linux-amd64")
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/danjacques/gofslock/fslock"
 	"golang.org/x/net/context"
 
 	"github.com/luci/luci-go/common/clock"
@@ -44,10 +45,21 @@ const (
 
 	// instanceCacheStateFilename is a name of the file with InstanceCache proto.
 	instanceCacheStateFilename = "state.db"
+
+	// instanceCacheLockFilename is a name of the file locked while the state
+	// file is updated, since the cache may be shared by several processes.
+	instanceCacheLockFilename = "state.db.lock"
+
+	// instanceCacheLockDelay is how long to wait between attempts to take the
+	// lock.
+	instanceCacheLockDelay = 10 * time.Millisecond
 )
 
 // InstanceCache is a file-system-based, thread-safe, LRU cache of instances.
 //
+// It can be shared by several processes, e.g. to reuse instances across site
+// roots.
+//
 // Does not validate instance hashes; it is caller's responsibility.
 type InstanceCache struct {
 	fs        local.FileSystem
@@ -57,6 +69,8 @@ type InstanceCache struct {
 	maxSize int
 	// Defaults to instanceCacheMaxAge, mocked in tests.
 	maxAge time.Duration
+	// Total size of the instances in bytes, 0 for no limit.
+	maxBytes int64
 }
 
 // NewInstanceCache initializes InstanceCache.
@@ -70,6 +84,17 @@ func NewInstanceCache(fs local.FileSystem) *InstanceCache {
 	}
 }
 
+// SetLimits changes the maximum number of instances and the maximum total size
+// in bytes of the instances.
+//
+// A zero maxInstances keeps the default, a zero maxBytes means no size limit.
+func (c *InstanceCache) SetLimits(maxInstances int, maxBytes int64) {
+	if maxInstances > 0 {
+		c.maxSize = maxInstances
+	}
+	c.maxBytes = maxBytes
+}
+
 // Get searches for the instance in the cache and opens it for reading.
 //
 // If the instance is not found, returns an os.IsNotExists error.
@@ -112,11 +137,31 @@ func (c *InstanceCache) Put(ctx context.Context, pin common.Pin, now time.Time,
 		return err
 	}
 
-	c.withState(ctx, now, func(s *messages.InstanceCache) {
+	return c.withState(ctx, now, func(s *messages.InstanceCache) {
 		touch(s, pin.InstanceID, now)
 		c.gc(ctx, s, now)
 	})
-	return nil
+}
+
+// Delete removes an instance from the cache, e.g. because it is corrupted.
+func (c *InstanceCache) Delete(ctx context.Context, pin common.Pin, now time.Time) error {
+	if err := common.ValidatePin(pin); err != nil {
+		return err
+	}
+	path, err := c.fs.RootRelToAbs(pin.InstanceID)
+	if err != nil {
+		return fmt.Errorf("invalid instance ID %q", pin.InstanceID)
+	}
+	var ret error
+	err = c.withState(ctx, now, func(s *messages.InstanceCache) {
+		if ret = c.fs.EnsureFileGone(ctx, path); ret == nil {
+			delete(s.Entries, pin.InstanceID)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return ret
 }
 
 // GC opportunistically purges entries that haven't been touched for too long.
@@ -149,10 +194,12 @@ func (h *garbageHeap) Pop() interface{} {
 
 // gc cleans up the old instances.
 //
-// There are two cleanup polices acting at the same time:
+// There are three cleanup polices acting at the same time:
 //   1. Instances that haven't been touched for too long are removed.
 //   2. If the number of instances in the state is greater than maximum, oldest
 //      instances are removed.
+//   3. If the total size of the instances is greater than maximum, oldest
+//      instances are removed. The most recently used one is always kept.
 func (c *InstanceCache) gc(ctx context.Context, state *messages.InstanceCache, now time.Time) {
 	// Kick out entries older than some threshold first.
 	garbage := stringset.New(0)
@@ -186,6 +233,10 @@ func (c *InstanceCache) gc(ctx context.Context, state *messages.InstanceCache, n
 		}
 	}
 
+	if c.maxBytes > 0 {
+		c.gcBySize(ctx, state, now, garbage)
+	}
+
 	garbage.Iter(func(instanceID string) bool {
 		path, err := c.fs.RootRelToAbs(instanceID)
 		if err != nil {
@@ -199,6 +250,44 @@ func (c *InstanceCache) gc(ctx context.Context, state *messages.InstanceCache, n
 	})
 }
 
+// gcBySize adds the oldest instances to garbage until the total size of the
+// other instances is below the limit.
+func (c *InstanceCache) gcBySize(ctx context.Context, state *messages.InstanceCache, now time.Time, garbage stringset.Set) {
+	var total int64
+	sizes := make(map[string]int64, len(state.Entries))
+	candidates := make(garbageHeap, 0, len(state.Entries))
+	for instanceID, e := range state.Entries {
+		if garbage.Has(instanceID) {
+			continue
+		}
+		path, err := c.fs.RootRelToAbs(instanceID)
+		if err != nil {
+			panic("impossible")
+		}
+		// Missing files are removed by the next sync.
+		if fi, err := os.Stat(path); err == nil {
+			sizes[instanceID] = fi.Size()
+			total += fi.Size()
+		}
+		candidates = append(candidates, &garbageCandidate{
+			instanceID:     instanceID,
+			lastAccessTime: google.TimeFromProto(e.LastAccess),
+		})
+	}
+	if total <= c.maxBytes {
+		return
+	}
+	logging.Infof(ctx, "cipd: instance cache is %d bytes, more than the limit of %d", total, c.maxBytes)
+	heap.Init(&candidates)
+	for total > c.maxBytes && candidates.Len() > 1 {
+		item := heap.Pop(&candidates).(*garbageCandidate)
+		garbage.Add(item.instanceID)
+		total -= sizes[item.instanceID]
+		logging.Infof(ctx, "cipd: purging cached instance %s (age %s, %d bytes)",
+			item.instanceID, now.Sub(item.lastAccessTime), sizes[item.instanceID])
+	}
+}
+
 // readState loads cache state from the state file.
 // If the file does not exist, corrupted or its state was not synchronized
 // with the instance files for a long time, synchronizes it.
@@ -307,7 +396,10 @@ func (c *InstanceCache) saveState(ctx context.Context, state *messages.InstanceC
 
 // withState loads cache state from the state file, calls f and saves it back.
 // See also readState.
-func (c *InstanceCache) withState(ctx context.Context, now time.Time, f func(*messages.InstanceCache)) {
+//
+// Returns an error, after logging it, if the state file could not be locked.
+// f is not called in that case.
+func (c *InstanceCache) withState(ctx context.Context, now time.Time, f func(*messages.InstanceCache)) error {
 	state := &messages.InstanceCache{}
 
 	start := clock.Now(ctx)
@@ -323,11 +415,32 @@ func (c *InstanceCache) withState(ctx context.Context, now time.Time, f func(*me
 	c.stateLock.Lock()
 	defer c.stateLock.Unlock()
 
-	c.readState(ctx, state, now)
-	f(state)
-	if err := c.saveState(ctx, state); err != nil {
-		logging.Warningf(ctx, "cipd: could not save instance cache - %s", err)
+	update := func() error {
+		c.readState(ctx, state, now)
+		f(state)
+		if err := c.saveState(ctx, state); err != nil {
+			logging.Warningf(ctx, "cipd: could not save instance cache - %s", err)
+		}
+		return nil
 	}
+
+	// Other processes may use the same cache, take the file lock too.
+	lockPath, err := c.fs.RootRelToAbs(instanceCacheLockFilename)
+	if err != nil {
+		panic("impossible")
+	}
+	if err := os.MkdirAll(c.fs.Root(), 0777); err != nil {
+		logging.Warningf(ctx, "cipd: could not create the instance cache - %s", err)
+		return update()
+	}
+	blocker := func() error {
+		return clock.Sleep(ctx, instanceCacheLockDelay).Err
+	}
+	if err := fslock.WithBlocking(lockPath, blocker, update); err != nil {
+		logging.Warningf(ctx, "cipd: could not lock the instance cache - %s", err)
+		return fmt.Errorf("could not lock the instance cache - %s", err)
+	}
+	return nil
 }
 
 // getAccessTime returns last access time of an instance.
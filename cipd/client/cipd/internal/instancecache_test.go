@@ -13,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/danjacques/gofslock/fslock"
 	"golang.org/x/net/context"
 
 	"github.com/luci/luci-go/cipd/client/cipd/common"
@@ -95,7 +96,7 @@ func TestInstanceCache(t *testing.T) {
 
 			files, err := tempDirFile.Readdirnames(0)
 			So(err, ShouldBeNil)
-			So(files, ShouldHaveLength, testInstanceCacheMaxSize+1) // 1 for state.db
+			So(files, ShouldHaveLength, testInstanceCacheMaxSize+2) // state.db and its lock
 
 			// Try to get.
 			for i := 0; i < testInstanceCacheMaxSize*2; i++ {
@@ -134,6 +135,59 @@ func TestInstanceCache(t *testing.T) {
 			So(alive, ShouldResemble, []int{5, 6, 7})
 		})
 
+		Convey("GC respects the size limit", func() {
+			cache.SetLimits(0, 10)
+			for i := 0; i < 5; i++ {
+				put(cache, pini(i), "blah")
+				now = now.Add(time.Second)
+			}
+
+			// Only the last two instances fit.
+			alive := []int{}
+			for i := 0; i < 5; i++ {
+				r, _ := cache.Get(ctx, pini(i), now)
+				if r != nil {
+					r.Close()
+					alive = append(alive, i)
+				}
+			}
+			So(alive, ShouldResemble, []int{3, 4})
+
+			// The last used instance is kept even if it is too large.
+			now = now.Add(time.Second)
+			put(cache, pini(5), "larger than the limit")
+			testHas(cache, pini(5), "larger than the limit")
+		})
+
+		Convey("Delete", func() {
+			pin := pini(0)
+			put(cache, pin, "blah")
+			So(cache.Delete(ctx, pin, now), ShouldBeNil)
+			_, err := cache.Get(ctx, pin, now)
+			So(os.IsNotExist(err), ShouldBeTrue)
+			_, ok := cache.getAccessTime(ctx, now, pin)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Delete fails if the state can't be locked", func() {
+			pin := pini(0)
+			put(cache, pin, "blah")
+
+			h, err := fslock.Lock(filepath.Join(tempDir, instanceCacheLockFilename))
+			So(err, ShouldBeNil)
+
+			cctx, cancel := context.WithCancel(ctx)
+			cancel()
+			So(cache.Delete(cctx, pin, now), ShouldNotBeNil)
+			So(cache.Put(cctx, pin, now, func(f *os.File) error {
+				_, err := f.WriteString("blah")
+				return err
+			}), ShouldNotBeNil)
+
+			So(h.Unlock(), ShouldBeNil)
+			testHas(cache, pin, "blah")
+		})
+
 		Convey("Sync", func() {
 			stateDbPath := filepath.Join(tempDir, instanceCacheStateFilename)
 			const count = 10
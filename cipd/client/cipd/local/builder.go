@@ -8,7 +8,10 @@ import (
 	"archive/zip"
 	"bytes"
 	"compress/flate"
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
 	"io/ioutil"
 	"os"
@@ -40,6 +43,18 @@ type BuildInstanceOptions struct {
 	// InstallMode defines how to install the package: "copy" or "symlink".
 	InstallMode InstallMode
 
+	// PostInstall lists the actions to run when deploying the package.
+	PostInstall []PostInstallAction
+
+	// FileHashes, if true, puts SHA1 hashes of the regular files into the
+	// package manifest. They are verified when the package is extracted and
+	// allow reusing unchanged files when upgrading it.
+	//
+	// The hashes are part of the package, and thus of its instance ID, so they
+	// are opt-in: building the same files without them gives the same instance
+	// ID as older clients.
+	FileHashes bool
+
 	// CompressionLevel defines deflate compression level in range [0-9].
 	CompressionLevel int
 }
@@ -69,16 +84,14 @@ func BuildInstance(ctx context.Context, opts BuildInstanceOptions) error {
 		}
 	}
 
-	// Generate the manifest file, add to the list of input files.
-	manifestFile, err := makeManifestFile(opts)
-	if err != nil {
+	// Check the manifest options before zipping anything.
+	if err := validateManifestOptions(opts); err != nil {
 		return err
 	}
-	files := append(opts.Input, manifestFile)
 
 	// Make sure filenames are unique.
-	seenNames := make(map[string]struct{}, len(files))
-	for _, f := range files {
+	seenNames := make(map[string]struct{}, len(opts.Input))
+	for _, f := range opts.Input {
 		_, seen := seenNames[f.Name()]
 		if seen {
 			return fmt.Errorf("file %s is provided twice", f.Name())
@@ -86,14 +99,25 @@ func BuildInstance(ctx context.Context, opts BuildInstanceOptions) error {
 		seenNames[f.Name()] = struct{}{}
 	}
 
-	// Write the final zip file.
-	return zipInputFiles(ctx, files, opts.Output, opts.CompressionLevel)
+	// Write the final zip file, with the manifest generated once the files (and
+	// their hashes, if requested) are zipped.
+	var hashes map[string]string
+	if opts.FileHashes {
+		hashes = make(map[string]string, len(opts.Input))
+	}
+	return zipInputFiles(ctx, opts.Input, opts.Output, opts.CompressionLevel, hashes, func() (File, error) {
+		return makeManifestFile(opts, hashes)
+	})
 }
 
 // zipInputFiles deterministically builds a zip archive out of input files and
-// writes it to the writer. Files are written in the order given.
-func zipInputFiles(ctx context.Context, files []File, w io.Writer, level int) error {
-	logging.Infof(ctx, "About to zip %d files with compression level %d", len(files), level)
+// the package manifest returned by makeManifest, and writes it to the writer.
+// Files are written in the order given, followed by the manifest.
+//
+// If hashes is not nil, SHA1 hex digests of the regular files are put there
+// while they are zipped, before makeManifest is called.
+func zipInputFiles(ctx context.Context, files []File, w io.Writer, level int, hashes map[string]string, makeManifest func() (File, error)) error {
+	logging.Infof(ctx, "About to zip %d files with compression level %d", len(files)+1, level)
 
 	writer := zip.NewWriter(w)
 	defer writer.Close()
@@ -111,14 +135,7 @@ func zipInputFiles(ctx context.Context, files []File, w io.Writer, level int) er
 		}
 	}
 
-	for i, in := range files {
-		progress(i)
-
-		// Bail out early if context is canceled.
-		if err := ctx.Err(); err != nil {
-			return err
-		}
-
+	zipFile := func(in File, h hash.Hash) error {
 		// Intentionally do not add timestamp or file mode to make zip archive
 		// deterministic. See also zip.FileInfoHeader() implementation.
 		fh := zip.FileHeader{
@@ -144,16 +161,39 @@ func zipInputFiles(ctx context.Context, files []File, w io.Writer, level int) er
 			return err
 		}
 		if in.Symlink() {
-			err = zipSymlinkFile(dst, in)
-		} else {
-			err = zipRegularFile(dst, in)
+			return zipSymlinkFile(dst, in)
 		}
-		if err != nil {
+		if h != nil {
+			dst = io.MultiWriter(dst, h)
+		}
+		return zipRegularFile(dst, in)
+	}
+
+	for i, in := range files {
+		progress(i)
+
+		// Bail out early if context is canceled.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var h hash.Hash
+		if hashes != nil && !in.Symlink() {
+			h = sha1.New()
+		}
+		if err := zipFile(in, h); err != nil {
 			return err
 		}
+		if h != nil {
+			hashes[in.Name()] = hex.EncodeToString(h.Sum(nil))
+		}
 	}
 
-	return nil
+	manifest, err := makeManifest()
+	if err != nil {
+		return err
+	}
+	return zipFile(manifest, nil)
 }
 
 func zipRegularFile(dst io.Writer, f File) error {
@@ -283,21 +323,48 @@ func (m *manifestFile) Open() (io.ReadCloser, error) {
 	return ioutil.NopCloser(bytes.NewReader(*m)), nil
 }
 
-// makeManifestFile generates a package manifest file and returns it as
-// File interface.
-func makeManifestFile(opts BuildInstanceOptions) (File, error) {
+// validateManifestOptions checks the options that go into the package
+// manifest.
+func validateManifestOptions(opts BuildInstanceOptions) error {
 	if opts.VersionFile != "" && !isCleanSlashPath(opts.VersionFile) {
-		return nil, fmt.Errorf("version file path should be a clean path relative to a package root: %s", opts.VersionFile)
+		return fmt.Errorf("version file path should be a clean path relative to a package root: %s", opts.VersionFile)
 	}
 	if err := ValidateInstallMode(opts.InstallMode); err != nil {
+		return err
+	}
+	return ValidatePostInstall(opts.PostInstall)
+}
+
+// makeManifestFile generates a package manifest file and returns it as
+// File interface.
+//
+// If hashes is not nil, it lists SHA1 hex digests of the regular files of
+// opts.Input, to put into the manifest.
+func makeManifestFile(opts BuildInstanceOptions, hashes map[string]string) (File, error) {
+	if err := validateManifestOptions(opts); err != nil {
 		return nil, err
 	}
+	var files []FileInfo
+	if hashes != nil {
+		files = make([]FileInfo, 0, len(hashes))
+		for _, f := range opts.Input {
+			if !f.Symlink() {
+				files = append(files, FileInfo{
+					Name: f.Name(),
+					Size: f.Size(),
+					Hash: hashes[f.Name()],
+				})
+			}
+		}
+	}
 	buf := &bytes.Buffer{}
 	err := writeManifest(&Manifest{
 		FormatVersion: manifestFormatVersion,
 		PackageName:   opts.PackageName,
 		VersionFile:   opts.VersionFile,
 		InstallMode:   opts.InstallMode,
+		PostInstall:   opts.PostInstall,
+		Files:         files,
 	}, buf)
 	if err != nil {
 		return nil, err
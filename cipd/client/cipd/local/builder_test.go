@@ -135,6 +135,57 @@ func TestBuildInstance(t *testing.T) {
 		}
 	})
 
+	Convey("Building package with file hashes", t, func() {
+		input := []File{
+			NewTestFile("testing/qwerty", "12345", false),
+			NewTestFile("abc", "duh", true),
+			NewTestSymlink("rel_symlink", "abc"),
+		}
+		withHashes := bytes.Buffer{}
+		err := BuildInstance(ctx, BuildInstanceOptions{
+			Input:            input,
+			Output:           &withHashes,
+			PackageName:      "testing",
+			CompressionLevel: 5,
+			FileHashes:       true,
+		})
+		So(err, ShouldBeNil)
+
+		goodManifest := `{
+  "format_version": "1",
+  "package_name": "testing",
+  "files": [
+    {
+      "name": "testing/qwerty",
+      "size": 5,
+      "hash": "8cb2237d0679ca88db6464eac60da96345513964"
+    },
+    {
+      "name": "abc",
+      "size": 3,
+      "hash": "1107c34522e2db80f1bc9713b7326bf2855d740a"
+    }
+  ]
+}`
+		files := readZip(withHashes.Bytes())
+		So(files, ShouldHaveLength, 4)
+		So(files[3].name, ShouldEqual, ".cipdpkg/manifest.json")
+		So(string(files[3].body), ShouldEqual, goodManifest)
+
+		// The hashes are opt-in, since they change the instance ID.
+		withoutHashes := bytes.Buffer{}
+		err = BuildInstance(ctx, BuildInstanceOptions{
+			Input:            input,
+			Output:           &withoutHashes,
+			PackageName:      "testing",
+			CompressionLevel: 5,
+		})
+		So(err, ShouldBeNil)
+		So(getSHA1(&withoutHashes), ShouldNotEqual, getSHA1(&withHashes))
+		files = readZip(withoutHashes.Bytes())
+		So(string(files[3].body), ShouldNotContainSubstring, "hash")
+	})
+
 	Convey("Duplicate files fail", t, func() {
 		err := BuildInstance(ctx, BuildInstanceOptions{
 			Input: []File{
@@ -5,7 +5,10 @@
 package local
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/rand"
 	"os"
@@ -82,6 +85,34 @@ type Deployer interface {
 	// RemoveDeployed deletes a package from a subdir given its name.
 	RemoveDeployed(ctx context.Context, subdir, packageName string) error
 
+	// VerifyDeployed checks that the files of a deployed package still match
+	// its manifest.
+	//
+	// It rehashes all the files and returns the ones that were modified, removed
+	// or had their symlinks or permissions changed. Returns an empty slice if
+	// the package is intact. Files deployed by older clients are checked only
+	// by size, since their manifests have no hashes.
+	VerifyDeployed(ctx context.Context, subdir, packageName string) ([]FileDrift, error)
+
+	// RepairDeployed restores the given files of a deployed package from the
+	// package instance.
+	//
+	// The instance must be the one currently deployed. Other files are left
+	// untouched.
+	RepairDeployed(ctx context.Context, subdir string, inst PackageInstance, files []string) error
+
+	// ReuseDeployed returns a PackageInstance that reads the files identical to
+	// the files of the package already deployed in subdir from the disk, and
+	// the total size of these files.
+	//
+	// Only files that still match the manifest of the deployed instance are
+	// reused. Files are matched by their SHA1 hash, as listed in the manifest of
+	// inst, so inst doesn't need to be read entirely; ExtractInstance verifies
+	// all the files against these hashes. Returns inst itself if the package
+	// isn't deployed, if the same instance is deployed or if the manifest of
+	// inst doesn't list the hashes of all its files.
+	ReuseDeployed(ctx context.Context, subdir string, inst PackageInstance) (PackageInstance, uint64, error)
+
 	// TempFile returns os.File located in <base>/.cipd/tmp/*.
 	//
 	// The file is open for reading and writing.
@@ -93,6 +124,25 @@ type Deployer interface {
 	CleanupTrash(ctx context.Context) error
 }
 
+// Kinds of FileDrift.
+const (
+	// DriftMissing is a file missing from the site root.
+	DriftMissing = "missing"
+	// DriftModified is a file whose type, size or content changed.
+	DriftModified = "modified"
+	// DriftSymlink is a symlink pointing to a wrong target.
+	DriftSymlink = "symlink"
+	// DriftMode is a file whose executable bit changed.
+	DriftMode = "mode"
+)
+
+// FileDrift describes a deployed file that doesn't match the package manifest.
+type FileDrift struct {
+	Name    string `json:"name"`              // slash separated path relative to the package root
+	Kind    string `json:"kind"`              // one of Drift* constants
+	Details string `json:"details,omitempty"` // human readable description
+}
+
 // NewDeployer return default Deployer implementation.
 func NewDeployer(root string) Deployer {
 	var err error
@@ -125,8 +175,21 @@ func (d errDeployer) FindDeployed(context.Context) (out common.PinSliceBySubdir,
 	return nil, d.err
 }
 func (d errDeployer) RemoveDeployed(context.Context, string, string) error { return d.err }
-func (d errDeployer) TempFile(context.Context, string) (*os.File, error)   { return nil, d.err }
-func (d errDeployer) CleanupTrash(context.Context) error                   { return d.err }
+
+func (d errDeployer) VerifyDeployed(context.Context, string, string) ([]FileDrift, error) {
+	return nil, d.err
+}
+
+func (d errDeployer) RepairDeployed(context.Context, string, PackageInstance, []string) error {
+	return d.err
+}
+
+func (d errDeployer) ReuseDeployed(context.Context, string, PackageInstance) (PackageInstance, uint64, error) {
+	return nil, 0, d.err
+}
+
+func (d errDeployer) TempFile(context.Context, string) (*os.File, error) { return nil, d.err }
+func (d errDeployer) CleanupTrash(context.Context) error                 { return d.err }
 
 ////////////////////////////////////////////////////////////////////////////////
 // Real deployer implementation.
@@ -192,6 +255,16 @@ func (d *deployerImpl) DeployInstance(ctx context.Context, subdir string, inst P
 		return common.Pin{}, err
 	}
 
+	// Run the post-install actions before the files are added to the site root,
+	// so the files they create are deployed like the others.
+	if len(newManifest.PostInstall) != 0 {
+		if err := d.runPostInstall(ctx, subdir, destPath, &newManifest); err != nil {
+			logging.Errorf(ctx, "Post-install actions of %s failed: %s", pin, err)
+			d.fs.EnsureDirectoryGone(ctx, destPath)
+			return common.Pin{}, err
+		}
+	}
+
 	// Remember currently deployed version (to remove it later). Do not freak out
 	// if it's not there (prevInstanceID == "") or broken (err != nil).
 	prevInstanceID, err := d.getCurrentInstanceID(pkgPath)
@@ -365,6 +438,122 @@ func (d *deployerImpl) RemoveDeployed(ctx context.Context, subdir, packageName s
 	return d.fs.EnsureDirectoryGone(ctx, pkgPath)
 }
 
+func (d *deployerImpl) VerifyDeployed(ctx context.Context, subdir, packageName string) ([]FileDrift, error) {
+	pkgPath, instanceID, err := d.deployedInstance(ctx, subdir, packageName)
+	if err != nil {
+		return nil, err
+	}
+	instanceDir := filepath.Join(pkgPath, instanceID)
+	manifest, err := d.readManifest(ctx, instanceDir)
+	if err != nil {
+		return nil, err
+	}
+	installMode, err := effectiveInstallMode(manifest.InstallMode)
+	if err != nil {
+		return nil, err
+	}
+
+	out := []FileDrift{}
+	for _, f := range manifest.Files {
+		relPath := filepath.FromSlash(f.Name)
+		destAbs, err := d.fs.RootRelToAbs(filepath.Join(subdir, relPath))
+		if err != nil {
+			return nil, err
+		}
+		// In "symlink" mode the site root has a symlink to the file in the package
+		// instance directory, check both.
+		path := destAbs
+		if installMode == InstallModeSymlink {
+			target, err := siteRootSymlinkTarget(destAbs, pkgPath, relPath)
+			if err != nil {
+				return nil, err
+			}
+			switch existing, err := os.Readlink(destAbs); {
+			case os.IsNotExist(err):
+				out = append(out, FileDrift{f.Name, DriftMissing, ""})
+				continue
+			case err != nil:
+				out = append(out, FileDrift{f.Name, DriftSymlink, "not a symlink in the site root"})
+				continue
+			case existing != target:
+				out = append(out, FileDrift{f.Name, DriftSymlink, fmt.Sprintf("points to %q in the site root", existing)})
+				continue
+			}
+			path = filepath.Join(instanceDir, relPath)
+		}
+		drift, err := verifyFile(path, f)
+		if err != nil {
+			return nil, err
+		}
+		if drift != nil {
+			out = append(out, *drift)
+		}
+	}
+	if len(out) != 0 {
+		logging.Warningf(ctx, "%d file(s) of %s in %s(/%s) don't match the manifest", len(out), packageName, d.fs.Root(), subdir)
+	}
+	return out, nil
+}
+
+func (d *deployerImpl) RepairDeployed(ctx context.Context, subdir string, inst PackageInstance, files []string) error {
+	pin := inst.Pin()
+	pkgPath, instanceID, err := d.deployedInstance(ctx, subdir, pin.PackageName)
+	if err != nil {
+		return err
+	}
+	if instanceID != pin.InstanceID {
+		return fmt.Errorf("can't repair %s, instance %s is deployed instead", pin, instanceID)
+	}
+	instanceDir := filepath.Join(pkgPath, instanceID)
+	manifest, err := d.readManifest(ctx, instanceDir)
+	if err != nil {
+		return err
+	}
+	installMode, err := effectiveInstallMode(manifest.InstallMode)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]File, len(inst.Files()))
+	for _, f := range inst.Files() {
+		byName[f.Name()] = f
+	}
+
+	// The manifest may differ from the instance files if post-install actions
+	// were run.
+	infos := make(map[string]FileInfo, len(manifest.Files))
+	for _, f := range manifest.Files {
+		infos[f.Name] = f
+	}
+
+	logging.Infof(ctx, "Repairing %d file(s) of %s in %s(/%s)", len(files), pin, d.fs.Root(), subdir)
+	for _, name := range files {
+		if infos[name].Generated {
+			return fmt.Errorf("%q was created by a post-install action of %s, reinstall the package to restore it", name, pin)
+		}
+		f := byName[name]
+		if f == nil {
+			return fmt.Errorf("no file %q in %s", name, pin)
+		}
+		path, err := d.installedFilePath(subdir, installMode, instanceDir, name)
+		if err != nil {
+			return err
+		}
+		executable := f.Executable() || infos[name].Executable
+		if err := d.restoreFile(ctx, path, f, executable); err != nil {
+			logging.Warningf(ctx, "Failed to restore %s: %s", path, err)
+			return err
+		}
+		if installMode == InstallModeSymlink {
+			fi := []FileInfo{{Name: name}}
+			if err := d.addToSiteRoot(ctx, subdir, fi, installMode, pkgPath, instanceDir); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (d *deployerImpl) TempFile(ctx context.Context, prefix string) (*os.File, error) {
 	dir, err := d.fs.EnsureDirectory(ctx, filepath.Join(d.fs.Root(), SiteServiceDir, "tmp"))
 	if err != nil {
@@ -717,16 +906,63 @@ func (d *deployerImpl) readManifest(ctx context.Context, instanceDir string) (Ma
 	return manifest, nil
 }
 
+// deployedInstance returns the package directory and the current instance ID
+// of a deployed package, or an error if it is not deployed.
+func (d *deployerImpl) deployedInstance(ctx context.Context, subdir, pkg string) (pkgPath, instanceID string, err error) {
+	if err = common.ValidateSubdir(subdir); err != nil {
+		return
+	}
+	if err = common.ValidatePackageName(pkg); err != nil {
+		return
+	}
+	if pkgPath, err = d.packagePath(ctx, subdir, pkg, false); err != nil {
+		return
+	}
+	if pkgPath != "" {
+		instanceID, err = d.getCurrentInstanceID(pkgPath)
+	}
+	if err == nil && instanceID == "" {
+		err = fmt.Errorf("package %s is not installed", pkg)
+	}
+	return
+}
+
+// restoreFile atomically replaces a file at the given path with a file from
+// a package instance.
+func (d *deployerImpl) restoreFile(ctx context.Context, path string, f File, executable bool) error {
+	if f.Symlink() {
+		target, err := f.SymlinkTarget()
+		if err != nil {
+			return err
+		}
+		return d.fs.EnsureSymlink(ctx, path, filepath.FromSlash(target))
+	}
+	// Same modes as used by fileSystemDestination.
+	mode := os.FileMode(0444)
+	if executable {
+		mode = 0555
+	}
+	return d.fs.EnsureFile(ctx, path, func(out *os.File) error {
+		in, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		if _, err = io.Copy(out, in); err != nil {
+			return err
+		}
+		if err = out.Chmod(mode); err != nil {
+			return err
+		}
+		return setWinFileAttributes(out.Name(), f.WinAttrs())
+	})
+}
+
 // addToSiteRoot moves or symlinks files into the site root directory (depending
 // on passed installMode).
 func (d *deployerImpl) addToSiteRoot(ctx context.Context, subdir string, files []FileInfo, installMode InstallMode, pkgDir, srcDir string) error {
-	// On Windows only InstallModeCopy is supported.
-	if runtime.GOOS == "windows" {
-		installMode = InstallModeCopy
-	} else if installMode == "" {
-		installMode = InstallModeSymlink // default on non-Windows
-	}
-	if err := ValidateInstallMode(installMode); err != nil {
+	installMode, err := effectiveInstallMode(installMode)
+	if err != nil {
 		return err
 	}
 
@@ -740,15 +976,9 @@ func (d *deployerImpl) addToSiteRoot(ctx context.Context, subdir string, files [
 			return err
 		}
 		if installMode == InstallModeSymlink {
-			// e.g. <base>/.cipd/pkgs/name/_current/bin/tool
-			targetAbs := filepath.Join(pkgDir, currentSymlink, relPath)
-			// e.g. ../.cipd/pkgs/name/_current/bin/tool
-			// has more `../` depending on subdir
-			targetRel, err := filepath.Rel(filepath.Dir(destAbs), targetAbs)
+			targetRel, err := siteRootSymlinkTarget(destAbs, pkgDir, relPath)
 			if err != nil {
-				logging.Warningf(
-					ctx, "Can't get relative path from %s to %s",
-					filepath.Dir(destAbs), targetAbs)
+				logging.Warningf(ctx, "Can't get relative path from %s to the package: %s", destAbs, err)
 				return err
 			}
 			if err = d.fs.EnsureSymlink(ctx, destAbs, targetRel); err != nil {
@@ -802,6 +1032,92 @@ func (d *deployerImpl) removeEmptyDirs(ctx context.Context, root string) {
 ////////////////////////////////////////////////////////////////////////////////
 // Utility functions.
 
+// effectiveInstallMode returns the install mode to use on this platform for
+// a package with the given install mode in its manifest.
+func effectiveInstallMode(installMode InstallMode) (InstallMode, error) {
+	// On Windows only InstallModeCopy is supported.
+	if runtime.GOOS == "windows" {
+		installMode = InstallModeCopy
+	} else if installMode == "" {
+		installMode = InstallModeSymlink // default on non-Windows
+	}
+	if err := ValidateInstallMode(installMode); err != nil {
+		return "", err
+	}
+	return installMode, nil
+}
+
+// siteRootSymlinkTarget returns the target of the site root symlink destAbs to
+// a file of a package deployed in "symlink" mode.
+func siteRootSymlinkTarget(destAbs, pkgDir, relPath string) (string, error) {
+	// e.g. <base>/.cipd/pkgs/name/_current/bin/tool
+	targetAbs := filepath.Join(pkgDir, currentSymlink, relPath)
+	// e.g. ../.cipd/pkgs/name/_current/bin/tool
+	// has more `../` depending on subdir
+	return filepath.Rel(filepath.Dir(destAbs), targetAbs)
+}
+
+// verifyFile compares a file on disk with its manifest entry.
+//
+// It returns nil if they match.
+func verifyFile(path string, f FileInfo) (*FileDrift, error) {
+	info, err := os.Lstat(path)
+	switch {
+	case os.IsNotExist(err):
+		return &FileDrift{f.Name, DriftMissing, ""}, nil
+	case err != nil:
+		return nil, err
+	}
+
+	isSymlink := info.Mode()&os.ModeSymlink != 0
+	if f.Symlink != "" {
+		if !isSymlink {
+			return &FileDrift{f.Name, DriftModified, "not a symlink"}, nil
+		}
+		target, err := os.Readlink(path)
+		if err != nil {
+			return nil, err
+		}
+		if target != filepath.FromSlash(f.Symlink) {
+			return &FileDrift{f.Name, DriftSymlink, fmt.Sprintf("points to %q instead of %q", target, f.Symlink)}, nil
+		}
+		return nil, nil
+	}
+
+	if !info.Mode().IsRegular() {
+		return &FileDrift{f.Name, DriftModified, "not a regular file"}, nil
+	}
+	if uint64(info.Size()) != f.Size {
+		return &FileDrift{f.Name, DriftModified, fmt.Sprintf("size is %d instead of %d", info.Size(), f.Size)}, nil
+	}
+	if f.Hash != "" {
+		switch hash, err := hashFile(path); {
+		case err != nil:
+			return nil, err
+		case hash != f.Hash:
+			return &FileDrift{f.Name, DriftModified, "content doesn't match"}, nil
+		}
+	}
+	if runtime.GOOS != "windows" && (info.Mode().Perm()&0111 != 0) != f.Executable {
+		return &FileDrift{f.Name, DriftMode, fmt.Sprintf("mode is %s", info.Mode().Perm())}, nil
+	}
+	return nil, nil
+}
+
+// hashFile returns the hex SHA1 digest of a file content.
+func hashFile(path string) (string, error) {
+	r, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	h := sha1.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // scanPackageDir finds a set of regular files (and symlinks) in a package
 // instance directory and returns them as FileInfo structs (with slash-separated
 // paths relative to dir directory). Skips package service directories (.cipdpkg
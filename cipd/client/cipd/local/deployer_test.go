@@ -1324,6 +1324,122 @@ func TestRemoveDeployedWindows(t *testing.T) {
 	})
 }
 
+func TestVerifyDeployedPosix(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on windows")
+	}
+
+	ctx := context.Background()
+
+	Convey("Given a temp directory", t, func() {
+		tempDir := mkTempDir()
+		d := NewDeployer(tempDir)
+
+		// overwrite replaces a deployed file, following site root symlinks.
+		overwrite := func(rel, body string, mode os.FileMode) {
+			abs, err := filepath.EvalSymlinks(filepath.Join(tempDir, filepath.FromSlash(rel)))
+			So(err, ShouldBeNil)
+			So(os.Remove(abs), ShouldBeNil)
+			So(ioutil.WriteFile(abs, []byte(body), mode), ShouldBeNil)
+		}
+
+		for _, mode := range []InstallMode{InstallModeSymlink, InstallModeCopy} {
+			mode := mode
+			inst := makeTestInstance("test/package", []File{
+				NewTestFile("some/file/path", "data a", false),
+				NewTestFile("some/executable", "data b", true),
+				NewTestSymlink("some/symlink", "executable"),
+			}, mode)
+
+			Convey(fmt.Sprintf("In %s mode", mode), func() {
+				_, err := d.DeployInstance(ctx, "subdir", inst)
+				So(err, ShouldBeNil)
+				deployed := scanDir(tempDir)
+
+				Convey("VerifyDeployed finds nothing in intact package", func() {
+					drift, err := d.VerifyDeployed(ctx, "subdir", "test/package")
+					So(err, ShouldBeNil)
+					So(drift, ShouldResemble, []FileDrift{})
+				})
+
+				Convey("VerifyDeployed fails for missing package", func() {
+					_, err := d.VerifyDeployed(ctx, "", "test/package")
+					So(err, ShouldErrLike, "is not installed")
+				})
+
+				Convey("VerifyDeployed and RepairDeployed work", func() {
+					overwrite("subdir/some/file/path", "data c", 0444)
+					overwrite("subdir/some/executable", "data b", 0444)
+					So(os.Remove(filepath.Join(tempDir, "subdir", "some", "symlink")), ShouldBeNil)
+
+					drift, err := d.VerifyDeployed(ctx, "subdir", "test/package")
+					So(err, ShouldBeNil)
+					So(drift, ShouldResemble, []FileDrift{
+						{"some/file/path", DriftModified, "content doesn't match"},
+						{"some/executable", DriftMode, "mode is -r--r--r--"},
+						{"some/symlink", DriftMissing, ""},
+					})
+
+					err = d.RepairDeployed(ctx, "subdir", inst, []string{
+						"some/file/path",
+						"some/executable",
+						"some/symlink",
+					})
+					So(err, ShouldBeNil)
+					So(scanDir(tempDir), ShouldResemble, deployed)
+					So(readFile(tempDir, "subdir/some/file/path"), ShouldEqual, "data a")
+
+					drift, err = d.VerifyDeployed(ctx, "subdir", "test/package")
+					So(err, ShouldBeNil)
+					So(drift, ShouldResemble, []FileDrift{})
+				})
+
+				Convey("VerifyDeployed detects truncated files", func() {
+					overwrite("subdir/some/file/path", "data", 0444)
+					drift, err := d.VerifyDeployed(ctx, "subdir", "test/package")
+					So(err, ShouldBeNil)
+					So(drift, ShouldResemble, []FileDrift{
+						{"some/file/path", DriftModified, "size is 4 instead of 6"},
+					})
+				})
+
+				Convey("RepairDeployed refuses other instances", func() {
+					other := makeTestInstance("test/package", nil, mode)
+					other.instanceID = "1111111111111111111111111111111111111111"
+					err := d.RepairDeployed(ctx, "subdir", other, []string{"some/file/path"})
+					So(err, ShouldErrLike, "is deployed instead")
+				})
+
+				Convey("RepairDeployed refuses unknown files", func() {
+					err := d.RepairDeployed(ctx, "subdir", inst, []string{"unknown"})
+					So(err, ShouldErrLike, `no file "unknown"`)
+				})
+			})
+		}
+
+		Convey("VerifyDeployed detects bad site root symlinks", func() {
+			inst := makeTestInstance("test/package", []File{
+				NewTestFile("some/file/path", "data a", false),
+			}, InstallModeSymlink)
+			_, err := d.DeployInstance(ctx, "", inst)
+			So(err, ShouldBeNil)
+
+			link := filepath.Join(tempDir, "some", "file", "path")
+			So(os.Remove(link), ShouldBeNil)
+			So(os.Symlink("elsewhere", link), ShouldBeNil)
+
+			drift, err := d.VerifyDeployed(ctx, "", "test/package")
+			So(err, ShouldBeNil)
+			So(drift, ShouldResemble, []FileDrift{
+				{"some/file/path", DriftSymlink, `points to "elsewhere" in the site root`},
+			})
+
+			So(d.RepairDeployed(ctx, "", inst, []string{"some/file/path"}), ShouldBeNil)
+			So(readFile(tempDir, "some/file/path"), ShouldEqual, "data a")
+		})
+	})
+}
+
 func TestUpgradeOldPkgDir(t *testing.T) {
 	ctx := context.Background()
 
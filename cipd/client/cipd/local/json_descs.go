@@ -51,7 +51,9 @@ type Manifest struct {
 	PackageName   string      `json:"package_name"`
 	VersionFile   string      `json:"version_file,omitempty"` // where to put JSON with info about deployed package
 	InstallMode   InstallMode `json:"install_mode,omitempty"` // how to install: "copy" or "symlink"
-	Files         []FileInfo  `json:"files,omitempty"`        // present only in deployed manifest
+	Files         []FileInfo  `json:"files,omitempty"`        // all files when deployed, regular files in packages
+
+	PostInstall []PostInstallAction `json:"post_install,omitempty"` // actions to run when deploying
 }
 
 // FileInfo is JSON-ish struct with info extracted from File interface.
@@ -74,6 +76,16 @@ type FileInfo struct {
 
 	// Symlink is a path the symlink points to or "" if the file is not a symlink.
 	Symlink string `json:"symlink,omitempty"`
+
+	// Hash is a hex SHA1 digest of the file content.
+	//
+	// Present only for regular files. Manifests deployed by older clients don't
+	// have it, and packages have it only if built with FileHashes option. The
+	// hashes of a package manifest are verified when the package is extracted.
+	Hash string `json:"hash,omitempty"`
+
+	// Generated is true if the file was created by a post-install action.
+	Generated bool `json:"generated,omitempty"`
 }
 
 // VersionFile describes JSON file with package version information that's
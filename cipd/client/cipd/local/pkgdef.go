@@ -36,6 +36,13 @@ type PackageDef struct {
 
 	// Data describes what is deployed with the package.
 	Data []PackageChunkDef
+
+	// PostInstall lists the actions to run when deploying the package, see
+	// PostInstallAction.
+	//
+	// Variables are not substituted in this section when the definition is
+	// loaded, but when the package is deployed.
+	PostInstall []PostInstallAction `yaml:"post_install"`
 }
 
 // PackageChunkDef represents one entry in 'data' section of package definition.
@@ -86,6 +93,9 @@ func LoadPackageDef(r io.Reader, vars map[string]string) (PackageDef, error) {
 	if err = ValidateInstallMode(out.InstallMode); err != nil {
 		return PackageDef{}, err
 	}
+	if err = ValidatePostInstall(out.PostInstall); err != nil {
+		return PackageDef{}, err
+	}
 
 	versionFile := ""
 	for i, chunk := range out.Data {
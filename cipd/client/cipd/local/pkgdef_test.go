@@ -154,6 +154,43 @@ func TestLoadPackageDef(t *testing.T) {
 		So(err, ShouldNotBeNil)
 	})
 
+	Convey("LoadPackageDef with post_install works", t, func() {
+		body := strings.NewReader(`{
+			"package": "package/${var1}",
+			"post_install": [
+				{"write_file": {"path": "bin/tool", "content": "${root}/tool", "executable": true}},
+				{"set_executable": ["bin/a", "bin/b"]},
+				{"compile_pyc": {"dir": "lib", "python": "python3"}}
+			]
+		}`)
+		def, err := LoadPackageDef(body, map[string]string{"var1": "value1"})
+		So(err, ShouldBeNil)
+		So(def.PostInstall, ShouldResemble, []PostInstallAction{
+			{WriteFile: &WriteFileAction{Path: "bin/tool", Content: "${root}/tool", Executable: true}},
+			{SetExecutable: []string{"bin/a", "bin/b"}},
+			{CompilePyc: &CompilePycAction{Dir: "lib", Python: "python3"}},
+		})
+	})
+
+	Convey("LoadPackageDef bad post_install", t, func() {
+		bad := []string{
+			`{}`,
+			`{"set_executable": ["a"], "compile_pyc": {"dir": "."}}`,
+			`{"write_file": {"path": "../a"}}`,
+			`{"write_file": {"path": ".cipdpkg/manifest.json"}}`,
+			`{"set_executable": ["/abs"]}`,
+			`{"compile_pyc": {"dir": "lib", "python": "/bin/sh"}}`,
+		}
+		for _, action := range bad {
+			body := strings.NewReader(`{
+				"package": "package/name",
+				"post_install": [` + action + `]
+			}`)
+			_, err := LoadPackageDef(body, nil)
+			So(err, ShouldNotBeNil)
+		}
+	})
+
 	Convey("LoadPackageDef two version_file entries", t, func() {
 		body := strings.NewReader(`{
 			"package": "package/name",
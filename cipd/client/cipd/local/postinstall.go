@@ -0,0 +1,323 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+	"github.com/luci/luci-go/common/logging"
+)
+
+// PostInstallAction is an action to run when deploying a package, defined in
+// the 'post_install' section of the package definition.
+//
+// Exactly one of its fields must be set. Actions are sandboxed: they can only
+// touch the files of the package being deployed, never through a symlink, and
+// can't run arbitrary commands. They run before the package files are added to the site root, so
+// files they create are deployed, verified and removed like the other files
+// of the package.
+//
+// The content of generated files may use ${root}, the absolute path of the
+// directory the package is deployed to, and ${os}, ${arch} and ${platform}, the
+// platform of the host. They are expanded at deployment time, other ${...}
+// strings are kept as is.
+type PostInstallAction struct {
+	// WriteFile generates a file, e.g. a wrapper script.
+	WriteFile *WriteFileAction `yaml:"write_file" json:"write_file,omitempty"`
+
+	// SetExecutable is a list of package files to make executable.
+	SetExecutable []string `yaml:"set_executable" json:"set_executable,omitempty"`
+
+	// CompilePyc compiles the Python files of a directory into .pyc files.
+	CompilePyc *CompilePycAction `yaml:"compile_pyc" json:"compile_pyc,omitempty"`
+}
+
+// WriteFileAction generates a file that isn't part of the package.
+type WriteFileAction struct {
+	// Path is a slash separated path relative to the package root.
+	Path string `yaml:"path" json:"path"`
+	// Content is the content of the file.
+	Content string `yaml:"content" json:"content,omitempty"`
+	// Executable is true to make the file executable.
+	Executable bool `yaml:"executable" json:"executable,omitempty"`
+}
+
+// CompilePycAction runs "python -m compileall" on a directory of the package.
+type CompilePycAction struct {
+	// Dir is a slash separated path relative to the package root.
+	Dir string `yaml:"dir" json:"dir"`
+	// Python is the Python interpreter to use, "python" by default.
+	//
+	// It is looked up in PATH and must be named like "python", "python3" or
+	// "python2.7".
+	Python string `yaml:"python" json:"python,omitempty"`
+}
+
+var pythonRe = regexp.MustCompile(`^python[0-9.]*$`)
+
+// ValidatePostInstall returns an error if some post-install action is invalid.
+func ValidatePostInstall(actions []PostInstallAction) error {
+	for i, a := range actions {
+		if err := a.validate(); err != nil {
+			return fmt.Errorf("post_install entry #%d: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// Private details.
+
+func (a *PostInstallAction) validate() error {
+	has := []string{}
+	if a.WriteFile != nil {
+		has = append(has, "write_file")
+	}
+	if len(a.SetExecutable) != 0 {
+		has = append(has, "set_executable")
+	}
+	if a.CompilePyc != nil {
+		has = append(has, "compile_pyc")
+	}
+	if len(has) == 0 {
+		return fmt.Errorf("needs 'write_file', 'set_executable' or 'compile_pyc' key")
+	}
+	if len(has) != 1 {
+		return fmt.Errorf("should have only one key, got %q", has)
+	}
+
+	switch {
+	case a.WriteFile != nil:
+		return validatePackagePath(a.WriteFile.Path)
+	case a.CompilePyc != nil:
+		if a.CompilePyc.Python != "" && !pythonRe.MatchString(a.CompilePyc.Python) {
+			return fmt.Errorf("%q is not a Python interpreter name", a.CompilePyc.Python)
+		}
+		if a.CompilePyc.Dir == "." {
+			return nil
+		}
+		return validatePackagePath(a.CompilePyc.Dir)
+	}
+	for _, p := range a.SetExecutable {
+		if err := validatePackagePath(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePackagePath checks that a path used by a post-install action is
+// a clean path inside the package, outside of the service directories.
+func validatePackagePath(p string) error {
+	if !isCleanSlashPath(p) {
+		return fmt.Errorf("%q must be a clean path relative to the package root", p)
+	}
+	if p == packageServiceDir || strings.HasPrefix(p, packageServiceDir+"/") ||
+		p == SiteServiceDir || strings.HasPrefix(p, SiteServiceDir+"/") {
+		return fmt.Errorf("%q is in a service directory", p)
+	}
+	return nil
+}
+
+// postInstallVars returns the variables to expand in the post-install actions
+// of a package deployed to the root directory.
+func postInstallVars(root string) map[string]string {
+	vars := common.TemplateArgs()
+	vars["root"] = root
+	return vars
+}
+
+// expandVars replaces the known "${key}" in a string, keeping the others.
+func expandVars(s string, vars map[string]string) string {
+	return subVarsRe.ReplaceAllStringFunc(s, func(match string) string {
+		if val, ok := vars[match[2:len(match)-1]]; ok {
+			return val
+		}
+		return match
+	})
+}
+
+// runPostInstall runs the post-install actions of a package extracted to dir,
+// to be deployed to the given subdir.
+//
+// The files created by the actions are added to the manifest, which is written
+// back to dir.
+func (d *deployerImpl) runPostInstall(ctx context.Context, subdir, dir string, m *Manifest) error {
+	if err := ValidatePostInstall(m.PostInstall); err != nil {
+		return err
+	}
+	root, err := d.fs.RootRelToAbs(subdir)
+	if err != nil {
+		return err
+	}
+	vars := postInstallVars(root)
+
+	byName := make(map[string]int, len(m.Files))
+	for i, f := range m.Files {
+		byName[f.Name] = i
+	}
+
+	for _, a := range m.PostInstall {
+		switch {
+		case a.WriteFile != nil:
+			name := a.WriteFile.Path
+			if _, ok := byName[name]; ok {
+				return fmt.Errorf("post-install action can't overwrite package file %q", name)
+			}
+			if err := checkNoSymlinks(dir, name, false); err != nil {
+				return err
+			}
+			logging.Infof(ctx, "Post-install: writing %s", name)
+			mode := os.FileMode(0444)
+			if a.WriteFile.Executable {
+				mode = 0555
+			}
+			content := expandVars(a.WriteFile.Content, vars)
+			err := d.fs.EnsureFile(ctx, filepath.Join(dir, filepath.FromSlash(name)), func(f *os.File) error {
+				if _, err := f.WriteString(content); err != nil {
+					return err
+				}
+				return f.Chmod(mode)
+			})
+			if err != nil {
+				return err
+			}
+
+		case len(a.SetExecutable) != 0:
+			for _, name := range a.SetExecutable {
+				i, ok := byName[name]
+				if !ok || m.Files[i].Symlink != "" {
+					return fmt.Errorf("post-install action can't make %q executable, not a package file", name)
+				}
+				if runtime.GOOS == "windows" {
+					logging.Warningf(ctx, "[data-loss] ignoring +x on %q", name)
+					continue
+				}
+				if err := checkNoSymlinks(dir, name, false); err != nil {
+					return err
+				}
+				logging.Infof(ctx, "Post-install: making %s executable", name)
+				if err := os.Chmod(filepath.Join(dir, filepath.FromSlash(name)), 0555); err != nil {
+					return err
+				}
+				m.Files[i].Executable = true
+			}
+
+		case a.CompilePyc != nil:
+			if err := checkNoSymlinks(dir, a.CompilePyc.Dir, true); err != nil {
+				return err
+			}
+			logging.Infof(ctx, "Post-install: compiling Python files in %s", a.CompilePyc.Dir)
+			if err := compilePyc(ctx, dir, a.CompilePyc); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Record the files created by the actions.
+	found, err := scanPackageDir(ctx, dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range found {
+		if _, ok := byName[f.Name]; ok {
+			continue
+		}
+		if f.Symlink == "" {
+			if f.Hash, err = hashFile(filepath.Join(dir, filepath.FromSlash(f.Name))); err != nil {
+				return err
+			}
+		}
+		f.Generated = true
+		m.Files = append(m.Files, f)
+	}
+
+	manifestPath := filepath.Join(dir, filepath.FromSlash(manifestName))
+	return d.fs.EnsureFile(ctx, manifestPath, func(f *os.File) error {
+		if err := writeManifest(m, f); err != nil {
+			return err
+		}
+		return f.Chmod(0444)
+	})
+}
+
+// checkNoSymlinks returns an error if the path name, relative to the package
+// extracted to dir, goes through a symlink. If recursive is true, name must be
+// a directory without symlinks in it.
+//
+// Packages may contain symlinks pointing outside of the package, so this keeps
+// the post-install actions from writing through them.
+func checkNoSymlinks(dir, name string, recursive bool) error {
+	path := dir
+	for _, elem := range strings.Split(name, "/") {
+		if elem == "." {
+			continue
+		}
+		path = filepath.Join(path, elem)
+		switch info, err := os.Lstat(path); {
+		case os.IsNotExist(err) && !recursive:
+			// Created by the action, as a regular file or directory.
+			return nil
+		case err != nil:
+			return err
+		case info.Mode()&os.ModeSymlink != 0:
+			return fmt.Errorf("post-install action can't use %q, it goes through a symlink", name)
+		}
+	}
+	if !recursive {
+		return nil
+	}
+	return filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("post-install action can't use %q, it contains symlinks", name)
+		}
+		return nil
+	})
+}
+
+// compilePyc runs "python -m compileall" on a directory of the package
+// extracted to dir.
+func compilePyc(ctx context.Context, dir string, a *CompilePycAction) error {
+	python := a.Python
+	if python == "" {
+		python = "python"
+	}
+	pyDir, err := filepath.Abs(filepath.Join(dir, filepath.FromSlash(a.Dir)))
+	if err != nil {
+		return err
+	}
+
+	// With -m, Python looks up the module in the current directory first, so it
+	// runs from an empty directory: a package must not be able to replace
+	// compileall with its own code. -E and -s ignore the PYTHON* environment
+	// variables and the user site directory for the same reason.
+	cwd, err := ioutil.TempDir("", "cipd_compileall")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(cwd)
+
+	// -d keeps the file names recorded in the .pyc files relative to the
+	// package root.
+	cmd := exec.Command(python, "-E", "-s", "-m", "compileall", "-q", "-d", filepath.FromSlash(a.Dir), pyDir)
+	cmd.Dir = cwd
+	if out, err := cmd.CombinedOutput(); err != nil {
+		logging.Errorf(ctx, "%s -m compileall failed:\n%s", python, out)
+		return fmt.Errorf("failed to compile Python files in %q - %s", a.Dir, err)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// makeTestInstanceWithHooks is like makeTestInstance, with post-install
+// actions in the manifest.
+func makeTestInstanceWithHooks(name string, files []File, installMode InstallMode, actions []PostInstallAction) *testPackageInstance {
+	out := bytes.Buffer{}
+	err := writeManifest(&Manifest{
+		FormatVersion: manifestFormatVersion,
+		PackageName:   name,
+		InstallMode:   installMode,
+		PostInstall:   actions,
+	}, &out)
+	if err != nil {
+		panic("Failed to write a manifest")
+	}
+	inst := makeTestInstance(name, files, installMode)
+	inst.files[len(inst.files)-1] = NewTestFile(manifestName, out.String(), false)
+	return inst
+}
+
+func TestPostInstall(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on windows")
+	}
+
+	ctx := context.Background()
+
+	Convey("Given a temp directory", t, func() {
+		tempDir := mkTempDir()
+		d := NewDeployer(tempDir)
+
+		for _, mode := range []InstallMode{InstallModeSymlink, InstallModeCopy} {
+			mode := mode
+
+			Convey(fmt.Sprintf("write_file and set_executable work in %s mode", mode), func() {
+				inst := makeTestInstanceWithHooks("test/package", []File{
+					NewTestFile("bin/tool", "data", false),
+				}, mode, []PostInstallAction{
+					{WriteFile: &WriteFileAction{
+						Path:       "bin/wrapper",
+						Content:    "${root}/bin/tool ${unknown}",
+						Executable: true,
+					}},
+					{SetExecutable: []string{"bin/tool"}},
+				})
+				_, err := d.DeployInstance(ctx, "subdir", inst)
+				So(err, ShouldBeNil)
+
+				root := tempDir + "/subdir"
+				So(readFile(tempDir, "subdir/bin/wrapper"), ShouldEqual, root+"/bin/tool ${unknown}")
+				files := scanDir(tempDir)
+				if mode == InstallModeCopy {
+					So(files, ShouldContain, "subdir/bin/tool*")
+					So(files, ShouldContain, "subdir/bin/wrapper*")
+				} else {
+					So(files, ShouldContain, ".cipd/pkgs/0/0123456789abcdef00000123456789abcdef0000/bin/tool*")
+					So(files, ShouldContain, ".cipd/pkgs/0/0123456789abcdef00000123456789abcdef0000/bin/wrapper*")
+					So(files, ShouldContain, "subdir/bin/wrapper:../../.cipd/pkgs/0/_current/bin/wrapper")
+				}
+
+				// The generated file is verified like the others.
+				drift, err := d.VerifyDeployed(ctx, "subdir", "test/package")
+				So(err, ShouldBeNil)
+				So(drift, ShouldResemble, []FileDrift{})
+
+				// And removed with the package.
+				So(d.RemoveDeployed(ctx, "subdir", "test/package"), ShouldBeNil)
+				So(scanDir(tempDir), ShouldBeNil)
+			})
+		}
+
+		Convey("Generated files are removed when upgrading", func() {
+			inst := makeTestInstanceWithHooks("test/package", nil, InstallModeCopy, []PostInstallAction{
+				{WriteFile: &WriteFileAction{Path: "generated"}},
+			})
+			_, err := d.DeployInstance(ctx, "", inst)
+			So(err, ShouldBeNil)
+			So(scanDir(tempDir), ShouldContain, "generated")
+
+			upgrade := makeTestInstance("test/package", nil, InstallModeCopy)
+			upgrade.instanceID = "1111111111111111111111111111111111111111"
+			_, err = d.DeployInstance(ctx, "", upgrade)
+			So(err, ShouldBeNil)
+			So(scanDir(tempDir), ShouldNotContain, "generated")
+		})
+
+		Convey("Generated files can't be repaired", func() {
+			inst := makeTestInstanceWithHooks("test/package", nil, InstallModeCopy, []PostInstallAction{
+				{WriteFile: &WriteFileAction{Path: "generated"}},
+			})
+			_, err := d.DeployInstance(ctx, "", inst)
+			So(err, ShouldBeNil)
+			err = d.RepairDeployed(ctx, "", inst, []string{"generated"})
+			So(err, ShouldErrLike, "reinstall the package")
+		})
+
+		Convey("Package files can't be overwritten", func() {
+			inst := makeTestInstanceWithHooks("test/package", []File{
+				NewTestFile("file", "data", false),
+			}, InstallModeCopy, []PostInstallAction{
+				{WriteFile: &WriteFileAction{Path: "file"}},
+			})
+			_, err := d.DeployInstance(ctx, "", inst)
+			So(err, ShouldErrLike, "can't overwrite package file")
+			So(scanDir(tempDir), ShouldNotContain, "file")
+		})
+
+		Convey("Invalid actions are rejected", func() {
+			inst := makeTestInstanceWithHooks("test/package", nil, InstallModeCopy, []PostInstallAction{
+				{CompilePyc: &CompilePycAction{Dir: ".", Python: "rm"}},
+			})
+			_, err := d.DeployInstance(ctx, "", inst)
+			So(err, ShouldErrLike, "not a Python interpreter")
+		})
+
+		Convey("Actions don't write through symlinks", func() {
+			outside := mkTempDir()
+			files := []File{
+				NewTestSymlink("lib", outside),
+				NewTestFile("src/mod.py", "x = 1\n", false),
+				NewTestSymlink("src/link.py", outside),
+			}
+
+			Convey("write_file", func() {
+				inst := makeTestInstanceWithHooks("test/package", files, InstallModeCopy, []PostInstallAction{
+					{WriteFile: &WriteFileAction{Path: "lib/evil"}},
+				})
+				_, err := d.DeployInstance(ctx, "", inst)
+				So(err, ShouldErrLike, "goes through a symlink")
+				So(scanDir(outside), ShouldBeEmpty)
+			})
+
+			Convey("compile_pyc on a symlinked directory", func() {
+				inst := makeTestInstanceWithHooks("test/package", files, InstallModeCopy, []PostInstallAction{
+					{CompilePyc: &CompilePycAction{Dir: "lib"}},
+				})
+				_, err := d.DeployInstance(ctx, "", inst)
+				So(err, ShouldErrLike, "goes through a symlink")
+				So(scanDir(outside), ShouldBeEmpty)
+			})
+
+			Convey("compile_pyc on a directory containing symlinks", func() {
+				inst := makeTestInstanceWithHooks("test/package", files, InstallModeCopy, []PostInstallAction{
+					{CompilePyc: &CompilePycAction{Dir: "src"}},
+				})
+				_, err := d.DeployInstance(ctx, "", inst)
+				So(err, ShouldErrLike, "contains symlinks")
+			})
+		})
+
+		Convey("compile_pyc works", func() {
+			if _, err := exec.LookPath("python3"); err != nil {
+				return // not installed
+			}
+
+			inst := makeTestInstanceWithHooks("test/package", []File{
+				NewTestFile("lib/mod.py", "x = 1\n", false),
+				// Must not be run instead of the standard compileall module.
+				NewTestFile("compileall.py", "raise SystemExit(3)\n", false),
+			}, InstallModeSymlink, []PostInstallAction{
+				{CompilePyc: &CompilePycAction{Dir: "lib", Python: "python3"}},
+			})
+			_, err := d.DeployInstance(ctx, "", inst)
+			So(err, ShouldBeNil)
+
+			// The .pyc file is linked into the site root.
+			compiled := 0
+			for _, f := range scanDir(tempDir) {
+				if strings.HasPrefix(f, "lib/") && strings.Contains(f, ".pyc:") {
+					compiled++
+				}
+			}
+			So(compiled, ShouldEqual, 1)
+		})
+	})
+}
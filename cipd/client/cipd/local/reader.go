@@ -7,6 +7,8 @@ package local
 import (
 	"archive/zip"
 	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"hash"
@@ -122,12 +124,21 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 
 	progress := newProgressReporter(ctx, files)
 
+	// SHA1 hex digests of extracted regular files, to put into the manifest.
+	hashes := make(map[string]string, len(files))
+
 	extractManifestFile := func(f File) (err error) {
 		defer progress.advance(f)
 		manifest, err := readManifestFile(f)
 		if err != nil {
 			return err
 		}
+		// Packages built by newer clients list the hashes of their files.
+		for _, fi := range manifest.Files {
+			if hash, ok := hashes[fi.Name]; ok && fi.Hash != "" && fi.Hash != hash {
+				return fmt.Errorf("%s doesn't match the hash in the package manifest", fi.Name)
+			}
+		}
 		manifest.Files = make([]FileInfo, 0, len(files))
 		for _, file := range files {
 			// Do not put info about service .cipdpkg files into the manifest,
@@ -141,6 +152,7 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 				Size:       file.Size(),
 				Executable: file.Executable(),
 				WinAttrs:   file.WinAttrs().String(),
+				Hash:       hashes[file.Name()],
 			}
 			if file.Symlink() {
 				target, err := file.SymlinkTarget()
@@ -188,8 +200,12 @@ func ExtractInstance(ctx context.Context, inst PackageInstance, dest Destination
 			return err
 		}
 		defer in.Close()
-		_, err = io.Copy(out, in)
-		return err
+		h := sha1.New()
+		if _, err = io.Copy(io.MultiWriter(out, h), in); err != nil {
+			return err
+		}
+		hashes[f.Name()] = hex.EncodeToString(h.Sum(nil))
+		return nil
 	}
 
 	var manifest File
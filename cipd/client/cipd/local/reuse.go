@@ -0,0 +1,197 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/logging"
+)
+
+func (d *deployerImpl) ReuseDeployed(ctx context.Context, subdir string, inst PackageInstance) (PackageInstance, uint64, error) {
+	pin := inst.Pin()
+	pkgPath, instanceID, err := d.deployedInstance(ctx, subdir, pin.PackageName)
+	if err != nil || instanceID == pin.InstanceID {
+		return inst, 0, nil
+	}
+
+	// Files are reused based on the SHA1 hashes listed in the package manifest.
+	// ExtractInstance verifies all the files against them, reused or not.
+	wantHash, err := packageHashes(inst)
+	if err != nil {
+		return nil, 0, err
+	}
+	if wantHash == nil {
+		logging.Debugf(ctx, "Not reusing files of %s: its manifest has no file hashes", pin.PackageName)
+		return inst, 0, nil
+	}
+
+	instanceDir := filepath.Join(pkgPath, instanceID)
+	manifest, err := d.readManifest(ctx, instanceDir)
+	if err != nil {
+		return nil, 0, err
+	}
+	installMode, err := effectiveInstallMode(manifest.InstallMode)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Deployed files without hash can't be checked, don't use them.
+	byHash := map[string][]FileInfo{}
+	for _, f := range manifest.Files {
+		if f.Symlink == "" && f.Hash != "" {
+			byHash[f.Hash] = append(byHash[f.Hash], f)
+		}
+	}
+
+	// Whether the deployed files still match the manifest, by name.
+	checked := map[string]bool{}
+	deployedOK := func(f FileInfo) bool {
+		if ok, seen := checked[f.Name]; seen {
+			return ok
+		}
+		ok := false
+		if path, err := d.installedFilePath(subdir, installMode, instanceDir, f.Name); err == nil {
+			if err = checkDeployedFile(path, f); err == nil {
+				ok = true
+			} else {
+				logging.Debugf(ctx, "Not reusing %s: %s", path, err)
+			}
+		}
+		checked[f.Name] = ok
+		return ok
+	}
+
+	files := make([]File, len(inst.Files()))
+	reused := uint64(0)
+	for i, f := range inst.Files() {
+		files[i] = f
+		if f.Symlink() || f.Name() == manifestName || f.Size() == 0 {
+			continue
+		}
+		// Try the file with the same name first, it's the most likely match.
+		candidates := byHash[wantHash[f.Name()]]
+		for j, c := range candidates {
+			if c.Name == f.Name() {
+				candidates = append([]FileInfo{c}, append(candidates[:j:j], candidates[j+1:]...)...)
+				break
+			}
+		}
+		for _, c := range candidates {
+			if c.Size == f.Size() && deployedOK(c) {
+				path, _ := d.installedFilePath(subdir, installMode, instanceDir, c.Name)
+				files[i] = &deployedFile{File: f, path: path}
+				reused += f.Size()
+				break
+			}
+		}
+	}
+	if reused != 0 {
+		logging.Infof(ctx, "Reusing %d bytes of the deployed files of %s", reused, pin.PackageName)
+	}
+	return &reusingInstance{inst, files}, reused, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// packageHashes returns the SHA1 hashes of the regular files of an instance,
+// as listed in its manifest.
+//
+// Returns nil if some file has no hash, e.g. because the package was built by
+// an older client.
+func packageHashes(inst PackageInstance) (map[string]string, error) {
+	var manifest Manifest
+	found := false
+	for _, f := range inst.Files() {
+		if f.Name() == manifestName {
+			var err error
+			if manifest, err = readManifestFile(f); err != nil {
+				return nil, err
+			}
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("no %s file, this is bad", manifestName)
+	}
+	hashes := make(map[string]string, len(manifest.Files))
+	for _, f := range manifest.Files {
+		if f.Hash != "" {
+			hashes[f.Name] = f.Hash
+		}
+	}
+	for _, f := range inst.Files() {
+		if f.Symlink() || strings.HasPrefix(f.Name(), packageServiceDir+"/") {
+			continue
+		}
+		if _, ok := hashes[f.Name()]; !ok {
+			return nil, nil
+		}
+	}
+	return hashes, nil
+}
+
+// installedFilePath returns the path of a file of the deployed instance in
+// instanceDir.
+//
+// In "symlink" install mode the file is in the instance directory, in "copy"
+// mode it is in the site root.
+func (d *deployerImpl) installedFilePath(subdir string, installMode InstallMode, instanceDir, name string) (string, error) {
+	relPath := filepath.FromSlash(name)
+	if installMode == InstallModeSymlink {
+		return filepath.Join(instanceDir, relPath), nil
+	}
+	return d.fs.RootRelToAbs(filepath.Join(subdir, relPath))
+}
+
+// checkDeployedFile checks that a deployed regular file still matches its
+// manifest entry.
+func checkDeployedFile(path string, f FileInfo) error {
+	r, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	h := sha1.New()
+	size, err := io.Copy(h, r)
+	if err != nil {
+		return err
+	}
+	if uint64(size) != f.Size || hex.EncodeToString(h.Sum(nil)) != f.Hash {
+		return fmt.Errorf("doesn't match the manifest")
+	}
+	return nil
+}
+
+// reusingInstance is a PackageInstance with some files read from disk.
+type reusingInstance struct {
+	PackageInstance
+	files []File
+}
+
+func (inst *reusingInstance) Files() []File { return inst.files }
+
+// deployedFile is a File of a package instance read from an identical file
+// already deployed.
+//
+// ExtractInstance verifies its hash, so a file modified since it was checked
+// is not deployed.
+type deployedFile struct {
+	File
+	path string
+}
+
+func (f *deployedFile) Open() (io.ReadCloser, error) {
+	return os.Open(f.path)
+}
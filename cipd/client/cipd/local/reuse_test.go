@@ -0,0 +1,141 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReuseDeployed(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping on windows")
+	}
+
+	ctx := context.Background()
+
+	Convey("Given a temp directory", t, func() {
+		tempDir := mkTempDir()
+		d := NewDeployer(tempDir)
+
+		for _, mode := range []InstallMode{InstallModeSymlink, InstallModeCopy} {
+			mode := mode
+
+			// buildZip builds an instance backed by a real zip, with SHA1 of files.
+			buildZip := func(files []File) PackageInstance {
+				out := bytes.Buffer{}
+				So(BuildInstance(ctx, BuildInstanceOptions{
+					Input:       files,
+					Output:      &out,
+					PackageName: "test/package",
+					InstallMode: mode,
+					FileHashes:  true,
+				}), ShouldBeNil)
+				inst, err := OpenInstance(ctx, bytes.NewReader(out.Bytes()), "", VerifyHash)
+				So(err, ShouldBeNil)
+				return inst
+			}
+
+			Convey(fmt.Sprintf("In %s mode", mode), func() {
+				v1 := buildZip([]File{
+					NewTestFile("same", "data a", false),
+					NewTestFile("changed", "data b 1", false),
+					NewTestFile("old name", "data c", false),
+					NewTestSymlink("link", "same"),
+				})
+				v2 := buildZip([]File{
+					NewTestFile("same", "data a", false),
+					NewTestFile("changed", "data b 2", false),
+					NewTestFile("new name", "data c", true),
+					NewTestSymlink("link", "same"),
+				})
+
+				Convey("Does nothing if the package isn't deployed", func() {
+					inst, reused, err := d.ReuseDeployed(ctx, "subdir", v2)
+					So(err, ShouldBeNil)
+					So(reused, ShouldEqual, 0)
+					So(inst, ShouldEqual, v2)
+				})
+
+				Convey("With v1 deployed", func() {
+					_, err := d.DeployInstance(ctx, "subdir", v1)
+					So(err, ShouldBeNil)
+
+					Convey("Does nothing for the deployed instance", func() {
+						inst, reused, err := d.ReuseDeployed(ctx, "subdir", v1)
+						So(err, ShouldBeNil)
+						So(reused, ShouldEqual, 0)
+						So(inst, ShouldEqual, v1)
+					})
+
+					Convey("Reuses unchanged files", func() {
+						inst, reused, err := d.ReuseDeployed(ctx, "subdir", v2)
+						So(err, ShouldBeNil)
+						So(reused, ShouldEqual, len("data a")+len("data c"))
+
+						_, err = d.DeployInstance(ctx, "subdir", inst)
+						So(err, ShouldBeNil)
+						So(readFile(tempDir, "subdir/same"), ShouldEqual, "data a")
+						So(readFile(tempDir, "subdir/changed"), ShouldEqual, "data b 2")
+						So(readFile(tempDir, "subdir/new name"), ShouldEqual, "data c")
+						So(readFile(tempDir, "subdir/link"), ShouldEqual, "data a")
+
+						drift, err := d.VerifyDeployed(ctx, "subdir", "test/package")
+						So(err, ShouldBeNil)
+						So(drift, ShouldResemble, []FileDrift{})
+					})
+
+					Convey("Doesn't reuse modified files", func() {
+						abs, err := filepath.EvalSymlinks(filepath.Join(tempDir, "subdir", "same"))
+						So(err, ShouldBeNil)
+						So(os.Remove(abs), ShouldBeNil)
+						So(ioutil.WriteFile(abs, []byte("data z"), 0444), ShouldBeNil)
+
+						inst, reused, err := d.ReuseDeployed(ctx, "subdir", v2)
+						So(err, ShouldBeNil)
+						So(reused, ShouldEqual, len("data c"))
+
+						_, err = d.DeployInstance(ctx, "subdir", inst)
+						So(err, ShouldBeNil)
+						So(readFile(tempDir, "subdir/same"), ShouldEqual, "data a")
+					})
+
+					Convey("Doesn't deploy files modified after the check", func() {
+						inst, reused, err := d.ReuseDeployed(ctx, "subdir", v2)
+						So(err, ShouldBeNil)
+						So(reused, ShouldEqual, len("data a")+len("data c"))
+
+						abs, err := filepath.EvalSymlinks(filepath.Join(tempDir, "subdir", "same"))
+						So(err, ShouldBeNil)
+						So(os.Remove(abs), ShouldBeNil)
+						So(ioutil.WriteFile(abs, []byte("data z"), 0444), ShouldBeNil)
+
+						_, err = d.DeployInstance(ctx, "subdir", inst)
+						So(err, ShouldNotBeNil)
+					})
+
+					Convey("Doesn't reuse files of instances without file hashes", func() {
+						v3 := makeTestInstance("test/package", []File{
+							NewTestFile("same", "data a", false),
+						}, mode)
+						inst, reused, err := d.ReuseDeployed(ctx, "subdir", v3)
+						So(err, ShouldBeNil)
+						So(reused, ShouldEqual, 0)
+						So(inst, ShouldEqual, v3)
+					})
+				})
+			})
+		}
+	})
+}
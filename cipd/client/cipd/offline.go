@@ -0,0 +1,792 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cipd
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/danjacques/gofslock/fslock"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/logging"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+	"github.com/luci/luci-go/cipd/client/cipd/local"
+)
+
+// An offline repository is a directory that replaces the backend service, to
+// use CIPD without network access. A Client uses it when its ServiceURL is
+// a file:// URL, see OfflineServiceURL.
+//
+// The directory contains:
+//   instances/<instance id>.zip - the package instance files.
+//   packages/<package name>/package.json - the registered instances of the
+//       package with their tags, and the refs of the package.
+//   acl.json - the package ACLs.
+//
+// It is populated with MirrorInstances, or by registering instances with
+// a Client using it. There's no authentication: ACLs are stored, but not
+// enforced, and all changes are made by offlineIdentity. Counters and client
+// binaries are not supported.
+
+// OfflineServiceURL returns the ServiceURL of the offline repository in dir.
+func OfflineServiceURL(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	p := filepath.ToSlash(abs)
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p // "C:/dir" on Windows
+	}
+	return (&url.URL{Scheme: "file", Path: p}).String(), nil
+}
+
+// MirrorInstances copies package instances, with their tags and the refs
+// pointing to them, from src to the offline repository in dir.
+//
+// Instance files already in the repository are not fetched again, but their
+// tags and refs are updated.
+func MirrorInstances(ctx context.Context, src Client, dir string, pins []common.Pin) error {
+	repo := newOfflineRepo(dir)
+	for _, pin := range pins {
+		if err := common.ValidatePin(pin); err != nil {
+			return err
+		}
+		if err := repo.mirror(ctx, src, pin); err != nil {
+			return fmt.Errorf("failed to mirror %s - %s", pin, err)
+		}
+	}
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// offlineIdentity is the identity changes to an offline repository are
+	// made by.
+	offlineIdentity = "anonymous:anonymous"
+
+	// offlineLockFilename is the name of the file locked while an offline
+	// repository is modified.
+	offlineLockFilename = ".lock"
+
+	// offlineLockDelay is how long to wait between attempts to take the lock.
+	offlineLockDelay = 100 * time.Millisecond
+)
+
+// offlinePackage is the content of the package.json file of a package.
+type offlinePackage struct {
+	Instances map[string]*offlineInstance `json:"instances"`
+	Refs      map[string]*offlineRef      `json:"refs,omitempty"`
+}
+
+type offlineInstance struct {
+	RegisteredBy string       `json:"registered_by"`
+	RegisteredTs time.Time    `json:"registered_ts"`
+	Tags         []offlineTag `json:"tags,omitempty"`
+}
+
+type offlineTag struct {
+	Tag          string    `json:"tag"`
+	RegisteredBy string    `json:"registered_by"`
+	RegisteredTs time.Time `json:"registered_ts"`
+}
+
+type offlineRef struct {
+	InstanceID string    `json:"instance_id"`
+	ModifiedBy string    `json:"modified_by"`
+	ModifiedTs time.Time `json:"modified_ts"`
+}
+
+type offlineACL struct {
+	PackagePath string    `json:"package_path"`
+	Role        string    `json:"role"`
+	Principals  []string  `json:"principals"`
+	ModifiedBy  string    `json:"modified_by"`
+	ModifiedTs  time.Time `json:"modified_ts"`
+}
+
+// offlineRepo implements remote and storage on top of an offline repository.
+//
+// Upload and fetch "URLs" are instance IDs.
+type offlineRepo struct {
+	dir string
+	fs  local.FileSystem
+
+	// lock serializes modifications within the process, the file lock
+	// serializes them across processes.
+	lock sync.Mutex
+}
+
+func newOfflineRepo(dir string) *offlineRepo {
+	return &offlineRepo{dir: dir, fs: local.NewFileSystem(dir, "")}
+}
+
+// offlineRepoDir returns the directory of a file:// ServiceURL.
+func offlineRepoDir(u *url.URL) (string, error) {
+	if u.Host != "" && u.Host != "localhost" {
+		return "", fmt.Errorf("expecting a local file URL, not %q", u)
+	}
+	p := u.Path
+	if runtime.GOOS == "windows" {
+		p = strings.TrimPrefix(p, "/")
+	}
+	dir := filepath.FromSlash(p)
+	if !filepath.IsAbs(dir) {
+		return "", fmt.Errorf("expecting an absolute path in %q", u)
+	}
+	return dir, nil
+}
+
+func (r *offlineRepo) instancePath(instanceID string) string {
+	return filepath.Join(r.dir, "instances", instanceID+".zip")
+}
+
+func (r *offlineRepo) packagePath(packageName string) string {
+	return filepath.Join(r.dir, "packages", filepath.FromSlash(packageName), "package.json")
+}
+
+func (r *offlineRepo) hasInstanceFile(instanceID string) bool {
+	_, err := os.Stat(r.instancePath(instanceID))
+	return err == nil
+}
+
+// withLock calls f with the repository locked for modifications.
+func (r *offlineRepo) withLock(ctx context.Context, f func() error) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err := os.MkdirAll(r.dir, 0777); err != nil {
+		return err
+	}
+	blocker := func() error {
+		return clock.Sleep(ctx, offlineLockDelay).Err
+	}
+	var err error
+	lockErr := fslock.WithBlocking(filepath.Join(r.dir, offlineLockFilename), blocker, func() error {
+		err = f()
+		return nil
+	})
+	if lockErr != nil {
+		return fmt.Errorf("failed to lock the offline repository - %s", lockErr)
+	}
+	return err
+}
+
+func (r *offlineRepo) readJSON(p string, v interface{}) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := json.NewDecoder(f).Decode(v); err != nil {
+		return fmt.Errorf("can't parse %s - %s", p, err)
+	}
+	return nil
+}
+
+func (r *offlineRepo) writeJSON(ctx context.Context, p string, v interface{}) error {
+	blob, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return r.fs.EnsureFile(ctx, p, func(f *os.File) error {
+		_, err := f.Write(append(blob, '\n'))
+		return err
+	})
+}
+
+// readPackage reads the metadata of a package.
+//
+// It returns nil if the package isn't registered.
+func (r *offlineRepo) readPackage(packageName string) (*offlinePackage, error) {
+	if err := common.ValidatePackageName(packageName); err != nil {
+		return nil, err
+	}
+	pkg := &offlinePackage{}
+	switch err := r.readJSON(r.packagePath(packageName), pkg); {
+	case os.IsNotExist(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	if pkg.Instances == nil {
+		pkg.Instances = map[string]*offlineInstance{}
+	}
+	if pkg.Refs == nil {
+		pkg.Refs = map[string]*offlineRef{}
+	}
+	return pkg, nil
+}
+
+// readInstance reads the metadata of a package and of one of its instances.
+func (r *offlineRepo) readInstance(pin common.Pin) (*offlinePackage, *offlineInstance, error) {
+	if err := common.ValidatePin(pin); err != nil {
+		return nil, nil, err
+	}
+	pkg, err := r.readPackage(pin.PackageName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pkg == nil {
+		return nil, nil, fmt.Errorf("package %q is not registered", pin.PackageName)
+	}
+	inst := pkg.Instances[pin.InstanceID]
+	if inst == nil {
+		return nil, nil, fmt.Errorf("package %q doesn't have instance %q", pin.PackageName, pin.InstanceID)
+	}
+	return pkg, inst, nil
+}
+
+// updateInstance reads the metadata of an instance, calls f and saves the
+// metadata back.
+func (r *offlineRepo) updateInstance(ctx context.Context, pin common.Pin, f func(*offlinePackage, *offlineInstance) error) error {
+	return r.withLock(ctx, func() error {
+		pkg, inst, err := r.readInstance(pin)
+		if err != nil {
+			return err
+		}
+		if err := f(pkg, inst); err != nil {
+			return err
+		}
+		return r.writeJSON(ctx, r.packagePath(pin.PackageName), pkg)
+	})
+}
+
+// listAllPackages returns the sorted names of the packages under a directory
+// of the package namespace, "" for all packages.
+func (r *offlineRepo) listAllPackages(prefix string) ([]string, error) {
+	root := filepath.Join(r.dir, "packages")
+	out := []string{}
+	err := filepath.Walk(filepath.Join(root, filepath.FromSlash(prefix)), func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || info.Name() != "package.json" {
+			return nil
+		}
+		rel, err := filepath.Rel(root, filepath.Dir(p))
+		if err != nil {
+			return err
+		}
+		out = append(out, filepath.ToSlash(rel))
+		return nil
+	})
+	sort.Strings(out)
+	return out, err
+}
+
+// offlineUnsupported returns the error of the operations an offline
+// repository doesn't support.
+func offlineUnsupported(what string) error {
+	return fmt.Errorf("%s is not supported by offline repositories", what)
+}
+
+func (r *offlineRepo) fetchACL(ctx context.Context, packagePath string) ([]PackageACL, error) {
+	var acls []offlineACL
+	if err := r.readJSON(filepath.Join(r.dir, "acl.json"), &acls); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	out := []PackageACL{}
+	for _, acl := range acls {
+		p := acl.PackagePath
+		if p == "" || p == packagePath || strings.HasPrefix(packagePath, p+"/") {
+			out = append(out, PackageACL{
+				PackagePath: acl.PackagePath,
+				Role:        acl.Role,
+				Principals:  acl.Principals,
+				ModifiedBy:  acl.ModifiedBy,
+				ModifiedTs:  UnixTime(acl.ModifiedTs),
+			})
+		}
+	}
+	return out, nil
+}
+
+func (r *offlineRepo) modifyACL(ctx context.Context, packagePath string, changes []PackageACLChange) error {
+	aclPath := filepath.Join(r.dir, "acl.json")
+	return r.withLock(ctx, func() error {
+		var acls []offlineACL
+		if err := r.readJSON(aclPath, &acls); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		now := clock.Now(ctx).UTC()
+		for _, c := range changes {
+			var acl *offlineACL
+			for i := range acls {
+				if acls[i].PackagePath == packagePath && acls[i].Role == c.Role {
+					acl = &acls[i]
+					break
+				}
+			}
+			if acl == nil {
+				acls = append(acls, offlineACL{PackagePath: packagePath, Role: c.Role})
+				acl = &acls[len(acls)-1]
+			}
+			principals := make([]string, 0, len(acl.Principals)+1)
+			for _, p := range acl.Principals {
+				if p != c.Principal {
+					principals = append(principals, p)
+				}
+			}
+			switch c.Action {
+			case GrantRole:
+				principals = append(principals, c.Principal)
+			case RevokeRole:
+			default:
+				return fmt.Errorf("unexpected action: %s", c.Action)
+			}
+			acl.Principals = principals
+			acl.ModifiedBy = offlineIdentity
+			acl.ModifiedTs = now
+		}
+
+		// Drop the roles nobody has anymore.
+		kept := acls[:0]
+		for _, acl := range acls {
+			if len(acl.Principals) != 0 {
+				kept = append(kept, acl)
+			}
+		}
+		return r.writeJSON(ctx, aclPath, kept)
+	})
+}
+
+func (r *offlineRepo) resolveVersion(ctx context.Context, packageName, version string) (common.Pin, error) {
+	if err := common.ValidateInstanceVersion(version); err != nil {
+		return common.Pin{}, err
+	}
+	pkg, err := r.readPackage(packageName)
+	if err != nil {
+		return common.Pin{}, err
+	}
+	if pkg == nil {
+		return common.Pin{}, fmt.Errorf("package %q is not registered", packageName)
+	}
+
+	pin := common.Pin{PackageName: packageName}
+	switch {
+	case common.ValidateInstanceID(version) == nil:
+		if pkg.Instances[version] != nil {
+			pin.InstanceID = version
+		}
+	case common.ValidatePackageRef(version) == nil:
+		if ref := pkg.Refs[version]; ref != nil {
+			pin.InstanceID = ref.InstanceID
+		}
+	default:
+		for id, inst := range pkg.Instances {
+			for _, t := range inst.Tags {
+				if t.Tag != version {
+					continue
+				}
+				if pin.InstanceID != "" {
+					return common.Pin{}, fmt.Errorf("more than one instance of package %q match version %q", packageName, version)
+				}
+				pin.InstanceID = id
+			}
+		}
+	}
+	if pin.InstanceID == "" {
+		return common.Pin{}, fmt.Errorf("package %q doesn't have instance with version %q", packageName, version)
+	}
+	return pin, nil
+}
+
+func (r *offlineRepo) initiateUpload(ctx context.Context, sha1 string) (*UploadSession, error) {
+	if err := common.ValidateInstanceID(sha1); err != nil {
+		return nil, err
+	}
+	if r.hasInstanceFile(sha1) {
+		return nil, nil
+	}
+	return &UploadSession{ID: sha1, URL: sha1}, nil
+}
+
+func (r *offlineRepo) finalizeUpload(ctx context.Context, sessionID string) (bool, error) {
+	if !r.hasInstanceFile(sessionID) {
+		return false, fmt.Errorf("%s was not uploaded", sessionID)
+	}
+	return true, nil
+}
+
+func (r *offlineRepo) registerInstance(ctx context.Context, pin common.Pin) (*registerInstanceResponse, error) {
+	if err := common.ValidatePin(pin); err != nil {
+		return nil, err
+	}
+	if !r.hasInstanceFile(pin.InstanceID) {
+		return &registerInstanceResponse{
+			uploadSession: &UploadSession{ID: pin.InstanceID, URL: pin.InstanceID},
+		}, nil
+	}
+	var resp *registerInstanceResponse
+	err := r.withLock(ctx, func() error {
+		pkg, err := r.readPackage(pin.PackageName)
+		if err != nil {
+			return err
+		}
+		if pkg == nil {
+			pkg = &offlinePackage{Instances: map[string]*offlineInstance{}}
+		}
+		if inst := pkg.Instances[pin.InstanceID]; inst != nil {
+			resp = &registerInstanceResponse{
+				alreadyRegistered: true,
+				registeredBy:      inst.RegisteredBy,
+				registeredTs:      inst.RegisteredTs,
+			}
+			return nil
+		}
+		inst := &offlineInstance{
+			RegisteredBy: offlineIdentity,
+			RegisteredTs: clock.Now(ctx).UTC(),
+		}
+		pkg.Instances[pin.InstanceID] = inst
+		resp = &registerInstanceResponse{
+			registeredBy: inst.RegisteredBy,
+			registeredTs: inst.RegisteredTs,
+		}
+		return r.writeJSON(ctx, r.packagePath(pin.PackageName), pkg)
+	})
+	return resp, err
+}
+
+func (r *offlineRepo) deletePackage(ctx context.Context, packageName string) error {
+	return r.withLock(ctx, func() error {
+		pkg, err := r.readPackage(packageName)
+		if err != nil {
+			return err
+		}
+		if pkg == nil {
+			return ErrPackageNotFound
+		}
+		if err := os.Remove(r.packagePath(packageName)); err != nil {
+			return err
+		}
+		for id := range pkg.Instances {
+			if err := os.Remove(r.instancePath(id)); err != nil && !os.IsNotExist(err) {
+				logging.Warningf(ctx, "cipd: failed to remove instance %s - %s", id, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *offlineRepo) setRef(ctx context.Context, ref string, pin common.Pin) error {
+	if err := common.ValidatePackageRef(ref); err != nil {
+		return err
+	}
+	return r.updateInstance(ctx, pin, func(pkg *offlinePackage, inst *offlineInstance) error {
+		pkg.Refs[ref] = &offlineRef{
+			InstanceID: pin.InstanceID,
+			ModifiedBy: offlineIdentity,
+			ModifiedTs: clock.Now(ctx).UTC(),
+		}
+		return nil
+	})
+}
+
+func (r *offlineRepo) attachTags(ctx context.Context, pin common.Pin, tags []string) error {
+	for _, tag := range tags {
+		if err := common.ValidateInstanceTag(tag); err != nil {
+			return err
+		}
+	}
+	return r.updateInstance(ctx, pin, func(pkg *offlinePackage, inst *offlineInstance) error {
+		now := clock.Now(ctx).UTC()
+		for _, tag := range tags {
+			if !inst.hasTag(tag) {
+				inst.Tags = append(inst.Tags, offlineTag{tag, offlineIdentity, now})
+			}
+		}
+		return nil
+	})
+}
+
+func (inst *offlineInstance) hasTag(tag string) bool {
+	for _, t := range inst.Tags {
+		if t.Tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *offlineRepo) fetchTags(ctx context.Context, pin common.Pin, tags []string) ([]TagInfo, error) {
+	_, inst, err := r.readInstance(pin)
+	if err != nil {
+		return nil, err
+	}
+	out := []TagInfo{}
+	for _, t := range inst.Tags {
+		if len(tags) == 0 || containsString(tags, t.Tag) {
+			out = append(out, TagInfo{
+				Tag:          t.Tag,
+				RegisteredBy: t.RegisteredBy,
+				RegisteredTs: UnixTime(t.RegisteredTs),
+			})
+		}
+	}
+	return out, nil
+}
+
+func (r *offlineRepo) fetchRefs(ctx context.Context, pin common.Pin, refs []string) ([]RefInfo, error) {
+	pkg, _, err := r.readInstance(pin)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(pkg.Refs))
+	for name := range pkg.Refs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := []RefInfo{}
+	for _, name := range names {
+		ref := pkg.Refs[name]
+		if ref.InstanceID == pin.InstanceID && (len(refs) == 0 || containsString(refs, name)) {
+			out = append(out, RefInfo{
+				Ref:        name,
+				ModifiedBy: ref.ModifiedBy,
+				ModifiedTs: UnixTime(ref.ModifiedTs),
+			})
+		}
+	}
+	return out, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *offlineRepo) fetchInstance(ctx context.Context, pin common.Pin) (*fetchInstanceResponse, error) {
+	_, inst, err := r.readInstance(pin)
+	if err != nil {
+		return nil, err
+	}
+	if !r.hasInstanceFile(pin.InstanceID) {
+		return nil, fmt.Errorf("the file of instance %s is missing from the offline repository", pin)
+	}
+	return &fetchInstanceResponse{
+		fetchURL:     pin.InstanceID,
+		registeredBy: inst.RegisteredBy,
+		registeredTs: inst.RegisteredTs,
+	}, nil
+}
+
+func (r *offlineRepo) fetchClientBinaryInfo(ctx context.Context, pin common.Pin) (*fetchClientBinaryInfoResponse, error) {
+	return nil, offlineUnsupported("client self-update")
+}
+
+func (r *offlineRepo) listPackages(ctx context.Context, dir string, recursive, showHidden bool) ([]string, []string, error) {
+	prefix := strings.Trim(dir, "/")
+	if prefix != "" {
+		if err := common.ValidatePackageName(prefix); err != nil {
+			return nil, nil, err
+		}
+	}
+	all, err := r.listAllPackages(prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Packages and directories are listed like the backend does: with full
+	// names, and only the direct children of the prefix if not recursive.
+	pkgs := []string{}
+	dirs := []string{}
+	seenDirs := map[string]bool{}
+	for _, pkg := range all {
+		rel := pkg
+		if prefix != "" {
+			rel = strings.TrimPrefix(pkg, prefix+"/")
+		}
+		if rel == pkg && prefix != "" {
+			continue // the prefix itself
+		}
+		chunks := strings.Split(rel, "/")
+		if recursive || len(chunks) == 1 {
+			pkgs = append(pkgs, pkg)
+		}
+		for i := 1; i < len(chunks); i++ {
+			if !recursive && i > 1 {
+				break
+			}
+			dir := path.Join(prefix, strings.Join(chunks[:i], "/"))
+			if !seenDirs[dir] {
+				seenDirs[dir] = true
+				dirs = append(dirs, dir)
+			}
+		}
+	}
+	return pkgs, dirs, nil
+}
+
+func (r *offlineRepo) searchInstances(ctx context.Context, tag, packageName string) (common.PinSlice, error) {
+	if err := common.ValidateInstanceTag(tag); err != nil {
+		return nil, err
+	}
+	names := []string{packageName}
+	if packageName == "" {
+		var err error
+		if names, err = r.listAllPackages(""); err != nil {
+			return nil, err
+		}
+	}
+	out := common.PinSlice{}
+	for _, name := range names {
+		pkg, err := r.readPackage(name)
+		if err != nil {
+			return nil, err
+		}
+		if pkg == nil {
+			continue
+		}
+		ids := []string{}
+		for id, inst := range pkg.Instances {
+			if inst.hasTag(tag) {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			out = append(out, common.Pin{PackageName: name, InstanceID: id})
+		}
+	}
+	return out, nil
+}
+
+func (r *offlineRepo) incrementCounter(ctx context.Context, pin common.Pin, counter string, delta int) error {
+	return offlineUnsupported("counters")
+}
+
+func (r *offlineRepo) readCounter(ctx context.Context, pin common.Pin, counter string) (Counter, error) {
+	return Counter{}, offlineUnsupported("counters")
+}
+
+// upload stores an instance file, checking it matches the instance ID.
+func (r *offlineRepo) upload(ctx context.Context, url string, data io.ReadSeeker) error {
+	if err := common.ValidateInstanceID(url); err != nil {
+		return err
+	}
+	if _, err := data.Seek(0, os.SEEK_SET); err != nil {
+		return err
+	}
+	return r.fs.EnsureFile(ctx, r.instancePath(url), func(f *os.File) error {
+		h, err := common.HashForInstanceID(url)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(io.MultiWriter(f, h), data); err != nil {
+			return err
+		}
+		if common.InstanceIDFromHash(h) != url {
+			return fmt.Errorf("package hash mismatch")
+		}
+		return nil
+	})
+}
+
+// download copies an instance file to output.
+func (r *offlineRepo) download(ctx context.Context, url string, output io.WriteSeeker, h hash.Hash) error {
+	if err := common.ValidateInstanceID(url); err != nil {
+		return err
+	}
+	f, err := os.Open(r.instancePath(url))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h.Reset()
+	if _, err := output.Seek(0, os.SEEK_SET); err != nil {
+		return err
+	}
+	_, err = io.Copy(io.MultiWriter(output, h), f)
+	return err
+}
+
+// openRanges is not supported, instance files are local and reading them whole
+// is cheap.
+func (r *offlineRepo) openRanges(ctx context.Context, url string) (rangeReader, error) {
+	return nil, errRangesUnsupported
+}
+
+// mirror copies an instance with its tags and refs from src.
+func (r *offlineRepo) mirror(ctx context.Context, src Client, pin common.Pin) error {
+	info, err := src.FetchInstanceInfo(ctx, pin)
+	if err != nil {
+		return err
+	}
+	tags, err := src.FetchInstanceTags(ctx, pin, nil)
+	if err != nil {
+		return err
+	}
+	refs, err := src.FetchInstanceRefs(ctx, pin, nil)
+	if err != nil {
+		return err
+	}
+
+	if r.hasInstanceFile(pin.InstanceID) {
+		logging.Infof(ctx, "cipd: %s is already mirrored", pin)
+	} else {
+		err := r.fs.EnsureFile(ctx, r.instancePath(pin.InstanceID), func(f *os.File) error {
+			return src.FetchInstanceTo(ctx, pin, f)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.withLock(ctx, func() error {
+		pkg, err := r.readPackage(pin.PackageName)
+		if err != nil {
+			return err
+		}
+		if pkg == nil {
+			pkg = &offlinePackage{
+				Instances: map[string]*offlineInstance{},
+				Refs:      map[string]*offlineRef{},
+			}
+		}
+		inst := pkg.Instances[pin.InstanceID]
+		if inst == nil {
+			inst = &offlineInstance{}
+			pkg.Instances[pin.InstanceID] = inst
+		}
+		inst.RegisteredBy = info.RegisteredBy
+		inst.RegisteredTs = time.Time(info.RegisteredTs).UTC()
+		for _, t := range tags {
+			if !inst.hasTag(t.Tag) {
+				inst.Tags = append(inst.Tags, offlineTag{t.Tag, t.RegisteredBy, time.Time(t.RegisteredTs).UTC()})
+			}
+		}
+		for _, ref := range refs {
+			pkg.Refs[ref.Ref] = &offlineRef{
+				InstanceID: pin.InstanceID,
+				ModifiedBy: ref.ModifiedBy,
+				ModifiedTs: time.Time(ref.ModifiedTs).UTC(),
+			}
+		}
+		return r.writeJSON(ctx, r.packagePath(pin.PackageName), pkg)
+	})
+}
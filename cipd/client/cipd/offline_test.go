@@ -0,0 +1,206 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cipd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/luci/luci-go/cipd/client/cipd/common"
+	"github.com/luci/luci-go/cipd/client/cipd/local"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOfflineRepo(t *testing.T) {
+	ctx := makeTestContext()
+
+	Convey("With an offline repository", t, func() {
+		tempDir, err := ioutil.TempDir("", "cipd_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tempDir)
+
+		newClient := func(dir, root string) Client {
+			serviceURL, err := OfflineServiceURL(filepath.Join(tempDir, dir))
+			So(err, ShouldBeNil)
+			client, err := NewClient(ClientOptions{
+				ServiceURL: serviceURL,
+				Root:       root,
+			})
+			So(err, ShouldBeNil)
+			return client
+		}
+		client := newClient("repo", "")
+
+		inst1 := buildInstanceInMemory(ctx, "a/pkg", []local.File{
+			local.NewTestFile("file", "data 1", false),
+		})
+		inst2 := buildInstanceInMemory(ctx, "a/pkg", []local.File{
+			local.NewTestFile("file", "data 2", false),
+		})
+		So(client.RegisterInstance(ctx, inst1, 0), ShouldBeNil)
+		So(client.RegisterInstance(ctx, inst2, 0), ShouldBeNil)
+
+		Convey("RegisterInstance stores the instance", func() {
+			So(client.RegisterInstance(ctx, inst1, 0), ShouldBeNil)
+			info, err := client.FetchInstanceInfo(ctx, inst1.Pin())
+			So(err, ShouldBeNil)
+			So(info.RegisteredBy, ShouldEqual, offlineIdentity)
+
+			_, err = os.Stat(filepath.Join(tempDir, "repo", "instances", inst1.Pin().InstanceID+".zip"))
+			So(err, ShouldBeNil)
+		})
+
+		Convey("ResolveVersion works", func() {
+			So(client.AttachTagsWhenReady(ctx, inst1.Pin(), []string{"version:1", "common:tag"}), ShouldBeNil)
+			So(client.AttachTagsWhenReady(ctx, inst2.Pin(), []string{"version:2", "common:tag"}), ShouldBeNil)
+			So(client.SetRefWhenReady(ctx, "latest", inst2.Pin()), ShouldBeNil)
+
+			pin, err := client.ResolveVersion(ctx, "a/pkg", "version:1")
+			So(err, ShouldBeNil)
+			So(pin, ShouldResemble, inst1.Pin())
+
+			pin, err = client.ResolveVersion(ctx, "a/pkg", "latest")
+			So(err, ShouldBeNil)
+			So(pin, ShouldResemble, inst2.Pin())
+
+			_, err = client.ResolveVersion(ctx, "a/pkg", "common:tag")
+			So(err, ShouldErrLike, "more than one instance")
+
+			_, err = client.ResolveVersion(ctx, "a/pkg", "version:3")
+			So(err, ShouldErrLike, "doesn't have instance with version")
+
+			_, err = client.ResolveVersion(ctx, "a/unknown", "latest")
+			So(err, ShouldErrLike, "is not registered")
+		})
+
+		Convey("Tags and refs can be fetched", func() {
+			So(client.AttachTagsWhenReady(ctx, inst1.Pin(), []string{"version:1", "other:tag"}), ShouldBeNil)
+			So(client.SetRefWhenReady(ctx, "stable", inst1.Pin()), ShouldBeNil)
+			So(client.SetRefWhenReady(ctx, "latest", inst2.Pin()), ShouldBeNil)
+
+			tags, err := client.FetchInstanceTags(ctx, inst1.Pin(), []string{"version:1"})
+			So(err, ShouldBeNil)
+			So(len(tags), ShouldEqual, 1)
+			So(tags[0].Tag, ShouldEqual, "version:1")
+
+			refs, err := client.FetchInstanceRefs(ctx, inst1.Pin(), nil)
+			So(err, ShouldBeNil)
+			So(len(refs), ShouldEqual, 1)
+			So(refs[0].Ref, ShouldEqual, "stable")
+		})
+
+		Convey("SetRefWhenReady fails for unknown instances", func() {
+			pin := Pin{"a/pkg", "0123456789012345678901234567890123456789"}
+			So(client.SetRefWhenReady(ctx, "latest", pin), ShouldErrLike, "doesn't have instance")
+		})
+
+		Convey("SearchInstances works", func() {
+			So(client.AttachTagsWhenReady(ctx, inst2.Pin(), []string{"version:2"}), ShouldBeNil)
+			pins, err := client.SearchInstances(ctx, "version:2", "")
+			So(err, ShouldBeNil)
+			So(pins, ShouldResemble, PinSlice{inst2.Pin()})
+
+			pins, err = client.SearchInstances(ctx, "version:2", "a/other")
+			So(err, ShouldBeNil)
+			So(pins, ShouldResemble, PinSlice{})
+		})
+
+		Convey("ListPackages works", func() {
+			inst3 := buildInstanceInMemory(ctx, "a/b/c/pkg", []local.File{
+				local.NewTestFile("file", "data 3", false),
+			})
+			So(client.RegisterInstance(ctx, inst3, 0), ShouldBeNil)
+
+			pkgs, err := client.ListPackages(ctx, "a", false, false)
+			So(err, ShouldBeNil)
+			So(pkgs, ShouldResemble, []string{"a/b/", "a/pkg"})
+
+			pkgs, err = client.ListPackages(ctx, "", true, false)
+			So(err, ShouldBeNil)
+			So(pkgs, ShouldResemble, []string{"a/", "a/b/", "a/b/c/", "a/b/c/pkg", "a/pkg"})
+		})
+
+		Convey("ACLs can be modified", func() {
+			err := client.ModifyACL(ctx, "a", []PackageACLChange{
+				{Action: GrantRole, Role: "READER", Principal: "group:all"},
+				{Action: GrantRole, Role: "OWNER", Principal: "user:a@example.com"},
+			})
+			So(err, ShouldBeNil)
+			err = client.ModifyACL(ctx, "a", []PackageACLChange{
+				{Action: RevokeRole, Role: "OWNER", Principal: "user:a@example.com"},
+			})
+			So(err, ShouldBeNil)
+
+			acls, err := client.FetchACL(ctx, "a/pkg")
+			So(err, ShouldBeNil)
+			So(len(acls), ShouldEqual, 1)
+			So(acls[0].PackagePath, ShouldEqual, "a")
+			So(acls[0].Role, ShouldEqual, "READER")
+			So(acls[0].Principals, ShouldResemble, []string{"group:all"})
+
+			acls, err = client.FetchACL(ctx, "b")
+			So(err, ShouldBeNil)
+			So(acls, ShouldResemble, []PackageACL{})
+		})
+
+		Convey("EnsurePackages works", func() {
+			root := filepath.Join(tempDir, "root")
+			client := newClient("repo", root)
+			_, err := client.EnsurePackages(ctx, PinSliceBySubdir{"": PinSlice{inst1.Pin()}}, false)
+			So(err, ShouldBeNil)
+
+			data, err := ioutil.ReadFile(filepath.Join(root, "file"))
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, "data 1")
+		})
+
+		Convey("DeletePackage works", func() {
+			So(client.DeletePackage(ctx, "a/pkg"), ShouldBeNil)
+			_, err := client.ResolveVersion(ctx, "a/pkg", "latest")
+			So(err, ShouldErrLike, "is not registered")
+			So(client.DeletePackage(ctx, "a/pkg"), ShouldEqual, ErrPackageNotFound)
+		})
+
+		Convey("Counters are not supported", func() {
+			So(client.IncrementCounter(ctx, inst1.Pin(), "test", 1), ShouldErrLike, "not supported")
+		})
+
+		Convey("MirrorInstances works", func() {
+			So(client.AttachTagsWhenReady(ctx, inst1.Pin(), []string{"version:1"}), ShouldBeNil)
+			So(client.SetRefWhenReady(ctx, "latest", inst1.Pin()), ShouldBeNil)
+
+			dir := filepath.Join(tempDir, "mirror")
+			So(MirrorInstances(ctx, client, dir, []Pin{inst1.Pin()}), ShouldBeNil)
+			// Mirroring again is a noop.
+			So(MirrorInstances(ctx, client, dir, []Pin{inst1.Pin()}), ShouldBeNil)
+
+			mirror := newClient("mirror", "")
+			pin, err := mirror.ResolveVersion(ctx, "a/pkg", "latest")
+			So(err, ShouldBeNil)
+			So(pin, ShouldResemble, inst1.Pin())
+
+			pin, err = mirror.ResolveVersion(ctx, "a/pkg", "version:1")
+			So(err, ShouldBeNil)
+			So(pin, ShouldResemble, inst1.Pin())
+
+			_, err = mirror.FetchInstanceInfo(ctx, inst2.Pin())
+			So(err, ShouldErrLike, "doesn't have instance")
+
+			out, err := os.Create(filepath.Join(tempDir, "fetched"))
+			So(err, ShouldBeNil)
+			So(mirror.FetchInstanceTo(ctx, inst1.Pin(), out), ShouldBeNil)
+			So(out.Close(), ShouldBeNil)
+			data, err := ioutil.ReadFile(out.Name())
+			So(err, ShouldBeNil)
+			expected, err := ioutil.ReadFile(filepath.Join(tempDir, "repo", "instances", inst1.Pin().InstanceID+".zip"))
+			So(err, ShouldBeNil)
+			So(data, ShouldResemble, expected)
+		})
+	})
+}
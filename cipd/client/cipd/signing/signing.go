@@ -0,0 +1,243 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package signing implements signing of package instances and verification of
+// the signatures.
+//
+// A signature is an ECDSA P-256 signature of the package name and instance ID.
+// Since the instance ID is the hash of the package file, it covers the package
+// content too. Signatures are stored as instance tags:
+//
+//   signature:<key ID>:<base64 signature>
+//
+// where the key ID identifies the public key needed to verify the signature.
+//
+// Keys are PEM files, as produced by:
+//
+//   openssl ecparam -name prime256v1 -genkey -noout -out private.pem
+//   openssl ec -in private.pem -pubout -out public.pem
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+)
+
+// TagKey is the key of the instance tags holding signatures.
+const TagKey = "signature"
+
+// ErrUnsigned is returned by KeyRing.Verify if the instance isn't signed by
+// any of the trusted keys.
+var ErrUnsigned = errors.New("the instance is not signed by a trusted key")
+
+// Signer signs package instances with a private key.
+type Signer struct {
+	key *ecdsa.PrivateKey
+	id  string
+}
+
+// NewSigner returns a Signer using the P-256 private key.
+func NewSigner(key *ecdsa.PrivateKey) (*Signer, error) {
+	if key.Curve != elliptic.P256() {
+		return nil, errors.New("only P-256 keys are supported")
+	}
+	id, err := KeyID(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{key, id}, nil
+}
+
+// LoadSigner reads a PEM encoded private key and returns a Signer using it.
+func LoadSigner(path string) (*Signer, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	var key interface{}
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%s: unexpected PEM block %q", path, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: not an ECDSA key", path)
+	}
+	return NewSigner(ecKey)
+}
+
+// KeyID returns the ID of the public key of the signer.
+func (s *Signer) KeyID() string {
+	return s.id
+}
+
+// Sign returns the signature of the instance, as an instance tag.
+func (s *Signer) Sign(pin common.Pin) (string, error) {
+	if err := common.ValidatePin(pin); err != nil {
+		return "", err
+	}
+	r, ss, err := ecdsa.Sign(rand.Reader, s.key, digest(pin))
+	if err != nil {
+		return "", err
+	}
+	sig, err := asn1.Marshal(ecdsaSignature{r, ss})
+	if err != nil {
+		return "", err
+	}
+	return TagKey + ":" + s.id + ":" + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// KeyRing is a set of trusted public keys.
+//
+// The zero value is an empty KeyRing.
+type KeyRing struct {
+	keys map[string]*ecdsa.PublicKey
+}
+
+// LoadKeyRing reads the PEM encoded public keys and returns a KeyRing trusting
+// them.
+func LoadKeyRing(paths ...string) (*KeyRing, error) {
+	k := &KeyRing{}
+	for _, p := range paths {
+		block, err := readPEM(p)
+		if err != nil {
+			return nil, err
+		}
+		if block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("%s: unexpected PEM block %q", p, block.Type)
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", p, err)
+		}
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("%s: not an ECDSA key", p)
+		}
+		if _, err := k.Add(ecKey); err != nil {
+			return nil, fmt.Errorf("%s: %s", p, err)
+		}
+	}
+	return k, nil
+}
+
+// Add trusts the public key and returns its ID.
+func (k *KeyRing) Add(key *ecdsa.PublicKey) (string, error) {
+	if key.Curve != elliptic.P256() {
+		return "", errors.New("only P-256 keys are supported")
+	}
+	id, err := KeyID(key)
+	if err != nil {
+		return "", err
+	}
+	if k.keys == nil {
+		k.keys = map[string]*ecdsa.PublicKey{}
+	}
+	k.keys[id] = key
+	return id, nil
+}
+
+// Empty returns true if no key is trusted.
+func (k *KeyRing) Empty() bool {
+	return k == nil || len(k.keys) == 0
+}
+
+// Verify checks that tags contain a valid signature of the instance by one of
+// the trusted keys.
+//
+// Tags that are not signatures and signatures by untrusted keys are ignored.
+// It returns ErrUnsigned if there's no signature by a trusted key, and an error
+// if any signature by a trusted key is invalid.
+func (k *KeyRing) Verify(pin common.Pin, tags []string) error {
+	if k.Empty() {
+		return ErrUnsigned
+	}
+	signed := false
+	for _, t := range tags {
+		if common.GetInstanceTagKey(t) != TagKey {
+			continue
+		}
+		chunks := strings.SplitN(t[len(TagKey)+1:], ":", 2)
+		if len(chunks) != 2 {
+			return fmt.Errorf("malformed signature tag %q", t)
+		}
+		key := k.keys[chunks[0]]
+		if key == nil {
+			continue
+		}
+		raw, err := base64.RawURLEncoding.DecodeString(chunks[1])
+		if err != nil {
+			return fmt.Errorf("malformed signature by key %s - %s", chunks[0], err)
+		}
+		var sig ecdsaSignature
+		if rest, err := asn1.Unmarshal(raw, &sig); err != nil || len(rest) != 0 || sig.R == nil || sig.S == nil {
+			return fmt.Errorf("malformed signature by key %s", chunks[0])
+		}
+		if !ecdsa.Verify(key, digest(pin), sig.R, sig.S) {
+			return fmt.Errorf("invalid signature of %s by key %s", pin, chunks[0])
+		}
+		signed = true
+	}
+	if !signed {
+		return ErrUnsigned
+	}
+	return nil
+}
+
+// KeyID returns the ID of a public key, as used in signature tags.
+func KeyID(key *ecdsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(der)
+	return hex.EncodeToString(h[:8]), nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// ecdsaSignature is the ASN.1 encoding of an ECDSA signature.
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
+// digest returns the hash of the signed message of an instance.
+func digest(pin common.Pin) []byte {
+	h := sha256.New()
+	fmt.Fprintf(h, "cipd-instance-signature-v1\x00%s\x00%s", pin.PackageName, pin.InstanceID)
+	return h.Sum(nil)
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM data found", path)
+	}
+	return block, nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/luci/luci-go/cipd/client/cipd/common"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSigning(t *testing.T) {
+	t.Parallel()
+
+	Convey("Given a key", t, func() {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		So(err, ShouldBeNil)
+		signer, err := NewSigner(key)
+		So(err, ShouldBeNil)
+		trusted := &KeyRing{}
+		id, err := trusted.Add(&key.PublicKey)
+		So(err, ShouldBeNil)
+		So(id, ShouldEqual, signer.KeyID())
+
+		pin := common.Pin{
+			PackageName: "some/package",
+			InstanceID:  "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		}
+		sig, err := signer.Sign(pin)
+		So(err, ShouldBeNil)
+		So(common.ValidateInstanceTag(sig), ShouldBeNil)
+		So(strings.HasPrefix(sig, TagKey+":"+id+":"), ShouldBeTrue)
+
+		Convey("Valid signature", func() {
+			So(trusted.Verify(pin, []string{"a:b", sig}), ShouldBeNil)
+		})
+
+		Convey("Signature of another instance", func() {
+			other := pin
+			other.InstanceID = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+			So(trusted.Verify(other, []string{sig}), ShouldNotBeNil)
+			other = pin
+			other.PackageName = "other/package"
+			So(trusted.Verify(other, []string{sig}), ShouldNotBeNil)
+		})
+
+		Convey("Unsigned", func() {
+			So(trusted.Verify(pin, []string{"a:b"}), ShouldEqual, ErrUnsigned)
+		})
+
+		Convey("Untrusted key", func() {
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			So(err, ShouldBeNil)
+			otherSigner, err := NewSigner(otherKey)
+			So(err, ShouldBeNil)
+			otherSig, err := otherSigner.Sign(pin)
+			So(err, ShouldBeNil)
+			So(trusted.Verify(pin, []string{otherSig}), ShouldEqual, ErrUnsigned)
+			So(trusted.Verify(pin, []string{otherSig, sig}), ShouldBeNil)
+		})
+
+		Convey("Malformed signature", func() {
+			So(trusted.Verify(pin, []string{TagKey + ":" + id + ":AAAA"}), ShouldNotBeNil)
+			So(trusted.Verify(pin, []string{TagKey + ":" + id}), ShouldNotBeNil)
+		})
+
+		Convey("Empty key ring", func() {
+			So((&KeyRing{}).Verify(pin, []string{sig}), ShouldEqual, ErrUnsigned)
+			So((*KeyRing)(nil).Empty(), ShouldBeTrue)
+		})
+
+		Convey("Loading PEM files", func() {
+			tempDir, err := ioutil.TempDir("", "cipd_test")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(tempDir)
+
+			der, err := x509.MarshalECPrivateKey(key)
+			So(err, ShouldBeNil)
+			privPath := filepath.Join(tempDir, "private.pem")
+			So(ioutil.WriteFile(privPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600), ShouldBeNil)
+
+			der, err = x509.MarshalPKIXPublicKey(&key.PublicKey)
+			So(err, ShouldBeNil)
+			pubPath := filepath.Join(tempDir, "public.pem")
+			So(ioutil.WriteFile(pubPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600), ShouldBeNil)
+
+			loaded, err := LoadSigner(privPath)
+			So(err, ShouldBeNil)
+			So(loaded.KeyID(), ShouldEqual, id)
+			sig, err := loaded.Sign(pin)
+			So(err, ShouldBeNil)
+
+			ring, err := LoadKeyRing(pubPath)
+			So(err, ShouldBeNil)
+			So(ring.Verify(pin, []string{sig}), ShouldBeNil)
+
+			_, err = LoadKeyRing(privPath)
+			So(err, ShouldNotBeNil)
+			_, err = LoadSigner(pubPath)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"hash"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"golang.org/x/net/context"
@@ -29,14 +31,24 @@ const (
 	downloadReportInterval = 5 * time.Second
 	// downloadMaxAttempts is how many times to retry a download on errors.
 	downloadMaxAttempts = 10
+	// rangeBlockSize is the size of a single range request when reading a file
+	// with openRanges.
+	rangeBlockSize int64 = 256 * 1024
+	// rangeCacheBlocks is how many recently fetched blocks are kept in memory.
+	rangeCacheBlocks = 8
 )
 
 // errTransientError is returned by getNextOffset in case of retryable error.
 var errTransientError = errors.New("Transient error in getUploadedOffset")
 
+// errRangesUnsupported is returned by openRanges if the storage doesn't support
+// range requests.
+var errRangesUnsupported = errors.New("range requests are not supported")
+
 // storageImpl implements storage via Google Storage signed URLs.
 type storageImpl struct {
 	chunkSize int64
+	blockSize int64
 	userAgent string
 	client    *http.Client
 }
@@ -303,3 +315,208 @@ func (r *readerWithProgress) Read(p []byte) (int, error) {
 	r.callback(r.total)
 	return n, err
 }
+
+////////////////////////////////////////////////////////////////////////////////
+// Random access via HTTP range requests.
+
+// rangeReader reads parts of a remote file on demand.
+type rangeReader interface {
+	io.ReadSeeker
+	io.ReaderAt
+
+	// fetched returns the number of bytes fetched from the storage so far.
+	fetched() int64
+}
+
+func (s *storageImpl) openRanges(ctx context.Context, url string) (rangeReader, error) {
+	r := &httpRangeReader{ctx: ctx, storage: s, url: url}
+	// The tail of the file holds the zip central directory, which is always
+	// read first. Fetching it also tells the size of the file.
+	tail, size, err := s.fetchRange(ctx, url, fmt.Sprintf("bytes=-%d", s.blockSize))
+	if err != nil {
+		return nil, err
+	}
+	r.size = size
+	r.tail = rangeBlock{size - int64(len(tail)), tail}
+	r.total = int64(len(tail))
+	return r, nil
+}
+
+// fetchRange fetches a range of a file, retrying on transient errors.
+//
+// Returns the data and the total size of the file. Returns errRangesUnsupported
+// if the server replies with the whole file.
+func (s *storageImpl) fetchRange(ctx context.Context, url, rng string) (data []byte, size int64, err error) {
+	for attempt := 0; attempt < downloadMaxAttempts; attempt++ {
+		if attempt != 0 {
+			logging.Warningf(ctx, "cipd: transient error fetching %s of the file - %s", rng, err)
+			clock.Sleep(ctx, 2*time.Second)
+		}
+		if err = ctx.Err(); err != nil {
+			return
+		}
+
+		var req *http.Request
+		if req, err = http.NewRequest("GET", url, nil); err != nil {
+			return
+		}
+		req.Header.Set("Range", rng)
+		req.Header.Set("User-Agent", s.userAgent)
+		var resp *http.Response
+		if resp, err = ctxhttp.Do(ctx, s.client, req); err != nil {
+			if isTemporaryNetError(err) {
+				continue
+			}
+			return
+		}
+
+		switch {
+		case isTemporaryHTTPError(resp.StatusCode):
+			resp.Body.Close()
+			err = fmt.Errorf("HTTP %d", resp.StatusCode)
+			continue
+		case resp.StatusCode == http.StatusOK:
+			resp.Body.Close()
+			return nil, 0, errRangesUnsupported
+		case resp.StatusCode != http.StatusPartialContent:
+			resp.Body.Close()
+			return nil, 0, fmt.Errorf("server replied with HTTP code %d", resp.StatusCode)
+		}
+
+		var start, end int64
+		_, err = fmt.Sscanf(resp.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &size)
+		if err != nil {
+			resp.Body.Close()
+			return nil, 0, fmt.Errorf("bad Content-Range header %q", resp.Header.Get("Content-Range"))
+		}
+		data, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			continue
+		}
+		if int64(len(data)) != end-start+1 {
+			err = fmt.Errorf("expecting %d bytes, got %d", end-start+1, len(data))
+			continue
+		}
+		return
+	}
+	return nil, 0, ErrDownloadError
+}
+
+// rangeBlock is a part of a file starting at offset.
+type rangeBlock struct {
+	offset int64
+	data   []byte
+}
+
+func (b *rangeBlock) contains(offset int64) bool {
+	return offset >= b.offset && offset < b.offset+int64(len(b.data))
+}
+
+// httpRangeReader implements rangeReader with HTTP range requests.
+//
+// It fetches the file by blocks of storageImpl.blockSize and keeps the last fetched
+// blocks in memory, since small sequential reads are common.
+type httpRangeReader struct {
+	ctx     context.Context
+	storage *storageImpl
+	url     string
+	size    int64
+
+	lock   sync.Mutex
+	tail   rangeBlock   // the end of the file, fetched by openRanges
+	blocks []rangeBlock // recently fetched blocks, most recent last
+	total  int64        // total number of bytes fetched
+	offset int64        // current offset for Read and Seek
+}
+
+func (r *httpRangeReader) fetched() int64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.total
+}
+
+func (r *httpRangeReader) ReadAt(p []byte, off int64) (n int, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if off < 0 {
+		return 0, fmt.Errorf("negative offset")
+	}
+	for n < len(p) {
+		if off >= r.size {
+			return n, io.EOF
+		}
+		b, err := r.block(off)
+		if err != nil {
+			return n, err
+		}
+		copied := copy(p[n:], b.data[off-b.offset:])
+		n += copied
+		off += int64(copied)
+	}
+	return n, nil
+}
+
+// block returns a block containing the offset, fetching it if necessary.
+//
+// Must be called under the lock.
+func (r *httpRangeReader) block(off int64) (*rangeBlock, error) {
+	if r.tail.contains(off) {
+		return &r.tail, nil
+	}
+	for i := range r.blocks {
+		if r.blocks[i].contains(off) {
+			return &r.blocks[i], nil
+		}
+	}
+
+	start := off - off%r.storage.blockSize
+	end := start + r.storage.blockSize
+	if end > r.tail.offset {
+		end = r.tail.offset
+	}
+	data, _, err := r.storage.fetchRange(r.ctx, r.url, fmt.Sprintf("bytes=%d-%d", start, end-1))
+	if err != nil {
+		return nil, err
+	}
+	r.total += int64(len(data))
+
+	if len(r.blocks) == rangeCacheBlocks {
+		r.blocks = append(r.blocks[:0], r.blocks[1:]...)
+	}
+	r.blocks = append(r.blocks, rangeBlock{start, data})
+	return &r.blocks[len(r.blocks)-1], nil
+}
+
+func (r *httpRangeReader) Read(p []byte) (int, error) {
+	r.lock.Lock()
+	off := r.offset
+	r.lock.Unlock()
+	n, err := r.ReadAt(p, off)
+	r.lock.Lock()
+	r.offset += int64(n)
+	r.lock.Unlock()
+	if err == io.EOF && n != 0 {
+		err = nil
+	}
+	return n, err
+}
+
+func (r *httpRangeReader) Seek(offset int64, whence int) (int64, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	switch whence {
+	case os.SEEK_SET:
+	case os.SEEK_CUR:
+		offset += r.offset
+	case os.SEEK_END:
+		offset += r.size
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("negative offset")
+	}
+	r.offset = offset
+	return offset, nil
+}
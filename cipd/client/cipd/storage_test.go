@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"crypto/sha1"
 	"encoding/hex"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -114,12 +115,81 @@ func TestDownload(t *testing.T) {
 	})
 }
 
+func TestOpenRanges(t *testing.T) {
+	ctx := makeTestContext()
+
+	rangeCall := func(rng, reply, contentRange string) expectedHTTPCall {
+		return expectedHTTPCall{
+			Method:          "GET",
+			Path:            "/dwn",
+			Headers:         http.Header{"Range": []string{rng}},
+			Status:          206,
+			Reply:           reply,
+			ResponseHeaders: http.Header{"Content-Range": []string{contentRange}},
+		}
+	}
+
+	Convey("openRanges fetches only the blocks being read", t, func(c C) {
+		storage := mockStorageImpl(c, []expectedHTTPCall{
+			rangeCall("bytes=-4", "cdef", "bytes 12-15/16"),
+			rangeCall("bytes=0-3", "0123", "bytes 0-3/16"),
+			// Simulate a transient error.
+			{
+				Method:  "GET",
+				Path:    "/dwn",
+				Headers: http.Header{"Range": []string{"bytes=4-7"}},
+				Status:  500,
+				Reply:   "error",
+			},
+			rangeCall("bytes=4-7", "4567", "bytes 4-7/16"),
+			rangeCall("bytes=8-11", "89ab", "bytes 8-11/16"),
+		})
+		r, err := storage.openRanges(ctx, "http://localhost/dwn")
+		So(err, ShouldBeNil)
+
+		size, err := r.Seek(0, os.SEEK_END)
+		So(err, ShouldBeNil)
+		So(size, ShouldEqual, 16)
+
+		buf := make([]byte, 6)
+		n, err := r.ReadAt(buf, 3)
+		So(err, ShouldBeNil)
+		So(string(buf[:n]), ShouldEqual, "345678")
+
+		// Cached blocks are not fetched again.
+		n, err = r.ReadAt(buf[:4], 10)
+		So(err, ShouldBeNil)
+		So(string(buf[:n]), ShouldEqual, "abcd")
+
+		n, err = r.ReadAt(buf, 14)
+		So(err, ShouldEqual, io.EOF)
+		So(string(buf[:n]), ShouldEqual, "ef")
+
+		So(r.fetched(), ShouldEqual, 16)
+	})
+
+	Convey("openRanges detects servers without range requests", t, func(c C) {
+		storage := mockStorageImpl(c, []expectedHTTPCall{
+			{
+				Method:  "GET",
+				Path:    "/dwn",
+				Headers: http.Header{"Range": []string{"bytes=-4"}},
+				Status:  200,
+				Reply:   "file data",
+			},
+		})
+		_, err := storage.openRanges(ctx, "http://localhost/dwn")
+		So(err, ShouldEqual, errRangesUnsupported)
+	})
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func mockStorageImpl(c C, expectations []expectedHTTPCall) *storageImpl {
 	client := mockClient(c, "", expectations)
 	return &storageImpl{
 		chunkSize: 5,
+		blockSize: 4,
 		userAgent: client.UserAgent,
 		client:    client.AnonymousClient,
 	}
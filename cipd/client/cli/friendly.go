@@ -92,6 +92,9 @@ type installationSiteConfig struct {
 	TrackedVersions map[string]string `json:",omitempty"`
 	// CacheDir contains shared cache.
 	CacheDir string `json:",omitempty"`
+	// TrustedKeys are paths to public keys installed packages must be signed
+	// with.
+	TrustedKeys []string `json:",omitempty"`
 }
 
 // read loads JSON from given path.
@@ -213,9 +216,10 @@ func (site *installationSite) initClient(ctx context.Context, authFlags authcli.
 		return errors.New("client is already initialized")
 	}
 	clientOpts := clientOptions{
-		authFlags:  authFlags,
-		serviceURL: site.cfg.ServiceURL,
-		cacheDir:   site.cfg.CacheDir,
+		authFlags:   authFlags,
+		serviceURL:  site.cfg.ServiceURL,
+		cacheDir:    site.cfg.CacheDir,
+		trustedKeys: site.cfg.TrustedKeys,
 	}
 	site.client, err = clientOpts.makeCipdClient(ctx, site.siteRoot)
 	return err
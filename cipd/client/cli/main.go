@@ -5,6 +5,7 @@
 package cli
 
 import (
+	"bytes"
 	"crypto/sha1"
 	"encoding/hex"
 	"encoding/json"
@@ -15,6 +16,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -35,6 +37,7 @@ import (
 	"github.com/luci/luci-go/cipd/client/cipd/common"
 	"github.com/luci/luci-go/cipd/client/cipd/ensure"
 	"github.com/luci/luci-go/cipd/client/cipd/local"
+	"github.com/luci/luci-go/cipd/client/cipd/signing"
 	"github.com/luci/luci-go/cipd/version"
 )
 
@@ -246,9 +249,13 @@ func makeCLIError(msg string, args ...interface{}) error {
 // clientOptions defines command line arguments related to CIPD client creation.
 // Subcommands that need a CIPD client embed it.
 type clientOptions struct {
-	authFlags  authcli.Flags
-	serviceURL string
-	cacheDir   string
+	authFlags         authcli.Flags
+	serviceURL        string
+	cacheDir          string
+	cacheMaxInstances int
+	cacheMaxSize      int64
+	trustedKeys       keyList
+	differential      bool
 }
 
 func (opts *clientOptions) registerFlags(f *flag.FlagSet, params Parameters) {
@@ -256,6 +263,14 @@ func (opts *clientOptions) registerFlags(f *flag.FlagSet, params Parameters) {
 		"Backend URL. If provided via an 'ensure file', the URL in the file takes precedence.")
 	f.StringVar(&opts.cacheDir, "cache-dir", "",
 		fmt.Sprintf("Directory for shared cache (can also be set by %s env var).", cipd.EnvCacheDir))
+	f.IntVar(&opts.cacheMaxInstances, "cache-max-instances", 0,
+		"Maximum number of package instances kept in the shared cache (default 100).")
+	f.Int64Var(&opts.cacheMaxSize, "cache-max-size", 0,
+		"Maximum total size in bytes of the package instances kept in the shared cache (default no limit).")
+	f.Var(&opts.trustedKeys, "trusted-key",
+		"Path to a PEM public key. If set, only packages signed with one of the trusted keys are deployed (can be used multiple times).")
+	f.BoolVar(&opts.differential, "differential-updates", false,
+		"When updating a deployed package, fetch only the files that changed, without verifying the instance ID (ignored with -trusted-key).")
 	opts.authFlags.Register(f, params.DefaultAuthOptions)
 }
 
@@ -269,12 +284,21 @@ func (opts *clientOptions) makeCipdClient(ctx context.Context, root string) (cip
 		return nil, err
 	}
 
+	trustedKeys, err := signing.LoadKeyRing(opts.trustedKeys...)
+	if err != nil {
+		return nil, err
+	}
+
 	realOpts := cipd.ClientOptions{
 		ServiceURL:          opts.serviceURL,
 		Root:                root,
 		CacheDir:            opts.cacheDir,
+		CacheMaxInstances:   opts.cacheMaxInstances,
+		CacheMaxSize:        opts.cacheMaxSize,
 		AuthenticatedClient: client,
 		AnonymousClient:     http.DefaultClient,
+		TrustedKeys:         trustedKeys,
+		DifferentialUpdates: opts.differential,
 	}
 	if err := realOpts.LoadFromEnv(cli.MakeGetEnv(ctx)); err != nil {
 		return nil, err
@@ -329,6 +353,9 @@ type inputOptions struct {
 	//
 	// Default is 1 (fastest).
 	compressionLevel int
+
+	// Put the hashes of the files into the package manifest.
+	fileHashes bool
 }
 
 func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
@@ -347,6 +374,8 @@ func (opts *inputOptions) registerFlags(f *flag.FlagSet) {
 	// Options for the builder.
 	f.IntVar(&opts.compressionLevel, "compression-level", 5,
 		"Deflate compression level [0-9]: 0 - disable, 1 - best speed, 9 - best compression.")
+	f.BoolVar(&opts.fileHashes, "file-hashes", false,
+		"Put the SHA1 hashes of the files into the package, for -differential-updates. Changes the instance ID.")
 }
 
 // prepareInput processes inputOptions by collecting all files to be added to
@@ -382,6 +411,7 @@ func (opts *inputOptions) prepareInput() (local.BuildInstanceOptions, error) {
 			PackageName:      opts.packageName,
 			InstallMode:      opts.installMode,
 			CompressionLevel: opts.compressionLevel,
+			FileHashes:       opts.fileHashes,
 		}, nil
 	}
 
@@ -417,7 +447,9 @@ func (opts *inputOptions) prepareInput() (local.BuildInstanceOptions, error) {
 			PackageName:      pkgDef.Package,
 			VersionFile:      pkgDef.VersionFile(),
 			InstallMode:      pkgDef.InstallMode,
+			PostInstall:      pkgDef.PostInstall,
 			CompressionLevel: opts.compressionLevel,
+			FileHashes:       opts.fileHashes,
 		}, nil
 	}
 
@@ -509,6 +541,78 @@ func (opts *uploadOptions) registerFlags(f *flag.FlagSet) {
 		cipd.CASFinalizationTimeout, "Maximum time to wait for backend-side package hash verification.")
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// signingOptions mixin.
+
+// keyList holds an array of paths to key files. It implements flag.Value.
+type keyList []string
+
+func (keys *keyList) String() string {
+	// String() for empty vars used in -help output.
+	if len(*keys) == 0 {
+		return "key.pem"
+	}
+	return strings.Join(*keys, " ")
+}
+
+// Set is called by 'flag' package when parsing command line options.
+func (keys *keyList) Set(value string) error {
+	*keys = append(*keys, value)
+	return nil
+}
+
+// signingOptions defines command line options for commands that sign
+// packages.
+type signingOptions struct {
+	signingKey string
+}
+
+func (opts *signingOptions) registerFlags(f *flag.FlagSet) {
+	f.StringVar(&opts.signingKey, "signing-key", "",
+		"Path to a PEM private key to sign the package instance with.")
+}
+
+// sign signs the instance if a signing key is set, returning the signature tag
+// or an empty string.
+func (opts *signingOptions) sign(pin common.Pin) (string, error) {
+	if opts.signingKey == "" {
+		return "", nil
+	}
+	signer, err := signing.LoadSigner(opts.signingKey)
+	if err != nil {
+		return "", err
+	}
+	return signer.Sign(pin)
+}
+
+// signatureFile returns the path of the file holding the signatures of a
+// package instance file built by 'pkg-build'.
+func signatureFile(instanceFile string) string {
+	return instanceFile + ".sig"
+}
+
+// readSignatures reads the signature tags stored next to a package instance
+// file, if any.
+func readSignatures(instanceFile string) ([]string, error) {
+	blob, err := ioutil.ReadFile(signatureFile(instanceFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var tags []string
+	for _, line := range strings.Split(string(blob), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			if err := common.ValidateInstanceTag(line); err != nil {
+				return nil, err
+			}
+			tags = append(tags, line)
+		}
+	}
+	return tags, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // Support for running operations concurrently.
 
@@ -657,6 +761,7 @@ func cmdCreate(params Parameters) *subcommands.Command {
 			c.Opts.tagsOptions.registerFlags(&c.Flags)
 			c.Opts.clientOptions.registerFlags(&c.Flags, params)
 			c.Opts.uploadOptions.registerFlags(&c.Flags)
+			c.Opts.signingOptions.registerFlags(&c.Flags)
 			return c
 		},
 	}
@@ -668,6 +773,7 @@ type createOpts struct {
 	tagsOptions
 	clientOptions
 	uploadOptions
+	signingOptions
 }
 
 type createRun struct {
@@ -698,10 +804,11 @@ func buildAndUploadInstance(ctx context.Context, opts *createOpts) (common.Pin,
 		return common.Pin{}, err
 	}
 	return registerInstanceFile(ctx, f.Name(), &registerOpts{
-		refsOptions:   opts.refsOptions,
-		tagsOptions:   opts.tagsOptions,
-		clientOptions: opts.clientOptions,
-		uploadOptions: opts.uploadOptions,
+		refsOptions:    opts.refsOptions,
+		tagsOptions:    opts.tagsOptions,
+		clientOptions:  opts.clientOptions,
+		uploadOptions:  opts.uploadOptions,
+		signingOptions: opts.signingOptions,
 	})
 }
 
@@ -726,6 +833,8 @@ func cmdEnsure(params Parameters) *subcommands.Command {
 				(`An "ensure" file. See syntax described here: ` +
 					`https://godoc.org/github.com/luci/luci-go/cipd/client/cipd/ensure.` +
 					` Providing '-' will read from stdin.`))
+			c.Flags.BoolVar(&c.check, "check", false,
+				"Also check that the files of the installed packages are intact and repair the modified ones, see 'verify-root'.")
 			return c
 		},
 	}
@@ -737,6 +846,7 @@ type ensureRun struct {
 
 	rootDir    string
 	ensureFile string
+	check      bool
 }
 
 func (c *ensureRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
@@ -745,6 +855,11 @@ func (c *ensureRun) Run(a subcommands.Application, args []string, env subcommand
 	}
 	ctx := cli.GetContext(a, c, env)
 	currentPins, _, err := ensurePackages(ctx, c.rootDir, c.ensureFile, false, c.clientOptions)
+	if err == nil && c.check {
+		var drift []cipd.PackageDrift
+		drift, err = verifyRoot(ctx, c.rootDir, true, c.clientOptions)
+		printDrift(drift)
+	}
 	return c.done(currentPins, err)
 }
 
@@ -783,11 +898,20 @@ func ensurePackages(ctx context.Context, root string, desiredStateFile string, d
 	client.BeginBatch(ctx)
 	defer client.EndBatch(ctx)
 
-	resolved, err := ensureFile.Resolve(func(pkg, vers string) (common.Pin, error) {
-		return client.ResolveVersion(ctx, pkg, vers)
-	})
-	if err != nil {
-		return nil, nil, err
+	// Deploy the pinned instances if the ensure file has a lockfile.
+	var resolved *ensure.ResolvedFile
+	if desiredStateFile != "-" {
+		if resolved, err = readLockFile(ctx, desiredStateFile, ensureFile); err != nil {
+			return nil, nil, err
+		}
+	}
+	if resolved == nil {
+		resolved, err = ensureFile.Resolve(func(pkg, vers string) (common.Pin, error) {
+			return client.ResolveVersion(ctx, pkg, vers)
+		})
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	actions, err := client.EnsurePackages(ctx, resolved.PackagesBySubdir, dryRun)
@@ -798,6 +922,355 @@ func ensurePackages(ctx context.Context, root string, desiredStateFile string, d
 	return resolved.PackagesBySubdir, actions, nil
 }
 
+// lockFilePath returns the path of the lockfile of an ensure file.
+func lockFilePath(ensureFile string) string {
+	return ensureFile + ".lock"
+}
+
+// readLockFile returns the packages to install on this platform according to
+// the lockfile of the ensure file, or nil if there's no lockfile.
+func readLockFile(ctx context.Context, ensureFilePath string, ensureFile *ensure.File) (*ensure.ResolvedFile, error) {
+	path := lockFilePath(ensureFilePath)
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	lock, err := ensure.ParseLockFile(f)
+	if err != nil {
+		return nil, err
+	}
+	if lock.EnsureFileDigest != ensureFile.Digest() {
+		return nil, fmt.Errorf("%s is out of date, run 'cipd ensure-file-resolve' to update it", path)
+	}
+	logging.Infof(ctx, "Using versions pinned in %s", path)
+	return lock.ForPlatform(ensure.CurrentPlatform())
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 'ensure-file-resolve' subcommand.
+
+// platformList holds an array of '-platform' command line options.
+type platformList []ensure.Platform
+
+func (l *platformList) String() string {
+	// String() for empty vars used in -help output.
+	if len(*l) == 0 {
+		return "os-arch"
+	}
+	chunks := make([]string, len(*l))
+	for i, p := range *l {
+		chunks[i] = p.String()
+	}
+	return strings.Join(chunks, " ")
+}
+
+// Set is called by 'flag' package when parsing command line options.
+func (l *platformList) Set(value string) error {
+	p, err := ensure.ParsePlatform(value)
+	if err != nil {
+		return commandLineError{err}
+	}
+	*l = append(*l, p)
+	return nil
+}
+
+func cmdEnsureFileResolve(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "ensure-file-resolve [options]",
+		ShortDesc: "resolves an ensure file for several platforms into a lockfile",
+		LongDesc: "Resolves an ensure file for several platforms into a lockfile.\n\n" +
+			"Expands the package templates of the ensure file for each platform " +
+			"given with -platform, or listed in the file with $VerifiedPlatform, " +
+			"and resolves all versions to instance IDs. The result is written to " +
+			"<ensure file>.lock, which 'ensure' then deploys from.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &ensureFileResolveRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params)
+			c.Flags.StringVar(&c.ensureFile, "ensure-file", "<path>",
+				`An "ensure" file. See syntax described here: `+
+					`https://godoc.org/github.com/luci/luci-go/cipd/client/cipd/ensure.`)
+			c.Flags.Var(&c.platforms, "platform",
+				"A platform to resolve the ensure file for, overriding $VerifiedPlatform (can be used multiple times).")
+			return c
+		},
+	}
+}
+
+type ensureFileResolveRun struct {
+	cipdSubcommand
+	clientOptions
+
+	ensureFile string
+	platforms  platformList
+}
+
+func (c *ensureFileResolveRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.done(resolveEnsureFile(ctx, c.ensureFile, c.platforms, c.clientOptions))
+}
+
+func resolveEnsureFile(ctx context.Context, ensureFilePath string, platforms []ensure.Platform, clientOpts clientOptions) (*ensure.LockFile, error) {
+	f, err := os.Open(ensureFilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	ensureFile, err := ensure.ParseFile(f)
+	if err != nil {
+		return nil, err
+	}
+	if ensureFile.ServiceURL != "" {
+		clientOpts.serviceURL = ensureFile.ServiceURL
+	}
+
+	client, err := clientOpts.makeCipdClient(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	client.BeginBatch(ctx)
+	defer client.EndBatch(ctx)
+
+	lock, err := ensureFile.ResolvePlatforms(func(pkg, vers string) (common.Pin, error) {
+		return client.ResolveVersion(ctx, pkg, vers)
+	}, platforms)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := lock.Serialize(buf); err != nil {
+		return nil, err
+	}
+	path := lockFilePath(ensureFilePath)
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0666); err != nil {
+		return nil, err
+	}
+	for _, p := range lock.Platforms() {
+		fmt.Printf("%s:\n", p)
+		printPinsBySubdir(lock.PackagesByPlatform[p.String()])
+	}
+	fmt.Printf("Wrote %s.\n", path)
+	return lock, nil
+}
+
+// printPinsBySubdir prints the pins of each subdir, indented.
+func printPinsBySubdir(pins common.PinSliceBySubdir) {
+	subdirs := make([]string, 0, len(pins))
+	for subdir := range pins {
+		subdirs = append(subdirs, subdir)
+	}
+	sort.Strings(subdirs)
+	for _, subdir := range subdirs {
+		for _, pin := range pins[subdir] {
+			if subdir == "" {
+				fmt.Printf("  %s\n", pin)
+			} else {
+				fmt.Printf("  %s (in %s)\n", pin, subdir)
+			}
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 'verify-root' subcommand.
+
+func cmdVerifyRoot(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "verify-root [options]",
+		ShortDesc: "checks that the installed files match the package manifests",
+		LongDesc: "Checks that the installed files match the package manifests.\n\n" +
+			"Rehashes all the files installed in the site root and reports the packages " +
+			"with modified or missing files. With -repair, restores only these files " +
+			"from the package instances, without reinstalling the packages. Returns " +
+			"a non-zero exit code if some files still don't match.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &verifyRootRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params)
+			c.Flags.StringVar(&c.rootDir, "root", "<path>", "Path to an installation site root directory.")
+			c.Flags.BoolVar(&c.repair, "repair", false, "Restore the modified and missing files.")
+			return c
+		},
+	}
+}
+
+type verifyRootRun struct {
+	cipdSubcommand
+	clientOptions
+
+	rootDir string
+	repair  bool
+}
+
+func (c *verifyRootRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	drift, err := verifyRoot(ctx, c.rootDir, c.repair, c.clientOptions)
+	if len(drift) == 0 && err == nil {
+		fmt.Println("All installed files are intact.")
+	} else {
+		printDrift(drift)
+	}
+	ret := c.done(drift, err)
+	if ret == 0 && !c.repair && len(drift) != 0 {
+		return 1
+	}
+	return ret
+}
+
+func verifyRoot(ctx context.Context, root string, repair bool, clientOpts clientOptions) ([]cipd.PackageDrift, error) {
+	client, err := clientOpts.makeCipdClient(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	return client.VerifyRoot(ctx, repair)
+}
+
+func printDrift(drift []cipd.PackageDrift) {
+	for _, d := range drift {
+		subdirString := ""
+		if d.Subdir != "" {
+			subdirString = fmt.Sprintf(" (subdir %q)", d.Subdir)
+		}
+		switch {
+		case d.Error != "":
+			fmt.Fprintf(os.Stderr, "Failed to verify %s%s: %s.\n", d.Pin, subdirString, d.Error)
+		case d.Repaired:
+			fmt.Printf("Repaired %s%s:\n", d.Pin, subdirString)
+		default:
+			fmt.Printf("Modified %s%s:\n", d.Pin, subdirString)
+		}
+		for _, f := range d.Files {
+			if f.Details != "" {
+				fmt.Printf("  %s: %s (%s)\n", f.Name, f.Kind, f.Details)
+			} else {
+				fmt.Printf("  %s: %s\n", f.Name, f.Kind)
+			}
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// 'mirror' subcommand.
+
+func cmdMirror(params Parameters) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "mirror [options]",
+		ShortDesc: "copies the packages of an ensure file to an offline repository",
+		LongDesc: "Copies the packages of an ensure file to an offline repository.\n\n" +
+			"Resolves the ensure file for each platform given with -platform, or listed " +
+			"in the file with $VerifiedPlatform, and copies the package instances, with " +
+			"their tags and refs, from the backend to the -dest directory. Running " +
+			"'ensure' with the printed -service-url then needs no network access. The " +
+			"ensure file must not set $ServiceURL, since it takes precedence.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &mirrorRun{}
+			c.registerBaseFlags()
+			c.clientOptions.registerFlags(&c.Flags, params)
+			c.Flags.StringVar(&c.ensureFile, "ensure-file", "<path>",
+				`An "ensure" file. See syntax described here: `+
+					`https://godoc.org/github.com/luci/luci-go/cipd/client/cipd/ensure.`)
+			c.Flags.Var(&c.platforms, "platform",
+				"A platform to mirror the packages for, overriding $VerifiedPlatform (can be used multiple times).")
+			c.Flags.StringVar(&c.dest, "dest", "<path>", "Path to the offline repository directory.")
+			return c
+		},
+	}
+}
+
+type mirrorRun struct {
+	cipdSubcommand
+	clientOptions
+
+	ensureFile string
+	platforms  platformList
+	dest       string
+}
+
+func (c *mirrorRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if !c.checkArgs(args, 0, 0) {
+		return 1
+	}
+	ctx := cli.GetContext(a, c, env)
+	return c.doneWithPins(mirrorEnsureFile(ctx, c.ensureFile, c.platforms, c.dest, c.clientOptions))
+}
+
+func mirrorEnsureFile(ctx context.Context, ensureFilePath string, platforms []ensure.Platform, dest string, clientOpts clientOptions) ([]pinInfo, error) {
+	f, err := os.Open(ensureFilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	ensureFile, err := ensure.ParseFile(f)
+	if err != nil {
+		return nil, err
+	}
+	if ensureFile.ServiceURL != "" {
+		clientOpts.serviceURL = ensureFile.ServiceURL
+	}
+	if len(platforms) == 0 && len(ensureFile.VerifiedPlatforms) == 0 {
+		platforms = []ensure.Platform{ensure.CurrentPlatform()}
+	}
+	serviceURL, err := cipd.OfflineServiceURL(dest)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clientOpts.makeCipdClient(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	client.BeginBatch(ctx)
+	defer client.EndBatch(ctx)
+
+	lock, err := ensureFile.ResolvePlatforms(func(pkg, vers string) (common.Pin, error) {
+		return client.ResolveVersion(ctx, pkg, vers)
+	}, platforms)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[common.Pin]bool{}
+	pins := common.PinSlice{}
+	for _, p := range lock.Platforms() {
+		bySubdir := lock.PackagesByPlatform[p.String()]
+		subdirs := make([]string, 0, len(bySubdir))
+		for subdir := range bySubdir {
+			subdirs = append(subdirs, subdir)
+		}
+		sort.Strings(subdirs)
+		for _, subdir := range subdirs {
+			for _, pin := range bySubdir[subdir] {
+				if !seen[pin] {
+					seen[pin] = true
+					pins = append(pins, pin)
+				}
+			}
+		}
+	}
+
+	if err := cipd.MirrorInstances(ctx, client, dest, pins); err != nil {
+		return nil, err
+	}
+	ret := make([]pinInfo, len(pins))
+	for i := range pins {
+		ret[i] = pinInfo{Pkg: pins[i].PackageName, Pin: &pins[i]}
+	}
+	fmt.Printf("Mirrored %d package instance(s), use -service-url %s.\n", len(pins), serviceURL)
+	return ret, nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'puppet-check-updates' subcommand.
 
@@ -1458,11 +1931,14 @@ func cmdBuild() *subcommands.Command {
 		Advanced:  true,
 		UsageLine: "pkg-build [options]",
 		ShortDesc: "builds a package instance file",
-		LongDesc:  "Builds a package instance producing *.cipd file.",
+		LongDesc: "Builds a package instance producing *.cipd file.\n\n" +
+			"If -signing-key is set, the signature of the instance is written to " +
+			"<out>.sig, to be attached to the instance by 'pkg-register'.",
 		CommandRun: func() subcommands.CommandRun {
 			c := &buildRun{}
 			c.registerBaseFlags()
 			c.inputOptions.registerFlags(&c.Flags)
+			c.signingOptions.registerFlags(&c.Flags)
 			c.Flags.StringVar(&c.outputFile, "out", "<path>", "Path to a file to write the final package to.")
 			return c
 		},
@@ -1472,6 +1948,7 @@ func cmdBuild() *subcommands.Command {
 type buildRun struct {
 	cipdSubcommand
 	inputOptions
+	signingOptions
 
 	outputFile string
 }
@@ -1485,7 +1962,11 @@ func (c *buildRun) Run(a subcommands.Application, args []string, env subcommands
 	if err != nil {
 		return c.done(nil, err)
 	}
-	return c.done(inspectInstanceFile(ctx, c.outputFile, false))
+	pin, err := inspectInstanceFile(ctx, c.outputFile, false)
+	if err != nil {
+		return c.done(nil, err)
+	}
+	return c.done(pin, signInstanceFile(ctx, c.outputFile, pin, &c.signingOptions))
 }
 
 func buildInstanceFile(ctx context.Context, instanceFile string, inputOpts inputOptions) error {
@@ -1512,6 +1993,23 @@ func buildInstanceFile(ctx context.Context, instanceFile string, inputOpts input
 	return nil
 }
 
+// signInstanceFile writes the signature of the instance to its signature file,
+// if a signing key is set. It removes stale signatures otherwise.
+func signInstanceFile(ctx context.Context, instanceFile string, pin common.Pin, opts *signingOptions) error {
+	sig, err := opts.sign(pin)
+	if err != nil {
+		return err
+	}
+	if sig == "" {
+		if err := os.Remove(signatureFile(instanceFile)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	logging.Infof(ctx, "Signed %s", pin)
+	return ioutil.WriteFile(signatureFile(instanceFile), []byte(sig+"\n"), 0666)
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 // 'pkg-deploy' subcommand.
 
@@ -1520,11 +2018,14 @@ func cmdDeploy() *subcommands.Command {
 		Advanced:  true,
 		UsageLine: "pkg-deploy <package instance file> [options]",
 		ShortDesc: "deploys a package instance file",
-		LongDesc:  "Deploys a *.cipd package instance into a site root.",
+		LongDesc: "Deploys a *.cipd package instance into a site root.\n\n" +
+			"If -trusted-key is set, the instance must have a valid signature by " +
+			"one of the trusted keys in <package instance file>.sig.",
 		CommandRun: func() subcommands.CommandRun {
 			c := &deployRun{}
 			c.registerBaseFlags()
 			c.Flags.StringVar(&c.rootDir, "root", "<path>", "Path to an installation site root directory.")
+			c.Flags.Var(&c.trustedKeys, "trusted-key", "Path to a PEM public key the package must be signed with (can be used multiple times).")
 			return c
 		},
 	}
@@ -1533,7 +2034,8 @@ func cmdDeploy() *subcommands.Command {
 type deployRun struct {
 	cipdSubcommand
 
-	rootDir string
+	rootDir     string
+	trustedKeys keyList
 }
 
 func (c *deployRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
@@ -1541,10 +2043,14 @@ func (c *deployRun) Run(a subcommands.Application, args []string, env subcommand
 		return 1
 	}
 	ctx := cli.GetContext(a, c, env)
-	return c.done(deployInstanceFile(ctx, c.rootDir, args[0]))
+	return c.done(deployInstanceFile(ctx, c.rootDir, args[0], c.trustedKeys))
 }
 
-func deployInstanceFile(ctx context.Context, root string, instanceFile string) (common.Pin, error) {
+func deployInstanceFile(ctx context.Context, root string, instanceFile string, trustedKeys []string) (common.Pin, error) {
+	keys, err := signing.LoadKeyRing(trustedKeys...)
+	if err != nil {
+		return common.Pin{}, err
+	}
 	inst, closer, err := local.OpenInstanceFile(ctx, instanceFile, "", local.VerifyHash)
 	if err != nil {
 		return common.Pin{}, err
@@ -1552,6 +2058,16 @@ func deployInstanceFile(ctx context.Context, root string, instanceFile string) (
 	defer closer()
 	inspectInstance(ctx, inst, false)
 
+	if !keys.Empty() {
+		sigs, err := readSignatures(instanceFile)
+		if err != nil {
+			return common.Pin{}, err
+		}
+		if err := keys.Verify(inst.Pin(), sigs); err != nil {
+			return common.Pin{}, fmt.Errorf("refusing to deploy %s - %s", inst.Pin(), err)
+		}
+	}
+
 	d := local.NewDeployer(root)
 	defer d.CleanupTrash(ctx)
 
@@ -1725,6 +2241,7 @@ func cmdRegister(params Parameters) *subcommands.Command {
 			c.Opts.tagsOptions.registerFlags(&c.Flags)
 			c.Opts.clientOptions.registerFlags(&c.Flags, params)
 			c.Opts.uploadOptions.registerFlags(&c.Flags)
+			c.Opts.signingOptions.registerFlags(&c.Flags)
 			return c
 		},
 	}
@@ -1735,6 +2252,7 @@ type registerOpts struct {
 	tagsOptions
 	clientOptions
 	uploadOptions
+	signingOptions
 }
 
 type registerRun struct {
@@ -1762,11 +2280,24 @@ func registerInstanceFile(ctx context.Context, instanceFile string, opts *regist
 		return common.Pin{}, err
 	}
 	inspectInstance(ctx, inst, false)
+	// Signatures made by 'pkg-build' are attached along the ones made now.
+	tags, err := readSignatures(instanceFile)
+	if err != nil {
+		return common.Pin{}, err
+	}
+	sig, err := opts.signingOptions.sign(inst.Pin())
+	if err != nil {
+		return common.Pin{}, err
+	}
+	if sig != "" {
+		tags = append(tags, sig)
+	}
+	tags = append(tags, opts.tagsOptions.tags...)
 	err = client.RegisterInstance(ctx, inst, opts.uploadOptions.verificationTimeout)
 	if err != nil {
 		return common.Pin{}, err
 	}
-	err = client.AttachTagsWhenReady(ctx, inst.Pin(), opts.tagsOptions.tags)
+	err = client.AttachTagsWhenReady(ctx, inst.Pin(), tags)
 	if err != nil {
 		return common.Pin{}, err
 	}
@@ -2179,6 +2710,9 @@ func GetApplication(params Parameters) *cli.Application {
 			cmdSearch(params),
 			cmdCreate(params),
 			cmdEnsure(params),
+			cmdEnsureFileResolve(params),
+			cmdVerifyRoot(params),
+			cmdMirror(params),
 			cmdResolve(params),
 			cmdDescribe(params),
 			cmdSetRef(params),
@@ -5,6 +5,7 @@
 package archiver
 
 import (
+	"crypto"
 	"errors"
 	"fmt"
 	"io"
@@ -124,6 +125,9 @@ func New(ctx context.Context, c *isolatedclient.Client, out io.Writer) *Archiver
 		maxConcurrentUpload:   8,
 		containsBatchingDelay: 100 * time.Millisecond,
 		containsBatchSize:     50,
+		minChunkSize:          defaultMinChunkSize,
+		avgChunkSize:          defaultAvgChunkSize,
+		maxChunkSize:          defaultMaxChunkSize,
 		stage1DedupeChan:      make(chan *Item),
 		stage2HashChan:        make(chan *Item),
 		stage3LookupChan:      make(chan *Item),
@@ -181,6 +185,7 @@ type Item struct {
 	err        error                                    // Item specific error
 	digestItem isolateservice.HandlersEndpointsV1Digest // Mutated by hashLoop(), used by doContains()
 	linked     []*Item                                  // Deduplicated item.
+	chunks     []isolated.Chunk                         // Set by PushFileChunked().
 
 	// Mutable but not accessible externally.
 	source isolatedclient.Source     // Source of data
@@ -223,6 +228,14 @@ func (i *Item) Digest() isolated.HexDigest {
 	return isolated.HexDigest(i.digestItem.Digest)
 }
 
+// Chunks returns the chunks of an item created by PushFileChunked once
+// hashed, nil otherwise.
+func (i *Item) Chunks() []isolated.Chunk {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	return i.chunks
+}
+
 func (i *Item) isFile() bool {
 	return len(i.path) != 0
 }
@@ -254,7 +267,7 @@ func (i *Item) calcDigest() error {
 	}
 	defer src.Close()
 
-	h := isolated.GetHash()
+	h := i.a.Hash().New()
 	size, err := io.Copy(h, src)
 	if err != nil {
 		i.SetErr(err)
@@ -310,6 +323,10 @@ type Archiver struct {
 	maxConcurrentUpload   int           // Stage 4; Network I/O bound.
 	containsBatchingDelay time.Duration // Used by stage 3
 	containsBatchSize     int           // Used by stage 3
+	minChunkSize          int64         // Used by PushFileChunked
+	avgChunkSize          int64         // Used by PushFileChunked
+	maxChunkSize          int64         // Used by PushFileChunked
+	chunkThreshold        int64         // Used by PushDirectory; 0 to disable chunking
 	closeLock             sync.Mutex
 	stage1DedupeChan      chan *Item
 	stage2HashChan        chan *Item
@@ -380,6 +397,20 @@ func (a *Archiver) PushFile(displayName, path string, priority int64) *Item {
 	return a.push(newItem(a, displayName, path, source, priority))
 }
 
+// EnableChunking makes PushDirectory split the files of at least threshold
+// bytes in content-defined chunks with PushFileChunked.
+//
+// It must be called before any item is pushed.
+func (a *Archiver) EnableChunking(threshold int64) {
+	a.chunkThreshold = threshold
+}
+
+// Hash returns the hashing algorithm used by the namespace the Archiver
+// uploads to.
+func (a *Archiver) Hash() crypto.Hash {
+	return a.c.Hash()
+}
+
 // Stats returns a copy of the statistics.
 func (a *Archiver) Stats() *Stats {
 	a.statsLock.Lock()
@@ -98,7 +98,7 @@ func TestArchiverFileHit(t *testing.T) {
 		ts := httptest.NewServer(server)
 		defer ts.Close()
 		a := New(ctx, isolatedclient.New(nil, nil, ts.URL, isolatedclient.DefaultNamespace, nil, nil), nil)
-		server.Inject([]byte("foo"))
+		server.Inject(isolatedclient.DefaultNamespace, []byte("foo"))
 		item := a.Push("foo", isolatedclient.NewBytesSource([]byte("foo")), 0)
 		item.WaitForHashed()
 		So(item.Digest(), ShouldResemble, isolated.HexDigest("0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"))
@@ -112,6 +112,27 @@ func TestArchiverFileHit(t *testing.T) {
 	})
 }
 
+func TestArchiverFileSHA256(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	Convey(`An archiver should hash with the namespace's algorithm.`, t, func() {
+		server := isolatedfake.New()
+		ts := httptest.NewServer(server)
+		defer ts.Close()
+		a := New(ctx, isolatedclient.New(nil, nil, ts.URL, "sha256-gzip", nil, nil), nil)
+		item := a.Push("foo", isolatedclient.NewBytesSource([]byte("foo")), 0)
+		item.WaitForHashed()
+		So(a.Close(), ShouldBeNil)
+
+		digest := isolated.HexDigest("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+		So(item.Digest(), ShouldResemble, digest)
+		So(item.Error(), ShouldBeNil)
+		So(server.Contents(), ShouldResemble, map[isolated.HexDigest][]byte{digest: []byte("foo")})
+		So(server.Error(), ShouldBeNil)
+	})
+}
+
 func TestArchiverCancel(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
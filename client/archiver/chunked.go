@@ -0,0 +1,107 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archiver
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/runtime/tracer"
+)
+
+// PushFileChunked schedules the upload of the file at path as content-defined
+// chunks, each one being a separate item on the server. Smaller priority
+// value means earlier processing.
+//
+// The returned Item is signaled once all the chunks are hashed. Its Digest()
+// is the digest of the whole file and its Chunks() must be recorded in an
+// isolated.Chunked file. The whole file itself is not uploaded, so a small
+// modification to a large file only uploads the chunks around it.
+func (a *Archiver) PushFileChunked(displayName, path string, priority int64) *Item {
+	s := &Item{DisplayName: displayName}
+	s.wgHashed.Add(1)
+	go func() {
+		defer s.wgHashed.Done()
+		end := tracer.Span(a, "chunk", tracer.Args{"name": displayName})
+		digest, size, chunks, err := a.pushChunks(displayName, path, priority)
+		end(tracer.Args{"err": err, "chunks": len(chunks)})
+		if err != nil {
+			s.SetErr(err)
+			return
+		}
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.digestItem.Digest = string(digest)
+		s.digestItem.Size = size
+		s.chunks = chunks
+	}()
+	return s
+}
+
+// pushChunks finds the chunk boundaries of the file at path, pushes each
+// chunk and waits for them to be hashed.
+func (a *Archiver) pushChunks(displayName, path string, priority int64) (isolated.HexDigest, int64, []isolated.Chunk, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	defer f.Close()
+
+	h := a.Hash().New()
+	c := newChunker(io.TeeReader(f, h), a.minChunkSize, a.avgChunkSize, a.maxChunkSize)
+	var items []*Item
+	offset := int64(0)
+	for {
+		size, err := c.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", 0, nil, fmt.Errorf("read(%s) failed: %s", displayName, err)
+		}
+		item := a.Push(fmt.Sprintf("%s[%d]", displayName, len(items)), sectionSource(path, offset, size), priority)
+		if item == nil {
+			return "", 0, nil, errors.New("archiver was closed")
+		}
+		items = append(items, item)
+		offset += size
+	}
+
+	chunks := make([]isolated.Chunk, len(items))
+	for i, item := range items {
+		item.WaitForHashed()
+		if err := item.Error(); err != nil {
+			return "", 0, nil, err
+		}
+		item.lock.Lock()
+		chunks[i] = isolated.Chunk{Digest: isolated.HexDigest(item.digestItem.Digest), Size: item.digestItem.Size}
+		item.lock.Unlock()
+	}
+	return isolated.Sum(h), offset, chunks, nil
+}
+
+// sectionSource returns an isolatedclient.Source reading size bytes at offset
+// in the file at path.
+func sectionSource(path string, offset, size int64) func() (io.ReadCloser, error) {
+	return func() (io.ReadCloser, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		return &sectionReadCloser{io.NewSectionReader(f, offset, size), f}, nil
+	}
+}
+
+type sectionReadCloser struct {
+	*io.SectionReader
+	f *os.File
+}
+
+func (s *sectionReadCloser) Close() error {
+	return s.f.Close()
+}
@@ -0,0 +1,90 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archiver
+
+import (
+	"bufio"
+	"io"
+)
+
+const (
+	// Default chunk size bounds used when chunking is enabled.
+	//
+	// Changing any of these values (or gearTable) changes the chunk boundaries
+	// and defeats deduplication against previously archived files.
+	defaultMinChunkSize = 256 * 1024
+	defaultAvgChunkSize = 1024 * 1024
+	defaultMaxChunkSize = 4 * 1024 * 1024
+)
+
+// gearTable maps each byte value to a pseudo random 64 bits value for the
+// gear rolling hash.
+//
+// It is generated with splitmix64 from a fixed seed so it is stable across
+// versions.
+var gearTable [256]uint64
+
+func init() {
+	x := uint64(0x6c756369) // "luci"
+	for i := range gearTable {
+		x += 0x9e3779b97f4a7c15
+		z := x
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gearTable[i] = z ^ (z >> 31)
+	}
+}
+
+// chunker splits a stream in content-defined chunks.
+//
+// Boundaries are found with a gear rolling hash so that inserting or removing
+// bytes in the stream only changes the chunks around the modification.
+type chunker struct {
+	r    *bufio.Reader
+	min  int64
+	max  int64
+	mask uint64
+}
+
+// newChunker returns a chunker emitting chunks between min and max bytes,
+// averaging roughly avg bytes. avg must be a power of two larger than 1.
+func newChunker(r io.Reader, min, avg, max int64) *chunker {
+	mask := uint64(0)
+	for a := avg; a > 1; a >>= 1 {
+		mask = (mask << 1) | 1
+	}
+	// Use the most significant bits of the hash, they depend on more bytes of
+	// the input.
+	for mask != 0 && mask&(1<<63) == 0 {
+		mask <<= 1
+	}
+	return &chunker{r: bufio.NewReaderSize(r, 64*1024), min: min, max: max, mask: mask}
+}
+
+// next returns the size of the next chunk.
+//
+// It returns io.EOF once the stream is exhausted.
+func (c *chunker) next() (int64, error) {
+	var h uint64
+	var size int64
+	for size < c.max {
+		b, err := c.r.ReadByte()
+		if err == io.EOF {
+			if size == 0 {
+				return 0, io.EOF
+			}
+			return size, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		size++
+		h = (h << 1) + gearTable[b]
+		if size >= c.min && h&c.mask == 0 {
+			break
+		}
+	}
+	return size, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package archiver
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func chunkSizes(data []byte, min, avg, max int64) []int64 {
+	c := newChunker(bytes.NewReader(data), min, avg, max)
+	var out []int64
+	for {
+		size, err := c.next()
+		if err == io.EOF {
+			return out
+		}
+		So(err, ShouldBeNil)
+		out = append(out, size)
+	}
+}
+
+func TestChunker(t *testing.T) {
+	t.Parallel()
+	Convey(`A chunker should find stable content-defined boundaries.`, t, func() {
+		data := make([]byte, 256*1024)
+		rand.New(rand.NewSource(0)).Read(data)
+
+		sizes := chunkSizes(data, 1024, 4096, 16384)
+		total := int64(0)
+		for i, s := range sizes {
+			So(s, ShouldBeLessThanOrEqualTo, 16384)
+			if i != len(sizes)-1 {
+				So(s, ShouldBeGreaterThanOrEqualTo, 1024)
+			}
+			total += s
+		}
+		So(total, ShouldEqual, len(data))
+		So(len(sizes), ShouldBeGreaterThan, 10)
+		So(chunkSizes(data, 1024, 4096, 16384), ShouldResemble, sizes)
+
+		// Inserting a byte at the start only changes the first chunk.
+		shifted := append([]byte{42}, data...)
+		shiftedSizes := chunkSizes(shifted, 1024, 4096, 16384)
+		So(shiftedSizes[1:], ShouldResemble, sizes[1:])
+		So(shiftedSizes[0], ShouldEqual, sizes[0]+1)
+
+		So(chunkSizes(nil, 1024, 4096, 16384), ShouldBeNil)
+	})
+}
@@ -115,6 +115,9 @@ func walk(root string, blacklist []string, c chan<- *walkItem) {
 // generated .isolated file.
 //
 // blacklist is a list of globs of files to ignore.
+//
+// If chunking is enabled with Archiver.EnableChunking, large files are
+// recorded as isolated.Chunked files.
 func PushDirectory(a *Archiver, root string, relDir string, blacklist []string) *Item {
 	total := 0
 	end := tracer.Span(a, "PushDirectory", tracer.Args{"path": relDir, "root": root})
@@ -127,7 +130,7 @@ func PushDirectory(a *Archiver, root string, relDir string, blacklist []string)
 
 	displayName := filepath.Base(root) + ".isolated"
 	i := isolated.Isolated{
-		Algo:    "sha-1",
+		Algo:    isolated.AlgoName(a.Hash()),
 		Files:   map[string]isolated.File{},
 		Version: isolated.IsolatedFormatVersion,
 	}
@@ -156,7 +159,11 @@ func PushDirectory(a *Archiver, root string, relDir string, blacklist []string)
 			i.Files[item.relPath] = isolated.SymLink(l)
 		} else {
 			i.Files[item.relPath] = isolated.BasicFile("", int(mode.Perm()), item.info.Size())
-			items = append(items, a.PushFile(item.relPath, item.fullPath, -item.info.Size()))
+			if a.chunkThreshold > 0 && item.info.Size() >= a.chunkThreshold {
+				items = append(items, a.PushFileChunked(item.relPath, item.fullPath, -item.info.Size()))
+			} else {
+				items = append(items, a.PushFile(item.relPath, item.fullPath, -item.info.Size()))
+			}
 		}
 	}
 	if s.Error() != nil {
@@ -177,6 +184,10 @@ func PushDirectory(a *Archiver, root string, relDir string, blacklist []string)
 			name := item.DisplayName
 			d := i.Files[name]
 			d.Digest = item.Digest()
+			if chunks := item.Chunks(); chunks != nil {
+				d.Chunks = chunks
+				d.Type = isolated.Chunked
+			}
 			i.Files[name] = d
 		}
 		if err == nil {
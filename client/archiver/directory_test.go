@@ -5,9 +5,11 @@
 package archiver
 
 import (
+	"crypto"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
+	"math/rand"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
@@ -98,7 +100,7 @@ func TestPushDirectory(t *testing.T) {
 		encoded, err := json.Marshal(isolatedData)
 		So(err, ShouldBeNil)
 		isolatedEncoded := string(encoded) + "\n"
-		isolatedHash := isolated.HashBytes([]byte(isolatedEncoded))
+		isolatedHash := isolated.HashBytes(crypto.SHA1, []byte(isolatedEncoded))
 
 		expected := map[string]string{
 			"0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33": "foo",
@@ -121,3 +123,72 @@ func TestPushDirectory(t *testing.T) {
 		So(server.Error(), ShouldBeNil)
 	})
 }
+
+func TestPushDirectoryChunked(t *testing.T) {
+	t.Parallel()
+	emptyContext := context.Background()
+
+	Convey(`Pushing a directory with chunking enabled should only upload modified chunks.`, t, func() {
+		server := isolatedfake.New()
+		ts := httptest.NewServer(server)
+		defer ts.Close()
+
+		tmpDir, err := ioutil.TempDir("", "archiver")
+		So(err, ShouldBeNil)
+		defer func() {
+			if err := os.RemoveAll(tmpDir); err != nil {
+				t.Fail()
+			}
+		}()
+		data := make([]byte, 128*1024)
+		rand.New(rand.NewSource(0)).Read(data)
+		So(ioutil.WriteFile(filepath.Join(tmpDir, "large"), data, 0600), ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(tmpDir, "small"), []byte("foo"), 0600), ShouldBeNil)
+
+		push := func() (*Item, *Stats) {
+			a := New(emptyContext, isolatedclient.New(nil, nil, ts.URL, isolatedclient.DefaultNamespace, nil, nil), nil)
+			a.minChunkSize, a.avgChunkSize, a.maxChunkSize = 1024, 4096, 16384
+			a.EnableChunking(1024)
+			item := PushDirectory(a, tmpDir, "", nil)
+			item.WaitForHashed()
+			So(a.Close(), ShouldBeNil)
+			So(item.Error(), ShouldBeNil)
+			return item, a.Stats()
+		}
+		load := func(item *Item) *isolated.Isolated {
+			i := &isolated.Isolated{}
+			So(json.Unmarshal(server.Contents()[item.Digest()], i), ShouldBeNil)
+			return i
+		}
+
+		item, stats := push()
+		So(stats.TotalHits(), ShouldEqual, 0)
+		i := load(item)
+		large := i.Files["large"]
+		So(large.Type, ShouldEqual, isolated.Chunked)
+		So(large.Digest, ShouldEqual, isolated.HashBytes(crypto.SHA1, data))
+		So(len(large.Chunks), ShouldBeGreaterThan, 1)
+		var content []byte
+		for _, c := range large.Chunks {
+			chunk, ok := server.Contents()[c.Digest]
+			So(ok, ShouldBeTrue)
+			So(int64(len(chunk)), ShouldEqual, c.Size)
+			content = append(content, chunk...)
+		}
+		So(content, ShouldResemble, data)
+		// The whole file is not uploaded.
+		_, ok := server.Contents()[large.Digest]
+		So(ok, ShouldBeFalse)
+		So(i.Files["small"].Type, ShouldEqual, isolated.FileType(""))
+
+		// Modify a byte in the middle of the file; only the chunks around it are
+		// uploaded again.
+		data[len(data)/2] ^= 0xff
+		So(ioutil.WriteFile(filepath.Join(tmpDir, "large"), data, 0600), ShouldBeNil)
+		item, stats = push()
+		So(stats.TotalHits(), ShouldBeGreaterThan, len(large.Chunks)/2)
+		So(stats.TotalBytesPushed(), ShouldBeLessThan, units.Size(len(data)/2))
+		So(load(item).Files["large"].Digest, ShouldEqual, isolated.HashBytes(crypto.SHA1, data))
+		So(server.Error(), ShouldBeNil)
+	})
+}
@@ -5,6 +5,7 @@
 package main
 
 import (
+	"crypto"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -172,7 +173,7 @@ func (c *expArchiveRun) main() error {
 
 	for _, bundle := range bundles {
 		bundle := bundle
-		digest, tarSize, err := bundle.Digest()
+		digest, tarSize, err := bundle.Digest(client.Hash())
 		if err != nil {
 			return err
 		}
@@ -202,7 +203,7 @@ func (c *expArchiveRun) main() error {
 
 	// Handle the large individually-uploaded files.
 	for _, item := range indivFiles {
-		d, err := hashFile(item.Path)
+		d, err := hashFile(client.Hash(), item.Path)
 		if err != nil {
 			return err
 		}
@@ -220,6 +221,7 @@ func (c *expArchiveRun) main() error {
 	}
 
 	// Marshal the isolated file into JSON, and create an Item to describe it.
+	isol.Algo = isolated.AlgoName(client.Hash())
 	isol.Files = files
 	isolJSON, err := json.Marshal(isol)
 	if err != nil {
@@ -228,7 +230,7 @@ func (c *expArchiveRun) main() error {
 	isolItem := &Item{
 		Path:    archiveOpts.Isolated,
 		RelPath: filepath.Base(archiveOpts.Isolated),
-		Digest:  isolated.HashBytes(isolJSON),
+		Digest:  isolated.HashBytes(client.Hash(), isolJSON),
 		Size:    int64(len(isolJSON)),
 	}
 
@@ -333,13 +335,13 @@ func (c *expArchiveRun) Run(a subcommands.Application, args []string, _ subcomma
 	return 0
 }
 
-func hashFile(path string) (isolated.HexDigest, error) {
+func hashFile(h crypto.Hash, path string) (isolated.HexDigest, error) {
 	f, err := os.Open(path)
 	if err != nil {
 		return "", err
 	}
 	defer f.Close()
-	return isolated.Hash(f)
+	return isolated.Hash(h, f)
 }
 
 func logStats(ctx context.Context, logger *eventlog.Client, start, end time.Time, archiveDetails *logpb.IsolateClientEvent_ArchiveDetails) error {
@@ -0,0 +1,106 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/maruel/subcommands"
+
+	"github.com/luci/luci-go/client/isolate"
+)
+
+func cmdFmt() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "fmt <options> <file.isolate>...",
+		ShortDesc: "rewrites .isolate files in canonical form",
+		LongDesc: `Rewrites .isolate files in canonical form.
+
+Conditions with the same expression are merged and sorted, files are sorted
+and duplicates are removed. The result is printed to stdout unless -w is
+specified.`,
+		CommandRun: func() subcommands.CommandRun {
+			c := fmtRun{}
+			c.commonFlags.Init()
+			c.Flags.BoolVar(&c.write, "w", false, "Write the result to the files instead of stdout")
+			c.Flags.BoolVar(&c.list, "l", false, "List the files whose formatting differs, without rewriting them")
+			return &c
+		},
+	}
+}
+
+type fmtRun struct {
+	commonFlags
+	write bool
+	list  bool
+}
+
+func (c *fmtRun) Parse(a subcommands.Application, args []string) error {
+	if err := c.commonFlags.Parse(); err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New("at least one .isolate file must be specified")
+	}
+	if c.write && c.list {
+		return errors.New("-w and -l are mutually exclusive")
+	}
+	return nil
+}
+
+func (c *fmtRun) main(a subcommands.Application, args []string) error {
+	for _, p := range args {
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		out, err := isolate.Format(content)
+		if err != nil {
+			return fmt.Errorf("%s: %s", p, err)
+		}
+		switch {
+		case c.list:
+			if !bytes.Equal(content, out) {
+				fmt.Println(p)
+			}
+		case c.write:
+			if bytes.Equal(content, out) {
+				continue
+			}
+			info, err := os.Stat(p)
+			if err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(p, out, info.Mode()); err != nil {
+				return err
+			}
+		default:
+			os.Stdout.Write(out)
+		}
+	}
+	return nil
+}
+
+func (c *fmtRun) Run(a subcommands.Application, args []string, _ subcommands.Env) int {
+	if err := c.Parse(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	cl, err := c.defaultFlags.StartTracing()
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	defer cl.Close()
+	if err := c.main(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return 0
+}
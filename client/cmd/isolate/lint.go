@@ -0,0 +1,87 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/maruel/subcommands"
+
+	"github.com/luci/luci-go/client/isolate"
+)
+
+func cmdLint() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "lint <options>",
+		ShortDesc: "reports problems in a .isolate file and its includes",
+		LongDesc: `Reports problems in a .isolate file and its includes.
+
+It reports conditions that never match, duplicated or overlapping includes and
+files missing for any combination of the config variables. Files using a path
+variable are only checked if the variable is specified with -path-variable.`,
+		CommandRun: func() subcommands.CommandRun {
+			c := lintRun{}
+			c.commonFlags.Init()
+			c.isolateFlags.Init(&c.Flags)
+			return &c
+		},
+	}
+}
+
+type lintRun struct {
+	commonFlags
+	isolateFlags
+}
+
+func (c *lintRun) Parse(a subcommands.Application, args []string) error {
+	if err := c.commonFlags.Parse(); err != nil {
+		return err
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if err := c.isolateFlags.Parse(cwd, RequireIsolateFile); err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return errors.New("position arguments not expected")
+	}
+	return nil
+}
+
+func (c *lintRun) main(a subcommands.Application, args []string) error {
+	issues, err := isolate.Lint(&c.ArchiveOptions)
+	if err != nil {
+		return err
+	}
+	for _, i := range issues {
+		fmt.Println(i.String())
+	}
+	if len(issues) != 0 {
+		return fmt.Errorf("found %d issue(s)", len(issues))
+	}
+	return nil
+}
+
+func (c *lintRun) Run(a subcommands.Application, args []string, _ subcommands.Env) int {
+	if err := c.Parse(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	cl, err := c.defaultFlags.StartTracing()
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	defer cl.Close()
+	if err := c.main(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return 0
+}
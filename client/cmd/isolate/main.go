@@ -20,7 +20,7 @@ import (
 
 // version must be updated whenever functional change (behavior, arguments,
 // supported commands) is done.
-const version = "0.3"
+const version = "0.4"
 
 func GetApplication(defaultAuthOpts auth.Options) *subcommands.DefaultApplication {
 	return &subcommands.DefaultApplication{
@@ -32,7 +32,9 @@ func GetApplication(defaultAuthOpts auth.Options) *subcommands.DefaultApplicatio
 			cmdBatchArchive(defaultAuthOpts),
 			cmdExpArchive(defaultAuthOpts),
 			cmdCheck(),
+			cmdFmt(),
 			subcommands.CmdHelp,
+			cmdLint(),
 			authcli.SubcommandInfo(defaultAuthOpts, "whoami", false),
 			authcli.SubcommandLogin(defaultAuthOpts, "login", false),
 			authcli.SubcommandLogout(defaultAuthOpts, "logout", false),
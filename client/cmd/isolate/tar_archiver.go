@@ -6,7 +6,7 @@ package main
 
 import (
 	"archive/tar"
-	"crypto/sha1"
+	"crypto"
 	"io"
 	"os"
 
@@ -63,15 +63,15 @@ func oneBundle(items []*Item, threshold int64) (*ItemBundle, []*Item) {
 	return bundle, nil
 }
 
-// Digest returns the hash and total size of the tar constructed from the
-// bundle's items.
-func (b *ItemBundle) Digest() (isolated.HexDigest, int64, error) {
-	h := sha1.New()
-	cw := &iotools.CountingWriter{Writer: h}
+// Digest returns the hash, calculated with h, and total size of the tar
+// constructed from the bundle's items.
+func (b *ItemBundle) Digest(h crypto.Hash) (isolated.HexDigest, int64, error) {
+	a := h.New()
+	cw := &iotools.CountingWriter{Writer: a}
 	if err := b.writeTar(cw); err != nil {
 		return "", 0, err
 	}
-	return isolated.Sum(h), cw.Count, nil
+	return isolated.Sum(a), cw.Count, nil
 }
 
 // Contents returns an io.ReadCloser containing the tar's contents.
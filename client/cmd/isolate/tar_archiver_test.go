@@ -5,6 +5,7 @@
 package main
 
 import (
+	"crypto"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -133,7 +134,7 @@ func TestItemBundle(t *testing.T) {
 		}
 
 		for i, bundle := range bundles {
-			digest, size, err := bundle.Digest()
+			digest, size, err := bundle.Digest(crypto.SHA1)
 			if err != nil {
 				t.Errorf("%s: bundle[%d].Digest gave err %v, want nil", tc.desc, i, err)
 				continue
@@ -187,7 +188,7 @@ func TestItemBundle_Errors(t *testing.T) {
 		t.Errorf("len(bundles) = %d, want 2", len(bundles))
 	}
 	for _, bundle := range bundles {
-		if _, _, err := bundle.Digest(); err == nil {
+		if _, _, err := bundle.Digest(crypto.SHA1); err == nil {
 			t.Errorf("Path %q, bundle.Digest gave nil error; want some error", bundle.Items[0].Path)
 		}
 		rc, err := bundle.Contents()
@@ -31,6 +31,8 @@ func cmdArchive(defaultAuthOpts auth.Options) *subcommands.Command {
 			c.Flags.Var(&c.files, "files", "Individual file(s) to archive")
 			c.Flags.Var(&c.blacklist, "blacklist",
 				"List of regexp to use as blacklist filter when uploading directories")
+			c.Flags.Int64Var(&c.chunkThreshold, "chunk-threshold", 0,
+				"Files in -dirs of at least this many bytes are uploaded as content-defined chunks; 0 disables chunking")
 			return &c
 		},
 	}
@@ -38,9 +40,10 @@ func cmdArchive(defaultAuthOpts auth.Options) *subcommands.Command {
 
 type archiveRun struct {
 	commonFlags
-	dirs      common.Strings
-	files     common.Strings
-	blacklist common.Strings
+	dirs           common.Strings
+	files          common.Strings
+	blacklist      common.Strings
+	chunkThreshold int64
 }
 
 func (c *archiveRun) Parse(a subcommands.Application, args []string) error {
@@ -50,6 +53,9 @@ func (c *archiveRun) Parse(a subcommands.Application, args []string) error {
 	if len(args) != 0 {
 		return errors.New("position arguments not expected")
 	}
+	if c.chunkThreshold < 0 {
+		return errors.New("-chunk-threshold must not be negative")
+	}
 	return nil
 }
 
@@ -69,6 +75,7 @@ func (c *archiveRun) main(a subcommands.Application, args []string) error {
 	ctx := c.defaultFlags.MakeLoggingContext(os.Stderr)
 	arch := archiver.New(ctx, isolatedclient.New(nil, authClient, c.isolatedFlags.ServerURL, c.isolatedFlags.Namespace, nil, nil), out)
 	common.CancelOnCtrlC(arch)
+	arch.EnableChunking(c.chunkThreshold)
 	items := make([]*archiver.Item, 0, len(c.files)+len(c.dirs))
 	names := make([]string, 0, cap(items))
 	for _, file := range c.files {
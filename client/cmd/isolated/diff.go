@@ -0,0 +1,104 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/maruel/subcommands"
+
+	"github.com/luci/luci-go/client/downloader"
+	"github.com/luci/luci-go/common/auth"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/isolatedclient"
+	"github.com/luci/luci-go/common/sync/parallel"
+)
+
+func cmdDiff(authOpts auth.Options) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "diff <options>... <before> <after>",
+		ShortDesc: "compares two .isolated trees.",
+		LongDesc: `Compares the files of two .isolated trees, including their includes.
+
+The trees are referenced by the hash of their .isolated file. Files are
+compared by path, digest, mode, type and symlink target. Added files are
+prefixed with '+', removed files with '-' and modified files with 'M'.`,
+		CommandRun: func() subcommands.CommandRun {
+			c := diffRun{}
+			c.commonFlags.Init(authOpts)
+			c.Flags.BoolVar(&c.json, "json", false, "Print the changes as JSON")
+			return &c
+		},
+	}
+}
+
+type diffRun struct {
+	commonFlags
+	json bool
+}
+
+func (c *diffRun) Parse(a subcommands.Application, args []string) error {
+	if err := c.commonFlags.Parse(); err != nil {
+		return err
+	}
+	if len(args) != 2 {
+		return errors.New("must specify the hashes of the two .isolated files to compare")
+	}
+	return nil
+}
+
+func (c *diffRun) main(a subcommands.Application, args []string) error {
+	authClient, err := c.createAuthClient()
+	if err != nil {
+		return err
+	}
+	ctx := c.defaultFlags.MakeLoggingContext(os.Stderr)
+	client := isolatedclient.New(nil, authClient, c.isolatedFlags.ServerURL, c.isolatedFlags.Namespace, nil, nil)
+	trees := make([]*downloader.Tree, len(args))
+	err = parallel.FanOutIn(func(ch chan<- func() error) {
+		for i, arg := range args {
+			i, arg := i, arg
+			ch <- func() error {
+				t, err := downloader.Resolve(ctx, client, isolated.HexDigest(arg), nil, 0)
+				trees[i] = t
+				return err
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	changes := downloader.Diff(trees[0], trees[1])
+	if c.json {
+		if changes == nil {
+			changes = []downloader.Change{}
+		}
+		return printJSON(a.GetOut(), changes)
+	}
+	for _, change := range changes {
+		fmt.Fprintf(a.GetOut(), "%s\n", change.String())
+	}
+	return nil
+}
+
+func (c *diffRun) Run(a subcommands.Application, args []string, _ subcommands.Env) int {
+	if err := c.Parse(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	cl, err := c.defaultFlags.StartTracing()
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	defer cl.Close()
+	if err := c.main(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return 0
+}
@@ -7,21 +7,40 @@ package main
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
-	"github.com/luci/luci-go/common/auth"
 	"github.com/maruel/subcommands"
+
+	"github.com/luci/luci-go/client/downloader"
+	"github.com/luci/luci-go/common/auth"
+	"github.com/luci/luci-go/common/data/caching/cache"
+	"github.com/luci/luci-go/common/data/text/units"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/isolatedclient"
 )
 
 func cmdDownload(authOpts auth.Options) *subcommands.Command {
 	return &subcommands.Command{
 		UsageLine: "download <options>...",
-		ShortDesc: "downloads a file or a .isolated tree from an isolate server.",
-		LongDesc: `Downloads one or multiple files, or a isolated tree from the isolate server.
+		ShortDesc: "downloads a .isolated tree from an isolate server.",
+		LongDesc: `Downloads a .isolated tree, including its includes, from the isolate server.
 
-Files are referenced by their hash`,
+The tree is referenced by the hash of its .isolated file. Items are fetched in
+parallel and, when -cache-dir is specified, kept in a local cache that is
+reused by subsequent downloads. Files already present in the output directory
+with the expected content are not fetched again, so an interrupted download
+can be resumed.`,
 		CommandRun: func() subcommands.CommandRun {
 			c := downloadRun{}
 			c.commonFlags.Init(authOpts)
+			c.Flags.StringVar(&c.isolated, "isolated", "", "Hash of the .isolated file to download")
+			c.Flags.StringVar(&c.outputDir, "output-dir", "", "Directory to download the tree into")
+			c.Flags.StringVar(&c.cacheDir, "cache-dir", "", "Directory of the local cache; if unset, no cache is used")
+			c.Flags.Int64Var(&c.cacheMaxSize, "cache-max-size", 50*1024*1024*1024, "Trim the cache to this many bytes")
+			c.Flags.IntVar(&c.cacheMaxItems, "cache-max-items", 100000, "Trim the cache to this many items")
+			c.Flags.IntVar(&c.maxConcurrent, "max-concurrent", 8, "Maximum number of items to fetch in parallel")
 			return &c
 		},
 	}
@@ -29,6 +48,12 @@ Files are referenced by their hash`,
 
 type downloadRun struct {
 	commonFlags
+	isolated      string
+	outputDir     string
+	cacheDir      string
+	cacheMaxSize  int64
+	cacheMaxItems int
+	maxConcurrent int
 }
 
 func (c *downloadRun) Parse(a subcommands.Application, args []string) error {
@@ -38,11 +63,65 @@ func (c *downloadRun) Parse(a subcommands.Application, args []string) error {
 	if len(args) != 0 {
 		return errors.New("position arguments not expected")
 	}
+	if c.isolated == "" {
+		return errors.New("-isolated must be specified")
+	}
+	if c.outputDir == "" {
+		return errors.New("-output-dir must be specified")
+	}
+	if c.maxConcurrent <= 0 {
+		return errors.New("-max-concurrent must be positive")
+	}
+	var err error
+	if c.outputDir, err = filepath.Abs(c.outputDir); err != nil {
+		return err
+	}
+	if c.cacheDir != "" {
+		if c.cacheDir, err = filepath.Abs(c.cacheDir); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (c *downloadRun) main(a subcommands.Application, args []string) error {
-	return errors.New("TODO")
+	start := time.Now()
+	authClient, err := c.createAuthClient()
+	if err != nil {
+		return err
+	}
+	ctx := c.defaultFlags.MakeLoggingContext(os.Stderr)
+	client := isolatedclient.New(nil, authClient, c.isolatedFlags.ServerURL, c.isolatedFlags.Namespace, nil, nil)
+
+	opts := &downloader.Options{MaxConcurrent: c.maxConcurrent}
+	if c.cacheDir != "" {
+		if err := os.MkdirAll(c.cacheDir, 0755); err != nil {
+			return err
+		}
+		policies := cache.Policies{MaxSize: units.Size(c.cacheMaxSize), MaxItems: c.cacheMaxItems}
+		d, err := cache.NewDisk(policies, c.cacheDir, c.isolatedFlags.Namespace)
+		if d == nil {
+			return err
+		}
+		if err != nil {
+			// The cache is still usable, its previous state is lost.
+			fmt.Fprintf(a.GetErr(), "%s: ignoring cache state: %s\n", a.GetName(), err)
+		}
+		defer d.Close()
+		opts.Cache = d
+	}
+
+	_, stats, err := downloader.FetchIsolated(ctx, client, isolated.HexDigest(c.isolated), c.outputDir, opts)
+	if err != nil {
+		return err
+	}
+	if !c.defaultFlags.Quiet {
+		fmt.Fprintf(os.Stderr, "Fetched   : %5d (%s)\n", stats.Fetched, stats.BytesFetched)
+		fmt.Fprintf(os.Stderr, "Cache hits: %5d\n", stats.CacheHits)
+		fmt.Fprintf(os.Stderr, "Reused    : %5d\n", stats.Reused)
+		fmt.Fprintf(os.Stderr, "Duration  : %s\n", units.Round(time.Since(start), time.Millisecond))
+	}
+	return nil
 }
 
 func (c *downloadRun) Run(a subcommands.Application, args []string, _ subcommands.Env) int {
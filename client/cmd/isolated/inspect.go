@@ -0,0 +1,143 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/maruel/subcommands"
+
+	"github.com/luci/luci-go/client/downloader"
+	"github.com/luci/luci-go/common/auth"
+	"github.com/luci/luci-go/common/data/text/units"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/isolatedclient"
+)
+
+func cmdInspect(authOpts auth.Options) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "inspect <options>...",
+		ShortDesc: "prints the content of a .isolated tree.",
+		LongDesc: `Prints the files of a .isolated tree, including its includes.
+
+For each file, its mode, size, digest and path are printed; symlinks are
+printed with their target.`,
+		CommandRun: func() subcommands.CommandRun {
+			c := inspectRun{}
+			c.commonFlags.Init(authOpts)
+			c.Flags.StringVar(&c.isolated, "isolated", "", "Hash of the .isolated file to inspect")
+			c.Flags.BoolVar(&c.json, "json", false, "Print the merged tree as JSON")
+			return &c
+		},
+	}
+}
+
+type inspectRun struct {
+	commonFlags
+	isolated string
+	json     bool
+}
+
+func (c *inspectRun) Parse(a subcommands.Application, args []string) error {
+	if err := c.commonFlags.Parse(); err != nil {
+		return err
+	}
+	if len(args) != 0 {
+		return errors.New("position arguments not expected")
+	}
+	if c.isolated == "" {
+		return errors.New("-isolated must be specified")
+	}
+	return nil
+}
+
+func (c *inspectRun) main(a subcommands.Application, args []string) error {
+	authClient, err := c.createAuthClient()
+	if err != nil {
+		return err
+	}
+	ctx := c.defaultFlags.MakeLoggingContext(os.Stderr)
+	client := isolatedclient.New(nil, authClient, c.isolatedFlags.ServerURL, c.isolatedFlags.Namespace, nil, nil)
+	t, err := downloader.Resolve(ctx, client, isolated.HexDigest(c.isolated), nil, 0)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return printJSON(a.GetOut(), t)
+	}
+	printTree(a.GetOut(), t)
+	return nil
+}
+
+func (c *inspectRun) Run(a subcommands.Application, args []string, _ subcommands.Env) int {
+	if err := c.Parse(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	cl, err := c.defaultFlags.StartTracing()
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	defer cl.Close()
+	if err := c.main(a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return 0
+}
+
+func printJSON(w io.Writer, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
+
+func printTree(w io.Writer, t *downloader.Tree) {
+	if len(t.Command) != 0 {
+		fmt.Fprintf(w, "command: %q\n", t.Command)
+	}
+	if t.RelativeCwd != "" {
+		fmt.Fprintf(w, "relative_cwd: %s\n", t.RelativeCwd)
+	}
+	if t.ReadOnly != nil {
+		fmt.Fprintf(w, "read_only: %d\n", *t.ReadOnly)
+	}
+	paths := make([]string, 0, len(t.Files))
+	for p := range t.Files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		fmt.Fprintf(w, "%s\n", formatFile(p, t.Files[p]))
+	}
+}
+
+// formatFile returns a one line description of a file in a tree.
+func formatFile(p string, f isolated.File) string {
+	if f.Link != nil {
+		return fmt.Sprintf("%-10s %10s %-40s %s -> %s", "symlink", "", "", p, *f.Link)
+	}
+	mode := "-"
+	if f.Mode != nil {
+		mode = fmt.Sprintf("%#o", *f.Mode)
+	}
+	size := "-"
+	if f.Size != nil {
+		size = units.Size(*f.Size).String()
+	}
+	if f.Type != "" && f.Type != isolated.Basic {
+		p = fmt.Sprintf("%s (%s)", p, f.Type)
+	}
+	return fmt.Sprintf("%-10s %10s %-40s %s", mode, size, f.Digest, p)
+}
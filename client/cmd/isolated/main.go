@@ -20,7 +20,7 @@ import (
 
 // version must be updated whenever functional change (behavior, arguments,
 // supported commands) is done.
-const version = "0.2"
+const version = "0.4"
 
 func GetApplication(defaultAuthOpts auth.Options) *subcommands.DefaultApplication {
 	return &subcommands.DefaultApplication{
@@ -29,8 +29,10 @@ func GetApplication(defaultAuthOpts auth.Options) *subcommands.DefaultApplicatio
 		// Keep in alphabetical order of their name.
 		Commands: []*subcommands.Command{
 			cmdArchive(defaultAuthOpts),
+			cmdDiff(defaultAuthOpts),
 			cmdDownload(defaultAuthOpts),
 			subcommands.CmdHelp,
+			cmdInspect(defaultAuthOpts),
 			authcli.SubcommandInfo(defaultAuthOpts, "whoami", false),
 			authcli.SubcommandLogin(defaultAuthOpts, "login", false),
 			authcli.SubcommandLogout(defaultAuthOpts, "logout", false),
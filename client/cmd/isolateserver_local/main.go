@@ -0,0 +1,68 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Binary isolateserver_local serves the isolate server API from a local
+// directory.
+//
+// It is meant for developers and sandboxes without network access; point
+// `isolate archive` and `isolated download` -isolate-server at it.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/luci/luci-go/common/data/text/units"
+	"github.com/luci/luci-go/common/isolatedclient/isolatedlocal"
+)
+
+func gcLoop(s *isolatedlocal.Server, interval, maxAge time.Duration, maxSize int64) {
+	for range time.Tick(interval) {
+		stats, err := s.GC(maxAge, maxSize)
+		if err != nil {
+			log.Printf("GC failed: %s", err)
+			continue
+		}
+		if stats.Removed != 0 {
+			log.Printf("GC removed %d items (%s), kept %d items (%s)",
+				stats.Removed, units.Size(stats.RemovedBytes), stats.Kept, units.Size(stats.KeptBytes))
+		}
+	}
+}
+
+func mainImpl() error {
+	root := flag.String("root", "", "Directory where the items are stored")
+	addr := flag.String("addr", "localhost:8080", "Address to listen on")
+	gcInterval := flag.Duration("gc-interval", time.Hour, "Interval between garbage collections; 0 to disable")
+	gcMaxAge := flag.Duration("gc-max-age", 7*24*time.Hour, "Remove items not accessed for this long; 0 to disable")
+	gcMaxSize := flag.Int64("gc-max-size", 0, "Remove the least recently accessed items above this many bytes; 0 to disable")
+	flag.Parse()
+	if *root == "" {
+		return fmt.Errorf("-root is required")
+	}
+	if flag.NArg() != 0 {
+		return fmt.Errorf("unknown arguments: %s", flag.Args())
+	}
+	s, err := isolatedlocal.New(*root)
+	if err != nil {
+		return err
+	}
+	if *gcInterval > 0 {
+		go gcLoop(s, *gcInterval, *gcMaxAge, *gcMaxSize)
+	}
+	log.Printf("Serving %s on http://%s", *root, *addr)
+	return http.ListenAndServe(*addr, s)
+}
+
+func main() {
+	log.SetFlags(log.Lmicroseconds)
+	if err := mainImpl(); err != nil {
+		fmt.Fprintf(os.Stderr, "isolateserver_local: %s\n", err)
+		os.Exit(1)
+	}
+}
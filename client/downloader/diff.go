@@ -0,0 +1,122 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package downloader
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/luci/luci-go/common/isolated"
+)
+
+// ChangeKind describes how a file differs between two trees.
+type ChangeKind string
+
+const (
+	// Added means the file is only present in the tree after.
+	Added ChangeKind = "added"
+	// Removed means the file is only present in the tree before.
+	Removed ChangeKind = "removed"
+	// Modified means the file content, mode or link target changed.
+	Modified ChangeKind = "modified"
+)
+
+// Change is a difference for a single path between two trees.
+type Change struct {
+	Path string         `json:"path"`
+	Kind ChangeKind     `json:"kind"`
+	Old  *isolated.File `json:"old,omitempty"`
+	New  *isolated.File `json:"new,omitempty"`
+	What []string       `json:"what,omitempty"` // For Modified: "digest", "mode", "type" or "link".
+}
+
+// String returns a one line description of the change.
+func (c *Change) String() string {
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("+ %s", c.Path)
+	case Removed:
+		return fmt.Sprintf("- %s", c.Path)
+	default:
+		return fmt.Sprintf("M %s %v", c.Path, c.What)
+	}
+}
+
+// Diff returns the differences between the files of the trees before and
+// after, sorted by path.
+//
+// Only the files are compared; differences in Command, ReadOnly and
+// RelativeCwd are not reported.
+func Diff(before, after *Tree) []Change {
+	var out []Change
+	for p, o := range before.Files {
+		o := o
+		n, ok := after.Files[p]
+		if !ok {
+			out = append(out, Change{Path: p, Kind: Removed, Old: &o})
+			continue
+		}
+		if what := compareFiles(&o, &n); len(what) != 0 {
+			out = append(out, Change{Path: p, Kind: Modified, Old: &o, New: &n, What: what})
+		}
+	}
+	for p, n := range after.Files {
+		n := n
+		if _, ok := before.Files[p]; !ok {
+			out = append(out, Change{Path: p, Kind: Added, New: &n})
+		}
+	}
+	sort.Sort(changesByPath(out))
+	return out
+}
+
+// Private details.
+
+type changesByPath []Change
+
+func (c changesByPath) Len() int           { return len(c) }
+func (c changesByPath) Less(i, j int) bool { return c[i].Path < c[j].Path }
+func (c changesByPath) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+// compareFiles returns the list of properties differing between two files.
+//
+// The size is not compared, as it can only change along the digest.
+func compareFiles(a, b *isolated.File) []string {
+	var what []string
+	if a.Digest != b.Digest {
+		what = append(what, "digest")
+	}
+	if !sameInt(a.Mode, b.Mode) {
+		what = append(what, "mode")
+	}
+	if fileType(a) != fileType(b) {
+		what = append(what, "type")
+	}
+	if !sameString(a.Link, b.Link) {
+		what = append(what, "link")
+	}
+	return what
+}
+
+func fileType(f *isolated.File) isolated.FileType {
+	if f.Type == "" {
+		return isolated.Basic
+	}
+	return f.Type
+}
+
+func sameInt(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sameString(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,50 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package downloader
+
+import (
+	"testing"
+
+	"github.com/luci/luci-go/common/isolated"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Diff reports the changes sorted by path.`, t, func() {
+		before := &Tree{Files: map[string]isolated.File{
+			"same":     isolated.BasicFile("aa", 0644, 1),
+			"content":  isolated.BasicFile("bb", 0644, 1),
+			"mode":     isolated.BasicFile("cc", 0644, 1),
+			"removed":  isolated.BasicFile("dd", 0644, 1),
+			"link":     isolated.SymLink("same"),
+			"tar/file": isolated.BasicFile("ee", 0644, 1),
+		}}
+		after := &Tree{Files: map[string]isolated.File{
+			"same":     isolated.BasicFile("aa", 0644, 1),
+			"content":  isolated.BasicFile("b2", 0644, 2),
+			"mode":     isolated.BasicFile("cc", 0755, 1),
+			"added":    isolated.BasicFile("ff", 0644, 1),
+			"link":     isolated.SymLink("content"),
+			"tar/file": isolated.TarFile("ee", 0644, 1),
+		}}
+
+		var got []string
+		for _, c := range Diff(before, after) {
+			got = append(got, c.String())
+		}
+		So(got, ShouldResemble, []string{
+			"+ added",
+			"M content [digest]",
+			"M link [link]",
+			"M mode [mode]",
+			"- removed",
+			"M tar/file [type]",
+		})
+		So(Diff(before, before), ShouldBeNil)
+	})
+}
@@ -0,0 +1,7 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package downloader implements the download of .isolated trees from an
+// isolated server, in parallel and backed by a local cache.
+package downloader
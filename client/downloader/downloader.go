@@ -0,0 +1,498 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package downloader
+
+import (
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/api/isolate/isolateservice/v1"
+	"github.com/luci/luci-go/common/data/caching/cache"
+	"github.com/luci/luci-go/common/data/text/units"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/isolatedclient"
+	"github.com/luci/luci-go/common/sync/parallel"
+)
+
+// Options are the options for FetchIsolated.
+type Options struct {
+	// MaxConcurrent is the maximum number of items fetched in parallel. If 0,
+	// 8 is used.
+	MaxConcurrent int
+	// Cache, if not nil, is consulted before fetching an item from the server
+	// and is populated with the fetched items. Files are hardlinked from it.
+	Cache cache.Cache
+}
+
+// Stats is statistics from FetchIsolated.
+type Stats struct {
+	Fetched      int        // Number of items downloaded from the server.
+	BytesFetched units.Size // Size of the items downloaded from the server.
+	CacheHits    int        // Number of items found in the local cache.
+	Reused       int        // Number of files already present and verified.
+}
+
+// Tree is a .isolated file merged with all its includes.
+type Tree struct {
+	Command     []string
+	Files       map[string]isolated.File
+	ReadOnly    *isolated.ReadOnlyValue
+	RelativeCwd string
+}
+
+// Resolve fetches the .isolated file root and all the .isolated files it
+// includes, directly or indirectly, and merges them.
+//
+// Files listed in a .isolated file take precedence over the ones in its
+// includes, and earlier includes take precedence over later ones. c may be
+// nil.
+func Resolve(ctx context.Context, client *isolatedclient.Client, root isolated.HexDigest, c cache.Cache, maxConcurrent int) (*Tree, error) {
+	h := client.Hash()
+	if !root.Validate(h) {
+		return nil, fmt.Errorf("invalid digest %q", root)
+	}
+	if maxConcurrent <= 0 {
+		maxConcurrent = 8
+	}
+
+	// Fetch the whole graph, one level of includes at a time.
+	loaded := map[isolated.HexDigest]*isolated.Isolated{}
+	var lock sync.Mutex
+	level := isolated.HexDigests{root}
+	for len(level) != 0 {
+		err := parallel.WorkPool(maxConcurrent, func(ch chan<- func() error) {
+			for _, d := range level {
+				d := d
+				ch <- func() error {
+					iso, err := loadIsolated(ctx, client, d, c)
+					if err != nil {
+						return fmt.Errorf("fetching %s: %s", d, err)
+					}
+					lock.Lock()
+					defer lock.Unlock()
+					loaded[d] = iso
+					return nil
+				}
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		var next isolated.HexDigests
+		seen := map[isolated.HexDigest]bool{}
+		for _, d := range level {
+			for _, inc := range loaded[d].Includes {
+				if _, ok := loaded[inc]; !ok && !seen[inc] {
+					if !inc.Validate(h) {
+						return nil, fmt.Errorf("invalid include %q in %s", inc, d)
+					}
+					seen[inc] = true
+					next = append(next, inc)
+				}
+			}
+		}
+		level = next
+	}
+
+	// Merge them in precedence order.
+	t := &Tree{Files: map[string]isolated.File{}}
+	visited := map[isolated.HexDigest]bool{}
+	var merge func(d isolated.HexDigest)
+	merge = func(d isolated.HexDigest) {
+		if visited[d] {
+			return
+		}
+		visited[d] = true
+		iso := loaded[d]
+		if t.Command == nil {
+			t.Command = iso.Command
+			t.RelativeCwd = iso.RelativeCwd
+		}
+		if t.ReadOnly == nil {
+			t.ReadOnly = iso.ReadOnly
+		}
+		for p, f := range iso.Files {
+			if _, ok := t.Files[p]; !ok {
+				t.Files[p] = f
+			}
+		}
+		for _, inc := range iso.Includes {
+			merge(inc)
+		}
+	}
+	merge(root)
+	return t, nil
+}
+
+// FetchIsolated downloads the tree rooted at the .isolated file root into
+// outputDir.
+//
+// Files already present in outputDir with the expected content are kept, so
+// an interrupted download can be resumed without fetching them again. Paths
+// that would resolve outside of outputDir are rejected.
+func FetchIsolated(ctx context.Context, client *isolatedclient.Client, root isolated.HexDigest, outputDir string, opts *Options) (*Tree, *Stats, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	maxConcurrent := opts.MaxConcurrent
+	if maxConcurrent <= 0 {
+		maxConcurrent = 8
+	}
+	t, err := Resolve(ctx, client, root, opts.Cache, maxConcurrent)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := &downloader{
+		ctx:    ctx,
+		client: client,
+		h:      client.Hash(),
+		cache:  opts.Cache,
+	}
+	// Group the files by content so each item is fetched once.
+	byDigest := map[isolated.HexDigest][]target{}
+	var paths []string
+	for p := range t.Files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		f := t.Files[p]
+		dest, err := outputPath(outputDir, p)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return nil, nil, err
+		}
+		if f.Link != nil {
+			if err := d.symlink(*f.Link, dest); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		switch f.Type {
+		case "", isolated.Basic, isolated.Chunked:
+		default:
+			return nil, nil, fmt.Errorf("%s: unsupported file type %q", p, f.Type)
+		}
+		if d.verified(f, dest) {
+			d.stats.Reused++
+			continue
+		}
+		byDigest[f.Digest] = append(byDigest[f.Digest], target{p, dest, f.Mode})
+	}
+
+	err = parallel.WorkPool(maxConcurrent, func(ch chan<- func() error) {
+		for _, targets := range byDigest {
+			targets := targets
+			ch <- func() error {
+				if err := d.fetch(t.Files[targets[0].rel], targets); err != nil {
+					return fmt.Errorf("%s: %s", targets[0].rel, err)
+				}
+				return nil
+			}
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, &d.stats, nil
+}
+
+// Private details.
+
+// target is a file to write in the output directory.
+type target struct {
+	rel  string // Path as listed in the .isolated file.
+	path string // Absolute path in the output directory.
+	mode *int   // Mode to set, if any.
+}
+
+// outputPath returns the path of the file rel, as listed in a .isolated file,
+// in outputDir. It fails if the path would not be inside outputDir.
+func outputPath(outputDir, rel string) (string, error) {
+	p := filepath.Clean(filepath.FromSlash(rel))
+	if filepath.IsAbs(p) || filepath.VolumeName(p) != "" || p == "." || p == ".." ||
+		strings.HasPrefix(p, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s: invalid path, it must be relative to the output directory", rel)
+	}
+	return filepath.Join(outputDir, p), nil
+}
+
+type downloader struct {
+	// Immutable.
+	ctx    context.Context
+	client *isolatedclient.Client
+	h      crypto.Hash
+	cache  cache.Cache
+
+	// Lock protected.
+	lock  sync.Mutex
+	stats Stats
+}
+
+// verified returns true if dest already has the content of f.
+func (d *downloader) verified(f isolated.File, dest string) bool {
+	info, err := os.Lstat(dest)
+	if err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	if f.Size != nil && info.Size() != *f.Size {
+		return false
+	}
+	src, err := os.Open(dest)
+	if err != nil {
+		return false
+	}
+	defer src.Close()
+	digest, err := isolated.Hash(d.h, src)
+	return err == nil && digest == f.Digest
+}
+
+func (d *downloader) symlink(link, dest string) error {
+	if l, err := os.Readlink(dest); err == nil && l == link {
+		d.lock.Lock()
+		d.stats.Reused++
+		d.lock.Unlock()
+		return nil
+	}
+	if err := os.Remove(dest); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.Symlink(link, dest)
+}
+
+// fetch puts the content of f at all the targets, either from the cache or
+// from the server.
+func (d *downloader) fetch(f isolated.File, targets []target) error {
+	for _, t := range targets {
+		if err := os.Remove(t.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if d.cache != nil && d.cache.Touch(f.Digest) {
+		d.lock.Lock()
+		d.stats.CacheHits++
+		d.lock.Unlock()
+		for _, t := range targets {
+			if err := d.fromCache(f.Digest, t); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	partial := targets[0].path + ".partial"
+	dst, err := os.Create(partial)
+	if err != nil {
+		return err
+	}
+	err = d.client.FetchFile(d.ctx, &f, dst)
+	if err2 := dst.Close(); err == nil {
+		err = err2
+	}
+	if err == nil && f.Type == isolated.Chunked {
+		// The chunks are verified individually, verify the reassembled file.
+		if !d.verified(f, partial) {
+			err = errors.New("reassembled file has invalid content")
+		}
+	}
+	if err != nil {
+		_ = os.Remove(partial)
+		return err
+	}
+	d.lock.Lock()
+	d.stats.Fetched++
+	if f.Size != nil {
+		d.stats.BytesFetched += units.Size(*f.Size)
+	}
+	d.lock.Unlock()
+
+	if d.cache != nil {
+		if err := addToCache(d.cache, f.Digest, partial); err == nil {
+			_ = os.Remove(partial)
+			for _, t := range targets {
+				if err := d.fromCache(f.Digest, t); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		// The item may not fit in the cache; the file is still usable.
+	}
+	if err := os.Rename(partial, targets[0].path); err != nil {
+		return err
+	}
+	if err := chmod(targets[0]); err != nil {
+		return err
+	}
+	for _, t := range targets[1:] {
+		if err := copyFile(targets[0].path, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fromCache hardlinks a cached item to t, falling back to a copy when
+// hardlinking is not possible, e.g. across file systems.
+//
+// The cache hardlinks a copy of the item with the mode requested for t. If the
+// resulting mode still doesn't match, e.g. with a cache that doesn't keep a
+// copy per mode, the file is copied instead.
+func (d *downloader) fromCache(digest isolated.HexDigest, t target) error {
+	perm := os.FileMode(0644)
+	if t.mode != nil {
+		perm = os.FileMode(*t.mode)
+	}
+	if err := d.cache.Hardlink(digest, t.path, perm); err == nil {
+		if t.mode == nil {
+			return nil
+		}
+		if info, err := os.Lstat(t.path); err == nil && info.Mode().Perm() == perm.Perm() {
+			return nil
+		}
+		if err := os.Remove(t.path); err != nil {
+			return err
+		}
+	}
+	src, err := d.cache.Read(digest)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	return writeFile(t, src)
+}
+
+func addToCache(c cache.Cache, digest isolated.HexDigest, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return c.Add(digest, f)
+}
+
+func copyFile(src string, t target) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return writeFile(t, f)
+}
+
+func writeFile(t target, src io.Reader) error {
+	dst, err := os.Create(t.path)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, src)
+	if err2 := dst.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		return err
+	}
+	return chmod(t)
+}
+
+// chmod sets the mode of t, if it has one. t must not be a hardlink to a
+// cached item.
+func chmod(t target) error {
+	if t.mode == nil {
+		return nil
+	}
+	return os.Chmod(t.path, os.FileMode(*t.mode))
+}
+
+// loadIsolated returns the parsed .isolated file digest, using the cache if
+// possible.
+func loadIsolated(ctx context.Context, client *isolatedclient.Client, digest isolated.HexDigest, c cache.Cache) (*isolated.Isolated, error) {
+	var content []byte
+	if c != nil {
+		if r, err := c.Read(digest); err == nil {
+			buf := &memWriteSeeker{}
+			_, err = io.Copy(buf, r)
+			r.Close()
+			if err == nil {
+				content = buf.data
+			}
+		}
+	}
+	if content == nil {
+		buf := &memWriteSeeker{}
+		if err := client.Fetch(ctx, &isolateservice.HandlersEndpointsV1Digest{Digest: string(digest)}, buf); err != nil {
+			return nil, err
+		}
+		content = buf.data
+		if c != nil {
+			_ = c.Add(digest, &memWriteSeeker{data: content})
+		}
+	}
+	iso := &isolated.Isolated{}
+	if err := json.Unmarshal(content, iso); err != nil {
+		return nil, err
+	}
+	algo, err := isolated.AlgoHash(iso.Algo)
+	if err != nil {
+		return nil, err
+	}
+	if algo != client.Hash() {
+		return nil, fmt.Errorf("algo %q doesn't match the namespace %q", iso.Algo, client.Namespace())
+	}
+	return iso, nil
+}
+
+// memWriteSeeker is an in-memory io.WriteSeeker, also usable as an io.Reader.
+type memWriteSeeker struct {
+	data []byte
+	pos  int
+}
+
+func (m *memWriteSeeker) Write(p []byte) (int, error) {
+	if end := m.pos + len(p); end > len(m.data) {
+		m.data = append(m.data[:m.pos], p...)
+	} else {
+		copy(m.data[m.pos:], p)
+	}
+	m.pos += len(p)
+	return len(p), nil
+}
+
+func (m *memWriteSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case os.SEEK_SET:
+	case os.SEEK_CUR:
+		offset += int64(m.pos)
+	case os.SEEK_END:
+		offset += int64(len(m.data))
+	}
+	if offset < 0 || offset > int64(len(m.data)) {
+		return 0, errors.New("invalid offset")
+	}
+	m.pos = int(offset)
+	return offset, nil
+}
+
+func (m *memWriteSeeker) Read(p []byte) (int, error) {
+	if m.pos >= len(m.data) {
+		return 0, io.EOF
+	}
+	n := copy(p, m.data[m.pos:])
+	m.pos += n
+	return n, nil
+}
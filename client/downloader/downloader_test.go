@@ -0,0 +1,140 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package downloader
+
+import (
+	"crypto"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/client/archiver"
+	"github.com/luci/luci-go/common/data/caching/cache"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/isolatedclient"
+	"github.com/luci/luci-go/common/isolatedclient/isolatedfake"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFetchIsolated(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	Convey(`A tree archived on the server can be downloaded and resumed.`, t, func() {
+		server := isolatedfake.New()
+		ts := httptest.NewServer(server)
+		defer ts.Close()
+		client := isolatedclient.New(nil, nil, ts.URL, isolatedclient.DefaultNamespace, nil, nil)
+
+		tmpDir, err := ioutil.TempDir("", "downloader")
+		So(err, ShouldBeNil)
+		defer func() {
+			if err := os.RemoveAll(tmpDir); err != nil {
+				t.Fail()
+			}
+		}()
+		srcDir := filepath.Join(tmpDir, "src")
+		So(os.MkdirAll(filepath.Join(srcDir, "sub"), 0700), ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(srcDir, "foo"), []byte("foo"), 0600), ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(srcDir, "sub", "bar"), []byte("bar"), 0600), ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(srcDir, "sub", "foo_dupe"), []byte("foo"), 0600), ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(srcDir, "run"), []byte("run"), 0700), ShouldBeNil)
+
+		a := archiver.New(ctx, client, nil)
+		item := archiver.PushDirectory(a, srcDir, "", nil)
+		item.WaitForHashed()
+		So(a.Close(), ShouldBeNil)
+		So(item.Error(), ShouldBeNil)
+
+		cacheDir := filepath.Join(tmpDir, "cache")
+		So(os.Mkdir(cacheDir, 0700), ShouldBeNil)
+		c, err := cache.NewDisk(cache.Policies{MaxSize: 1024 * 1024, MaxItems: 100}, cacheDir, isolatedclient.DefaultNamespace)
+		So(err, ShouldBeNil)
+		defer c.Close()
+		opts := &Options{MaxConcurrent: 2, Cache: c}
+
+		check := func(outDir string) {
+			for name, content := range map[string]string{"foo": "foo", "sub/bar": "bar", "sub/foo_dupe": "foo", "run": "run"} {
+				actual, err := ioutil.ReadFile(filepath.Join(outDir, filepath.FromSlash(name)))
+				So(err, ShouldBeNil)
+				So(string(actual), ShouldEqual, content)
+			}
+			info, err := os.Stat(filepath.Join(outDir, "run"))
+			So(err, ShouldBeNil)
+			So(info.Mode().Perm(), ShouldEqual, os.FileMode(0700))
+
+			// Setting the mode of the output files must not modify the cached items.
+			items, err := ioutil.ReadDir(cacheDir)
+			So(err, ShouldBeNil)
+			for _, item := range items {
+				if isolated.HexDigest(item.Name()).Validate(crypto.SHA1) {
+					So(item.Mode().Perm(), ShouldEqual, os.FileMode(0600))
+				}
+			}
+
+			// All the output files are hardlinks to the cache, including the ones
+			// with another mode than the cached items.
+			for _, name := range []string{"foo", "sub/bar", "sub/foo_dupe", "run"} {
+				out, err := os.Stat(filepath.Join(outDir, filepath.FromSlash(name)))
+				So(err, ShouldBeNil)
+				linked := false
+				for _, item := range items {
+					if os.SameFile(out, item) {
+						linked = true
+					}
+				}
+				So(linked, ShouldBeTrue)
+			}
+		}
+
+		outDir := filepath.Join(tmpDir, "out")
+		tree, stats, err := FetchIsolated(ctx, client, item.Digest(), outDir, opts)
+		So(err, ShouldBeNil)
+		So(len(tree.Files), ShouldEqual, 4)
+		So(stats, ShouldResemble, &Stats{Fetched: 3, BytesFetched: 9})
+		check(outDir)
+
+		// Interrupted download: one file is missing and one is corrupted.
+		So(os.Remove(filepath.Join(outDir, "foo")), ShouldBeNil)
+		So(os.Chmod(filepath.Join(outDir, "sub", "bar"), 0600), ShouldBeNil)
+		So(os.Remove(filepath.Join(outDir, "sub", "bar")), ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(outDir, "sub", "bar"), []byte("baz"), 0600), ShouldBeNil)
+		_, stats, err = FetchIsolated(ctx, client, item.Digest(), outDir, opts)
+		So(err, ShouldBeNil)
+		So(stats, ShouldResemble, &Stats{CacheHits: 2, Reused: 2})
+		check(outDir)
+
+		// Without a cache, everything verified is reused.
+		_, stats, err = FetchIsolated(ctx, client, item.Digest(), outDir, nil)
+		So(err, ShouldBeNil)
+		So(stats, ShouldResemble, &Stats{Reused: 4})
+
+		_, _, err = FetchIsolated(ctx, client, isolated.HexDigest("0123456789012345678901234567890123456789"), outDir, nil)
+		So(err, ShouldNotBeNil)
+		So(server.Error(), ShouldBeNil)
+	})
+}
+
+func TestOutputPath(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Paths from a .isolated file must stay in the output directory.`, t, func() {
+		outDir := filepath.Join("out", "dir")
+		for _, rel := range []string{"foo", "sub/bar", "sub/../foo", "./foo"} {
+			p, err := outputPath(outDir, rel)
+			So(err, ShouldBeNil)
+			So(filepath.Dir(p), ShouldStartWith, outDir)
+		}
+		for _, rel := range []string{"", ".", "..", "../foo", "sub/../../foo", "/etc/passwd"} {
+			_, err := outputPath(outDir, rel)
+			So(err, ShouldNotBeNil)
+		}
+	})
+}
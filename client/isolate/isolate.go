@@ -200,7 +200,7 @@ func ProcessIsolate(opts *ArchiveOptions) ([]string, string, *isolated.Isolated,
 
 	// Prepare the .isolated struct.
 	isol := &isolated.Isolated{
-		Algo:     "sha-1",
+		Algo:     isolated.Algorithm,
 		Files:    map[string]isolated.File{},
 		ReadOnly: readOnly.ToIsolated(),
 		Version:  isolated.IsolatedFormatVersion,
@@ -255,6 +255,7 @@ func archive(arch *archiver.Archiver, opts *ArchiveOptions, displayName string)
 	if err != nil {
 		return nil, err
 	}
+	i.Algo = isolated.AlgoName(arch.Hash())
 	// Handle each dependency, either a file or a directory..
 	fileItems := make([]*archiver.Item, 0, filesCount)
 	dirItems := make([]*archiver.Item, 0, dirsCount)
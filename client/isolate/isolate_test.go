@@ -5,6 +5,7 @@
 package isolate
 
 import (
+	"crypto"
 	"encoding/json"
 	"io/ioutil"
 	"log"
@@ -146,7 +147,7 @@ func TestArchive(t *testing.T) {
 		encoded, err := json.Marshal(baseIsolatedData)
 		So(err, ShouldBeNil)
 		baseIsolatedEncoded := string(encoded) + "\n"
-		baseIsolatedHash := isolated.HashBytes([]byte(baseIsolatedEncoded))
+		baseIsolatedHash := isolated.HashBytes(crypto.SHA1, []byte(baseIsolatedEncoded))
 
 		//   /second/
 		secondIsolatedData := isolated.Isolated{
@@ -159,7 +160,7 @@ func TestArchive(t *testing.T) {
 		encoded, err = json.Marshal(secondIsolatedData)
 		So(err, ShouldBeNil)
 		secondIsolatedEncoded := string(encoded) + "\n"
-		secondIsolatedHash := isolated.HashBytes([]byte(secondIsolatedEncoded))
+		secondIsolatedHash := isolated.HashBytes(crypto.SHA1, []byte(secondIsolatedEncoded))
 
 		isolatedData := isolated.Isolated{
 			Algo:    "sha-1",
@@ -178,7 +179,7 @@ func TestArchive(t *testing.T) {
 		encoded, err = json.Marshal(isolatedData)
 		So(err, ShouldBeNil)
 		isolatedEncoded := string(encoded) + "\n"
-		isolatedHash := isolated.HashBytes([]byte(isolatedEncoded))
+		isolatedHash := isolated.HashBytes(crypto.SHA1, []byte(isolatedEncoded))
 
 		expected := map[string]string{
 			"0beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33": "foo",
@@ -212,7 +213,7 @@ func TestArchive(t *testing.T) {
 		}
 
 		So(server.Error(), ShouldBeNil)
-		digest, err := isolated.HashFile(filepath.Join(tmpDir, "baz.isolated"))
+		digest, err := isolated.HashFile(crypto.SHA1, filepath.Join(tmpDir, "baz.isolated"))
 		So(digest, ShouldResemble, isolateservice.HandlersEndpointsV1Digest{Digest: string(isolatedHash), IsIsolated: false, Size: int64(len(isolatedEncoded))})
 		So(err, ShouldBeNil)
 	})
@@ -0,0 +1,255 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package isolate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LintIssue is a problem found in a .isolate file by Lint.
+type LintIssue struct {
+	// Isolate is the path of the .isolate file with the problem.
+	Isolate string
+	// Message describes the problem.
+	Message string
+}
+
+func (l *LintIssue) String() string {
+	return l.Isolate + ": " + l.Message
+}
+
+// Lint checks the .isolate file opts.Isolate and the files it includes.
+//
+// It reports:
+//   - conditions that can't match any configuration or that are repeated,
+//   - includes listed twice or already included through another include,
+//   - include cycles and missing includes,
+//   - files missing on disk, for each configuration in the cartesian product
+//     of the config variables.
+//
+// Files referencing a path variable not in opts are not checked. An error is
+// returned only if a file can't be read or parsed.
+func Lint(opts *ArchiveOptions) ([]LintIssue, error) {
+	root, err := filepath.Abs(opts.Isolate)
+	if err != nil {
+		return nil, err
+	}
+	l := &linter{
+		closures: map[string]map[string]bool{},
+		visiting: map[string]bool{},
+	}
+	if _, err := l.visit(root); err != nil {
+		return nil, err
+	}
+	if !l.broken {
+		if err := l.lintFiles(root, opts); err != nil {
+			return nil, err
+		}
+	}
+	return l.issues, nil
+}
+
+// Private details.
+
+type linter struct {
+	issues []LintIssue
+	// closures is the set of the files transitively included by each visited
+	// .isolate file.
+	closures map[string]map[string]bool
+	visiting map[string]bool
+	// broken is true if the includes can't be loaded.
+	broken bool
+}
+
+// lintInclude is an include of a .isolate file.
+type lintInclude struct {
+	name     string          // As written in the .isolate file.
+	path     string          // Absolute path.
+	includes map[string]bool // Files it includes, directly or not.
+}
+
+func (l *linter) add(isolate, format string, args ...interface{}) {
+	l.issues = append(l.issues, LintIssue{isolate, fmt.Sprintf(format, args...)})
+}
+
+// visit lints the .isolate file at p and the ones it includes.
+//
+// It returns the set of the files it includes, directly or not.
+func (l *linter) visit(p string) (map[string]bool, error) {
+	if c, ok := l.closures[p]; ok {
+		return c, nil
+	}
+	if l.visiting[p] {
+		l.broken = true
+		l.add(p, "include cycle")
+		return map[string]bool{}, nil
+	}
+	l.visiting[p] = true
+	defer delete(l.visiting, p)
+
+	content, err := ioutil.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	pi, err := processIsolate(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", p, err)
+	}
+	if err := l.lintConditions(p, pi); err != nil {
+		return nil, err
+	}
+
+	var direct []lintInclude
+	seen := map[string]bool{}
+	closure := map[string]bool{}
+	for _, name := range pi.includes {
+		if filepath.IsAbs(name) {
+			l.add(p, "include %q must be a relative path", name)
+			continue
+		}
+		ip := filepath.Clean(filepath.Join(filepath.Dir(p), filepath.FromSlash(name)))
+		if seen[ip] {
+			l.add(p, "include %q is listed more than once", name)
+			continue
+		}
+		seen[ip] = true
+		sub, err := l.visit(ip)
+		if os.IsNotExist(err) {
+			l.add(p, "include %q doesn't exist", name)
+			l.broken = true
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		direct = append(direct, lintInclude{name, ip, sub})
+		closure[ip] = true
+		for k := range sub {
+			closure[k] = true
+		}
+	}
+	for _, a := range direct {
+		for _, b := range direct {
+			if a.path != b.path && b.includes[a.path] {
+				l.add(p, "include %q is already included by %q", a.name, b.name)
+				break
+			}
+		}
+	}
+	l.closures[p] = closure
+	return closure, nil
+}
+
+// lintConditions reports the repeated conditions and the ones that are false
+// for all the values of the variables used in the file.
+func (l *linter) lintConditions(p string, pi *processedIsolate) error {
+	count := map[string]int{}
+	for _, c := range pi.conditions {
+		count[c.condition]++
+		if count[c.condition] == 2 {
+			l.add(p, "condition %q is listed more than once", c.condition)
+		}
+	}
+	vars := make([]string, 0, len(pi.varsValsSet))
+	for v := range pi.varsValsSet {
+		vars = append(vars, v)
+	}
+	sort.Strings(vars)
+	configs, err := pi.getAllConfigs(vars)
+	if err != nil {
+		return err
+	}
+	index := makeConfigVariableIndex(vars)
+	for _, c := range pi.conditions {
+		reachable := false
+		for _, config := range configs {
+			ok, err := c.evaluate(func(name string) variableValue { return config[index[name]] })
+			if err == nil && ok {
+				reachable = true
+				break
+			}
+		}
+		if !reachable {
+			l.add(p, "condition %q never matches", c.condition)
+		}
+	}
+	return nil
+}
+
+// lintFiles reports the files missing for each configuration.
+func (l *linter) lintFiles(root string, opts *ArchiveOptions) error {
+	content, err := ioutil.ReadFile(root)
+	if err != nil {
+		return err
+	}
+	configs, err := LoadIsolateAsConfig(filepath.Dir(root), content)
+	if err != nil {
+		return err
+	}
+	values := variablesValuesSet{}
+	for _, v := range configs.ConfigVariables {
+		values[v] = map[variableValueKey]variableValue{}
+	}
+	for _, pair := range configs.byConfig {
+		for i, v := range pair.key {
+			if v.isBound() {
+				values[configs.ConfigVariables[i]][v.key()] = v
+			}
+		}
+	}
+	all := [][]variableValue{{}}
+	if len(configs.ConfigVariables) != 0 {
+		if all, err = values.cartesianProductOfValues(configs.ConfigVariables); err != nil {
+			return err
+		}
+	}
+	sort.Sort(configNames(all))
+
+	for _, name := range all {
+		settings, err := configs.GetConfig(name)
+		if err != nil {
+			return err
+		}
+		o := *opts
+		o.ConfigVariables = map[string]string{}
+		desc := make([]string, len(name))
+		for i, v := range name {
+			o.ConfigVariables[configs.ConfigVariables[i]] = v.String()
+			desc[i] = configs.ConfigVariables[i] + "=" + v.String()
+		}
+		config := strings.Join(desc, ",")
+		if config == "" {
+			config = "all configurations"
+		}
+		for _, f := range settings.Files {
+			resolved, err := ReplaceVariables(f, &o)
+			if err != nil {
+				continue
+			}
+			p := filepath.Join(settings.IsolateDir, filepath.FromSlash(resolved))
+			info, err := os.Stat(p)
+			switch {
+			case err != nil:
+				l.add(root, "%s: %q is missing", config, f)
+			case strings.HasSuffix(f, "/") && !info.IsDir():
+				l.add(root, "%s: %q is not a directory", config, f)
+			case !strings.HasSuffix(f, "/") && info.IsDir():
+				l.add(root, "%s: %q is a directory and must end with '/'", config, f)
+			}
+		}
+	}
+	return nil
+}
+
+type configNames [][]variableValue
+
+func (c configNames) Len() int           { return len(c) }
+func (c configNames) Less(i, j int) bool { return configName(c[i]).compare(c[j]) > 0 }
+func (c configNames) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
@@ -0,0 +1,113 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package isolate
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+	Convey(`Lint should report problems in .isolate files.`, t, func() {
+		tmpDir, err := ioutil.TempDir("", "isolate")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(tmpDir)
+
+		write := func(name, content string) string {
+			p := filepath.Join(tmpDir, filepath.FromSlash(name))
+			So(os.MkdirAll(filepath.Dir(p), 0700), ShouldBeNil)
+			So(ioutil.WriteFile(p, []byte(content), 0600), ShouldBeNil)
+			return p
+		}
+		lint := func(isolate string) []string {
+			opts := &ArchiveOptions{Isolate: isolate}
+			opts.Init()
+			issues, err := Lint(opts)
+			So(err, ShouldBeNil)
+			out := make([]string, len(issues))
+			for i := range issues {
+				rel, err := filepath.Rel(tmpDir, issues[i].Isolate)
+				So(err, ShouldBeNil)
+				out[i] = filepath.ToSlash(rel) + ": " + issues[i].Message
+			}
+			return out
+		}
+
+		Convey(`Clean file`, func() {
+			write("a", "")
+			write("dir/b", "")
+			p := write("base.isolate", `{
+  'variables': {'files': ['a', 'dir/']},
+  'conditions': [
+    ['OS=="linux"', {'variables': {'files': ['dir/b']}}],
+    ['OS=="win"', {'variables': {'files': ['<(PRODUCT_DIR)/foo.exe']}}],
+  ],
+}`)
+			So(lint(p), ShouldBeEmpty)
+		})
+
+		Convey(`Missing files per configuration`, func() {
+			write("a", "")
+			write("dir/b", "")
+			p := write("base.isolate", `{
+  'variables': {'files': ['a/', 'dir', 'missing']},
+  'conditions': [
+    ['OS=="linux"', {'variables': {'files': ['linux_only']}}],
+    ['OS=="win"', {'variables': {'files': ['dir/b']}}],
+  ],
+}`)
+			So(lint(p), ShouldResemble, []string{
+				`base.isolate: OS=linux: "a/" is not a directory`,
+				`base.isolate: OS=linux: "dir" is a directory and must end with '/'`,
+				`base.isolate: OS=linux: "linux_only" is missing`,
+				`base.isolate: OS=linux: "missing" is missing`,
+				`base.isolate: OS=win: "a/" is not a directory`,
+				`base.isolate: OS=win: "dir" is a directory and must end with '/'`,
+				`base.isolate: OS=win: "missing" is missing`,
+			})
+		})
+
+		Convey(`Conditions`, func() {
+			p := write("base.isolate", `{
+  'conditions': [
+    ['OS=="linux"', {'variables': {}}],
+    ['OS=="linux"', {'variables': {}}],
+    ['OS=="linux" and OS=="win"', {'variables': {}}],
+  ],
+}`)
+			So(lint(p), ShouldResemble, []string{
+				`base.isolate: condition "OS==\"linux\"" is listed more than once`,
+				`base.isolate: condition "OS==\"linux\" and OS==\"win\"" never matches`,
+			})
+		})
+
+		Convey(`Includes`, func() {
+			write("common.isolate", `{'variables': {}}`)
+			write("sub/mid.isolate", `{'includes': ['../common.isolate']}`)
+			p := write("base.isolate", `{
+  'includes': ['common.isolate', 'sub/mid.isolate', 'common.isolate'],
+}`)
+			So(lint(p), ShouldResemble, []string{
+				`base.isolate: include "common.isolate" is listed more than once`,
+				`base.isolate: include "common.isolate" is already included by "sub/mid.isolate"`,
+			})
+		})
+
+		Convey(`Broken includes`, func() {
+			write("a.isolate", `{'includes': ['b.isolate']}`)
+			write("b.isolate", `{'includes': ['a.isolate']}`)
+			p := write("base.isolate", `{'includes': ['a.isolate', 'missing.isolate']}`)
+			So(lint(p), ShouldResemble, []string{
+				`a.isolate: include cycle`,
+				`base.isolate: include "missing.isolate" doesn't exist`,
+			})
+		})
+	})
+}
@@ -0,0 +1,458 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package isolate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Format returns the canonical form of the content of a .isolate file.
+//
+// Conditions with the same expression are merged and sorted by expression,
+// and the files are sorted and deduplicated. Duplicated includes are removed,
+// the order of the others is preserved as it defines their precedence.
+//
+// Comment lines are kept with the item that follows them, so they move along
+// when the items are sorted or merged.
+func Format(content []byte) ([]byte, error) {
+	i, err := parseIsolate(content)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.Variables.verify(); err != nil {
+		return nil, err
+	}
+	// Only verify the conditions are valid, they are kept as written.
+	for _, c := range i.Conditions {
+		if _, err := processCondition(c, variablesValuesSet{}); err != nil {
+			return nil, fmt.Errorf("condition %q: %s", c.Condition, err)
+		}
+	}
+
+	cm, err := parseComments(content)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &bytes.Buffer{}
+	cm.write(out, 0)
+	out.WriteString("{\n")
+	if includes := uniqueStrings(i.Includes); len(includes) != 0 {
+		writeList(out, cm, 1, nil, "includes", includes)
+	}
+	if !i.Variables.isEmpty() {
+		writeVariables(out, cm, 1, nil, &i.Variables)
+	}
+	conditions, err := mergeConditions(i.Conditions)
+	if err != nil {
+		return nil, err
+	}
+	if len(conditions) != 0 {
+		cm.write(out, 1, "conditions")
+		fmt.Fprintf(out, "%s'conditions': [\n", indent(1))
+		for _, c := range conditions {
+			cm.write(out, 2, "conditions", c.Condition)
+			fmt.Fprintf(out, "%s[%s, {\n", indent(2), pythonString(c.Condition))
+			writeVariables(out, cm, 3, []string{"conditions", c.Condition}, &c.Variables)
+			cm.write(out, 3, "conditions", c.Condition, commentsEnd)
+			fmt.Fprintf(out, "%s}],\n", indent(2))
+		}
+		cm.write(out, 2, "conditions", commentsEnd)
+		fmt.Fprintf(out, "%s],\n", indent(1))
+	}
+	cm.write(out, 1, commentsEnd)
+	// Comments attached to items that are not written, e.g. to unknown keys,
+	// are not dropped.
+	cm.writeRemaining(out, 1)
+	out.WriteString("}\n")
+	cm.write(out, 0, commentsTrailer)
+	return out.Bytes(), nil
+}
+
+// Private details.
+
+// mergeConditions merges the conditions with the same expression and returns
+// them sorted by expression.
+func mergeConditions(conditions []condition) ([]condition, error) {
+	byCond := map[string]*condition{}
+	for _, c := range conditions {
+		m, ok := byCond[c.Condition]
+		if !ok {
+			c := c
+			byCond[c.Condition] = &c
+			continue
+		}
+		if err := m.Variables.merge(&c.Variables); err != nil {
+			return nil, fmt.Errorf("condition %q: %s", c.Condition, err)
+		}
+	}
+	out := make([]condition, 0, len(byCond))
+	for _, c := range byCond {
+		out = append(out, *c)
+	}
+	sort.Sort(conditionsByExpr(out))
+	return out, nil
+}
+
+// merge adds the files of rhs to v. The commands and read_only values must not
+// conflict.
+func (v *variables) merge(rhs *variables) error {
+	if len(rhs.Command) != 0 {
+		if len(v.Command) != 0 && strings.Join(v.Command, "\x00") != strings.Join(rhs.Command, "\x00") {
+			return fmt.Errorf("conflicting commands %q and %q", v.Command, rhs.Command)
+		}
+		v.Command = rhs.Command
+	}
+	if rhs.ReadOnly != nil {
+		if v.ReadOnly != nil && *v.ReadOnly != *rhs.ReadOnly {
+			return fmt.Errorf("conflicting read_only %d and %d", *v.ReadOnly, *rhs.ReadOnly)
+		}
+		v.ReadOnly = rhs.ReadOnly
+	}
+	v.Files = append(v.Files, rhs.Files...)
+	return nil
+}
+
+type conditionsByExpr []condition
+
+func (c conditionsByExpr) Len() int           { return len(c) }
+func (c conditionsByExpr) Less(i, j int) bool { return c[i].Condition < c[j].Condition }
+func (c conditionsByExpr) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+// writeVariables writes a 'variables' dict with its keys sorted.
+//
+// parent is the path of the dict containing it.
+func writeVariables(out *bytes.Buffer, cm *comments, level int, parent []string, v *variables) {
+	path := appendPath(parent, "variables")
+	cm.write(out, level, path...)
+	fmt.Fprintf(out, "%s'variables': {\n", indent(level))
+	if len(v.Command) != 0 {
+		// The order of the arguments matters.
+		writeList(out, cm, level+1, path, "command", v.Command)
+	}
+	if files := uniqueStrings(v.Files); len(files) != 0 {
+		sort.Strings(files)
+		writeList(out, cm, level+1, path, "files", files)
+	}
+	if v.ReadOnly != nil {
+		cm.write(out, level+1, appendPath(path, "read_only")...)
+		fmt.Fprintf(out, "%s'read_only': %d,\n", indent(level+1), *v.ReadOnly)
+	}
+	cm.write(out, level+1, appendPath(path, commentsEnd)...)
+	fmt.Fprintf(out, "%s},\n", indent(level))
+}
+
+func writeList(out *bytes.Buffer, cm *comments, level int, parent []string, key string, items []string) {
+	path := appendPath(parent, key)
+	cm.write(out, level, path...)
+	fmt.Fprintf(out, "%s%s: [\n", indent(level), pythonString(key))
+	for _, i := range items {
+		cm.write(out, level+1, appendPath(path, i)...)
+		fmt.Fprintf(out, "%s%s,\n", indent(level+1), pythonString(i))
+	}
+	cm.write(out, level+1, appendPath(path, commentsEnd)...)
+	fmt.Fprintf(out, "%s],\n", indent(level))
+}
+
+func indent(level int) string {
+	return strings.Repeat("  ", level)
+}
+
+// pythonString returns s as a single quoted Python string literal.
+func pythonString(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `'`, `\'`, -1)
+	return "'" + s + "'"
+}
+
+// uniqueStrings returns items without duplicates, in their original order.
+func uniqueStrings(items []string) []string {
+	seen := make(map[string]bool, len(items))
+	out := make([]string, 0, len(items))
+	for _, i := range items {
+		if !seen[i] {
+			seen[i] = true
+			out = append(out, i)
+		}
+	}
+	return out
+}
+
+const (
+	// commentsEnd is the path element of the comments before the closing
+	// bracket of a dict or a list.
+	commentsEnd = "\x01"
+	// commentsTrailer is the path of the comments after the root dict.
+	commentsTrailer = "\x02"
+)
+
+// comments are the comment lines of a .isolate file, keyed by the path of the
+// item that follows them.
+//
+// The path of a dict value is the path of the dict and its key. The path of a
+// string in a list is the path of the list and the string. A list in a list,
+// e.g. a condition, is identified by its first string; a dict in a list has
+// the path of the list.
+type comments struct {
+	lines map[string][]string
+	// order is the keys of lines, in the order they were first seen.
+	order []string
+}
+
+// write writes the comments attached to path, at most once.
+func (c *comments) write(out *bytes.Buffer, level int, path ...string) {
+	k := strings.Join(path, "\x00")
+	for _, l := range c.lines[k] {
+		fmt.Fprintf(out, "%s%s\n", indent(level), l)
+	}
+	delete(c.lines, k)
+}
+
+// writeRemaining writes the comments that were not written yet, in their
+// original order.
+func (c *comments) writeRemaining(out *bytes.Buffer, level int) {
+	for _, k := range c.order {
+		if k != commentsTrailer {
+			c.write(out, level, k)
+		}
+	}
+}
+
+func appendPath(path []string, elem string) []string {
+	return append(path[:len(path):len(path)], elem)
+}
+
+// parseComments returns the comments of content, a .isolate file.
+//
+// Only the structure of content is parsed, its values are not verified.
+func parseComments(content []byte) (*comments, error) {
+	p := &commentParser{src: string(content), out: &comments{lines: map[string][]string{}}}
+	if err := p.value(nil); err != nil {
+		return nil, err
+	}
+	p.skip()
+	if p.pos != len(p.src) {
+		return nil, p.errorf("unexpected %q", p.src[p.pos])
+	}
+	p.attach([]string{commentsTrailer}, p.take())
+	return p.out, nil
+}
+
+// commentParser parses a Python literal, collecting its comments.
+type commentParser struct {
+	src string
+	pos int
+	// pending is the comment lines not yet attached to an item.
+	pending []string
+	out     *comments
+}
+
+func (p *commentParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// skip skips the white spaces and the comments.
+func (p *commentParser) skip() {
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		case '#':
+			end := strings.IndexByte(p.src[p.pos:], '\n')
+			if end == -1 {
+				end = len(p.src) - p.pos
+			}
+			p.pending = append(p.pending, strings.TrimSpace(p.src[p.pos:p.pos+end]))
+			p.pos += end
+		default:
+			return
+		}
+	}
+}
+
+// take returns the pending comment lines.
+func (p *commentParser) take() []string {
+	lines := p.pending
+	p.pending = nil
+	return lines
+}
+
+func (p *commentParser) attach(path []string, lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+	k := strings.Join(path, "\x00")
+	if _, ok := p.out.lines[k]; !ok {
+		p.out.order = append(p.out.order, k)
+	}
+	p.out.lines[k] = append(p.out.lines[k], lines...)
+}
+
+func (p *commentParser) peek() byte {
+	if p.pos == len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// value parses a value, attaching the pending comments to path.
+func (p *commentParser) value(path []string) error {
+	p.skip()
+	p.attach(path, p.take())
+	switch c := p.peek(); c {
+	case '{':
+		return p.dict(path)
+	case '[':
+		p.pos++
+		return p.items(path)
+	case '\'', '"':
+		_, err := p.str()
+		return err
+	case 0:
+		return errors.New("unexpected end of file")
+	default:
+		// A number or an identifier like True.
+		start := p.pos
+		for p.pos < len(p.src) && !strings.ContainsRune(" \t\r\n#,:]}", rune(p.src[p.pos])) {
+			p.pos++
+		}
+		if p.pos == start {
+			return p.errorf("unexpected %q", c)
+		}
+		return nil
+	}
+}
+
+func (p *commentParser) dict(path []string) error {
+	p.pos++
+	for {
+		p.skip()
+		switch p.peek() {
+		case '}':
+			p.attach(appendPath(path, commentsEnd), p.take())
+			p.pos++
+			return nil
+		case '\'', '"':
+		default:
+			return p.errorf("expected a string key")
+		}
+		k, err := p.str()
+		if err != nil {
+			return err
+		}
+		kp := appendPath(path, k)
+		p.attach(kp, p.take())
+		p.skip()
+		if p.peek() != ':' {
+			return p.errorf("expected ':'")
+		}
+		p.pos++
+		if err := p.value(kp); err != nil {
+			return err
+		}
+		if err := p.separator('}'); err != nil {
+			return err
+		}
+	}
+}
+
+// items parses the items of a list, up to its closing bracket.
+func (p *commentParser) items(path []string) error {
+	for i := 0; ; i++ {
+		p.skip()
+		switch p.peek() {
+		case ']':
+			p.attach(appendPath(path, commentsEnd), p.take())
+			p.pos++
+			return nil
+		case '\'', '"':
+			v, err := p.str()
+			if err != nil {
+				return err
+			}
+			p.attach(appendPath(path, v), p.take())
+		case '[':
+			if err := p.pair(path, i); err != nil {
+				return err
+			}
+		case '{':
+			if err := p.value(path); err != nil {
+				return err
+			}
+		default:
+			if err := p.value(appendPath(path, strconv.Itoa(i))); err != nil {
+				return err
+			}
+		}
+		if err := p.separator(']'); err != nil {
+			return err
+		}
+	}
+}
+
+// pair parses a list in a list, like a condition, identified by its first
+// string. i is its index in the outer list.
+func (p *commentParser) pair(path []string, i int) error {
+	lines := p.take()
+	p.pos++
+	p.skip()
+	self := appendPath(path, strconv.Itoa(i))
+	if c := p.peek(); c == '\'' || c == '"' {
+		v, err := p.str()
+		if err != nil {
+			return err
+		}
+		self = appendPath(path, v)
+		if err := p.separator(']'); err != nil {
+			return err
+		}
+	}
+	p.attach(self, append(lines, p.take()...))
+	return p.items(self)
+}
+
+// separator skips the comma after an item, if any.
+func (p *commentParser) separator(closing byte) error {
+	p.skip()
+	switch p.peek() {
+	case ',':
+		p.pos++
+		return nil
+	case closing:
+		return nil
+	default:
+		return p.errorf("expected ',' or %q", closing)
+	}
+}
+
+// str parses a quoted string.
+func (p *commentParser) str() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var out []byte
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		p.pos++
+		switch c {
+		case quote:
+			return string(out), nil
+		case '\\':
+			if p.pos == len(p.src) {
+				break
+			}
+			c = p.src[p.pos]
+			p.pos++
+		case '\n':
+			return "", p.errorf("unterminated string")
+		}
+		out = append(out, c)
+	}
+	return "", errors.New("unterminated string")
+}
@@ -0,0 +1,124 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package isolate
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+	Convey(`Format should canonicalize .isolate files.`, t, func() {
+		content := []byte(`# Copyright.
+# Second line.
+{
+  # About the conditions.
+  'conditions': [
+    # Windows.
+    ['OS=="win"', {'variables': {'files': ['b.exe', 'a.dll']}}],
+    ['OS=="linux"', {'variables': {'command': ['./run', 'x'], 'files': [
+      'z',
+      # Comes first.
+      'y',
+      # Last line.
+    ]}}],
+    # More Windows.
+    ['OS=="win"', {'variables': {'files': ['a.dll', 'c.pdb'], 'read_only': 1}}],
+  ],
+  'variables': {'files': ['b', 'a', 'b']},
+  'includes': ['common.isolate', 'other.isolate', 'common.isolate'],
+  # End.
+}
+# Trailer.
+`)
+		expected := `# Copyright.
+# Second line.
+{
+  'includes': [
+    'common.isolate',
+    'other.isolate',
+  ],
+  'variables': {
+    'files': [
+      'a',
+      'b',
+    ],
+  },
+  # About the conditions.
+  'conditions': [
+    ['OS=="linux"', {
+      'variables': {
+        'command': [
+          './run',
+          'x',
+        ],
+        'files': [
+          # Comes first.
+          'y',
+          'z',
+          # Last line.
+        ],
+      },
+    }],
+    # Windows.
+    # More Windows.
+    ['OS=="win"', {
+      'variables': {
+        'files': [
+          'a.dll',
+          'b.exe',
+          'c.pdb',
+        ],
+        'read_only': 1,
+      },
+    }],
+  ],
+  # End.
+}
+# Trailer.
+`
+		out, err := Format(content)
+		So(err, ShouldBeNil)
+		So(string(out), ShouldResemble, expected)
+
+		// Formatting is idempotent.
+		again, err := Format(out)
+		So(err, ShouldBeNil)
+		So(string(again), ShouldResemble, expected)
+	})
+
+	Convey(`Format should keep comments of unknown items.`, t, func() {
+		out, err := Format([]byte(`{
+  # Unknown.
+  'unknown': [1, 2],
+  'variables': {'files': ['a']},
+}`))
+		So(err, ShouldBeNil)
+		So(string(out), ShouldResemble, `{
+  'variables': {
+    'files': [
+      'a',
+    ],
+  },
+  # Unknown.
+}
+`)
+	})
+
+	Convey(`Format should reject conflicting conditions.`, t, func() {
+		_, err := Format([]byte(`{'conditions': [
+  ['OS=="win"', {'variables': {'read_only': 1}}],
+  ['OS=="win"', {'variables': {'read_only': 2}}],
+]}`))
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey(`Format should reject invalid conditions.`, t, func() {
+		_, err := Format([]byte(`{'conditions': [['OS=', {'variables': {}}]]}`))
+		So(err, ShouldNotBeNil)
+	})
+}
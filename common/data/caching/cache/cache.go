@@ -6,8 +6,10 @@ package cache
 
 import (
 	"bytes"
+	"crypto"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
@@ -28,6 +30,10 @@ type Cache interface {
 	// Keys returns the list of all cached digests in LRU order.
 	Keys() isolated.HexDigests
 
+	// Hash returns the hashing algorithm used to calculate the digests of the
+	// cached items.
+	Hash() crypto.Hash
+
 	// Touch updates the LRU position of an item to ensure it is kept in the
 	// cache.
 	//
@@ -45,6 +51,10 @@ type Cache interface {
 
 	// Hardlink ensures file at |dest| has the same content as cached |digest|.
 	//
+	// |dest| has the mode |perm|. Since hardlinks share their mode, a disk cache
+	// keeps a copy of the item for each mode it was hardlinked with; the copies
+	// are evicted along with the item.
+	//
 	// Note that the behavior when dest already exists is undefined. It will work
 	// on all POSIX and may or may not fail on Windows depending on the
 	// implementation used. Do not rely on this behavior.
@@ -67,36 +77,52 @@ type Policies struct {
 	//
 	// BUG: Implement Policies.MinFreeSpace.
 	MinFreeSpace units.Size
+	// Eviction selects the items to evict when the cache is above MaxSize or
+	// MaxItems. If nil, the least recently used items are evicted first.
+	Eviction EvictionPolicy
 }
 
 // NewMemory creates a purely in-memory cache.
-func NewMemory(policies Policies) Cache {
+//
+// The items are hashed with the algorithm used by namespace.
+func NewMemory(policies Policies, namespace string) Cache {
+	h := isolated.GetHash(namespace)
 	return &memory{
 		policies: policies,
+		h:        h,
 		data:     map[isolated.HexDigest][]byte{},
-		lru:      makeLRUDict(),
+		lru:      makeLRUDict(h),
 	}
 }
 
 // NewDisk creates a disk based cache.
 //
+// The items are hashed with the algorithm used by namespace. A single path
+// must not be shared between namespaces using different algorithms.
+//
+// A path can be used by multiple processes at the same time. The state is
+// protected by a lock file; on Close, the changes done by this process are
+// merged into the state saved by the other processes in the meantime.
+//
 // It may return both a valid Cache and an error if it failed to load the
 // previous cache metadata. It is safe to ignore this error.
-func NewDisk(policies Policies, path string) (Cache, error) {
+func NewDisk(policies Policies, path, namespace string) (Cache, error) {
 	if !filepath.IsAbs(path) {
 		return nil, errors.New("must use absolute path")
 	}
+	h := isolated.GetHash(namespace)
 	d := &disk{
 		policies: policies,
 		path:     path,
-		lru:      makeLRUDict(),
+		h:        h,
+		lru:      makeLRUDict(h),
 	}
-	p := d.statePath()
-	f, err := os.Open(p)
-	if err == nil {
-		defer f.Close()
-		err = json.NewDecoder(f).Decode(&d.lru)
-	} else if os.IsNotExist(err) {
+	l, err := lockFile(d.lockPath())
+	if err != nil {
+		return nil, err
+	}
+	defer l.Close()
+	if err = d.loadState(&d.lru); os.IsNotExist(err) {
 		// The fact that the cache is new is not an error.
 		err = nil
 	}
@@ -108,6 +134,7 @@ func NewDisk(policies Policies, path string) (Cache, error) {
 type memory struct {
 	// Immutable.
 	policies Policies
+	h        crypto.Hash
 
 	// Lock protected.
 	lock sync.Mutex
@@ -127,8 +154,12 @@ func (m *memory) Keys() isolated.HexDigests {
 	return m.lru.keys()
 }
 
+func (m *memory) Hash() crypto.Hash {
+	return m.h
+}
+
 func (m *memory) Touch(digest isolated.HexDigest) bool {
-	if !digest.Validate() {
+	if !digest.Validate(m.h) {
 		return false
 	}
 	m.lock.Lock()
@@ -141,7 +172,7 @@ func (m *memory) Touch(digest isolated.HexDigest) bool {
 }
 
 func (m *memory) Evict(digest isolated.HexDigest) {
-	if !digest.Validate() {
+	if !digest.Validate(m.h) {
 		return
 	}
 	m.lock.Lock()
@@ -151,7 +182,7 @@ func (m *memory) Evict(digest isolated.HexDigest) {
 }
 
 func (m *memory) Read(digest isolated.HexDigest) (io.ReadCloser, error) {
-	if !digest.Validate() {
+	if !digest.Validate(m.h) {
 		return nil, os.ErrInvalid
 	}
 	m.lock.Lock()
@@ -164,7 +195,7 @@ func (m *memory) Read(digest isolated.HexDigest) (io.ReadCloser, error) {
 }
 
 func (m *memory) Add(digest isolated.HexDigest, src io.Reader) error {
-	if !digest.Validate() {
+	if !digest.Validate(m.h) {
 		return os.ErrInvalid
 	}
 	// TODO(maruel): Use a LimitedReader flavor that fails when reaching limit.
@@ -172,7 +203,7 @@ func (m *memory) Add(digest isolated.HexDigest, src io.Reader) error {
 	if err != nil {
 		return err
 	}
-	if isolated.HashBytes(content) != digest {
+	if isolated.HashBytes(m.h, content) != digest {
 		return errors.New("invalid hash")
 	}
 	if units.Size(len(content)) > m.policies.MaxSize {
@@ -187,7 +218,7 @@ func (m *memory) Add(digest isolated.HexDigest, src io.Reader) error {
 }
 
 func (m *memory) Hardlink(digest isolated.HexDigest, dest string, perm os.FileMode) error {
-	if !digest.Validate() {
+	if !digest.Validate(m.h) {
 		return os.ErrInvalid
 	}
 	m.lock.Lock()
@@ -200,36 +231,77 @@ func (m *memory) Hardlink(digest isolated.HexDigest, dest string, perm os.FileMo
 }
 
 func (m *memory) respectPolicies() {
-	for m.lru.length() > m.policies.MaxItems || m.lru.sum > m.policies.MaxSize {
-		k, _ := m.lru.popOldest()
+	for _, k := range m.lru.trim(&m.policies) {
 		delete(m.data, k)
 	}
 }
 
+// diskOp is a change done to a disk cache state.
+type diskOp struct {
+	kind   diskOpKind
+	digest isolated.HexDigest
+	size   units.Size
+}
+
+type diskOpKind int
+
+const (
+	opAdd diskOpKind = iota
+	opTouch
+	opEvict
+)
+
 type disk struct {
 	// Immutable.
 	policies Policies
 	path     string
+	h        crypto.Hash
 
 	// Lock protected.
 	lock sync.Mutex
-	lru  lruDict // Implements LRU based eviction.
+	lru  lruDict  // Implements LRU based eviction.
+	ops  []diskOp // Changes done to lru since it was loaded.
 	// TODO(maruel): Add stats about: # added, # removed.
-	// TODO(maruel): stateFile
 }
 
 func (d *disk) Close() error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
-	if !d.lru.IsDirty() {
+	if len(d.ops) == 0 {
 		return nil
 	}
-	f, err := os.Create(d.statePath())
-	if err == nil {
-		defer f.Close()
-		err = json.NewEncoder(f).Encode(&d.lru)
+	l, err := lockFile(d.lockPath())
+	if err != nil {
+		return err
 	}
-	return err
+	defer l.Close()
+
+	// Other processes may have updated the state since it was loaded. Replay
+	// the changes done by this process on top of the current state.
+	merged := makeLRUDict(d.h)
+	if err := d.loadState(&merged); err != nil {
+		if !os.IsNotExist(err) {
+			// The state is corrupted; it is replaced with this process' view.
+			merged = d.lru
+			d.ops = nil
+		}
+	}
+	for _, op := range d.ops {
+		switch op.kind {
+		case opAdd:
+			merged.pushFront(op.digest, op.size)
+		case opTouch:
+			merged.touch(op.digest)
+		case opEvict:
+			merged.pop(op.digest)
+		}
+	}
+	for _, k := range merged.trim(&d.policies) {
+		d.removeItem(k)
+	}
+	d.lru = merged
+	d.ops = nil
+	return d.saveState()
 }
 
 func (d *disk) Keys() isolated.HexDigests {
@@ -238,8 +310,12 @@ func (d *disk) Keys() isolated.HexDigests {
 	return d.lru.keys()
 }
 
+func (d *disk) Hash() crypto.Hash {
+	return d.h
+}
+
 func (d *disk) Touch(digest isolated.HexDigest) bool {
-	if !digest.Validate() {
+	if !digest.Validate(d.h) {
 		return false
 	}
 	d.lock.Lock()
@@ -248,22 +324,24 @@ func (d *disk) Touch(digest isolated.HexDigest) bool {
 	if err := os.Chtimes(d.itemPath(digest), mtime, mtime); err != nil {
 		return false
 	}
+	// The item may have been added by another process, in which case it is
+	// only known after Close.
 	d.lru.touch(digest)
+	d.ops = append(d.ops, diskOp{kind: opTouch, digest: digest})
 	return true
 }
 
 func (d *disk) Evict(digest isolated.HexDigest) {
-	if !digest.Validate() {
+	if !digest.Validate(d.h) {
 		return
 	}
 	d.lock.Lock()
 	defer d.lock.Unlock()
-	d.lru.pop(digest)
-	_ = os.Remove(d.itemPath(digest))
+	d.evict(digest)
 }
 
 func (d *disk) Read(digest isolated.HexDigest) (io.ReadCloser, error) {
-	if !digest.Validate() {
+	if !digest.Validate(d.h) {
 		return nil, os.ErrInvalid
 	}
 	f, err := os.Open(d.itemPath(digest))
@@ -274,45 +352,52 @@ func (d *disk) Read(digest isolated.HexDigest) (io.ReadCloser, error) {
 }
 
 func (d *disk) Add(digest isolated.HexDigest, src io.Reader) error {
-	if !digest.Validate() {
+	if !digest.Validate(d.h) {
 		return os.ErrInvalid
 	}
-	p := d.itemPath(digest)
-	dst, err := os.Create(p)
+	// Write to a temporary file first, so other processes never see a
+	// partially written item.
+	dst, err := ioutil.TempFile(d.path, "tmp")
 	if err != nil {
 		return err
 	}
-	h := isolated.GetHash()
+	tmp := dst.Name()
+	h := d.h.New()
 	// TODO(maruel): Use a LimitedReader flavor that fails when reaching limit.
 	size, err := io.Copy(dst, io.TeeReader(src, h))
 	if err2 := dst.Close(); err == nil {
 		err = err2
 	}
-	if err != nil {
-		_ = os.Remove(p)
-		return err
+	if err == nil && isolated.Sum(h) != digest {
+		err = errors.New("invalid hash")
 	}
-	if isolated.Sum(h) != digest {
-		_ = os.Remove(p)
-		return errors.New("invalid hash")
+	if err == nil && units.Size(size) > d.policies.MaxSize {
+		err = errors.New("item too large")
 	}
-	if units.Size(size) > d.policies.MaxSize {
-		_ = os.Remove(p)
-		return errors.New("item too large")
+	if err == nil {
+		err = os.Rename(tmp, d.itemPath(digest))
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
 	}
 
 	d.lock.Lock()
 	defer d.lock.Unlock()
 	d.lru.pushFront(digest, units.Size(size))
+	d.ops = append(d.ops, diskOp{kind: opAdd, digest: digest, size: units.Size(size)})
 	d.respectPolicies()
 	return nil
 }
 
 func (d *disk) Hardlink(digest isolated.HexDigest, dest string, perm os.FileMode) error {
-	if !digest.Validate() {
+	if !digest.Validate(d.h) {
 		return os.ErrInvalid
 	}
-	src := d.itemPath(digest)
+	src, err := d.modePath(digest, perm)
+	if err != nil {
+		return err
+	}
 	// - Windows, if dest exists, the call fails. In particular, trying to
 	//   os.Remove() will fail if the file's ReadOnly bit is set. What's worse is
 	//   that the ReadOnly bit is set on the file inode, shared on all hardlinks
@@ -335,13 +420,110 @@ func (d *disk) itemPath(digest isolated.HexDigest) string {
 	return filepath.Join(d.path, string(digest))
 }
 
+// modePath returns the path of a file with the content of the item digest and
+// the mode perm, creating it next to the item if needed.
+func (d *disk) modePath(digest isolated.HexDigest, perm os.FileMode) (string, error) {
+	src := d.itemPath(digest)
+	info, err := os.Stat(src)
+	if err != nil {
+		return "", err
+	}
+	if info.Mode().Perm() == perm.Perm() {
+		return src, nil
+	}
+	p := fmt.Sprintf("%s.%o", src, perm.Perm())
+	if _, err := os.Lstat(p); err == nil {
+		return p, nil
+	}
+
+	// Write to a temporary file first, so other processes never see a
+	// partially written copy.
+	in, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+	dst, err := ioutil.TempFile(d.path, "tmp")
+	if err != nil {
+		return "", err
+	}
+	tmp := dst.Name()
+	_, err = io.Copy(dst, in)
+	if err2 := dst.Close(); err == nil {
+		err = err2
+	}
+	if err == nil {
+		err = os.Chmod(tmp, perm.Perm())
+	}
+	if err == nil {
+		err = os.Rename(tmp, p)
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return "", err
+	}
+	return p, nil
+}
+
+// removeItem deletes the item digest and its copies with other modes.
+func (d *disk) removeItem(digest isolated.HexDigest) {
+	src := d.itemPath(digest)
+	copies, _ := filepath.Glob(src + ".*")
+	for _, p := range copies {
+		// Read-only files can't be deleted on Windows.
+		_ = os.Chmod(p, 0600)
+		_ = os.Remove(p)
+	}
+	_ = os.Remove(src)
+}
+
 func (d *disk) statePath() string {
 	return filepath.Join(d.path, "state.json")
 }
 
+func (d *disk) lockPath() string {
+	return filepath.Join(d.path, "state.lock")
+}
+
+// loadState reads the state file into l. The lock file must be held.
+func (d *disk) loadState(l *lruDict) error {
+	f, err := os.Open(d.statePath())
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return json.NewDecoder(f).Decode(l)
+}
+
+// saveState atomically writes the state file. The lock file must be held.
+func (d *disk) saveState() error {
+	f, err := ioutil.TempFile(d.path, "state")
+	if err != nil {
+		return err
+	}
+	err = json.NewEncoder(f).Encode(&d.lru)
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), d.statePath())
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+	return err
+}
+
+// evict removes an item. d.lock must be held.
+func (d *disk) evict(digest isolated.HexDigest) {
+	d.lru.pop(digest)
+	d.ops = append(d.ops, diskOp{kind: opEvict, digest: digest})
+	d.removeItem(digest)
+}
+
 func (d *disk) respectPolicies() {
-	for d.lru.length() > d.policies.MaxItems || d.lru.sum > d.policies.MaxSize {
-		k, _ := d.lru.popOldest()
-		_ = os.Remove(d.itemPath(k))
+	for _, k := range d.lru.trim(&d.policies) {
+		d.ops = append(d.ops, diskOp{kind: opEvict, digest: k})
+		d.removeItem(k)
 	}
 }
@@ -16,7 +16,7 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 )
 
-func testCache(t *testing.T, c Cache) isolated.HexDigests {
+func testCache(t *testing.T, c Cache, namespace string) isolated.HexDigests {
 	var expected isolated.HexDigests
 	Convey(`Common tests performed on a cache of objects.`, func() {
 		// c's policies must have MaxItems == 2 and MaxSize == 1024.
@@ -28,18 +28,19 @@ func testCache(t *testing.T, c Cache) isolated.HexDigests {
 			}
 		}()
 
-		fakeDigest := isolated.HexDigest("0123456789012345678901234567890123456789")
-		badDigest := isolated.HexDigest("012345678901234567890123456789012345678")
+		h := isolated.GetHash(namespace)
+		fakeDigest := isolated.HashBytes(h, []byte("not in cache"))
+		badDigest := fakeDigest[:len(fakeDigest)-1]
 		emptyContent := []byte{}
-		emptyDigest := isolated.HashBytes(emptyContent)
+		emptyDigest := isolated.HashBytes(h, emptyContent)
 		file1Content := []byte("foo")
-		file1Digest := isolated.HashBytes(file1Content)
+		file1Digest := isolated.HashBytes(h, file1Content)
 		file2Content := []byte("foo bar")
-		file2Digest := isolated.HashBytes(file2Content)
+		file2Digest := isolated.HashBytes(h, file2Content)
 		largeContent := bytes.Repeat([]byte("A"), 1023)
-		largeDigest := isolated.HashBytes(largeContent)
+		largeDigest := isolated.HashBytes(h, largeContent)
 		tooLargeContent := bytes.Repeat([]byte("A"), 1025)
-		tooLargeDigest := isolated.HashBytes(tooLargeContent)
+		tooLargeDigest := isolated.HashBytes(h, tooLargeContent)
 
 		So(c.Keys(), ShouldResemble, isolated.HexDigests{})
 
@@ -101,9 +102,11 @@ func testCache(t *testing.T, c Cache) isolated.HexDigests {
 }
 
 func TestNewMemory(t *testing.T) {
-	Convey(`Test the memory-based cache of objects.`, t, func() {
-		testCache(t, NewMemory(Policies{MaxSize: 1024, MaxItems: 2}))
-	})
+	for _, namespace := range []string{"default-gzip", "sha256-flate"} {
+		Convey(`Test the memory-based cache of objects in `+namespace, t, func() {
+			testCache(t, NewMemory(Policies{MaxSize: 1024, MaxItems: 2}, namespace), namespace)
+		})
+	}
 }
 
 func TestNewDisk(t *testing.T) {
@@ -116,17 +119,124 @@ func TestNewDisk(t *testing.T) {
 			}
 		}()
 		pol := Policies{MaxSize: 1024, MaxItems: 2}
-		c, err := NewDisk(pol, td)
+		c, err := NewDisk(pol, td, "default-gzip")
 		So(err, ShouldBeNil)
-		expected := testCache(t, c)
+		expected := testCache(t, c, "default-gzip")
 
-		c, err = NewDisk(pol, td)
+		c, err = NewDisk(pol, td, "default-gzip")
 		So(err, ShouldBeNil)
 		So(c.Keys(), ShouldResemble, expected)
 		So(c.Close(), ShouldBeNil)
 
-		c, err = NewDisk(pol, "non absolute path")
+		// The state is rejected when loaded with a different algorithm.
+		c, err = NewDisk(pol, td, "sha256-gzip")
+		So(c, ShouldNotBeNil)
+		So(err, ShouldNotBeNil)
+
+		c, err = NewDisk(pol, "non absolute path", "default-gzip")
 		So(c, ShouldBeNil)
 		So(err, ShouldNotBeNil)
 	})
 }
+
+func TestDiskShared(t *testing.T) {
+	Convey(`Two disk caches sharing a directory merge their state.`, t, func() {
+		td, err := ioutil.TempDir("", "cache")
+		So(err, ShouldBeNil)
+		defer func() {
+			if err := os.RemoveAll(td); err != nil {
+				t.Error(err)
+			}
+		}()
+		h := isolated.GetHash("default-gzip")
+		fooDigest := isolated.HashBytes(h, []byte("foo"))
+		barDigest := isolated.HashBytes(h, []byte("bar"))
+		pol := Policies{MaxSize: 1024, MaxItems: 10}
+
+		c1, err := NewDisk(pol, td, "default-gzip")
+		So(err, ShouldBeNil)
+		c2, err := NewDisk(pol, td, "default-gzip")
+		So(err, ShouldBeNil)
+		So(c1.Add(fooDigest, bytes.NewBufferString("foo")), ShouldBeNil)
+		So(c2.Add(barDigest, bytes.NewBufferString("bar")), ShouldBeNil)
+		// Items added by another user of the directory can be used.
+		So(c2.Touch(fooDigest), ShouldBeTrue)
+		So(c1.Close(), ShouldBeNil)
+		So(c2.Close(), ShouldBeNil)
+
+		c, err := NewDisk(pol, td, "default-gzip")
+		So(err, ShouldBeNil)
+		So(c.Keys(), ShouldResemble, isolated.HexDigests{fooDigest, barDigest})
+		So(c.Close(), ShouldBeNil)
+	})
+}
+
+func TestDiskHardlinkModes(t *testing.T) {
+	Convey(`Hardlinks with different modes share a copy per mode.`, t, func() {
+		td, err := ioutil.TempDir("", "cache")
+		So(err, ShouldBeNil)
+		defer func() {
+			if err := os.RemoveAll(td); err != nil {
+				t.Error(err)
+			}
+		}()
+		h := isolated.GetHash("default-gzip")
+		fooDigest := isolated.HashBytes(h, []byte("foo"))
+		c, err := NewDisk(Policies{MaxSize: 1024, MaxItems: 10}, td, "default-gzip")
+		So(err, ShouldBeNil)
+		So(c.Add(fooDigest, bytes.NewBufferString("foo")), ShouldBeNil)
+
+		out := filepath.Join(td, "out")
+		So(os.Mkdir(out, 0700), ShouldBeNil)
+		stat := func(name string) os.FileInfo {
+			info, err := os.Stat(filepath.Join(out, name))
+			So(err, ShouldBeNil)
+			return info
+		}
+		So(c.Hardlink(fooDigest, filepath.Join(out, "a"), 0500), ShouldBeNil)
+		So(c.Hardlink(fooDigest, filepath.Join(out, "b"), 0500), ShouldBeNil)
+		So(c.Hardlink(fooDigest, filepath.Join(out, "c"), 0600), ShouldBeNil)
+		So(stat("a").Mode().Perm(), ShouldEqual, os.FileMode(0500))
+		So(stat("c").Mode().Perm(), ShouldEqual, os.FileMode(0600))
+		So(os.SameFile(stat("a"), stat("b")), ShouldBeTrue)
+		So(os.SameFile(stat("a"), stat("c")), ShouldBeFalse)
+
+		item, err := os.Stat(filepath.Join(td, string(fooDigest)))
+		So(err, ShouldBeNil)
+		So(item.Mode().Perm(), ShouldEqual, os.FileMode(0600))
+		So(os.SameFile(item, stat("c")), ShouldBeTrue)
+
+		// The copies are evicted with the item.
+		c.Evict(fooDigest)
+		copies, err := filepath.Glob(filepath.Join(td, string(fooDigest)+"*"))
+		So(err, ShouldBeNil)
+		So(copies, ShouldBeEmpty)
+		So(c.Close(), ShouldBeNil)
+	})
+}
+
+func TestScrub(t *testing.T) {
+	Convey(`Scrub evicts corrupted items.`, t, func() {
+		td, err := ioutil.TempDir("", "cache")
+		So(err, ShouldBeNil)
+		defer func() {
+			if err := os.RemoveAll(td); err != nil {
+				t.Error(err)
+			}
+		}()
+		h := isolated.GetHash("default-gzip")
+		fooDigest := isolated.HashBytes(h, []byte("foo"))
+		barDigest := isolated.HashBytes(h, []byte("bar"))
+		c, err := NewDisk(Policies{MaxSize: 1024, MaxItems: 10}, td, "default-gzip")
+		So(err, ShouldBeNil)
+		So(c.Add(fooDigest, bytes.NewBufferString("foo")), ShouldBeNil)
+		So(c.Add(barDigest, bytes.NewBufferString("bar")), ShouldBeNil)
+		So(ioutil.WriteFile(filepath.Join(td, string(barDigest)), []byte("corrupted"), 0600), ShouldBeNil)
+
+		evicted, err := Scrub(c)
+		So(err, ShouldBeNil)
+		So(evicted, ShouldResemble, isolated.HexDigests{barDigest})
+		So(c.Keys(), ShouldResemble, isolated.HexDigests{fooDigest})
+		So(c.Close(), ShouldBeNil)
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"sort"
+
+	"github.com/luci/luci-go/common/data/text/units"
+	"github.com/luci/luci-go/common/isolated"
+)
+
+// Item describes a cached item to an EvictionPolicy.
+type Item struct {
+	Digest isolated.HexDigest
+	Size   units.Size
+	Hits   int // Number of times the item was added or touched.
+}
+
+// EvictionPolicy selects the items to evict when a cache exceeds its
+// Policies.
+//
+// The most recently used item is never a candidate, so an item that was just
+// added is not immediately evicted.
+type EvictionPolicy interface {
+	// Candidates returns the items that may be evicted, the first one being
+	// evicted first.
+	//
+	// items is in least recently used first order. The implementation may
+	// reorder it in place and return it.
+	Candidates(items []Item) []Item
+}
+
+// LRU evicts the least recently used items first.
+//
+// It is the default when Policies.Eviction is nil.
+type LRU struct{}
+
+// Candidates implements EvictionPolicy.
+func (LRU) Candidates(items []Item) []Item {
+	return items
+}
+
+// LFU evicts the least frequently used items first.
+//
+// Items used as frequently are evicted in least recently used first order.
+type LFU struct{}
+
+// Candidates implements EvictionPolicy.
+func (LFU) Candidates(items []Item) []Item {
+	sort.Stable(byHits(items))
+	return items
+}
+
+// SizeTiered evicts the items of the largest size tier first.
+//
+// Items are grouped in tiers of sizes growing by a factor of 4. Within a tier,
+// items are evicted in least recently used first order. This keeps the many
+// small items, which are the most expensive to fetch again per byte, at the
+// expense of a few large ones.
+type SizeTiered struct {
+	// Pinned items are never evicted. The cache may stay above its limits if
+	// only pinned items are left.
+	Pinned isolated.HexDigests
+}
+
+// Candidates implements EvictionPolicy.
+func (s SizeTiered) Candidates(items []Item) []Item {
+	pinned := make(map[isolated.HexDigest]bool, len(s.Pinned))
+	for _, d := range s.Pinned {
+		pinned[d] = true
+	}
+	out := items[:0]
+	for _, i := range items {
+		if !pinned[i.Digest] {
+			out = append(out, i)
+		}
+	}
+	sort.Stable(byTier(out))
+	return out
+}
+
+// Private details.
+
+type byHits []Item
+
+func (b byHits) Len() int           { return len(b) }
+func (b byHits) Less(i, j int) bool { return b[i].Hits < b[j].Hits }
+func (b byHits) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+
+// byTier sorts the largest tier first.
+type byTier []Item
+
+func (b byTier) Len() int           { return len(b) }
+func (b byTier) Less(i, j int) bool { return sizeTier(b[i].Size) > sizeTier(b[j].Size) }
+func (b byTier) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+
+// sizeTier returns floor(log4(size)).
+func sizeTier(size units.Size) int {
+	t := 0
+	for size >= 4 {
+		size >>= 2
+		t++
+	}
+	return t
+}
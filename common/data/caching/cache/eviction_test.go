@@ -0,0 +1,65 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/luci/luci-go/common/isolated"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEvictionPolicies(t *testing.T) {
+	t.Parallel()
+
+	items := func() []Item {
+		// Least recently used first.
+		return []Item{
+			{Digest: "a", Size: 1000, Hits: 3},
+			{Digest: "b", Size: 10, Hits: 1},
+			{Digest: "c", Size: 2000, Hits: 1},
+			{Digest: "d", Size: 20, Hits: 2},
+		}
+	}
+	digests := func(items []Item) []isolated.HexDigest {
+		out := make([]isolated.HexDigest, len(items))
+		for i, item := range items {
+			out[i] = item.Digest
+		}
+		return out
+	}
+
+	Convey(`LRU keeps the order.`, t, func() {
+		So(digests(LRU{}.Candidates(items())), ShouldResemble, []isolated.HexDigest{"a", "b", "c", "d"})
+	})
+
+	Convey(`LFU evicts the least used first.`, t, func() {
+		So(digests(LFU{}.Candidates(items())), ShouldResemble, []isolated.HexDigest{"b", "c", "d", "a"})
+	})
+
+	Convey(`SizeTiered evicts the large items first and skips pinned ones.`, t, func() {
+		So(digests(SizeTiered{}.Candidates(items())), ShouldResemble, []isolated.HexDigest{"c", "a", "d", "b"})
+		So(digests(SizeTiered{Pinned: isolated.HexDigests{"c"}}.Candidates(items())), ShouldResemble, []isolated.HexDigest{"a", "d", "b"})
+	})
+
+	Convey(`A cache uses its eviction policy.`, t, func() {
+		h := isolated.GetHash("default-gzip")
+		content := [][]byte{[]byte("foo"), []byte("bar"), []byte("baz")}
+		var d isolated.HexDigests
+		for _, c := range content {
+			d = append(d, isolated.HashBytes(h, c))
+		}
+		c := NewMemory(Policies{MaxSize: 1024, MaxItems: 2, Eviction: LFU{}}, "default-gzip")
+		So(c.Add(d[0], bytes.NewBuffer(content[0])), ShouldBeNil)
+		So(c.Touch(d[0]), ShouldBeTrue)
+		So(c.Add(d[1], bytes.NewBuffer(content[1])), ShouldBeNil)
+		// d[0] is the least recently used but the most frequently used.
+		So(c.Add(d[2], bytes.NewBuffer(content[2])), ShouldBeNil)
+		So(c.Keys(), ShouldResemble, isolated.HexDigests{d[2], d[0]})
+		So(c.Close(), ShouldBeNil)
+	})
+}
@@ -0,0 +1,38 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32       = syscall.NewLazyDLL("kernel32.dll")
+	procLockFileEx = kernel32.NewProc("LockFileEx")
+)
+
+const lockfileExclusiveLock = 2
+
+// lockFile blocks until it holds an exclusive lock on the file at path,
+// creating it if needed.
+//
+// The lock is released when the returned file is closed.
+func lockFile(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+	if err != nil {
+		return nil, err
+	}
+	// Lock the whole file. Without LOCKFILE_FAIL_IMMEDIATELY, the call blocks
+	// until the lock is acquired.
+	ol := &syscall.Overlapped{}
+	r, _, e := syscall.Syscall6(procLockFileEx.Addr(), 6, f.Fd(), lockfileExclusiveLock, 0, 0xffffffff, 0xffffffff, uintptr(unsafe.Pointer(ol)))
+	if r == 0 {
+		f.Close()
+		return nil, e
+	}
+	return f, nil
+}
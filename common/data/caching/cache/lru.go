@@ -6,6 +6,7 @@ package cache
 
 import (
 	"container/list"
+	"crypto"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -127,14 +128,18 @@ func (o *orderedDict) serialized() []entry {
 //
 // Designed to be serialized as JSON on disk.
 type lruDict struct {
-	items orderedDict // ordered key -> value mapping, newest items at the bottom.
-	dirty bool        // true if was modified after loading until it is marshaled.
-	sum   units.Size  // sum of all the values.
+	h     crypto.Hash                // algorithm used to calculate the keys.
+	items orderedDict                // ordered key -> value mapping, newest items at the bottom.
+	hits  map[isolated.HexDigest]int // number of times each key was pushed or touched.
+	dirty bool                       // true if was modified after loading until it is marshaled.
+	sum   units.Size                 // sum of all the values.
 }
 
-func makeLRUDict() lruDict {
+func makeLRUDict(h crypto.Hash) lruDict {
 	return lruDict{
+		h:     h,
 		items: makeOrderedDict(),
+		hits:  map[isolated.HexDigest]int{},
 	}
 }
 
@@ -150,9 +155,15 @@ func (l *lruDict) length() int {
 	return l.items.length()
 }
 
+func (l *lruDict) contains(key isolated.HexDigest) bool {
+	_, ok := l.items.entries[key]
+	return ok
+}
+
 func (l *lruDict) pop(key isolated.HexDigest) units.Size {
 	out := l.items.pop(key)
 	l.sum -= out
+	delete(l.hits, key)
 	l.dirty = true
 	return out
 }
@@ -161,33 +172,81 @@ func (l *lruDict) popOldest() (isolated.HexDigest, units.Size) {
 	k, v := l.items.popOldest()
 	l.sum -= v
 	if k != "" {
+		delete(l.hits, k)
 		l.dirty = true
 	}
 	return k, v
 }
 
 func (l *lruDict) pushFront(key isolated.HexDigest, value units.Size) {
+	l.sum -= l.items.pop(key)
 	l.items.pushFront(key, value)
 	l.sum += value
+	l.hits[key]++
 	l.dirty = true
 }
 
 func (l *lruDict) touch(key isolated.HexDigest) {
+	if !l.contains(key) {
+		return
+	}
 	l.items.pushFront(key, l.items.pop(key))
+	l.hits[key]++
 	l.dirty = true
 }
 
+// overLimits returns true if the dict doesn't respect the policies.
+func (l *lruDict) overLimits(p *Policies) bool {
+	return l.length() > p.MaxItems || l.sum > p.MaxSize
+}
+
+// trim removes items until the dict respects the policies and returns the
+// removed keys.
+//
+// The items are selected by p.Eviction. With an eviction policy other than
+// the default, the dict may still be above the limits if the policy excluded
+// some items from eviction.
+func (l *lruDict) trim(p *Policies) isolated.HexDigests {
+	var out isolated.HexDigests
+	if p.Eviction == nil {
+		for l.overLimits(p) {
+			k, _ := l.popOldest()
+			out = append(out, k)
+		}
+		return out
+	}
+	if !l.overLimits(p) {
+		return nil
+	}
+	// The most recently used item is never a candidate.
+	items := make([]Item, 0, l.length())
+	for e := l.items.ll.Back(); e != nil && e != l.items.ll.Front(); e = e.Prev() {
+		kv := e.Value.(*entry)
+		items = append(items, Item{Digest: kv.key, Size: kv.value, Hits: l.hits[kv.key]})
+	}
+	for _, i := range p.Eviction.Candidates(items) {
+		if !l.overLimits(p) {
+			break
+		}
+		l.pop(i.Digest)
+		out = append(out, i.Digest)
+	}
+	return out
+}
+
 type serializedLRUDict struct {
-	Version int     // 1.
-	Algo    string  // "sha-1".
-	Items   []entry // ordered key -> value mapping in order.
+	Version int                        // 1.
+	Algo    string                     // "sha-1", "sha-256" or "sha-512".
+	Items   []entry                    // ordered key -> value mapping in order.
+	Hits    map[isolated.HexDigest]int `json:",omitempty"` // key -> number of uses.
 }
 
 func (l *lruDict) MarshalJSON() ([]byte, error) {
 	s := &serializedLRUDict{
 		Version: 1,
-		Algo:    "sha-1",
+		Algo:    isolated.AlgoName(l.h),
 		Items:   l.items.serialized(),
+		Hits:    l.hits,
 	}
 	// Not strictly true but #closeneough.
 	content, err := json.Marshal(s)
@@ -205,16 +264,21 @@ func (l *lruDict) UnmarshalJSON(data []byte) error {
 	if s.Version != 1 {
 		return errors.New("invalid lru dict version")
 	}
-	if s.Algo != "sha-1" {
+	if s.Algo != isolated.AlgoName(l.h) {
 		return errors.New("invalid lru dict algo")
 	}
 	l.sum = 0
 	for _, e := range s.Items {
-		if !e.key.Validate() {
+		if !e.key.Validate(l.h) {
 			return fmt.Errorf("invalid entry: %s", e.key)
 		}
 		l.items.pushBack(e.key, e.value)
 		l.sum += e.value
+		// Items saved before hits were tracked count as used once.
+		l.hits[e.key] = 1
+		if h, ok := s.Hits[e.key]; ok {
+			l.hits[e.key] = h
+		}
 	}
 	l.dirty = false
 	return nil
@@ -0,0 +1,41 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cache
+
+import (
+	"os"
+
+	"github.com/luci/luci-go/common/isolated"
+)
+
+// Scrub rehashes all the items in c and evicts the ones that are corrupted.
+//
+// Items that are missing, for example because they were deleted by another
+// process, are evicted too. It returns the evicted digests.
+func Scrub(c Cache) (isolated.HexDigests, error) {
+	h := c.Hash()
+	var evicted isolated.HexDigests
+	for _, digest := range c.Keys() {
+		r, err := c.Read(digest)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return evicted, err
+			}
+			c.Evict(digest)
+			evicted = append(evicted, digest)
+			continue
+		}
+		actual, err := isolated.Hash(h, r)
+		r.Close()
+		if err != nil {
+			return evicted, err
+		}
+		if actual != digest {
+			c.Evict(digest)
+			evicted = append(evicted, digest)
+		}
+	}
+	return evicted, nil
+}
@@ -7,26 +7,69 @@
 package isolated
 
 import (
-	"crypto/sha1"
-	"hash"
+	"crypto"
+	// Register the hash functions that can be returned by GetHash.
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"fmt"
 	"io"
 	"log"
+	"strings"
 )
 
-// GetHash returns a fresh instance of the hashing algorithm to be used to
-// calculate the HexDigest.
+// GetHash returns the hashing algorithm to be used to calculate the HexDigest
+// of the items stored in namespace.
 //
-// It is currently hardcoded to sha-1.
-func GetHash() hash.Hash {
-	return sha1.New()
+// A namespace prefix of "sha256-" and "sha512-" respectively selects sha-256
+// and sha-512. Any other namespace, including "default-gzip", uses sha-1.
+func GetHash(namespace string) crypto.Hash {
+	switch {
+	case strings.HasPrefix(namespace, "sha256-"):
+		return crypto.SHA256
+	case strings.HasPrefix(namespace, "sha512-"):
+		return crypto.SHA512
+	default:
+		return crypto.SHA1
+	}
+}
+
+// AlgoName returns the name of the hashing algorithm as stored in the Algo
+// member of an Isolated.
+func AlgoName(h crypto.Hash) string {
+	switch h {
+	case crypto.SHA256:
+		return "sha-256"
+	case crypto.SHA512:
+		return "sha-512"
+	default:
+		return Algorithm
+	}
+}
+
+// AlgoHash returns the hashing algorithm corresponding to the Algo member of
+// an Isolated.
+func AlgoHash(algo string) (crypto.Hash, error) {
+	switch algo {
+	case "", Algorithm:
+		return crypto.SHA1, nil
+	case "sha-256":
+		return crypto.SHA256, nil
+	case "sha-512":
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("unknown algo %q", algo)
+	}
 }
 
-// GetDecompressor returns a fresh instance of the decompression algorithm.
+// GetDecompressor returns a fresh instance of the decompression algorithm to
+// use for the items stored in namespace.
 //
 // It must be closed after use.
 //
-// It is currently hardcoded to RFC 1950 (zlib).
-func GetDecompressor(in io.Reader) io.ReadCloser {
+// All namespaces currently use RFC 1950 (zlib); despite their name, "-flate"
+// namespaces store zlib streams, not raw RFC 1951 (deflate) data.
+func GetDecompressor(namespace string, in io.Reader) io.ReadCloser {
 	d, err := newZlibReader(in)
 	if err != nil {
 		// The data is corrupted.
@@ -35,12 +78,14 @@ func GetDecompressor(in io.Reader) io.ReadCloser {
 	return d
 }
 
-// GetCompressor returns a fresh instance of the compression algorithm.
+// GetCompressor returns a fresh instance of the compression algorithm to use
+// for the items stored in namespace.
 //
 // It must be closed after use.
 //
-// It is currently hardcoded to RFC 1950 (zlib).
-func GetCompressor(out io.Writer) io.WriteCloser {
+// All namespaces currently use RFC 1950 (zlib); despite their name, "-flate"
+// namespaces store zlib streams, not raw RFC 1951 (deflate) data.
+func GetCompressor(namespace string, out io.Writer) io.WriteCloser {
 	c, _ := newZlibWriterLevel(out, 7)
 	return c
 }
@@ -49,9 +94,9 @@ func GetCompressor(out io.Writer) io.WriteCloser {
 // are accepted.
 type HexDigest string
 
-// Validate returns true if the hash is valid.
-func (d HexDigest) Validate() bool {
-	if len(d) != sha1.Size*2 {
+// Validate returns true if the hash is valid for the hashing algorithm h.
+func (d HexDigest) Validate(h crypto.Hash) bool {
+	if len(d) != h.Size()*2 {
 		return false
 	}
 	for _, c := range d {
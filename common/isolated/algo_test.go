@@ -5,6 +5,10 @@
 package isolated
 
 import (
+	"bytes"
+	"compress/zlib"
+	"crypto"
+	"io/ioutil"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -18,8 +22,9 @@ func TestHexDigestValid(t *testing.T) {
 			"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
 		}
 		for _, in := range valid {
-			So(HexDigest(in).Validate(), ShouldBeTrue)
+			So(HexDigest(in).Validate(crypto.SHA1), ShouldBeTrue)
 		}
+		So(HexDigest("0123456789012345678901234567890123456789012345678901234567890123").Validate(crypto.SHA256), ShouldBeTrue)
 	})
 }
 
@@ -33,7 +38,58 @@ func TestHexDigestInvalid(t *testing.T) {
 			"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA",
 		}
 		for _, in := range invalid {
-			So(HexDigest(in).Validate(), ShouldBeFalse)
+			So(HexDigest(in).Validate(crypto.SHA1), ShouldBeFalse)
 		}
+		So(HexDigest("0123456789012345678901234567890123456789").Validate(crypto.SHA256), ShouldBeFalse)
+	})
+}
+
+func TestGetHash(t *testing.T) {
+	t.Parallel()
+	Convey(`Namespaces select the hashing algorithm.`, t, func() {
+		So(GetHash("default-gzip"), ShouldEqual, crypto.SHA1)
+		So(GetHash("sha256-flate"), ShouldEqual, crypto.SHA256)
+		So(GetHash("sha256-gzip"), ShouldEqual, crypto.SHA256)
+		So(GetHash("sha512-gzip"), ShouldEqual, crypto.SHA512)
+		So(HashBytes(GetHash("sha256-gzip"), nil), ShouldEqual, HexDigest("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"))
+
+		for _, h := range []crypto.Hash{crypto.SHA1, crypto.SHA256, crypto.SHA512} {
+			a, err := AlgoHash(AlgoName(h))
+			So(err, ShouldBeNil)
+			So(a, ShouldEqual, h)
+		}
+		_, err := AlgoHash("md5")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestCompressor(t *testing.T) {
+	t.Parallel()
+	Convey(`Namespaces select the compression algorithm.`, t, func() {
+		for _, ns := range []string{"default-gzip", "sha256-flate"} {
+			buf := bytes.Buffer{}
+			c := GetCompressor(ns, &buf)
+			_, err := c.Write([]byte("hello"))
+			So(err, ShouldBeNil)
+			So(c.Close(), ShouldBeNil)
+			d := GetDecompressor(ns, &buf)
+			out, err := ioutil.ReadAll(d)
+			So(err, ShouldBeNil)
+			So(d.Close(), ShouldBeNil)
+			So(string(out), ShouldEqual, "hello")
+		}
+	})
+
+	Convey(`"-flate" namespaces use zlib.`, t, func() {
+		buf := bytes.Buffer{}
+		c := GetCompressor("sha256-flate", &buf)
+		_, err := c.Write([]byte("hello"))
+		So(err, ShouldBeNil)
+		So(c.Close(), ShouldBeNil)
+		d, err := zlib.NewReader(&buf)
+		So(err, ShouldBeNil)
+		out, err := ioutil.ReadAll(d)
+		So(err, ShouldBeNil)
+		So(string(out), ShouldEqual, "hello")
 	})
 }
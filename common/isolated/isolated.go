@@ -4,6 +4,8 @@
 
 package isolated
 
+import "crypto"
+
 // IsolatedFormatVersion is version of *.isolated file format. Put into JSON.
 const IsolatedFormatVersion = "1.4"
 
@@ -25,7 +27,7 @@ const (
 	DirsReadOnly ReadOnlyValue = 2
 )
 
-// Algorithm is the value for Algo.
+// Algorithm is the default value for Algo.
 const Algorithm = "sha-1"
 
 // FileType describes the type of file being isolated.
@@ -40,14 +42,28 @@ const (
 
 	// TarArchive represents a tar archive containing a large number of small files.
 	TarArchive FileType = "tar"
+
+	// Chunked represents a large file split in content-defined chunks. Its
+	// content is the concatenation of the items listed in File.Chunks.
+	Chunked FileType = "chunked"
 )
 
+// Chunk is a piece of a Chunked file, stored as a separate item on the server.
+type Chunk struct {
+	Digest HexDigest `json:"h"`
+	Size   int64     `json:"s"`
+}
+
 // File describes a single file referenced by content in a .isolated file.
 //
 // For regular files, the Digest, Mode, and Size fields should be set, and the
 // Type field should be set for non-basic files.
 // For symbolic links, only the Link field should be set.
+// For chunked files, the Chunks field lists the items to concatenate and the
+// Digest field is the digest of the whole file, which is not itself stored on
+// the server.
 type File struct {
+	Chunks []Chunk   `json:"c,omitempty"`
 	Digest HexDigest `json:"h,omitempty"`
 	Link   *string   `json:"l,omitempty"`
 	Mode   *int      `json:"m,omitempty"`
@@ -81,9 +97,20 @@ func TarFile(d HexDigest, mode int, size int64) File {
 	}
 }
 
+// ChunkedFile returns a File populated for a file split in chunks.
+func ChunkedFile(d HexDigest, mode int, size int64, chunks []Chunk) File {
+	return File{
+		Chunks: chunks,
+		Digest: d,
+		Mode:   &mode,
+		Size:   &size,
+		Type:   Chunked,
+	}
+}
+
 // Isolated is the data from a JSON serialized .isolated file.
 type Isolated struct {
-	Algo        string          `json:"algo"` // Must be "sha-1"
+	Algo        string          `json:"algo"` // One of "sha-1", "sha-256" or "sha-512"
 	Command     []string        `json:"command,omitempty"`
 	Files       map[string]File `json:"files,omitempty"`
 	Includes    HexDigests      `json:"includes,omitempty"`
@@ -92,10 +119,11 @@ type Isolated struct {
 	Version     string          `json:"version"`
 }
 
-// New returns a new Isolated with the default Algo and Version.
-func New() *Isolated {
+// New returns a new Isolated with the Algo matching h and the default
+// Version.
+func New(h crypto.Hash) *Isolated {
 	return &Isolated{
-		Algo:    Algorithm,
+		Algo:    AlgoName(h),
 		Version: IsolatedFormatVersion,
 		Files:   map[string]File{},
 	}
@@ -6,8 +6,11 @@ package isolatedclient
 
 import (
 	"bytes"
+	"crypto"
 	"encoding/base64"
 	"errors"
+	"fmt"
+	"hash"
 	"io"
 	"io/ioutil"
 	"log"
@@ -107,6 +110,16 @@ func New(anonClient, authClient *http.Client, host, namespace string, rFn retry.
 	return i
 }
 
+// Namespace returns the namespace this client talks to.
+func (i *Client) Namespace() string {
+	return i.namespace
+}
+
+// Hash returns the hashing algorithm used for the items in the namespace.
+func (i *Client) Hash() crypto.Hash {
+	return isolated.GetHash(i.namespace)
+}
+
 // ServerCapabilities returns the server details.
 func (i *Client) ServerCapabilities(c context.Context) (*isolateservice.HandlersEndpointsV1ServerDetails, error) {
 	out := &isolateservice.HandlersEndpointsV1ServerDetails{}
@@ -174,13 +187,52 @@ func (i *Client) Push(c context.Context, state *PushState, source Source) (err e
 }
 
 // Fetch downloads an item from the server.
+//
+// The content is verified against item.Digest with the hashing algorithm of
+// the namespace.
 func (i *Client) Fetch(c context.Context, item *isolateservice.HandlersEndpointsV1Digest, dest io.WriteSeeker) error {
+	v := &verifier{dest: dest, h: i.Hash().New()}
+	if err := i.fetch(c, item, v); err != nil {
+		return err
+	}
+	if d := isolated.Sum(v.h); d != isolated.HexDigest(item.Digest) {
+		return fmt.Errorf("fetched content for %s hashes to %s", item.Digest, d)
+	}
+	return nil
+}
+
+// FetchFile downloads the content of a file referenced in a .isolated file.
+//
+// Chunked files are reassembled in dest by fetching each of their chunks.
+func (i *Client) FetchFile(c context.Context, f *isolated.File, dest io.WriteSeeker) error {
+	if f.Type != isolated.Chunked {
+		item := &isolateservice.HandlersEndpointsV1Digest{Digest: string(f.Digest)}
+		if f.Size != nil {
+			item.Size = *f.Size
+		}
+		return i.Fetch(c, item, dest)
+	}
+	offset := int64(0)
+	for _, chunk := range f.Chunks {
+		if _, err := dest.Seek(offset, os.SEEK_SET); err != nil {
+			return err
+		}
+		item := &isolateservice.HandlersEndpointsV1Digest{Digest: string(chunk.Digest), Size: chunk.Size}
+		if err := i.Fetch(c, item, &offsetWriteSeeker{dest, offset}); err != nil {
+			return fmt.Errorf("fetching chunk %s: %s", chunk.Digest, err)
+		}
+		offset += chunk.Size
+	}
+	return nil
+}
+
+func (i *Client) fetch(c context.Context, item *isolateservice.HandlersEndpointsV1Digest, dest io.WriteSeeker) error {
 	// Perform initial request.
 	url := i.url + "/api/isolateservice/v1/retrieve"
 	in := &isolateservice.HandlersEndpointsV1RetrieveRequest{
 		Digest: item.Digest,
 		Namespace: &isolateservice.HandlersEndpointsV1Namespace{
-			DigestHash: "sha-1",
+			DigestHash: isolated.AlgoName(i.Hash()),
 			Namespace:  i.namespace,
 		},
 		Offset: 0,
@@ -196,7 +248,7 @@ func (i *Client) Fetch(c context.Context, item *isolateservice.HandlersEndpoints
 		if err != nil {
 			return err
 		}
-		decompressor := isolated.GetDecompressor(bytes.NewReader(decoded))
+		decompressor := isolated.GetDecompressor(i.namespace, bytes.NewReader(decoded))
 		defer decompressor.Close()
 		_, err = io.Copy(dest, decompressor)
 		return err
@@ -239,7 +291,7 @@ func (i *Client) doPush(c context.Context, state *PushState, source Source) (err
 
 func (i *Client) doPushDB(c context.Context, state *PushState, reader io.Reader) error {
 	buf := bytes.Buffer{}
-	compressor := isolated.GetCompressor(&buf)
+	compressor := isolated.GetCompressor(i.namespace, &buf)
 	if _, err := io.Copy(compressor, reader); err != nil {
 		return err
 	}
@@ -253,6 +305,47 @@ func (i *Client) doPushDB(c context.Context, state *PushState, reader io.Reader)
 	return i.postJSON(c, "/api/isolateservice/v1/store_inline", nil, in, nil)
 }
 
+// verifier is an io.WriteSeeker that hashes the data written to dest.
+//
+// Seeking back to the start resets the hash, so a retried download is hashed
+// from scratch.
+type verifier struct {
+	dest io.WriteSeeker
+	h    hash.Hash
+}
+
+func (v *verifier) Write(p []byte) (int, error) {
+	n, err := v.dest.Write(p)
+	_, _ = v.h.Write(p[:n])
+	return n, err
+}
+
+func (v *verifier) Seek(offset int64, whence int) (int64, error) {
+	n, err := v.dest.Seek(offset, whence)
+	if err == nil && n == 0 {
+		v.h.Reset()
+	}
+	return n, err
+}
+
+// offsetWriteSeeker is an io.WriteSeeker whose origin is at offset in dest.
+type offsetWriteSeeker struct {
+	dest   io.WriteSeeker
+	offset int64
+}
+
+func (o *offsetWriteSeeker) Write(p []byte) (int, error) {
+	return o.dest.Write(p)
+}
+
+func (o *offsetWriteSeeker) Seek(offset int64, whence int) (int64, error) {
+	if whence == os.SEEK_SET {
+		offset += o.offset
+	}
+	n, err := o.dest.Seek(offset, whence)
+	return n - o.offset, err
+}
+
 // defaultGCSHandler implements the default Fetch and Push handlers for
 // interacting with GCS.
 type defaultGCSHandler struct{}
@@ -265,7 +358,7 @@ func (gcs defaultGCSHandler) Fetch(c context.Context, i *Client, content isolate
 	}
 	handler := func(resp *http.Response) error {
 		defer resp.Body.Close()
-		decompressor := isolated.GetDecompressor(resp.Body)
+		decompressor := isolated.GetDecompressor(i.namespace, resp.Body)
 		defer decompressor.Close()
 		if _, err := dest.Seek(0, os.SEEK_SET); err != nil {
 			return err
@@ -295,7 +388,7 @@ func (gcs defaultGCSHandler) Push(c context.Context, i *Client, status isolatese
 			src.Close()
 			return nil, err
 		}
-		request.Body = newCompressed(src)
+		request.Body = newCompressed(i.namespace, src)
 		request.Header.Set("Content-Type", "application/octet-stream")
 		return request, nil
 	}, func(resp *http.Response) error {
@@ -329,11 +422,11 @@ func (c *compressed) Close() error {
 	return err
 }
 
-func newCompressed(src io.ReadCloser) io.ReadCloser {
+func newCompressed(namespace string, src io.ReadCloser) io.ReadCloser {
 	pr, pw := io.Pipe()
 	go func() {
 		// The compressor itself is not thread safe.
-		compressor := isolated.GetCompressor(pw)
+		compressor := isolated.GetCompressor(namespace, pw)
 
 		buf := make([]byte, 4096)
 		if _, err := io.CopyBuffer(compressor, src, buf); err != nil {
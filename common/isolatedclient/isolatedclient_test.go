@@ -46,14 +46,23 @@ func TestIsolateServerCaps(t *testing.T) {
 func TestIsolateServerSmall(t *testing.T) {
 	t.Parallel()
 	Convey(``, t, func() {
-		testNormal(context.Background(), t, foo, bar)
+		testNormal(context.Background(), t, DefaultNamespace, foo, bar)
 	})
 }
 
+func TestIsolateServerSHA256(t *testing.T) {
+	t.Parallel()
+	for _, namespace := range []string{"sha256-flate", "sha256-gzip"} {
+		Convey(namespace, t, func() {
+			testNormal(context.Background(), t, namespace, foo, large)
+		})
+	}
+}
+
 func TestIsolateServerLarge(t *testing.T) {
 	t.Parallel()
 	Convey(``, t, func() {
-		testNormal(context.Background(), t, large)
+		testNormal(context.Background(), t, DefaultNamespace, large)
 	})
 }
 
@@ -69,7 +78,7 @@ func TestIsolateServerRetryGCSPartial(t *testing.T) {
 		defer flaky.ts.Close()
 		client := New(nil, nil, flaky.ts.URL, DefaultNamespace, fastRetry, nil)
 
-		digests, contents, expected := makeItems(large)
+		digests, contents, expected := makeItems(DefaultNamespace, large)
 		states, err := client.Contains(ctx, digests)
 		So(err, ShouldBeNil)
 		So(len(states), ShouldResemble, len(digests))
@@ -135,24 +144,24 @@ func init() {
 	}
 }
 
-func makeItems(contents ...[]byte) ([]*isolateservice.HandlersEndpointsV1Digest, [][]byte, map[isolated.HexDigest][]byte) {
+func makeItems(namespace string, contents ...[]byte) ([]*isolateservice.HandlersEndpointsV1Digest, [][]byte, map[isolated.HexDigest][]byte) {
 	digests := make([]*isolateservice.HandlersEndpointsV1Digest, 0, len(contents))
 	expected := make(map[isolated.HexDigest][]byte, len(contents))
 	for _, content := range contents {
-		hex := isolated.HashBytes(content)
+		hex := isolated.HashBytes(isolated.GetHash(namespace), content)
 		digests = append(digests, &isolateservice.HandlersEndpointsV1Digest{Digest: string(hex), IsIsolated: false, Size: int64(len(content))})
 		expected[hex] = content
 	}
 	return digests, contents, expected
 }
 
-func testNormal(ctx context.Context, t *testing.T, contents ...[]byte) {
+func testNormal(ctx context.Context, t *testing.T, namespace string, contents ...[]byte) {
 	Convey(``, func() {
-		digests, _, expected := makeItems(contents...)
+		digests, _, expected := makeItems(namespace, contents...)
 		server := isolatedfake.New()
 		ts := httptest.NewServer(server)
 		defer ts.Close()
-		client := New(nil, nil, ts.URL, DefaultNamespace, noRetry, nil)
+		client := New(nil, nil, ts.URL, namespace, noRetry, nil)
 		states, err := client.Contains(ctx, digests)
 		So(err, ShouldBeNil)
 		So(len(states), ShouldResemble, len(digests))
@@ -210,7 +219,7 @@ func TestRetry(t *testing.T) {
 		defer flaky.ts.Close()
 		client := New(nil, nil, flaky.ts.URL, DefaultNamespace, tc.retryFactory, nil)
 
-		digests, contents, expected := makeItems(tc.items...)
+		digests, contents, expected := makeItems(DefaultNamespace, tc.items...)
 		states, err := client.Contains(ctx, digests)
 		if err != nil {
 			return failedContains
@@ -433,7 +442,7 @@ func TestCompressor(t *testing.T) {
 				Reader:   tc.Src,
 				CloseErr: tc.CloseErr,
 			}
-			comp := newCompressed(src)
+			comp := newCompressed(DefaultNamespace, src)
 
 			var readErr error
 			switch {
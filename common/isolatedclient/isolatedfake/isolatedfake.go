@@ -52,8 +52,9 @@ type IsolatedFake interface {
 	http.Handler
 	// Contents returns all the uncompressed data on the fake isolated server.
 	Contents() map[isolated.HexDigest][]byte
-	// Inject adds uncompressed data in the fake isolated server.
-	Inject(data []byte)
+	// Inject adds uncompressed data in the fake isolated server, hashed as
+	// specified by namespace.
+	Inject(namespace string, data []byte)
 	Error() error
 }
 
@@ -77,6 +78,7 @@ func New() IsolatedFake {
 	server.handleJSON("/api/isolateservice/v1/preupload", server.preupload)
 	server.handleJSON("/api/isolateservice/v1/finalize_gs_upload", server.finalizeGSUpload)
 	server.handleJSON("/api/isolateservice/v1/store_inline", server.storeInline)
+	server.mux.HandleFunc("/api/isolateservice/v1/retrieve", server.retrieve)
 	server.mux.HandleFunc("/fake/cloudstorage", server.fakeCloudStorage)
 
 	// Fail on anything else.
@@ -102,8 +104,8 @@ func (server *isolatedFake) Contents() map[isolated.HexDigest][]byte {
 	return out
 }
 
-func (server *isolatedFake) Inject(data []byte) {
-	h := isolated.HashBytes(data)
+func (server *isolatedFake) Inject(namespace string, data []byte) {
+	h := isolated.HashBytes(isolated.GetHash(namespace), data)
 	server.lock.Lock()
 	defer server.lock.Unlock()
 	server.contents[h] = data
@@ -147,17 +149,24 @@ func (server *isolatedFake) preupload(r *http.Request) interface{} {
 	if err := json.NewDecoder(r.Body).Decode(data); err != nil {
 		server.Fail(err)
 	}
-	if data.Namespace == nil || data.Namespace.Namespace != "default-gzip" {
-		server.Fail(fmt.Errorf("unexpected namespace %#v", data.Namespace.Namespace))
+	if data.Namespace == nil || data.Namespace.Namespace == "" {
+		server.Fail(fmt.Errorf("unexpected namespace %#v", data.Namespace))
+		return &isolateservice.HandlersEndpointsV1UrlCollection{}
 	}
+	namespace := data.Namespace.Namespace
+	h := isolated.GetHash(namespace)
 	out := &isolateservice.HandlersEndpointsV1UrlCollection{}
 
 	server.lock.Lock()
 	defer server.lock.Unlock()
 	for i, d := range data.Items {
+		if !isolated.HexDigest(d.Digest).Validate(h) {
+			server.failLocked(fmt.Errorf("invalid digest %#v for namespace %#v", d.Digest, namespace))
+			continue
+		}
 		if _, ok := server.contents[isolated.HexDigest(d.Digest)]; !ok {
 			// Simulate a write to Cloud Storage for larger writes.
-			ticket := "ticket:" + string(d.Digest)
+			ticket := "ticket:" + namespace + ":" + string(d.Digest)
 			s := &isolateservice.HandlersEndpointsV1PreuploadStatus{
 				Index:        int64(i),
 				UploadTicket: ticket,
@@ -165,6 +174,7 @@ func (server *isolatedFake) preupload(r *http.Request) interface{} {
 			if d.Size > 1024 {
 				v := url.Values{}
 				v.Add("digest", string(d.Digest))
+				v.Add("namespace", namespace)
 				u := &url.URL{Scheme: "http", Host: r.Host, Path: "/fake/cloudstorage", RawQuery: v.Encode()}
 				s.GsUploadUrl = u.String()
 				//log.Printf("%s", s.GsUploadUrl)
@@ -187,14 +197,15 @@ func (server *isolatedFake) fakeCloudStorage(w http.ResponseWriter, r *http.Requ
 		server.Fail(fmt.Errorf("invalid method: %s", r.Method))
 		return
 	}
-	raw, err := ioutil.ReadAll(isolated.GetDecompressor(r.Body))
+	namespace := r.URL.Query().Get("namespace")
+	raw, err := ioutil.ReadAll(isolated.GetDecompressor(namespace, r.Body))
 	if err != nil {
 		w.WriteHeader(500)
 		server.Fail(err)
 		return
 	}
 	digest := isolated.HexDigest(r.URL.Query().Get("digest"))
-	if digest != isolated.HashBytes(raw) {
+	if digest != isolated.HashBytes(isolated.GetHash(namespace), raw) {
 		w.WriteHeader(400)
 		server.Fail(fmt.Errorf("invalid digest %#v", digest))
 		return
@@ -212,14 +223,12 @@ func (server *isolatedFake) finalizeGSUpload(r *http.Request) interface{} {
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
 	}
-	prefix := "ticket:"
-	if !strings.HasPrefix(data.UploadTicket, prefix) {
-		err := fmt.Errorf("unexpected ticket %#v", data.UploadTicket)
+	namespace, digest, err := parseTicket(data.UploadTicket)
+	if err != nil {
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
 	}
-	digest := isolated.HexDigest(data.UploadTicket[len(prefix):])
-	if !digest.Validate() {
+	if !digest.Validate(isolated.GetHash(namespace)) {
 		err := fmt.Errorf("invalid digest %#v", digest)
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
@@ -244,15 +253,13 @@ func (server *isolatedFake) storeInline(r *http.Request) interface{} {
 		return map[string]string{"err": err.Error()}
 	}
 
-	prefix := "ticket:"
-	if !strings.HasPrefix(data.UploadTicket, prefix) {
-		err := fmt.Errorf("unexpected ticket %#v", data.UploadTicket)
+	namespace, digest, err := parseTicket(data.UploadTicket)
+	if err != nil {
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
 	}
-
-	digest := isolated.HexDigest(data.UploadTicket[len(prefix):])
-	if !digest.Validate() {
+	h := isolated.GetHash(namespace)
+	if !digest.Validate(h) {
 		err := fmt.Errorf("invalid digest %#v", digest)
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
@@ -262,12 +269,12 @@ func (server *isolatedFake) storeInline(r *http.Request) interface{} {
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
 	}
-	raw, err := ioutil.ReadAll(isolated.GetDecompressor(bytes.NewReader(blob)))
+	raw, err := ioutil.ReadAll(isolated.GetDecompressor(namespace, bytes.NewReader(blob)))
 	if err != nil {
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
 	}
-	if digest != isolated.HashBytes(raw) {
+	if digest != isolated.HashBytes(h, raw) {
 		err := fmt.Errorf("invalid digest %#v", digest)
 		server.Fail(err)
 		return map[string]string{"err": err.Error()}
@@ -279,3 +286,56 @@ func (server *isolatedFake) storeInline(r *http.Request) interface{} {
 	//log.Printf("  storing %s = %d bytes", digest, len(raw))
 	return map[string]string{"ok": "true"}
 }
+
+func (server *isolatedFake) retrieve(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	data := &isolateservice.HandlersEndpointsV1RetrieveRequest{}
+	if err := json.NewDecoder(r.Body).Decode(data); err != nil {
+		w.WriteHeader(400)
+		server.Fail(err)
+		return
+	}
+	if data.Namespace == nil || data.Namespace.Namespace == "" {
+		w.WriteHeader(400)
+		server.Fail(fmt.Errorf("unexpected namespace %#v", data.Namespace))
+		return
+	}
+
+	server.lock.Lock()
+	raw, ok := server.contents[isolated.HexDigest(data.Digest)]
+	server.lock.Unlock()
+	if !ok {
+		// Not a failure, the client may probe for missing content.
+		w.WriteHeader(404)
+		return
+	}
+	buf := bytes.Buffer{}
+	compressor := isolated.GetCompressor(data.Namespace.Namespace, &buf)
+	if _, err := compressor.Write(raw); err != nil {
+		w.WriteHeader(500)
+		server.Fail(err)
+		return
+	}
+	if err := compressor.Close(); err != nil {
+		w.WriteHeader(500)
+		server.Fail(err)
+		return
+	}
+	out := &isolateservice.HandlersEndpointsV1RetrievedContent{
+		Content: base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}
+	w.Header().Set("Content-Type", contentType)
+	if err := json.NewEncoder(w).Encode(out); err != nil {
+		server.Fail(err)
+	}
+}
+
+// parseTicket extracts the namespace and the digest from an upload ticket
+// generated by preupload.
+func parseTicket(ticket string) (string, isolated.HexDigest, error) {
+	parts := strings.SplitN(ticket, ":", 3)
+	if len(parts) != 3 || parts[0] != "ticket" {
+		return "", "", fmt.Errorf("unexpected ticket %#v", ticket)
+	}
+	return parts[1], isolated.HexDigest(parts[2]), nil
+}
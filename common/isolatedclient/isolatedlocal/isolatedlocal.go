@@ -0,0 +1,480 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package isolatedlocal implements an isolate server storing its content in a
+// local directory.
+//
+// It serves the same endpoints as the real server, so the isolate clients can
+// be used without network access. Items are stored uncompressed under
+// <root>/<namespace>/<digest[:2]>/<digest>.
+package isolatedlocal
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/luci/luci-go/common/api/isolate/isolateservice/v1"
+	"github.com/luci/luci-go/common/isolated"
+)
+
+const (
+	contentType = "application/json; charset=utf-8"
+
+	// inlineMaxSize is the largest item stored and retrieved inline in the
+	// JSON requests. Larger items go through the upload and download
+	// endpoints, like the real server does with Cloud Storage.
+	inlineMaxSize = 64 * 1024
+
+	stagingDir = ".staging"
+)
+
+var namespaceRe = regexp.MustCompile(`^[a-z0-9A-Z\-._]+$`)
+
+// Server is an isolate server storing items in a directory.
+type Server struct {
+	root string
+	mux  *http.ServeMux
+}
+
+// GCStats is the result of a garbage collection.
+type GCStats struct {
+	Removed      int
+	RemovedBytes int64
+	Kept         int
+	KeptBytes    int64
+}
+
+// New returns a Server storing items in the directory root.
+func New(root string) (*Server, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+	s := &Server{root: root, mux: http.NewServeMux()}
+	s.handleJSON("/api/isolateservice/v1/server_details", s.serverDetails)
+	s.handleJSON("/api/isolateservice/v1/preupload", s.preupload)
+	s.handleJSON("/api/isolateservice/v1/finalize_gs_upload", s.finalizeGSUpload)
+	s.handleJSON("/api/isolateservice/v1/store_inline", s.storeInline)
+	s.handleJSON("/api/isolateservice/v1/retrieve", s.retrieve)
+	s.mux.HandleFunc("/local/upload", s.upload)
+	s.mux.HandleFunc("/local/download", s.download)
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, fmt.Sprintf("unknown endpoint %s", r.URL), http.StatusNotFound)
+	})
+	return s, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// GC removes the items that were not accessed for maxAge, then the least
+// recently accessed items until the total size is at most maxSize bytes.
+//
+// Zero values disable the respective limit. Uploads staged for more than an
+// hour are removed too.
+func (s *Server) GC(maxAge time.Duration, maxSize int64) (*GCStats, error) {
+	var items gcItems
+	now := time.Now()
+	err := filepath.Walk(s.root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		if filepath.Base(filepath.Dir(p)) == stagingDir {
+			if now.Sub(info.ModTime()) > time.Hour {
+				return os.Remove(p)
+			}
+			return nil
+		}
+		items = append(items, gcItem{p, info.Size(), info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(items)
+	out := &GCStats{}
+	for _, i := range items {
+		expired := maxAge > 0 && now.Sub(i.mtime) > maxAge
+		if expired || (maxSize > 0 && out.KeptBytes+i.size > maxSize) {
+			if err := os.Remove(i.path); err != nil {
+				return out, err
+			}
+			out.Removed++
+			out.RemovedBytes += i.size
+			continue
+		}
+		out.Kept++
+		out.KeptBytes += i.size
+	}
+	return out, nil
+}
+
+// Private details.
+
+type gcItem struct {
+	path  string
+	size  int64
+	mtime time.Time
+}
+
+// gcItems sorts the most recently accessed items first.
+type gcItems []gcItem
+
+func (g gcItems) Len() int           { return len(g) }
+func (g gcItems) Less(i, j int) bool { return g[i].mtime.After(g[j].mtime) }
+func (g gcItems) Swap(i, j int)      { g[i], g[j] = g[j], g[i] }
+
+type jsonAPI func(r *http.Request) (interface{}, error)
+
+// httpError is an error with an HTTP status code.
+type httpError struct {
+	code int
+	msg  string
+}
+
+func (e *httpError) Error() string {
+	return e.msg
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &httpError{http.StatusBadRequest, fmt.Sprintf(format, args...)}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	if e, ok := err.(*httpError); ok {
+		code = e.code
+	}
+	http.Error(w, err.Error(), code)
+}
+
+func (s *Server) handleJSON(path string, handler jsonAPI) {
+	s.mux.Handle(path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		if r.Method != "POST" {
+			writeError(w, &httpError{http.StatusMethodNotAllowed, "invalid method " + r.Method})
+			return
+		}
+		out, err := handler(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", contentType)
+		_ = json.NewEncoder(w).Encode(out)
+	}))
+}
+
+func (s *Server) itemPath(namespace string, digest isolated.HexDigest) (string, error) {
+	return s.path(namespace, string(digest[:2]), string(digest))
+}
+
+func (s *Server) stagingPath(namespace string, digest isolated.HexDigest) (string, error) {
+	return s.path(namespace, stagingDir, string(digest))
+}
+
+// path joins elems under the root directory. It fails if the result is not
+// inside the root directory.
+func (s *Server) path(elems ...string) (string, error) {
+	p := filepath.Join(append([]string{s.root}, elems...)...)
+	rel, err := filepath.Rel(s.root, p)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", badRequest("invalid path %q", filepath.Join(elems...))
+	}
+	return p, nil
+}
+
+// validate checks a namespace and a digest received from a client.
+func validate(namespace string, digest isolated.HexDigest) error {
+	// The namespace is used as a directory name.
+	if !namespaceRe.MatchString(namespace) || namespace == "." || namespace == ".." {
+		return badRequest("invalid namespace %q", namespace)
+	}
+	if !digest.Validate(isolated.GetHash(namespace)) {
+		return badRequest("invalid digest %q for namespace %q", digest, namespace)
+	}
+	return nil
+}
+
+// exists returns true if the item is present, and marks it as accessed.
+func (s *Server) exists(namespace string, digest isolated.HexDigest) bool {
+	p, err := s.itemPath(namespace, digest)
+	if err != nil {
+		return false
+	}
+	now := time.Now()
+	return os.Chtimes(p, now, now) == nil
+}
+
+// store verifies raw and atomically writes it at dest.
+func store(namespace string, digest isolated.HexDigest, raw []byte, dest string) error {
+	if isolated.HashBytes(isolated.GetHash(namespace), raw) != digest {
+		return badRequest("content doesn't match digest %s", digest)
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(dest), ".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(raw)
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), dest)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+	return err
+}
+
+func decompress(namespace string, r io.Reader) ([]byte, error) {
+	d := isolated.GetDecompressor(namespace, r)
+	if d == nil {
+		return nil, badRequest("invalid compressed content")
+	}
+	defer d.Close()
+	raw, err := ioutil.ReadAll(d)
+	if err != nil {
+		return nil, badRequest("invalid compressed content: %s", err)
+	}
+	return raw, nil
+}
+
+func compress(namespace string, raw []byte) ([]byte, error) {
+	buf := bytes.Buffer{}
+	c := isolated.GetCompressor(namespace, &buf)
+	if _, err := c.Write(raw); err != nil {
+		return nil, err
+	}
+	if err := c.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Tickets are "<namespace>:<digest>".
+func makeTicket(namespace string, digest isolated.HexDigest) string {
+	return namespace + ":" + string(digest)
+}
+
+func parseTicket(ticket string) (string, isolated.HexDigest, error) {
+	i := strings.LastIndex(ticket, ":")
+	if i == -1 {
+		return "", "", badRequest("invalid ticket %q", ticket)
+	}
+	namespace, digest := ticket[:i], isolated.HexDigest(ticket[i+1:])
+	return namespace, digest, validate(namespace, digest)
+}
+
+func (s *Server) serverDetails(r *http.Request) (interface{}, error) {
+	return &isolateservice.HandlersEndpointsV1ServerDetails{ServerVersion: "local"}, nil
+}
+
+func (s *Server) preupload(r *http.Request) (interface{}, error) {
+	data := &isolateservice.HandlersEndpointsV1DigestCollection{}
+	if err := json.NewDecoder(r.Body).Decode(data); err != nil {
+		return nil, badRequest("%s", err)
+	}
+	if data.Namespace == nil {
+		return nil, badRequest("namespace is required")
+	}
+	namespace := data.Namespace.Namespace
+	out := &isolateservice.HandlersEndpointsV1UrlCollection{}
+	for i, d := range data.Items {
+		digest := isolated.HexDigest(d.Digest)
+		if err := validate(namespace, digest); err != nil {
+			return nil, err
+		}
+		if s.exists(namespace, digest) {
+			continue
+		}
+		status := &isolateservice.HandlersEndpointsV1PreuploadStatus{
+			Index:        int64(i),
+			UploadTicket: makeTicket(namespace, digest),
+		}
+		if d.Size > inlineMaxSize {
+			v := url.Values{}
+			v.Add("namespace", namespace)
+			v.Add("digest", string(digest))
+			u := &url.URL{Scheme: "http", Host: r.Host, Path: "/local/upload", RawQuery: v.Encode()}
+			status.GsUploadUrl = u.String()
+		}
+		out.Items = append(out.Items, status)
+	}
+	return out, nil
+}
+
+func (s *Server) upload(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	if r.Method != "PUT" {
+		writeError(w, &httpError{http.StatusMethodNotAllowed, "invalid method " + r.Method})
+		return
+	}
+	namespace := r.URL.Query().Get("namespace")
+	digest := isolated.HexDigest(r.URL.Query().Get("digest"))
+	err := validate(namespace, digest)
+	if err == nil {
+		var dest string
+		if dest, err = s.stagingPath(namespace, digest); err == nil {
+			var raw []byte
+			if raw, err = decompress(namespace, r.Body); err == nil {
+				err = store(namespace, digest, raw, dest)
+			}
+		}
+	}
+	if err != nil {
+		writeError(w, err)
+	}
+}
+
+func (s *Server) finalizeGSUpload(r *http.Request) (interface{}, error) {
+	data := &isolateservice.HandlersEndpointsV1FinalizeRequest{}
+	if err := json.NewDecoder(r.Body).Decode(data); err != nil {
+		return nil, badRequest("%s", err)
+	}
+	namespace, digest, err := parseTicket(data.UploadTicket)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := s.itemPath(namespace, digest)
+	if err != nil {
+		return nil, err
+	}
+	staged, err := s.stagingPath(namespace, digest)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(staged, dest); err != nil {
+		if os.IsNotExist(err) {
+			return nil, badRequest("finalizing non uploaded item %s", digest)
+		}
+		return nil, err
+	}
+	return map[string]string{"ok": "true"}, nil
+}
+
+func (s *Server) storeInline(r *http.Request) (interface{}, error) {
+	data := &isolateservice.HandlersEndpointsV1StorageRequest{}
+	if err := json.NewDecoder(r.Body).Decode(data); err != nil {
+		return nil, badRequest("%s", err)
+	}
+	namespace, digest, err := parseTicket(data.UploadTicket)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := s.itemPath(namespace, digest)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := base64.StdEncoding.DecodeString(data.Content)
+	if err != nil {
+		return nil, badRequest("%s", err)
+	}
+	raw, err := decompress(namespace, bytes.NewReader(blob))
+	if err != nil {
+		return nil, err
+	}
+	if err := store(namespace, digest, raw, dest); err != nil {
+		return nil, err
+	}
+	return map[string]string{"ok": "true"}, nil
+}
+
+func (s *Server) retrieve(r *http.Request) (interface{}, error) {
+	data := &isolateservice.HandlersEndpointsV1RetrieveRequest{}
+	if err := json.NewDecoder(r.Body).Decode(data); err != nil {
+		return nil, badRequest("%s", err)
+	}
+	if data.Namespace == nil {
+		return nil, badRequest("namespace is required")
+	}
+	namespace, digest := data.Namespace.Namespace, isolated.HexDigest(data.Digest)
+	if err := validate(namespace, digest); err != nil {
+		return nil, err
+	}
+	p, err := s.itemPath(namespace, digest)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, &httpError{http.StatusNotFound, fmt.Sprintf("%s not found", digest)}
+		}
+		return nil, err
+	}
+	s.exists(namespace, digest)
+	if info.Size() > inlineMaxSize {
+		v := url.Values{}
+		v.Add("namespace", namespace)
+		v.Add("digest", string(digest))
+		u := &url.URL{Scheme: "http", Host: r.Host, Path: "/local/download", RawQuery: v.Encode()}
+		return &isolateservice.HandlersEndpointsV1RetrievedContent{Url: u.String()}, nil
+	}
+	raw, err := ioutil.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := compress(namespace, raw)
+	if err != nil {
+		return nil, err
+	}
+	return &isolateservice.HandlersEndpointsV1RetrievedContent{Content: base64.StdEncoding.EncodeToString(blob)}, nil
+}
+
+func (s *Server) download(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	if r.Method != "GET" {
+		writeError(w, &httpError{http.StatusMethodNotAllowed, "invalid method " + r.Method})
+		return
+	}
+	namespace := r.URL.Query().Get("namespace")
+	digest := isolated.HexDigest(r.URL.Query().Get("digest"))
+	if err := validate(namespace, digest); err != nil {
+		writeError(w, err)
+		return
+	}
+	p, err := s.itemPath(namespace, digest)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = &httpError{http.StatusNotFound, fmt.Sprintf("%s not found", digest)}
+		}
+		writeError(w, err)
+		return
+	}
+	defer f.Close()
+	w.Header().Set("Content-Type", "application/octet-stream")
+	c := isolated.GetCompressor(namespace, w)
+	if _, err := io.Copy(c, f); err == nil {
+		_ = c.Close()
+	}
+}
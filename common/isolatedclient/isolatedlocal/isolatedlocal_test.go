@@ -0,0 +1,150 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package isolatedlocal
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/common/api/isolate/isolateservice/v1"
+	"github.com/luci/luci-go/common/isolated"
+	"github.com/luci/luci-go/common/isolatedclient"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type memWriteSeeker struct {
+	data []byte
+	pos  int
+}
+
+func (m *memWriteSeeker) Write(p []byte) (int, error) {
+	if end := m.pos + len(p); end > len(m.data) {
+		m.data = append(m.data, make([]byte, end-len(m.data))...)
+	}
+	copy(m.data[m.pos:], p)
+	m.pos += len(p)
+	return len(p), nil
+}
+
+func (m *memWriteSeeker) Seek(offset int64, whence int) (int64, error) {
+	m.pos = int(offset)
+	return offset, nil
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	small := []byte("small")
+	large := make([]byte, 3*inlineMaxSize)
+	src := rand.New(rand.NewSource(0))
+	for i := range large {
+		large[i] = byte(src.Uint32())
+	}
+
+	Convey(`A local server`, t, func() {
+		root, err := ioutil.TempDir("", "isolatedlocal")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(root)
+		server, err := New(root)
+		So(err, ShouldBeNil)
+		ts := httptest.NewServer(server)
+		defer ts.Close()
+
+		for _, namespace := range []string{isolatedclient.DefaultNamespace, "sha256-flate"} {
+			namespace := namespace
+			Convey(`round trips items in `+namespace, func() {
+				client := isolatedclient.New(nil, nil, ts.URL, namespace, nil, nil)
+				h := isolated.GetHash(namespace)
+				var digests []*isolateservice.HandlersEndpointsV1Digest
+				contents := [][]byte{small, large}
+				for _, c := range contents {
+					digests = append(digests, &isolateservice.HandlersEndpointsV1Digest{
+						Digest: string(isolated.HashBytes(h, c)),
+						Size:   int64(len(c)),
+					})
+				}
+
+				states, err := client.Contains(ctx, digests)
+				So(err, ShouldBeNil)
+				So(len(states), ShouldEqual, 2)
+				for i, state := range states {
+					So(state, ShouldNotBeNil)
+					So(client.Push(ctx, state, isolatedclient.NewBytesSource(contents[i])), ShouldBeNil)
+				}
+
+				states, err = client.Contains(ctx, digests)
+				So(err, ShouldBeNil)
+				for _, state := range states {
+					So(state, ShouldBeNil)
+				}
+
+				for i, d := range digests {
+					dest := &memWriteSeeker{}
+					So(client.Fetch(ctx, d, dest), ShouldBeNil)
+					So(dest.data, ShouldResemble, contents[i])
+				}
+			})
+		}
+
+		Convey(`returns an error for a missing item`, func() {
+			client := isolatedclient.New(nil, nil, ts.URL, isolatedclient.DefaultNamespace, nil, nil)
+			d := &isolateservice.HandlersEndpointsV1Digest{
+				Digest: string(isolated.HashBytes(client.Hash(), []byte("missing"))),
+				Size:   7,
+			}
+			So(client.Fetch(ctx, d, &memWriteSeeker{}), ShouldNotBeNil)
+		})
+
+		Convey(`garbage collects old items`, func() {
+			client := isolatedclient.New(nil, nil, ts.URL, isolatedclient.DefaultNamespace, nil, nil)
+			digest := isolated.HashBytes(client.Hash(), small)
+			states, err := client.Contains(ctx, []*isolateservice.HandlersEndpointsV1Digest{{Digest: string(digest), Size: 5}})
+			So(err, ShouldBeNil)
+			So(client.Push(ctx, states[0], isolatedclient.NewBytesSource(small)), ShouldBeNil)
+
+			stats, err := server.GC(time.Hour, 0)
+			So(err, ShouldBeNil)
+			So(stats, ShouldResemble, &GCStats{Kept: 1, KeptBytes: 5})
+
+			p := filepath.Join(root, isolatedclient.DefaultNamespace, string(digest[:2]), string(digest))
+			old := time.Now().Add(-2 * time.Hour)
+			So(os.Chtimes(p, old, old), ShouldBeNil)
+			stats, err = server.GC(time.Hour, 0)
+			So(err, ShouldBeNil)
+			So(stats, ShouldResemble, &GCStats{Removed: 1, RemovedBytes: 5})
+			_, err = os.Stat(p)
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey(`rejects invalid namespaces`, func() {
+			for _, namespace := range []string{"../escape", ".", ".."} {
+				client := isolatedclient.New(nil, nil, ts.URL, namespace, nil, nil)
+				_, err := client.Contains(ctx, []*isolateservice.HandlersEndpointsV1Digest{
+					{Digest: string(isolated.HashBytes(client.Hash(), small)), Size: 5},
+				})
+				So(err, ShouldNotBeNil)
+			}
+		})
+
+		Convey(`keeps paths under the root`, func() {
+			_, err := server.path("..", "escape")
+			So(err, ShouldNotBeNil)
+			_, err = server.path(".")
+			So(err, ShouldNotBeNil)
+			p, err := server.path("ns", "ab")
+			So(err, ShouldBeNil)
+			So(p, ShouldEqual, filepath.Join(root, "ns", "ab"))
+		})
+	})
+}
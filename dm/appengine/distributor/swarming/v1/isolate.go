@@ -30,7 +30,7 @@ func mkFile(data []byte) *isolated.File {
 	mode := 0444
 	size := int64(len(data))
 	return &isolated.File{
-		Digest: isolated.HashBytes(data),
+		Digest: isolated.HashBytes(isolated.GetHash(isolatedclient.DefaultNamespace), data),
 		Mode:   &mode,
 		Size:   &size,
 	}
@@ -52,7 +52,7 @@ func mkIsolated(c context.Context, params *sv1.Parameters, prevFile, descFile *i
 		"${DM.HOST}", info.DefaultVersionHostname(c),
 	)
 
-	iso := isolated.New()
+	iso := isolated.New(isolated.GetHash(isolatedclient.DefaultNamespace))
 	iso.Command = make([]string, len(params.Job.Command))
 	for i, tok := range params.Job.Command {
 		iso.Command[i] = cmdReplacer.Replace(tok)
@@ -0,0 +1,170 @@
+// Code generated by protoc-gen-go.
+// source: github.com/luci/luci-go/logdog/api/endpoints/coordinator/bundles/v1/service.proto
+// DO NOT EDIT!
+
+/*
+Package logdog is a generated protocol buffer package.
+
+It is generated from these files:
+	github.com/luci/luci-go/logdog/api/endpoints/coordinator/bundles/v1/service.proto
+
+It has these top-level messages:
+	PublishRequest
+*/
+package logdog
+
+import prpc "github.com/luci/luci-go/grpc/prpc"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import google_protobuf "github.com/golang/protobuf/ptypes/empty"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// PublishRequest is the request structure for the Publish endpoint.
+type PublishRequest struct {
+	// The butlerproto-formatted Butler log bundle message. This is the same data
+	// that would otherwise be published to the Coordinator's Pub/Sub topic.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *PublishRequest) Reset()                    { *m = PublishRequest{} }
+func (m *PublishRequest) String() string            { return proto.CompactTextString(m) }
+func (*PublishRequest) ProtoMessage()               {}
+func (*PublishRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *PublishRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PublishRequest)(nil), "logdog.PublishRequest")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Bundles service
+
+type BundlesClient interface {
+	// Publish ingests a single Butler log bundle message.
+	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+}
+type bundlesPRPCClient struct {
+	client *prpc.Client
+}
+
+func NewBundlesPRPCClient(client *prpc.Client) BundlesClient {
+	return &bundlesPRPCClient{client}
+}
+
+func (c *bundlesPRPCClient) Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := c.client.Call(ctx, "logdog.Bundles", "Publish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type bundlesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBundlesClient(cc *grpc.ClientConn) BundlesClient {
+	return &bundlesClient{cc}
+}
+
+func (c *bundlesClient) Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/logdog.Bundles/Publish", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Bundles service
+
+type BundlesServer interface {
+	// Publish ingests a single Butler log bundle message.
+	Publish(context.Context, *PublishRequest) (*google_protobuf.Empty, error)
+}
+
+func RegisterBundlesServer(s prpc.Registrar, srv BundlesServer) {
+	s.RegisterService(&_Bundles_serviceDesc, srv)
+}
+
+func _Bundles_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundlesServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Bundles/Publish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundlesServer).Publish(ctx, req.(*PublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Bundles_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "logdog.Bundles",
+	HandlerType: (*BundlesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Publish",
+			Handler:    _Bundles_Publish_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/luci/luci-go/logdog/api/endpoints/coordinator/bundles/v1/service.proto",
+}
+
+func init() {
+	proto.RegisterFile("github.com/luci/luci-go/logdog/api/endpoints/coordinator/bundles/v1/service.proto", fileDescriptor0)
+}
+
+var fileDescriptor0 = []byte{
+	// 192 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x54, 0xcd, 0x3d, 0x4b, 0xc6, 0x30,
+	0x10, 0x07, 0x70, 0x0a, 0xd2, 0x42, 0x10, 0x87, 0x0c, 0x45, 0xea, 0x22, 0xe2, 0xe0, 0x62, 0x0e,
+	0x75, 0x72, 0x15, 0xdd, 0xb5, 0xdf, 0x20, 0x2f, 0x67, 0x1a, 0x48, 0x73, 0x31, 0x2f, 0x05, 0xbf,
+	0xbd, 0x98, 0xe8, 0xf0, 0x2c, 0xc7, 0xdd, 0x71, 0xff, 0xfb, 0xb1, 0x0f, 0xeb, 0xca, 0x56, 0x95,
+	0xd0, 0xb4, 0x83, 0xaf, 0xda, 0xb5, 0x72, 0x6f, 0x09, 0x3c, 0x59, 0x43, 0x16, 0x64, 0x74, 0x80,
+	0xc1, 0x44, 0x72, 0xa1, 0x64, 0xd0, 0x44, 0xc9, 0xb8, 0x20, 0x0b, 0x25, 0x50, 0x35, 0x18, 0x8f,
+	0x19, 0x8e, 0x07, 0xc8, 0x98, 0x0e, 0xa7, 0x51, 0xc4, 0x44, 0x85, 0xf8, 0xd8, 0xa3, 0xcb, 0x95,
+	0x25, 0xb2, 0x1e, 0xa1, 0x6d, 0x55, 0xfd, 0x04, 0xdc, 0x63, 0xf9, 0xee, 0x47, 0x37, 0xb7, 0xec,
+	0xe2, 0xbd, 0x2a, 0xef, 0xf2, 0xb6, 0xe2, 0x57, 0xc5, 0x5c, 0x38, 0x67, 0x67, 0x46, 0x16, 0x79,
+	0x39, 0x5c, 0x0f, 0x77, 0xe7, 0x6b, 0xeb, 0x1f, 0x5f, 0xd9, 0xf4, 0xd2, 0x19, 0xfe, 0xcc, 0xa6,
+	0xbf, 0x00, 0x9f, 0x45, 0x17, 0xc4, 0xe9, 0x87, 0x65, 0x16, 0x5d, 0x14, 0xff, 0xa2, 0x78, 0xfb,
+	0x15, 0xd5, 0xd8, 0xe6, 0xa7, 0x9f, 0x01, 0x00, 0x27, 0x7d, 0x93, 0x92, 0xec, 0x00, 0x00, 0x00,
+}
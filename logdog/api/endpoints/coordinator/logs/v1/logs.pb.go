@@ -17,6 +17,8 @@ It has these top-level messages:
 	QueryResponse
 	ListRequest
 	ListResponse
+	SearchRequest
+	SearchResponse
 	LogStreamState
 */
 package logdog
@@ -457,6 +459,19 @@ type QueryRequest struct {
 	Tags map[string]string `protobuf:"bytes,15,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Purged restricts the query to streams that have or haven't been purged.
 	Purged QueryRequest_Trinary `protobuf:"varint,16,opt,name=purged,enum=logdog.QueryRequest_Trinary" json:"purged,omitempty"`
+	// Fields is the set of indexed fields to constrain a STRUCTURED stream query
+	// with.
+	//
+	// A Field entry may either be:
+	// - A key/value query, in which case the results are constrained by logs
+	//   that have indexed that value for the key.
+	// - A key with an empty value, in which case the results are constrained by
+	//   logs that index that key, regardless of its values.
+	//
+	// Since a map can't distinguish a missing value from an empty one, an empty
+	// value is always a presence-only query: logs that indexed an empty value
+	// for a key can't be queried for that value specifically.
+	Fields map[string]string `protobuf:"bytes,17,rep,name=fields" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *QueryRequest) Reset()                    { *m = QueryRequest{} }
@@ -555,6 +570,13 @@ func (m *QueryRequest) GetPurged() QueryRequest_Trinary {
 	return QueryRequest_BOTH
 }
 
+func (m *QueryRequest) GetFields() map[string]string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
 // The stream type to filter on.
 type QueryRequest_StreamTypeFilter struct {
 	// The StreamType value to filter on.
@@ -882,6 +904,211 @@ func (m *ListResponse_Component) GetDesc() *logpb.LogStreamDescriptor {
 	return nil
 }
 
+// SearchRequest is the request structure for the user Search endpoint.
+//
+// Search scans the archived TEXT log streams that match a path query for lines
+// that contain the supplied text. Streams that have not been archived are not
+// searched.
+type SearchRequest struct {
+	// The project to search.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The path query that selects the log streams to search. It uses the same
+	// syntax as QueryRequest's path.
+	Path string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	// The text to search for.
+	//
+	// A line matches if it contains this text, ignoring case. The text must
+	// contain at least one letter or digit.
+	Text string `protobuf:"bytes,3,opt,name=text" json:"text,omitempty"`
+	// The number of lines of context to return before and after each matching
+	// line.
+	ContextLines int32 `protobuf:"varint,4,opt,name=context_lines,json=contextLines" json:"context_lines,omitempty"`
+	// The maximum number of log streams to search.
+	//
+	// If zero, no upper bound will be indicated. However, the searched stream
+	// count is still subject to internal constraints.
+	MaxResults int32 `protobuf:"varint,5,opt,name=max_results,json=maxResults" json:"max_results,omitempty"`
+	// If not empty, indicates that this search should continue at the point
+	// where the previous search left off.
+	Next string `protobuf:"bytes,6,opt,name=next" json:"next,omitempty"`
+}
+
+func (m *SearchRequest) Reset()                    { *m = SearchRequest{} }
+func (m *SearchRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()               {}
+func (*SearchRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *SearchRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetContextLines() int32 {
+	if m != nil {
+		return m.ContextLines
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetMaxResults() int32 {
+	if m != nil {
+		return m.MaxResults
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+// SearchResponse is the response structure for the user Search endpoint.
+type SearchResponse struct {
+	// Project is the project name that all responses belong to.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The searched log streams that contain matching lines, or whose search was
+	// truncated by the request's limits. Other log streams are omitted.
+	Streams []*SearchResponse_Stream `protobuf:"bytes,2,rep,name=streams" json:"streams,omitempty"`
+	// If not empty, indicates that there are more log streams to search. They
+	// can be searched by repeating the Search request with the same Path and
+	// Text fields and supplying this value in the Next field.
+	Next string `protobuf:"bytes,3,opt,name=next" json:"next,omitempty"`
+}
+
+func (m *SearchResponse) Reset()                    { *m = SearchResponse{} }
+func (m *SearchResponse) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()               {}
+func (*SearchResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *SearchResponse) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *SearchResponse) GetStreams() []*SearchResponse_Stream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *SearchResponse) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+// Match is a single line that contains the search text.
+type SearchResponse_Match struct {
+	// The stream index of the log entry that contains the line.
+	StreamIndex int64 `protobuf:"varint,1,opt,name=stream_index,json=streamIndex" json:"stream_index,omitempty"`
+	// The index of the line within its log entry.
+	LineIndex int32 `protobuf:"varint,2,opt,name=line_index,json=lineIndex" json:"line_index,omitempty"`
+	// The matching line.
+	Line string `protobuf:"bytes,3,opt,name=line" json:"line,omitempty"`
+	// The lines preceding the matching line, in stream order.
+	Before []string `protobuf:"bytes,4,rep,name=before" json:"before,omitempty"`
+	// The lines following the matching line, in stream order.
+	After []string `protobuf:"bytes,5,rep,name=after" json:"after,omitempty"`
+}
+
+func (m *SearchResponse_Match) Reset()                    { *m = SearchResponse_Match{} }
+func (m *SearchResponse_Match) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse_Match) ProtoMessage()               {}
+func (*SearchResponse_Match) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8, 0} }
+
+func (m *SearchResponse_Match) GetStreamIndex() int64 {
+	if m != nil {
+		return m.StreamIndex
+	}
+	return 0
+}
+
+func (m *SearchResponse_Match) GetLineIndex() int32 {
+	if m != nil {
+		return m.LineIndex
+	}
+	return 0
+}
+
+func (m *SearchResponse_Match) GetLine() string {
+	if m != nil {
+		return m.Line
+	}
+	return ""
+}
+
+func (m *SearchResponse_Match) GetBefore() []string {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *SearchResponse_Match) GetAfter() []string {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+// Stream is a single searched log stream and its matching lines.
+type SearchResponse_Stream struct {
+	// Path is the log stream path.
+	Path string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	// The matching lines in the log stream, in stream order.
+	Matches []*SearchResponse_Match `protobuf:"bytes,2,rep,name=matches" json:"matches,omitempty"`
+	// If true, the log stream may contain more matching lines than returned.
+	Truncated bool `protobuf:"varint,3,opt,name=truncated" json:"truncated,omitempty"`
+}
+
+func (m *SearchResponse_Stream) Reset()                    { *m = SearchResponse_Stream{} }
+func (m *SearchResponse_Stream) String() string            { return proto.CompactTextString(m) }
+func (*SearchResponse_Stream) ProtoMessage()               {}
+func (*SearchResponse_Stream) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8, 1} }
+
+func (m *SearchResponse_Stream) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SearchResponse_Stream) GetMatches() []*SearchResponse_Match {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+func (m *SearchResponse_Stream) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GetRequest)(nil), "logdog.GetRequest")
 	proto.RegisterType((*GetRequest_SignURLRequest)(nil), "logdog.GetRequest.SignURLRequest")
@@ -895,6 +1122,10 @@ func init() {
 	proto.RegisterType((*ListRequest)(nil), "logdog.ListRequest")
 	proto.RegisterType((*ListResponse)(nil), "logdog.ListResponse")
 	proto.RegisterType((*ListResponse_Component)(nil), "logdog.ListResponse.Component")
+	proto.RegisterType((*SearchRequest)(nil), "logdog.SearchRequest")
+	proto.RegisterType((*SearchResponse)(nil), "logdog.SearchResponse")
+	proto.RegisterType((*SearchResponse_Match)(nil), "logdog.SearchResponse.Match")
+	proto.RegisterType((*SearchResponse_Stream)(nil), "logdog.SearchResponse.Stream")
 	proto.RegisterEnum("logdog.QueryRequest_Trinary", QueryRequest_Trinary_name, QueryRequest_Trinary_value)
 	proto.RegisterEnum("logdog.ListResponse_Component_Type", ListResponse_Component_Type_name, ListResponse_Component_Type_value)
 }
@@ -918,6 +1149,9 @@ type LogsClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	// List returns log stream paths rooted under the path hierarchy.
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Search returns the lines in archived log streams that contain the
+	// requested text.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 }
 type logsPRPCClient struct {
 	client *prpc.Client
@@ -963,6 +1197,15 @@ func (c *logsPRPCClient) List(ctx context.Context, in *ListRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *logsPRPCClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.client.Call(ctx, "logdog.Logs", "Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type logsClient struct {
 	cc *grpc.ClientConn
 }
@@ -1007,6 +1250,15 @@ func (c *logsClient) List(ctx context.Context, in *ListRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *logsClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := grpc.Invoke(ctx, "/logdog.Logs/Search", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Logs service
 
 type LogsServer interface {
@@ -1018,6 +1270,9 @@ type LogsServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
 	// List returns log stream paths rooted under the path hierarchy.
 	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Search returns the lines in archived log streams that contain the
+	// requested text.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 }
 
 func RegisterLogsServer(s prpc.Registrar, srv LogsServer) {
@@ -1096,6 +1351,24 @@ func _Logs_List_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Logs_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Logs/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Logs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "logdog.Logs",
 	HandlerType: (*LogsServer)(nil),
@@ -1116,6 +1389,10 @@ var _Logs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _Logs_List_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _Logs_Search_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1/logs.proto",
@@ -1126,76 +1403,89 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 1130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xb4, 0x56, 0xef, 0x8e, 0xdb, 0x44,
-	0x10, 0xc7, 0x89, 0xf3, 0x6f, 0x9c, 0xbb, 0x86, 0xa5, 0x54, 0xc6, 0xa5, 0x6d, 0x9a, 0x52, 0x11,
-	0x24, 0x70, 0xda, 0x50, 0x28, 0x2a, 0x12, 0xa8, 0xbd, 0x5e, 0xcb, 0x9f, 0x83, 0xbb, 0x6e, 0x52,
-	0x24, 0x3e, 0x45, 0x4e, 0xb2, 0xe7, 0x1a, 0x9c, 0x5d, 0xe3, 0x5d, 0x1f, 0x97, 0x67, 0xe0, 0x13,
-	0x9f, 0x10, 0x8f, 0xc5, 0x23, 0x20, 0x1e, 0x80, 0x27, 0x40, 0x42, 0x3b, 0x6b, 0xc7, 0x69, 0x1a,
-	0x7a, 0x45, 0x94, 0x2f, 0x49, 0x66, 0xe6, 0x37, 0xbb, 0x3b, 0xbf, 0x99, 0xdf, 0x6e, 0xe0, 0xcb,
-	0x30, 0x52, 0x4f, 0xb2, 0xa9, 0x3f, 0x13, 0x8b, 0x41, 0x9c, 0xcd, 0x22, 0xfc, 0x78, 0x2f, 0x14,
-	0x83, 0x58, 0x84, 0x73, 0x11, 0x0e, 0x82, 0x24, 0x1a, 0x30, 0x3e, 0x4f, 0x44, 0xc4, 0x95, 0x1c,
-	0xcc, 0x84, 0x48, 0xe7, 0x11, 0x0f, 0x94, 0x48, 0x35, 0x40, 0x0e, 0x4e, 0x6e, 0xe2, 0xb7, 0x9f,
-	0xa4, 0x42, 0x09, 0x52, 0x37, 0x49, 0xde, 0xc1, 0x7f, 0x5e, 0x54, 0xaa, 0x40, 0x31, 0xb3, 0xaa,
-	0x37, 0x7c, 0x81, 0xd5, 0x62, 0x11, 0x26, 0x53, 0xfd, 0x99, 0xe7, 0x5c, 0x09, 0x85, 0x08, 0x63,
-	0x36, 0x40, 0x6b, 0x9a, 0x1d, 0x0f, 0x54, 0xb4, 0x60, 0x52, 0x05, 0x8b, 0x24, 0x07, 0x5c, 0xde,
-	0x04, 0xcc, 0xb3, 0x34, 0x50, 0x91, 0xe0, 0x26, 0xde, 0xfb, 0xa9, 0x0a, 0xf0, 0x90, 0x29, 0xca,
-	0x7e, 0xc8, 0x98, 0x54, 0xc4, 0x85, 0x46, 0x92, 0x8a, 0xef, 0xd8, 0x4c, 0xb9, 0x56, 0xd7, 0xea,
-	0xb7, 0x68, 0x61, 0x12, 0x02, 0x76, 0x12, 0xa8, 0x27, 0x6e, 0x05, 0xdd, 0xf8, 0x9b, 0x9c, 0x87,
-	0x1a, 0x16, 0xe0, 0x56, 0xbb, 0x56, 0xbf, 0x49, 0x8d, 0xa1, 0xbd, 0x11, 0x9f, 0xb3, 0x53, 0xd7,
-	0xee, 0x5a, 0xfd, 0x2a, 0x35, 0x06, 0xb9, 0x04, 0x30, 0x5d, 0x2a, 0x36, 0x99, 0x89, 0x8c, 0x2b,
-	0xb7, 0xd6, 0xb5, 0xfa, 0x35, 0xda, 0xd2, 0x9e, 0x3d, 0xed, 0x20, 0x17, 0xa1, 0x15, 0x8b, 0x30,
-	0x8f, 0xd6, 0x31, 0xda, 0x8c, 0x45, 0x68, 0x82, 0xd7, 0x61, 0x97, 0x0b, 0x3e, 0x99, 0x09, 0xae,
-	0xa2, 0x30, 0x13, 0x99, 0x74, 0x1b, 0xb8, 0xe1, 0x0e, 0x17, 0x7c, 0x6f, 0xe5, 0x24, 0x9f, 0xc3,
-	0xb9, 0x90, 0xa9, 0x89, 0x8c, 0x42, 0xce, 0xe6, 0x93, 0x2c, 0x8d, 0xa5, 0xdb, 0xec, 0x5a, 0x7d,
-	0x67, 0x78, 0xd5, 0x37, 0x14, 0xfa, 0x65, 0xa5, 0xfe, 0x28, 0x0a, 0xf9, 0x63, 0x7a, 0x90, 0x9b,
-	0x74, 0x27, 0x64, 0x6a, 0x84, 0x89, 0x8f, 0xd3, 0x58, 0x7a, 0x19, 0xec, 0x3e, 0x0d, 0x20, 0x1f,
-	0x40, 0x33, 0x8e, 0x8e, 0x99, 0xe6, 0x17, 0xa9, 0x71, 0x86, 0x6f, 0xf8, 0x86, 0x5b, 0xbf, 0xe0,
-	0xd6, 0xbf, 0x9f, 0x73, 0x4b, 0x57, 0x50, 0x72, 0x01, 0xea, 0x52, 0xa5, 0x2c, 0x58, 0x20, 0x71,
-	0x4d, 0x9a, 0x5b, 0x25, 0x49, 0x39, 0x75, 0x68, 0xf4, 0x1e, 0x81, 0x33, 0x0e, 0xa2, 0xf8, 0x25,
-	0x76, 0xa3, 0xf7, 0x47, 0x05, 0x1c, 0x2c, 0x5b, 0x26, 0x82, 0x4b, 0xf6, 0x9c, 0x35, 0xdf, 0x2d,
-	0xf2, 0x2b, 0x58, 0xde, 0x85, 0x82, 0xb4, 0x03, 0x11, 0x8e, 0xf0, 0xd0, 0x23, 0x1d, 0x2d, 0xba,
-	0xec, 0x83, 0x3d, 0x67, 0x72, 0x86, 0x9b, 0x39, 0x43, 0xcf, 0xc7, 0xc9, 0x2c, 0xb1, 0xf7, 0x99,
-	0x9c, 0xa5, 0x51, 0xa2, 0x44, 0x4a, 0x11, 0x47, 0xae, 0x81, 0xad, 0x87, 0xde, 0xb5, 0xbb, 0xd5,
-	0xbe, 0x33, 0x3c, 0x57, 0xe2, 0xf7, 0xb9, 0x4a, 0x97, 0x14, 0x83, 0xe4, 0x53, 0x70, 0xd6, 0xbb,
-	0x57, 0xc3, 0xb5, 0x2f, 0x3f, 0xd5, 0x3d, 0x53, 0x86, 0x5f, 0xf6, 0x8a, 0x82, 0x2c, 0xfb, 0x76,
-	0x02, 0x50, 0x46, 0xc8, 0x1d, 0x00, 0x76, 0x9a, 0x44, 0xa6, 0x29, 0x79, 0xd7, 0xbc, 0x67, 0xba,
-	0x36, 0x2e, 0x24, 0x43, 0xd7, 0xd0, 0x1b, 0x8d, 0x6b, 0x6d, 0x6f, 0x5c, 0xab, 0x68, 0xdc, 0x2f,
-	0x35, 0x68, 0x3f, 0xca, 0x58, 0xba, 0x7c, 0xc9, 0x42, 0xc2, 0x43, 0xa2, 0x90, 0x9a, 0xd4, 0x18,
-	0x3a, 0x9f, 0xb3, 0x53, 0x23, 0xa1, 0x16, 0xc5, 0xdf, 0xe4, 0x0a, 0x38, 0x8b, 0xe0, 0x74, 0x92,
-	0x32, 0x99, 0xc5, 0x4a, 0xe6, 0xfa, 0x81, 0x45, 0x70, 0x4a, 0x8d, 0x87, 0x5c, 0x85, 0xb6, 0x56,
-	0x0f, 0xe3, 0x6a, 0xa2, 0x96, 0x09, 0x73, 0x01, 0x93, 0x9d, 0xdc, 0x37, 0x5e, 0x26, 0x8c, 0x3c,
-	0x00, 0xc7, 0x94, 0x68, 0x10, 0x0e, 0xb2, 0x75, 0xbd, 0xe0, 0x7e, 0xbd, 0x38, 0xdf, 0xb4, 0x58,
-	0x67, 0x3d, 0x88, 0x62, 0xc5, 0x52, 0x0a, 0x72, 0xe5, 0x21, 0x37, 0xa0, 0xc6, 0xd9, 0x8f, 0x2c,
-	0x75, 0xdb, 0x67, 0xf2, 0x6d, 0x80, 0x3a, 0x43, 0xc4, 0x73, 0x96, 0xba, 0x3b, 0x67, 0x67, 0x20,
-	0x90, 0x5c, 0x83, 0x1d, 0x0c, 0x4e, 0x4e, 0x58, 0x2a, 0x75, 0x6f, 0x77, 0xb1, 0x9e, 0x36, 0x3a,
-	0xbf, 0x31, 0x3e, 0x32, 0x04, 0x5b, 0x05, 0xa1, 0x74, 0xcf, 0xe1, 0xc4, 0x5d, 0xde, 0x5a, 0xc9,
-	0x38, 0x08, 0x65, 0x3e, 0x80, 0x1a, 0x4b, 0x6e, 0x41, 0x3d, 0xc9, 0xd2, 0x90, 0xcd, 0xdd, 0x4e,
-	0xd7, 0xea, 0xef, 0x0e, 0xdf, 0xdc, 0x9e, 0x95, 0x46, 0x3c, 0x48, 0x97, 0x34, 0xc7, 0x7a, 0x1f,
-	0x43, 0x67, 0x93, 0x12, 0xf2, 0x36, 0xd4, 0x4e, 0x82, 0x38, 0x33, 0x97, 0xc5, 0xee, 0xf0, 0xd5,
-	0x7c, 0xe0, 0x4b, 0x1c, 0x35, 0x71, 0xef, 0x36, 0xb4, 0x56, 0xa7, 0x20, 0x1d, 0xa8, 0x7e, 0xcf,
-	0x96, 0xf9, 0xc8, 0xe8, 0x9f, 0x7a, 0x08, 0xcc, 0x3a, 0x66, 0x5e, 0x8c, 0x71, 0xa7, 0xf2, 0x91,
-	0xd5, 0x7b, 0x0b, 0x1a, 0xf9, 0x41, 0x48, 0x13, 0xec, 0x7b, 0x87, 0xe3, 0xcf, 0x3a, 0xaf, 0x90,
-	0x06, 0x54, 0xbf, 0xdd, 0x1f, 0x75, 0x2c, 0x52, 0x87, 0xca, 0xd7, 0x87, 0x9d, 0x4a, 0xef, 0xe7,
-	0x0a, 0xec, 0xe4, 0x87, 0x3f, 0xf3, 0x06, 0xf8, 0x10, 0x1a, 0xa6, 0x91, 0xd2, 0xad, 0x20, 0x69,
-	0x9b, 0xe5, 0x17, 0xe2, 0x43, 0x10, 0x2d, 0xc0, 0xab, 0x91, 0xac, 0x96, 0x23, 0xe9, 0xfd, 0x6a,
-	0x41, 0xdd, 0xe0, 0x56, 0x13, 0x6f, 0xad, 0x4d, 0xfc, 0xff, 0x7b, 0xd9, 0x5c, 0x02, 0xd0, 0xdf,
-	0x93, 0x52, 0x3e, 0x6d, 0xda, 0xd2, 0x9e, 0x23, 0x7c, 0xf4, 0xfe, 0xb4, 0xc0, 0x39, 0x88, 0xe4,
-	0x0b, 0xbc, 0x7a, 0x17, 0xa1, 0xa5, 0x8f, 0x3b, 0x99, 0x06, 0xb2, 0xe8, 0x40, 0x53, 0x3b, 0xee,
-	0x05, 0x92, 0xfd, 0x83, 0x6a, 0x0b, 0x32, 0xec, 0xa7, 0xf5, 0x99, 0x6b, 0x4b, 0xf0, 0x78, 0x89,
-	0xd2, 0x6d, 0x16, 0xa2, 0x39, 0xe4, 0xf1, 0x52, 0xbf, 0x70, 0x11, 0x9f, 0xc5, 0xd9, 0x9c, 0x4d,
-	0xf2, 0xf9, 0xab, 0x9b, 0x17, 0x2e, 0xf7, 0x1e, 0xa1, 0x53, 0x5f, 0x4a, 0xe2, 0xf8, 0x58, 0x32,
-	0x85, 0x0f, 0x60, 0x8d, 0xe6, 0xd6, 0xa6, 0xfe, 0x9b, 0x9b, 0xfa, 0xef, 0xfd, 0x55, 0x81, 0xb6,
-	0xa9, 0xf8, 0xcc, 0x21, 0x78, 0x6e, 0xc9, 0x5b, 0x3a, 0x4d, 0x3e, 0x01, 0x98, 0x89, 0x45, 0x22,
-	0x38, 0xe3, 0xaa, 0xb8, 0xdf, 0x57, 0x6a, 0x5b, 0xdf, 0xd4, 0xdf, 0x2b, 0x60, 0x74, 0x2d, 0xc3,
-	0xfb, 0xdd, 0x82, 0xd6, 0x2a, 0x82, 0x3b, 0x04, 0xf9, 0x1b, 0xab, 0x77, 0x08, 0x16, 0x8c, 0xdc,
-	0x06, 0x1b, 0xef, 0xa4, 0x0a, 0x4a, 0xe9, 0xda, 0xf3, 0xd7, 0xf6, 0x51, 0x5c, 0x98, 0x50, 0x4e,
-	0x59, 0xf5, 0xdf, 0x4c, 0x99, 0xfd, 0x62, 0x53, 0xd6, 0x7b, 0x07, 0x6c, 0xbc, 0xf1, 0x9a, 0x60,
-	0x1f, 0xdd, 0x45, 0xf5, 0x01, 0xd4, 0x47, 0x63, 0xba, 0x7f, 0xf7, 0xab, 0x8e, 0x45, 0x1c, 0x68,
-	0x1c, 0xd1, 0xc3, 0x2f, 0xf6, 0xf7, 0xc6, 0x9d, 0xca, 0xf0, 0x37, 0x0b, 0xec, 0x03, 0xfd, 0xc2,
-	0xf9, 0x50, 0x7d, 0xc8, 0x14, 0x21, 0xcf, 0xfe, 0x23, 0xf1, 0x5e, 0xdb, 0xf2, 0xce, 0x91, 0x1b,
-	0x60, 0xeb, 0x7f, 0x04, 0x64, 0x15, 0x5c, 0xfb, 0x7f, 0xb0, 0x3d, 0xe3, 0x16, 0xd4, 0x50, 0xad,
-	0xe4, 0xfc, 0xb6, 0xbb, 0xcb, 0x7b, 0x7d, 0xab, 0xa4, 0xc9, 0x4d, 0xb0, 0x35, 0x9d, 0xe5, 0x3e,
-	0x6b, 0xfa, 0xf0, 0xce, 0x6f, 0x63, 0x7c, 0x5a, 0x47, 0x75, 0xbd, 0xff, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x92, 0xcb, 0xcc, 0xa4, 0x5b, 0x0b, 0x00, 0x00,
+	// 1342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x72, 0xdb, 0xc4,
+	0x17, 0xff, 0x4b, 0x96, 0x1d, 0xfb, 0xc8, 0x49, 0xdd, 0xfd, 0xb7, 0x1d, 0xa1, 0x7e, 0xb9, 0x2e,
+	0x1d, 0xcc, 0x0c, 0xc8, 0xad, 0x29, 0x4d, 0x29, 0x33, 0x30, 0x6d, 0x9a, 0x96, 0x42, 0x4a, 0xd2,
+	0xb5, 0xcb, 0x0c, 0x57, 0x1e, 0xd9, 0x5e, 0x2b, 0x02, 0x59, 0x2b, 0xb4, 0xab, 0x10, 0xbf, 0x02,
+	0xe5, 0x86, 0x4b, 0x9e, 0x81, 0xa7, 0x62, 0x78, 0x00, 0xae, 0xb9, 0x60, 0x86, 0xd9, 0x0f, 0x59,
+	0x8e, 0x63, 0x92, 0x14, 0xca, 0x8d, 0xad, 0x73, 0xf6, 0x77, 0x76, 0xf7, 0x7c, 0xfc, 0xce, 0x59,
+	0xf8, 0x22, 0x08, 0xf9, 0x7e, 0x36, 0xf4, 0x46, 0x74, 0xda, 0x89, 0xb2, 0x51, 0x28, 0x7f, 0xde,
+	0x0f, 0x68, 0x27, 0xa2, 0xc1, 0x98, 0x06, 0x1d, 0x3f, 0x09, 0x3b, 0x24, 0x1e, 0x27, 0x34, 0x8c,
+	0x39, 0xeb, 0x8c, 0x28, 0x4d, 0xc7, 0x61, 0xec, 0x73, 0x9a, 0x0a, 0x00, 0xeb, 0x1c, 0xdc, 0x91,
+	0xff, 0x5e, 0x92, 0x52, 0x4e, 0x51, 0x45, 0x19, 0xb9, 0x3b, 0xff, 0x7a, 0x53, 0xc6, 0x7d, 0x4e,
+	0xd4, 0xae, 0x6e, 0xf7, 0x0c, 0xbb, 0x45, 0x34, 0x48, 0x86, 0xe2, 0x57, 0xdb, 0x5c, 0x0f, 0x28,
+	0x0d, 0x22, 0xd2, 0x91, 0xd2, 0x30, 0x9b, 0x74, 0x78, 0x38, 0x25, 0x8c, 0xfb, 0xd3, 0x44, 0x03,
+	0xae, 0x2d, 0x03, 0xc6, 0x59, 0xea, 0xf3, 0x90, 0xc6, 0x6a, 0xbd, 0xf5, 0xaa, 0x04, 0xf0, 0x94,
+	0x70, 0x4c, 0xbe, 0xcb, 0x08, 0xe3, 0xc8, 0x81, 0xb5, 0x24, 0xa5, 0xdf, 0x90, 0x11, 0x77, 0x8c,
+	0xa6, 0xd1, 0xae, 0xe1, 0x5c, 0x44, 0x08, 0xac, 0xc4, 0xe7, 0xfb, 0x8e, 0x29, 0xd5, 0xf2, 0x1b,
+	0x5d, 0x80, 0xb2, 0x74, 0xc0, 0x29, 0x35, 0x8d, 0x76, 0x15, 0x2b, 0x41, 0x68, 0xc3, 0x78, 0x4c,
+	0x0e, 0x1d, 0xab, 0x69, 0xb4, 0x4b, 0x58, 0x09, 0xe8, 0x2a, 0xc0, 0x70, 0xc6, 0xc9, 0x60, 0x44,
+	0xb3, 0x98, 0x3b, 0xe5, 0xa6, 0xd1, 0x2e, 0xe3, 0x9a, 0xd0, 0x6c, 0x09, 0x05, 0xba, 0x0c, 0xb5,
+	0x88, 0x06, 0x7a, 0xb5, 0x22, 0x57, 0xab, 0x11, 0x0d, 0xd4, 0xe2, 0x2d, 0xd8, 0x88, 0x69, 0x3c,
+	0x18, 0xd1, 0x98, 0x87, 0x41, 0x46, 0x33, 0xe6, 0xac, 0xc9, 0x03, 0xd7, 0x63, 0x1a, 0x6f, 0xcd,
+	0x95, 0xe8, 0x19, 0x9c, 0x0b, 0x08, 0x1f, 0xb0, 0x30, 0x88, 0xc9, 0x78, 0x90, 0xa5, 0x11, 0x73,
+	0xaa, 0x4d, 0xa3, 0x6d, 0x77, 0x6f, 0x78, 0x2a, 0x84, 0x5e, 0xe1, 0xa9, 0xd7, 0x0b, 0x83, 0xf8,
+	0x25, 0xde, 0xd1, 0x22, 0x5e, 0x0f, 0x08, 0xef, 0x49, 0xc3, 0x97, 0x69, 0xc4, 0xdc, 0x0c, 0x36,
+	0x8e, 0x02, 0xd0, 0x87, 0x50, 0x8d, 0xc2, 0x09, 0x11, 0xf1, 0x95, 0xa1, 0xb1, 0xbb, 0x6f, 0x79,
+	0x2a, 0xb6, 0x5e, 0x1e, 0x5b, 0xef, 0xb1, 0x8e, 0x2d, 0x9e, 0x43, 0xd1, 0x25, 0xa8, 0x30, 0x9e,
+	0x12, 0x7f, 0x2a, 0x03, 0x57, 0xc5, 0x5a, 0x2a, 0x82, 0xa4, 0x43, 0x27, 0x85, 0xd6, 0x0b, 0xb0,
+	0xfb, 0x7e, 0x18, 0xbd, 0xc1, 0x6c, 0xb4, 0x7e, 0x33, 0xc1, 0x96, 0x6e, 0xb3, 0x84, 0xc6, 0x8c,
+	0x9c, 0xb0, 0xe7, 0x7b, 0xb9, 0xbd, 0x29, 0xdd, 0xbb, 0x94, 0x07, 0x6d, 0x87, 0x06, 0x3d, 0x79,
+	0xe9, 0x9e, 0x58, 0xcd, 0xb3, 0xec, 0x81, 0x35, 0x26, 0x6c, 0x24, 0x0f, 0xb3, 0xbb, 0xae, 0x27,
+	0x2b, 0xb3, 0xc0, 0x3e, 0x26, 0x6c, 0x94, 0x86, 0x09, 0xa7, 0x29, 0x96, 0x38, 0x74, 0x13, 0x2c,
+	0x51, 0xf4, 0x8e, 0xd5, 0x2c, 0xb5, 0xed, 0xee, 0xb9, 0x02, 0xbf, 0x1d, 0xf3, 0x74, 0x86, 0xe5,
+	0x22, 0xfa, 0x14, 0xec, 0xc5, 0xec, 0x95, 0xe5, 0xde, 0xd7, 0x8e, 0x64, 0x4f, 0xb9, 0xe1, 0x15,
+	0xb9, 0xc2, 0xc0, 0x8a, 0xbc, 0x1d, 0x00, 0x14, 0x2b, 0xe8, 0x01, 0x00, 0x39, 0x4c, 0x42, 0x95,
+	0x14, 0x9d, 0x35, 0xf7, 0x58, 0xd6, 0xfa, 0x39, 0x65, 0xf0, 0x02, 0x7a, 0x29, 0x71, 0xb5, 0xd5,
+	0x89, 0xab, 0xe5, 0x89, 0xfb, 0xb1, 0x02, 0xf5, 0x17, 0x19, 0x49, 0x67, 0x6f, 0x98, 0x48, 0xf2,
+	0x92, 0x92, 0x48, 0x55, 0xac, 0x04, 0x61, 0x1f, 0x93, 0x43, 0x45, 0xa1, 0x1a, 0x96, 0xdf, 0xe8,
+	0x3a, 0xd8, 0x53, 0xff, 0x70, 0x90, 0x12, 0x96, 0x45, 0x9c, 0x69, 0xfe, 0xc0, 0xd4, 0x3f, 0xc4,
+	0x4a, 0x83, 0x6e, 0x40, 0x5d, 0xb0, 0x87, 0xc4, 0x7c, 0xc0, 0x67, 0x09, 0x71, 0x40, 0x1a, 0xdb,
+	0x5a, 0xd7, 0x9f, 0x25, 0x04, 0x3d, 0x01, 0x5b, 0xb9, 0xa8, 0x10, 0xb6, 0x8c, 0xd6, 0xad, 0x3c,
+	0xf6, 0x8b, 0xce, 0x79, 0x2a, 0xc5, 0xc2, 0xea, 0x49, 0x18, 0x71, 0x92, 0x62, 0x60, 0x73, 0x0d,
+	0xba, 0x0d, 0xe5, 0x98, 0x7c, 0x4f, 0x52, 0xa7, 0x7e, 0x6a, 0xbc, 0x15, 0x50, 0x58, 0xd0, 0x68,
+	0x4c, 0x52, 0x67, 0xfd, 0x74, 0x0b, 0x09, 0x44, 0x37, 0x61, 0x5d, 0x2e, 0x0e, 0x0e, 0x48, 0xca,
+	0x44, 0x6e, 0x37, 0xa4, 0x3f, 0x75, 0xa9, 0xfc, 0x4a, 0xe9, 0x50, 0x17, 0x2c, 0xee, 0x07, 0xcc,
+	0x39, 0xd7, 0x2c, 0x2d, 0x56, 0xd1, 0x11, 0x4f, 0xfa, 0x7e, 0xc0, 0x74, 0x01, 0x0a, 0x2c, 0xba,
+	0x0b, 0x95, 0x24, 0x4b, 0x03, 0x32, 0x76, 0x1a, 0x4d, 0xa3, 0xbd, 0xd1, 0xbd, 0xb2, 0xda, 0x2a,
+	0x0d, 0x63, 0x3f, 0x9d, 0x61, 0x8d, 0x45, 0xf7, 0xa1, 0x32, 0x09, 0x49, 0x34, 0x66, 0xce, 0x79,
+	0x79, 0x56, 0x73, 0xa5, 0xd5, 0x13, 0x09, 0x51, 0xa7, 0x69, 0xbc, 0xfb, 0x31, 0x34, 0x96, 0x83,
+	0x89, 0xde, 0x81, 0xf2, 0x81, 0x1f, 0x65, 0xaa, 0xcd, 0x6c, 0x74, 0xcf, 0x6b, 0xaa, 0x14, 0x38,
+	0xac, 0xd6, 0xdd, 0x4d, 0xa8, 0xcd, 0xef, 0x8f, 0x1a, 0x50, 0xfa, 0x96, 0xcc, 0x74, 0xb1, 0x89,
+	0x4f, 0x51, 0x3e, 0x6a, 0x1f, 0x55, 0x69, 0x4a, 0x78, 0x60, 0xde, 0x37, 0xdc, 0x8f, 0xc0, 0x5e,
+	0xb8, 0xcc, 0xeb, 0x98, 0xb6, 0xde, 0x86, 0x35, 0xed, 0x3d, 0xaa, 0x82, 0xf5, 0x68, 0xb7, 0xff,
+	0x59, 0xe3, 0x7f, 0x68, 0x0d, 0x4a, 0x5f, 0x6f, 0xf7, 0x1a, 0x06, 0xaa, 0x80, 0xf9, 0xe5, 0x6e,
+	0xc3, 0x6c, 0xfd, 0x64, 0xc2, 0xba, 0xf6, 0xfd, 0xd4, 0xb6, 0x73, 0x0f, 0xd6, 0x54, 0xf5, 0x30,
+	0xc7, 0x94, 0xd1, 0x5b, 0x8e, 0x79, 0xce, 0x78, 0x09, 0xc2, 0x39, 0x78, 0xce, 0x83, 0x52, 0xc1,
+	0x03, 0xf7, 0x67, 0x03, 0x2a, 0x0a, 0x37, 0xa7, 0x99, 0xb1, 0x40, 0xb3, 0xff, 0xb6, 0xc3, 0x5d,
+	0x05, 0x10, 0xff, 0x83, 0x82, 0xb3, 0x75, 0x5c, 0x13, 0x9a, 0x3d, 0x39, 0x69, 0x7f, 0x37, 0xc0,
+	0xde, 0x09, 0xd9, 0x19, 0x46, 0xed, 0x65, 0xa8, 0x89, 0xeb, 0x0e, 0x86, 0x3e, 0xcb, 0x33, 0x50,
+	0x15, 0x8a, 0x47, 0x3e, 0x23, 0x7f, 0xd3, 0x2a, 0xf2, 0x60, 0x58, 0x47, 0x9b, 0x82, 0x26, 0x34,
+	0x8d, 0xa3, 0x99, 0xec, 0x17, 0xd5, 0x9c, 0xa9, 0xbb, 0x71, 0x34, 0x13, 0x63, 0x35, 0x8c, 0x47,
+	0x51, 0x36, 0x26, 0x03, 0x5d, 0xf4, 0x15, 0x35, 0x56, 0xb5, 0x76, 0x4f, 0x2a, 0x45, 0x27, 0xa4,
+	0x93, 0x09, 0x23, 0x5c, 0x4e, 0xdd, 0x32, 0xd6, 0xd2, 0x72, 0xd3, 0xa9, 0x2e, 0x37, 0x9d, 0xd6,
+	0x9f, 0x26, 0xd4, 0x95, 0xc7, 0xa7, 0x16, 0xc1, 0x89, 0x2e, 0xaf, 0xc8, 0x34, 0xfa, 0x04, 0x60,
+	0x44, 0xa7, 0x09, 0x8d, 0x49, 0xcc, 0xf3, 0xa1, 0x32, 0xa7, 0xf8, 0xe2, 0xa1, 0xde, 0x56, 0x0e,
+	0xc3, 0x0b, 0x16, 0xee, 0xaf, 0x06, 0xd4, 0xe6, 0x2b, 0xf2, 0x04, 0x5f, 0x0f, 0x76, 0x71, 0x82,
+	0x3f, 0x25, 0x68, 0x13, 0x2c, 0xd9, 0x08, 0x4d, 0xc9, 0xc2, 0x9b, 0x27, 0xef, 0xed, 0x49, 0x5e,
+	0x4a, 0x83, 0xa2, 0xca, 0x4a, 0xaf, 0x53, 0x65, 0xd6, 0xd9, 0xaa, 0xac, 0xf5, 0x2e, 0x58, 0xb2,
+	0xcd, 0x56, 0xc1, 0xda, 0x7b, 0x28, 0xd9, 0x07, 0x50, 0xe9, 0xf5, 0xf1, 0xf6, 0xc3, 0xe7, 0x0d,
+	0x03, 0xd9, 0xb0, 0xb6, 0x87, 0x77, 0x3f, 0xdf, 0xde, 0xea, 0x37, 0xcc, 0xd6, 0x2f, 0x06, 0xac,
+	0xf7, 0x88, 0x9f, 0x8e, 0xf6, 0xff, 0xd9, 0x54, 0x42, 0x60, 0xf1, 0x85, 0xb8, 0x8b, 0x6f, 0xd1,
+	0x79, 0xe5, 0xd0, 0x38, 0xe4, 0x83, 0x28, 0x8c, 0x09, 0x93, 0xf7, 0x2e, 0xe3, 0xba, 0x56, 0xee,
+	0x08, 0xdd, 0x72, 0x65, 0x94, 0x8f, 0x8d, 0xa3, 0x3c, 0xa3, 0x95, 0x22, 0xa3, 0xad, 0x3f, 0x4c,
+	0xd8, 0xc8, 0x6f, 0x7b, 0x6a, 0xbd, 0x6c, 0x2e, 0x37, 0x8d, 0xab, 0x79, 0x94, 0x8f, 0x6e, 0x71,
+	0xa6, 0xae, 0xf1, 0xca, 0x80, 0xf2, 0x73, 0x9f, 0x8f, 0xf6, 0xc5, 0x98, 0xd4, 0x94, 0x51, 0x33,
+	0xde, 0x90, 0x2f, 0x58, 0x4d, 0xa3, 0x67, 0xf9, 0x3b, 0x56, 0x38, 0xae, 0x01, 0xa6, 0x7a, 0xc7,
+	0x0a, 0x8d, 0x5a, 0x46, 0x60, 0x09, 0x21, 0xdf, 0x5f, 0x7c, 0x0b, 0x02, 0x0d, 0xc9, 0x84, 0xa6,
+	0x44, 0xd6, 0x69, 0x0d, 0x6b, 0x49, 0x50, 0xd9, 0x9f, 0x70, 0x92, 0x3a, 0x65, 0xa9, 0x56, 0x82,
+	0x9b, 0x9e, 0xd8, 0xc2, 0xee, 0xc1, 0xda, 0x54, 0x5c, 0x95, 0x1c, 0xeb, 0x96, 0x4b, 0x8e, 0x4b,
+	0x87, 0x70, 0x0e, 0x46, 0x57, 0xa0, 0xc6, 0xd3, 0x2c, 0x1e, 0xf9, 0x9c, 0x8c, 0x75, 0xeb, 0x28,
+	0x14, 0xdd, 0x1f, 0x4c, 0xb0, 0x76, 0xc4, 0x03, 0xcc, 0x83, 0xd2, 0x53, 0xc2, 0x11, 0x3a, 0xfe,
+	0x60, 0x76, 0xff, 0xbf, 0xe2, 0x19, 0x86, 0x6e, 0x83, 0x25, 0x1e, 0xac, 0x68, 0xbe, 0xb8, 0xf0,
+	0x7c, 0x5d, 0x6d, 0x71, 0x17, 0xca, 0xb2, 0xaf, 0xa3, 0x0b, 0xab, 0x86, 0xa4, 0x7b, 0x71, 0x65,
+	0xf3, 0x47, 0x77, 0xc0, 0x12, 0xc4, 0x2b, 0xce, 0x59, 0xe8, 0xa4, 0xee, 0x85, 0x55, 0xdc, 0x44,
+	0x9b, 0x50, 0x51, 0x21, 0x41, 0x17, 0x97, 0x43, 0xa4, 0xcc, 0x2e, 0xad, 0x8e, 0xdc, 0xb0, 0x22,
+	0x1b, 0xf8, 0x07, 0x7f, 0x0d, 0x00, 0x8e, 0x6a, 0x16, 0x19, 0x33, 0x0e, 0x00, 0x00,
 }
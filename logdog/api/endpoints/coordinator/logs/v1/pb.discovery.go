@@ -12,1100 +12,1360 @@ func init() {
 			"logdog.Logs",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 236, 189, 13, 112, 92, 201,
-			121, 24, 136, 238, 247, 102, 48, 104, 128, 4, 240, 0, 130, 224,
-			3, 73, 52, 103, 73, 2, 32, 7, 3, 44, 151, 187, 210, 146,
-			75, 197, 32, 9, 146, 179, 194, 2, 208, 0, 88, 122, 215, 178,
-			200, 135, 153, 6, 240, 118, 103, 222, 155, 125, 239, 13, 64, 172,
-			188, 209, 218, 183, 113, 164, 36, 74, 201, 177, 207, 235, 36, 170,
-			205, 37, 87, 167, 58, 43, 137, 92, 82, 34, 157, 229, 43, 57,
-			201, 201, 169, 40, 235, 31, 157, 173, 156, 44, 159, 78, 41, 199,
-			82, 157, 74, 42, 91, 185, 146, 237, 186, 84, 233, 78, 190, 250,
-			190, 238, 126, 63, 3, 128, 228, 74, 235, 170, 115, 74, 172, 93,
-			114, 190, 126, 253, 186, 191, 239, 235, 175, 191, 254, 250, 251, 190,
-			238, 199, 62, 56, 193, 198, 55, 125, 127, 179, 33, 102, 90, 129,
-			31, 249, 235, 237, 141, 153, 200, 109, 138, 48, 114, 154, 173, 50,
-			22, 89, 253, 178, 66, 89, 87, 40, 94, 102, 61, 171, 186, 142,
-			53, 202, 186, 67, 81, 243, 189, 122, 56, 74, 56, 153, 52, 170,
-			26, 180, 134, 89, 206, 115, 60, 63, 28, 165, 156, 76, 230, 170,
-			18, 184, 186, 202, 134, 106, 126, 179, 220, 209, 230, 213, 195, 113,
-			139, 203, 80, 180, 76, 254, 59, 66, 254, 11, 33, 255, 132, 26,
-			55, 151, 175, 126, 156, 158, 188, 41, 235, 47, 171, 250, 229, 219,
-			162, 209, 120, 183, 231, 239, 120, 171, 187, 45, 17, 62, 253, 171,
-			103, 88, 222, 50, 79, 118, 53, 9, 251, 173, 62, 70, 250, 44,
-			227, 100, 151, 117, 225, 223, 244, 113, 124, 161, 230, 55, 248, 213,
-			246, 198, 134, 8, 66, 62, 205, 101, 83, 19, 33, 175, 59, 145,
-			195, 93, 47, 18, 65, 109, 203, 241, 54, 5, 223, 240, 131, 166,
-			19, 49, 126, 205, 111, 237, 6, 238, 230, 86, 196, 47, 204, 206,
-			190, 83, 189, 192, 43, 94, 173, 204, 249, 92, 163, 193, 241, 89,
-			200, 3, 17, 138, 96, 91, 212, 203, 140, 111, 69, 81, 43, 188,
-			52, 51, 83, 23, 219, 162, 225, 183, 68, 16, 106, 10, 107, 126,
-			83, 178, 182, 230, 55, 166, 215, 37, 18, 51, 140, 241, 170, 168,
-			187, 97, 20, 184, 235, 237, 200, 245, 61, 238, 120, 117, 222, 14,
-			5, 119, 61, 30, 250, 237, 160, 38, 176, 100, 221, 245, 156, 96,
-			23, 241, 10, 75, 124, 199, 141, 182, 184, 31, 224, 191, 126, 59,
-			98, 188, 233, 215, 221, 13, 183, 230, 64, 11, 37, 238, 4, 130,
-			183, 68, 208, 116, 163, 72, 212, 121, 43, 240, 183, 221, 186, 168,
-			243, 104, 203, 137, 120, 180, 5, 212, 53, 26, 254, 142, 235, 109,
-			114, 24, 31, 23, 94, 10, 225, 37, 198, 155, 34, 186, 196, 24,
-			135, 63, 231, 58, 16, 11, 185, 191, 161, 49, 170, 249, 117, 193,
-			155, 237, 48, 226, 129, 136, 28, 215, 195, 86, 157, 117, 127, 27,
-			30, 41, 142, 49, 238, 249, 145, 91, 19, 37, 30, 109, 185, 33,
-			111, 184, 97, 4, 45, 164, 123, 244, 234, 29, 232, 212, 221, 176,
-			214, 112, 220, 166, 8, 202, 7, 33, 225, 122, 105, 94, 104, 36,
-			90, 129, 95, 111, 215, 68, 130, 7, 75, 16, 249, 161, 240, 96,
-			92, 81, 87, 247, 107, 237, 166, 240, 34, 71, 15, 210, 140, 31,
-			112, 63, 218, 18, 1, 111, 58, 145, 8, 92, 167, 17, 38, 172,
-			198, 1, 138, 182, 4, 227, 105, 236, 99, 162, 22, 133, 139, 111,
-			66, 195, 158, 211, 20, 128, 80, 90, 182, 60, 63, 121, 134, 124,
-			119, 163, 16, 40, 242, 100, 83, 126, 16, 242, 166, 179, 203, 215,
-			5, 72, 74, 157, 71, 62, 23, 94, 221, 15, 66, 1, 66, 209,
-			10, 252, 166, 31, 9, 46, 121, 18, 133, 188, 46, 2, 119, 91,
-			212, 249, 70, 224, 55, 153, 228, 66, 232, 111, 68, 59, 32, 38,
-			74, 130, 120, 216, 18, 53, 144, 32, 222, 10, 92, 16, 172, 0,
-			100, 199, 147, 82, 20, 134, 136, 59, 227, 171, 183, 42, 43, 124,
-			101, 233, 198, 234, 237, 185, 234, 60, 175, 172, 240, 229, 234, 210,
-			179, 149, 235, 243, 215, 249, 213, 231, 248, 234, 173, 121, 126, 109,
-			105, 249, 185, 106, 229, 230, 173, 85, 126, 107, 105, 225, 250, 124,
-			117, 133, 207, 45, 94, 231, 215, 150, 22, 87, 171, 149, 171, 107,
-			171, 75, 213, 21, 198, 139, 115, 43, 188, 178, 82, 196, 39, 115,
-			139, 207, 241, 249, 31, 95, 174, 206, 175, 172, 240, 165, 42, 175,
-			60, 179, 188, 80, 153, 191, 206, 111, 207, 85, 171, 115, 139, 171,
-			149, 249, 149, 18, 175, 44, 94, 91, 88, 187, 94, 89, 188, 89,
-			226, 87, 215, 86, 249, 226, 210, 42, 227, 11, 149, 103, 42, 171,
-			243, 215, 249, 234, 82, 9, 187, 221, 251, 30, 95, 186, 193, 159,
-			153, 175, 94, 187, 53, 183, 184, 58, 119, 181, 178, 80, 89, 125,
-			14, 59, 188, 81, 89, 93, 132, 206, 110, 44, 85, 25, 159, 227,
-			203, 115, 213, 213, 202, 181, 181, 133, 185, 42, 95, 94, 171, 46,
-			47, 173, 204, 115, 160, 236, 122, 101, 229, 218, 194, 92, 229, 153,
-			249, 235, 101, 94, 89, 228, 139, 75, 124, 254, 217, 249, 197, 85,
-			190, 114, 107, 110, 97, 33, 75, 40, 227, 75, 183, 23, 231, 171,
-			128, 125, 154, 76, 126, 117, 158, 47, 84, 230, 174, 46, 204, 67,
-			87, 72, 231, 245, 74, 117, 254, 218, 42, 16, 148, 252, 186, 86,
-			185, 62, 191, 184, 58, 183, 80, 98, 124, 101, 121, 254, 90, 101,
-			110, 161, 196, 231, 127, 124, 254, 153, 229, 133, 185, 234, 115, 37,
-			213, 232, 202, 252, 123, 214, 230, 23, 87, 43, 115, 11, 252, 250,
-			220, 51, 115, 55, 231, 87, 248, 228, 131, 184, 178, 92, 93, 186,
-			182, 86, 157, 127, 6, 176, 94, 186, 193, 87, 214, 174, 174, 172,
-			86, 86, 215, 86, 231, 249, 205, 165, 165, 235, 200, 236, 149, 249,
-			234, 179, 149, 107, 243, 43, 151, 249, 194, 210, 10, 50, 108, 109,
-			101, 190, 196, 248, 245, 185, 213, 57, 236, 122, 185, 186, 116, 163,
-			178, 186, 114, 25, 126, 95, 93, 91, 169, 32, 227, 42, 139, 171,
-			243, 213, 234, 218, 242, 106, 101, 105, 113, 138, 223, 90, 186, 61,
-			255, 236, 124, 149, 95, 155, 91, 91, 153, 191, 142, 28, 94, 90,
-			4, 106, 65, 86, 230, 151, 170, 207, 65, 179, 192, 7, 28, 129,
-			18, 191, 125, 107, 126, 245, 214, 124, 21, 152, 138, 220, 154, 3,
-			54, 172, 172, 86, 43, 215, 86, 211, 213, 150, 170, 124, 117, 169,
-			186, 202, 82, 116, 242, 197, 249, 155, 11, 149, 155, 243, 139, 215,
-			230, 225, 241, 18, 52, 115, 187, 178, 50, 63, 197, 231, 170, 149,
-			21, 168, 80, 193, 142, 249, 237, 185, 231, 248, 210, 26, 82, 13,
-			3, 181, 182, 50, 207, 228, 239, 148, 232, 150, 112, 60, 121, 229,
-			6, 159, 187, 254, 108, 5, 48, 87, 181, 151, 151, 86, 86, 42,
-			74, 92, 144, 109, 215, 110, 41, 158, 151, 25, 43, 48, 66, 45,
-			131, 23, 142, 194, 175, 130, 101, 20, 187, 46, 179, 94, 102, 22,
-			190, 217, 221, 37, 129, 62, 150, 3, 128, 90, 70, 177, 251, 40,
-			59, 196, 242, 8, 117, 73, 240, 48, 235, 150, 32, 145, 176, 170,
-			220, 109, 25, 69, 251, 146, 106, 241, 145, 174, 113, 213, 34, 145,
-			128, 172, 4, 221, 62, 18, 183, 72, 160, 197, 71, 226, 22, 9,
-			182, 248, 72, 220, 34, 49, 44, 227, 17, 251, 164, 106, 241, 116,
-			87, 73, 181, 72, 37, 32, 43, 81, 128, 186, 135, 84, 139, 20,
-			90, 4, 80, 182, 72, 177, 69, 128, 85, 229, 110, 203, 56, 61,
-			114, 94, 181, 120, 166, 107, 70, 181, 104, 72, 64, 86, 50, 168,
-			101, 156, 233, 30, 83, 45, 26, 208, 34, 128, 178, 69, 3, 91,
-			4, 88, 85, 238, 182, 140, 51, 39, 203, 170, 197, 179, 93, 69,
-			213, 162, 41, 1, 89, 201, 164, 150, 113, 182, 219, 86, 45, 154,
-			208, 34, 128, 178, 69, 19, 91, 4, 88, 85, 54, 44, 227, 236,
-			137, 83, 170, 197, 137, 152, 234, 156, 4, 100, 165, 28, 181, 140,
-			137, 238, 211, 170, 197, 28, 180, 8, 160, 108, 49, 135, 45, 2,
-			172, 42, 27, 150, 49, 49, 161, 169, 158, 236, 58, 165, 90, 204,
-			75, 64, 86, 202, 83, 203, 152, 236, 30, 85, 45, 230, 161, 69,
-			0, 101, 139, 121, 108, 17, 96, 85, 185, 219, 50, 38, 199, 56,
-			251, 240, 0, 163, 102, 151, 101, 58, 93, 77, 98, 191, 58, 192,
-			231, 120, 108, 241, 224, 74, 38, 66, 225, 69, 33, 119, 120, 203,
-			119, 189, 8, 215, 31, 183, 9, 246, 64, 93, 180, 132, 87, 23,
-			30, 174, 95, 142, 183, 43, 203, 95, 246, 61, 193, 64, 239, 215,
-			156, 134, 240, 234, 78, 80, 74, 90, 17, 117, 238, 132, 92, 153,
-			97, 184, 206, 109, 4, 78, 45, 89, 205, 245, 3, 88, 172, 193,
-			38, 67, 24, 172, 25, 191, 33, 141, 17, 215, 227, 107, 171, 215,
-			248, 124, 203, 175, 109, 97, 119, 101, 94, 137, 184, 27, 114, 225,
-			129, 13, 0, 150, 10, 172, 151, 184, 210, 45, 7, 126, 67, 180,
-			34, 183, 198, 111, 6, 98, 211, 15, 92, 199, 227, 215, 20, 78,
-			124, 103, 203, 173, 109, 113, 113, 47, 18, 208, 33, 172, 109, 73,
-			37, 141, 56, 227, 235, 78, 237, 197, 29, 39, 128, 26, 62, 223,
-			21, 78, 192, 125, 111, 79, 151, 78, 24, 182, 155, 208, 171, 211,
-			104, 240, 166, 235, 181, 35, 129, 214, 11, 127, 98, 150, 197, 36,
-			53, 124, 111, 179, 196, 221, 178, 40, 243, 134, 112, 90, 9, 169,
-			129, 224, 197, 176, 41, 156, 64, 212, 139, 60, 244, 165, 81, 228,
-			249, 233, 90, 140, 71, 206, 122, 67, 64, 159, 158, 16, 208, 229,
-			134, 31, 72, 243, 176, 5, 246, 14, 46, 229, 188, 138, 134, 162,
-			27, 170, 101, 117, 118, 118, 246, 209, 105, 252, 111, 117, 118, 246,
-			18, 254, 247, 60, 80, 241, 228, 147, 79, 62, 57, 253, 232, 133,
-			233, 199, 30, 93, 189, 240, 216, 165, 199, 159, 188, 244, 248, 147,
-			229, 39, 245, 159, 231, 203, 140, 95, 221, 5, 134, 71, 129, 91,
-			139, 144, 149, 10, 165, 0, 154, 47, 241, 29, 193, 133, 23, 182,
-			3, 33, 75, 119, 4, 175, 1, 199, 124, 111, 91, 4, 17, 143,
-			124, 166, 70, 213, 111, 114, 94, 189, 113, 141, 63, 246, 216, 99,
-			79, 130, 57, 43, 56, 52, 233, 109, 134, 101, 198, 87, 132, 224,
-			63, 161, 237, 210, 157, 157, 157, 178, 43, 162, 141, 178, 31, 108,
-			206, 4, 27, 53, 248, 31, 94, 42, 71, 247, 162, 159, 156, 124,
-			152, 90, 83, 96, 10, 204, 223, 115, 154, 173, 134, 224, 143, 94,
-			226, 215, 252, 102, 171, 29, 137, 148, 20, 35, 58, 203, 75, 43,
-			149, 31, 231, 119, 65, 104, 38, 167, 238, 150, 149, 85, 153, 84,
-			138, 55, 23, 151, 229, 147, 100, 179, 17, 138, 232, 142, 26, 175,
-			73, 124, 125, 113, 109, 97, 97, 106, 106, 223, 122, 40, 182, 147,
-			179, 83, 151, 83, 56, 93, 120, 16, 78, 155, 34, 130, 86, 252,
-			141, 186, 179, 155, 194, 45, 140, 130, 118, 45, 194, 14, 182, 157,
-			6, 143, 182, 85, 143, 153, 234, 103, 163, 237, 18, 71, 132, 46,
-			255, 160, 36, 109, 151, 163, 109, 128, 238, 71, 145, 172, 212, 14,
-			69, 141, 159, 227, 143, 206, 206, 102, 41, 124, 236, 64, 10, 111,
-			187, 222, 99, 23, 248, 221, 155, 34, 90, 217, 13, 35, 209, 132,
-			199, 115, 225, 13, 183, 33, 86, 179, 3, 113, 163, 178, 48, 191,
-			90, 121, 102, 158, 111, 68, 10, 141, 131, 222, 57, 187, 17, 105,
-			76, 215, 42, 139, 171, 79, 92, 228, 145, 91, 123, 49, 228, 87,
-			248, 228, 228, 164, 44, 153, 218, 136, 202, 245, 157, 91, 238, 230,
-			214, 117, 39, 194, 183, 166, 248, 83, 79, 241, 199, 46, 76, 241,
-			159, 226, 248, 108, 193, 223, 209, 143, 52, 223, 102, 102, 248, 28,
-			224, 91, 247, 119, 66, 108, 18, 38, 211, 163, 179, 179, 41, 85,
-			20, 150, 227, 10, 2, 85, 208, 163, 79, 236, 157, 101, 113, 107,
-			240, 250, 163, 79, 92, 188, 120, 241, 29, 143, 61, 49, 59, 27,
-			79, 249, 117, 177, 225, 7, 130, 175, 121, 238, 61, 221, 202, 147,
-			239, 152, 237, 108, 165, 252, 131, 13, 230, 164, 164, 159, 79, 78,
-			74, 166, 204, 224, 96, 193, 159, 41, 62, 157, 70, 231, 1, 18,
-			12, 237, 0, 187, 116, 59, 103, 82, 237, 160, 0, 76, 101, 4,
-			224, 226, 129, 2, 240, 180, 179, 237, 240, 187, 114, 32, 203, 181,
-			118, 16, 8, 47, 130, 42, 207, 184, 141, 134, 27, 166, 4, 0,
-			52, 36, 111, 98, 41, 191, 194, 15, 126, 225, 62, 98, 206, 175,
-			36, 165, 101, 79, 236, 92, 109, 187, 141, 186, 8, 38, 167, 128,
-			176, 21, 197, 33, 213, 133, 100, 204, 148, 108, 11, 254, 64, 157,
-			69, 73, 187, 235, 69, 64, 185, 170, 41, 73, 87, 100, 35, 7,
-			166, 202, 235, 208, 50, 226, 146, 240, 224, 241, 3, 121, 160, 168,
-			208, 235, 38, 95, 222, 141, 182, 228, 14, 6, 254, 120, 254, 14,
-			191, 34, 23, 51, 169, 156, 212, 236, 87, 226, 114, 5, 52, 253,
-			164, 231, 239, 168, 114, 28, 31, 85, 10, 197, 124, 90, 87, 149,
-			40, 158, 59, 247, 228, 84, 199, 184, 166, 249, 50, 169, 42, 95,
-			81, 255, 150, 100, 131, 87, 240, 239, 41, 134, 127, 12, 19, 44,
-			5, 167, 48, 200, 254, 49, 97, 166, 137, 54, 227, 6, 29, 182,
-			127, 158, 240, 106, 98, 16, 104, 4, 253, 13, 92, 147, 145, 184,
-			208, 245, 106, 105, 209, 102, 251, 203, 54, 127, 6, 182, 201, 235,
-			66, 178, 7, 255, 58, 96, 189, 98, 251, 45, 88, 207, 115, 215,
-			171, 53, 218, 161, 187, 45, 202, 140, 29, 98, 57, 64, 209, 180,
-			204, 13, 234, 160, 145, 8, 96, 14, 80, 238, 214, 16, 177, 140,
-			141, 66, 191, 134, 12, 203, 216, 176, 134, 216, 55, 36, 113, 196,
-			50, 26, 212, 178, 191, 76, 248, 162, 239, 77, 123, 98, 211, 137,
-			220, 109, 145, 181, 76, 28, 69, 45, 135, 197, 121, 63, 203, 164,
-			204, 23, 213, 139, 122, 205, 231, 219, 78, 163, 45, 66, 185, 245,
-			78, 26, 67, 7, 65, 24, 185, 141, 6, 223, 114, 182, 5, 247,
-			210, 125, 202, 177, 149, 47, 50, 185, 194, 214, 252, 182, 23, 193,
-			130, 15, 118, 136, 54, 190, 58, 24, 56, 171, 22, 246, 146, 250,
-			159, 237, 195, 31, 98, 90, 102, 131, 110, 12, 43, 30, 144, 28,
-			80, 173, 249, 67, 128, 7, 133, 67, 26, 50, 44, 163, 49, 48,
-			184, 158, 71, 239, 208, 99, 236, 15, 134, 217, 194, 166, 27, 109,
-			181, 215, 209, 103, 212, 104, 215, 92, 252, 107, 122, 211, 159, 105,
-			248, 155, 117, 127, 115, 198, 105, 185, 51, 194, 171, 163, 133, 24,
-			206, 212, 124, 63, 168, 187, 158, 19, 249, 1, 84, 8, 103, 182,
-			31, 157, 9, 35, 39, 82, 94, 53, 43, 47, 223, 178, 31, 228,
-			224, 43, 254, 188, 193, 14, 47, 248, 155, 43, 81, 32, 156, 230,
-			10, 180, 96, 61, 194, 14, 225, 179, 59, 219, 34, 8, 93, 223,
-			67, 223, 94, 79, 181, 15, 11, 159, 149, 101, 214, 69, 214, 93,
-			11, 132, 19, 137, 58, 186, 248, 122, 47, 216, 157, 110, 189, 114,
-			60, 33, 170, 186, 170, 117, 134, 29, 142, 68, 208, 116, 61, 167,
-			113, 7, 172, 219, 123, 163, 6, 250, 13, 15, 233, 210, 10, 20,
-			90, 79, 177, 110, 39, 168, 109, 185, 219, 98, 212, 196, 198, 139,
-			101, 73, 79, 57, 139, 106, 121, 78, 214, 170, 120, 27, 126, 85,
-			191, 98, 141, 176, 124, 171, 29, 108, 138, 250, 104, 142, 147, 201,
-			66, 85, 65, 246, 255, 72, 88, 111, 234, 5, 107, 140, 245, 32,
-			14, 119, 218, 65, 67, 209, 88, 192, 130, 181, 160, 97, 157, 96,
-			44, 196, 142, 240, 41, 197, 167, 61, 178, 4, 30, 31, 99, 133,
-			186, 19, 57, 248, 208, 192, 135, 221, 0, 195, 35, 155, 21, 106,
-			62, 168, 171, 72, 98, 95, 168, 198, 176, 117, 150, 245, 55, 252,
-			205, 59, 194, 139, 130, 221, 59, 40, 119, 136, 163, 81, 61, 212,
-			240, 55, 231, 161, 244, 26, 20, 62, 253, 111, 6, 88, 222, 50,
-			205, 174, 89, 194, 62, 77, 208, 165, 105, 118, 89, 23, 62, 78,
-			50, 222, 201, 71, 159, 224, 171, 91, 130, 47, 172, 93, 171, 240,
-			185, 118, 180, 229, 7, 97, 249, 0, 23, 229, 90, 136, 14, 39,
-			229, 8, 74, 28, 122, 110, 200, 55, 253, 109, 17, 120, 96, 215,
-			123, 117, 229, 159, 154, 107, 57, 53, 104, 216, 173, 9, 47, 20,
-			37, 174, 198, 156, 95, 40, 207, 234, 41, 227, 120, 56, 53, 252,
-			182, 87, 215, 238, 178, 133, 202, 181, 249, 197, 149, 121, 190, 225,
-			54, 68, 188, 119, 206, 23, 14, 179, 30, 70, 141, 46, 203, 40,
-			116, 79, 178, 55, 136, 220, 8, 29, 234, 154, 37, 246, 207, 17,
-			158, 29, 78, 64, 199, 225, 235, 110, 221, 13, 4, 206, 101, 167,
-			193, 81, 168, 229, 124, 149, 158, 47, 216, 159, 180, 192, 220, 149,
-			47, 194, 86, 162, 17, 130, 162, 223, 219, 150, 104, 174, 139, 122,
-			93, 26, 246, 30, 159, 215, 147, 135, 7, 226, 165, 182, 8, 163,
-			153, 64, 132, 45, 223, 11, 133, 50, 3, 161, 21, 173, 153, 15,
-			21, 70, 216, 117, 173, 152, 251, 11, 167, 236, 119, 72, 111, 178,
-			102, 133, 43, 119, 52, 90, 214, 185, 154, 42, 184, 109, 144, 92,
-			70, 84, 50, 186, 179, 191, 112, 104, 52, 165, 59, 251, 11, 135,
-			83, 186, 179, 191, 255, 120, 74, 119, 246, 143, 115, 182, 172, 85,
-			167, 85, 40, 219, 215, 112, 168, 113, 1, 216, 217, 18, 146, 225,
-			13, 127, 83, 117, 195, 119, 28, 160, 106, 211, 13, 35, 17, 164,
-			124, 145, 252, 90, 162, 38, 50, 106, 202, 42, 244, 159, 210, 170,
-			40, 15, 61, 156, 74, 169, 41, 171, 56, 149, 82, 83, 86, 105,
-			154, 109, 35, 42, 212, 50, 70, 10, 167, 108, 23, 81, 81, 29,
-			227, 124, 145, 162, 149, 70, 104, 34, 228, 122, 70, 243, 166, 8,
-			67, 103, 19, 246, 116, 178, 150, 28, 75, 55, 228, 211, 143, 150,
-			88, 252, 30, 178, 12, 84, 182, 108, 192, 245, 54, 99, 132, 169,
-			105, 153, 35, 5, 171, 172, 144, 162, 57, 192, 67, 107, 82, 96,
-			208, 200, 97, 205, 59, 106, 88, 198, 200, 56, 103, 183, 0, 97,
-			163, 203, 50, 143, 209, 73, 195, 190, 196, 83, 211, 30, 125, 170,
-			142, 139, 238, 111, 44, 228, 117, 17, 57, 110, 35, 84, 99, 151,
-			38, 163, 204, 100, 187, 6, 140, 208, 49, 118, 132, 189, 135, 229,
-			1, 2, 161, 24, 51, 143, 217, 87, 145, 21, 202, 141, 187, 18,
-			249, 129, 179, 41, 248, 90, 117, 1, 198, 40, 16, 123, 121, 34,
-			185, 229, 198, 93, 215, 203, 140, 245, 179, 110, 217, 164, 105, 153,
-			99, 230, 49, 116, 242, 200, 130, 28, 116, 194, 18, 152, 88, 198,
-			88, 239, 112, 2, 27, 150, 49, 118, 116, 148, 253, 132, 194, 137,
-			88, 198, 9, 211, 182, 23, 222, 34, 78, 129, 179, 163, 7, 83,
-			198, 68, 246, 197, 14, 132, 230, 132, 57, 118, 44, 238, 29, 86,
-			183, 19, 41, 236, 64, 112, 78, 244, 30, 73, 96, 195, 50, 78,
-			140, 30, 99, 207, 43, 236, 168, 101, 140, 155, 163, 246, 187, 223,
-			34, 118, 78, 24, 138, 230, 122, 67, 212, 239, 135, 28, 8, 200,
-			184, 121, 194, 142, 59, 7, 17, 25, 79, 33, 7, 188, 25, 239,
-			29, 74, 96, 195, 50, 198, 71, 142, 178, 175, 17, 133, 157, 97,
-			25, 167, 205, 17, 251, 183, 9, 10, 105, 208, 22, 37, 116, 53,
-			0, 42, 160, 171, 93, 1, 123, 138, 104, 71, 8, 143, 207, 202,
-			224, 65, 102, 21, 227, 59, 128, 124, 140, 25, 175, 108, 48, 190,
-			225, 52, 66, 29, 129, 112, 189, 186, 91, 115, 34, 17, 38, 1,
-			153, 142, 201, 235, 249, 96, 135, 200, 85, 162, 177, 203, 27, 190,
-			83, 71, 93, 7, 230, 25, 186, 33, 154, 162, 238, 202, 61, 190,
-			228, 89, 172, 5, 100, 175, 78, 67, 86, 131, 31, 226, 94, 203,
-			13, 50, 12, 50, 76, 203, 60, 109, 142, 143, 198, 12, 48, 114,
-			64, 112, 33, 129, 137, 101, 156, 238, 25, 76, 96, 96, 200, 240,
-			17, 246, 136, 226, 143, 105, 25, 19, 230, 73, 123, 24, 71, 207,
-			107, 55, 215, 69, 0, 147, 30, 136, 72, 122, 49, 77, 203, 156,
-			48, 79, 143, 196, 173, 152, 57, 120, 173, 39, 129, 137, 101, 76,
-			176, 68, 134, 76, 195, 50, 38, 142, 159, 96, 14, 170, 23, 195,
-			50, 206, 83, 219, 94, 133, 33, 64, 147, 205, 109, 148, 58, 89,
-			149, 26, 255, 146, 10, 226, 184, 33, 223, 112, 69, 163, 222, 57,
-			175, 157, 6, 211, 51, 59, 214, 36, 192, 135, 243, 116, 210, 80,
-			218, 194, 200, 67, 151, 90, 147, 0, 15, 206, 31, 62, 162, 33,
-			64, 103, 244, 24, 251, 0, 226, 102, 90, 198, 76, 97, 212, 14,
-			120, 37, 53, 146, 130, 75, 195, 66, 173, 81, 104, 195, 54, 252,
-			205, 50, 159, 67, 148, 113, 168, 183, 28, 144, 28, 225, 233, 170,
-			110, 200, 125, 175, 177, 203, 184, 83, 123, 209, 243, 119, 26, 162,
-			190, 41, 163, 57, 78, 189, 233, 122, 110, 24, 5, 210, 76, 173,
-			53, 92, 216, 1, 196, 152, 3, 111, 103, 10, 231, 109, 133, 29,
-			112, 118, 166, 208, 167, 33, 98, 25, 51, 135, 180, 93, 14, 92,
-			157, 25, 57, 26, 219, 150, 127, 122, 138, 157, 236, 180, 2, 235,
-			237, 64, 250, 180, 14, 136, 242, 94, 98, 133, 235, 170, 202, 91,
-			14, 242, 46, 239, 31, 228, 61, 164, 27, 76, 98, 188, 15, 27,
-			224, 253, 101, 46, 3, 188, 119, 126, 20, 224, 253, 81, 128, 247,
-			71, 1, 222, 31, 5, 120, 127, 20, 224, 253, 81, 128, 247, 175,
-			76, 128, 183, 148, 14, 240, 150, 50, 1, 222, 161, 108, 128, 119,
-			168, 35, 192, 171, 195, 177, 164, 219, 50, 30, 137, 195, 177, 167,
-			187, 202, 233, 0, 111, 57, 19, 224, 29, 203, 6, 120, 199, 58,
-			2, 188, 99, 233, 0, 239, 201, 233, 56, 192, 91, 76, 7, 120,
-			139, 153, 0, 175, 157, 13, 240, 218, 29, 1, 94, 29, 142, 5,
-			123, 233, 76, 28, 142, 61, 27, 83, 109, 74, 32, 29, 224, 61,
-			157, 13, 240, 158, 238, 8, 240, 158, 78, 7, 120, 227, 112, 236,
-			68, 28, 142, 205, 73, 32, 29, 224, 29, 205, 6, 120, 71, 59,
-			2, 188, 58, 28, 155, 235, 182, 140, 137, 49, 206, 190, 206, 164,
-			23, 162, 218, 117, 135, 216, 95, 6, 165, 161, 109, 147, 108, 52,
-			54, 116, 55, 61, 48, 52, 55, 220, 123, 162, 62, 221, 16, 222,
-			102, 180, 197, 195, 150, 227, 225, 134, 23, 246, 226, 169, 176, 43,
-			227, 14, 188, 35, 157, 137, 233, 72, 235, 193, 33, 216, 148, 159,
-			147, 101, 28, 157, 50, 250, 185, 79, 248, 87, 199, 77, 177, 213,
-			154, 239, 213, 68, 43, 130, 245, 239, 69, 193, 139, 117, 103, 183,
-			136, 81, 225, 98, 211, 247, 162, 173, 162, 110, 38, 16, 13, 39,
-			146, 43, 74, 226, 52, 199, 21, 80, 153, 14, 117, 23, 236, 22,
-			225, 213, 132, 222, 228, 48, 30, 237, 164, 107, 43, 63, 43, 186,
-			103, 98, 86, 1, 10, 110, 236, 10, 114, 234, 176, 70, 250, 1,
-			15, 219, 235, 17, 144, 11, 28, 65, 215, 169, 147, 138, 24, 36,
-			33, 84, 167, 213, 10, 252, 123, 46, 172, 179, 141, 93, 126, 126,
-			250, 209, 217, 210, 236, 236, 44, 70, 128, 195, 3, 162, 141, 113,
-			207, 216, 108, 6, 67, 180, 33, 90, 161, 104, 215, 125, 52, 100,
-			246, 198, 30, 195, 200, 9, 34, 126, 133, 151, 203, 229, 203, 157,
-			207, 132, 87, 207, 60, 137, 59, 210, 102, 178, 126, 42, 31, 199,
-			198, 115, 18, 63, 16, 94, 61, 134, 166, 101, 95, 26, 190, 220,
-			241, 146, 14, 46, 192, 43, 242, 183, 126, 1, 33, 221, 137, 187,
-			193, 39, 247, 116, 244, 20, 159, 229, 103, 207, 118, 182, 245, 46,
-			62, 59, 197, 223, 175, 195, 44, 123, 94, 58, 127, 133, 63, 122,
-			121, 207, 83, 213, 245, 149, 56, 232, 52, 59, 171, 42, 189, 194,
-			69, 3, 108, 213, 20, 2, 97, 220, 216, 187, 246, 197, 224, 169,
-			251, 99, 48, 125, 31, 12, 206, 239, 135, 193, 67, 69, 118, 19,
-			240, 124, 50, 98, 111, 93, 12, 14, 28, 236, 131, 133, 68, 62,
-			74, 143, 249, 149, 236, 152, 243, 243, 123, 152, 112, 57, 121, 73,
-			75, 64, 106, 212, 211, 47, 236, 17, 131, 228, 157, 44, 159, 51,
-			66, 151, 102, 113, 242, 194, 190, 220, 77, 198, 55, 169, 248, 174,
-			116, 197, 3, 250, 56, 191, 127, 31, 251, 202, 80, 42, 200, 85,
-			45, 12, 176, 150, 118, 165, 62, 75, 135, 237, 26, 95, 65, 197,
-			154, 14, 111, 129, 30, 74, 107, 214, 142, 240, 203, 244, 99, 143,
-			62, 94, 122, 252, 29, 79, 128, 142, 128, 255, 25, 104, 179, 243,
-			29, 133, 7, 132, 172, 158, 165, 85, 43, 229, 118, 125, 54, 19,
-			178, 122, 54, 19, 178, 122, 214, 26, 98, 255, 141, 161, 253, 174,
-			239, 163, 150, 253, 231, 84, 35, 251, 214, 130, 85, 105, 154, 88,
-			66, 148, 22, 182, 144, 55, 68, 136, 222, 32, 143, 251, 158, 136,
-			91, 11, 50, 75, 138, 220, 114, 56, 124, 150, 241, 187, 138, 87,
-			119, 149, 179, 3, 148, 175, 195, 91, 126, 232, 162, 187, 192, 15,
-			120, 28, 225, 186, 139, 8, 169, 138, 101, 126, 195, 15, 98, 217,
-			10, 17, 149, 84, 135, 126, 192, 155, 126, 32, 74, 220, 65, 151,
-			203, 203, 34, 240, 149, 127, 86, 59, 67, 51, 173, 201, 173, 217,
-			186, 96, 49, 121, 14, 70, 36, 55, 61, 88, 252, 176, 122, 22,
-			207, 206, 97, 204, 68, 208, 96, 8, 83, 5, 7, 68, 212, 222,
-			71, 159, 77, 71, 212, 222, 151, 137, 168, 189, 47, 19, 81, 123,
-			95, 42, 162, 246, 167, 85, 118, 225, 33, 34, 106, 13, 127, 179,
-			181, 14, 127, 43, 79, 72, 14, 11, 30, 24, 54, 179, 31, 224,
-			81, 41, 254, 9, 101, 67, 113, 64, 226, 186, 8, 107, 129, 219,
-			138, 252, 0, 99, 83, 129, 216, 112, 239, 169, 128, 147, 130, 44,
-			139, 153, 176, 49, 84, 129, 38, 252, 109, 93, 96, 189, 42, 4,
-			21, 237, 182, 4, 134, 153, 14, 95, 24, 44, 35, 134, 101, 217,
-			242, 234, 110, 75, 84, 85, 160, 10, 126, 91, 167, 88, 31, 108,
-			41, 133, 23, 201, 151, 76, 108, 175, 87, 149, 97, 149, 119, 178,
-			158, 152, 26, 140, 62, 221, 63, 118, 151, 84, 182, 222, 201, 204,
-			200, 217, 12, 71, 243, 220, 152, 236, 189, 112, 90, 97, 178, 15,
-			153, 229, 85, 103, 51, 196, 112, 86, 21, 223, 176, 206, 178, 126,
-			185, 195, 191, 179, 225, 54, 196, 29, 113, 47, 26, 237, 70, 204,
-			14, 201, 226, 27, 110, 67, 204, 223, 139, 236, 119, 176, 158, 248,
-			85, 107, 128, 25, 47, 138, 93, 197, 40, 248, 105, 13, 179, 28,
-			74, 167, 98, 147, 4, 46, 209, 119, 146, 226, 11, 204, 92, 21,
-			247, 34, 235, 44, 203, 53, 92, 79, 132, 163, 4, 113, 28, 80,
-			56, 194, 179, 242, 130, 235, 137, 170, 124, 108, 95, 98, 38, 128,
-			73, 139, 36, 213, 162, 117, 156, 245, 212, 69, 195, 109, 186, 145,
-			8, 116, 236, 47, 46, 40, 94, 100, 249, 171, 136, 53, 140, 166,
-			191, 177, 17, 138, 8, 95, 55, 171, 10, 130, 209, 172, 59, 145,
-			131, 175, 246, 85, 241, 119, 241, 31, 16, 86, 184, 238, 68, 206,
-			102, 224, 52, 227, 10, 36, 169, 96, 61, 202, 186, 91, 78, 16,
-			185, 78, 67, 69, 84, 143, 42, 228, 245, 91, 229, 101, 249, 184,
-			170, 235, 217, 55, 89, 183, 42, 3, 66, 100, 64, 21, 154, 60,
-			84, 149, 0, 244, 19, 186, 47, 75, 126, 153, 85, 252, 13, 101,
-			13, 39, 140, 80, 158, 10, 85, 252, 93, 252, 4, 101, 133, 5,
-			21, 129, 180, 46, 177, 94, 24, 243, 59, 41, 210, 122, 47, 28,
-			219, 35, 34, 90, 151, 85, 25, 212, 94, 146, 148, 159, 98, 125,
-			82, 162, 85, 120, 87, 118, 220, 43, 203, 100, 112, 247, 20, 235,
-			83, 98, 157, 68, 128, 205, 170, 18, 117, 89, 197, 102, 133, 80,
-			188, 212, 6, 147, 20, 37, 216, 172, 198, 176, 117, 138, 153, 17,
-			200, 15, 67, 180, 122, 83, 3, 124, 171, 171, 138, 143, 172, 9,
-			150, 151, 98, 53, 218, 139, 149, 14, 169, 74, 114, 212, 110, 117,
-			85, 213, 99, 107, 90, 70, 113, 129, 185, 163, 125, 88, 181, 191,
-			131, 231, 183, 186, 170, 113, 149, 171, 61, 172, 91, 77, 164, 226,
-			63, 53, 144, 97, 18, 221, 50, 51, 235, 34, 172, 41, 78, 217,
-			7, 207, 139, 42, 214, 179, 102, 88, 183, 138, 42, 140, 82, 20,
-			211, 35, 201, 43, 216, 98, 89, 206, 29, 93, 203, 58, 199, 6,
-			97, 152, 238, 100, 88, 43, 249, 214, 15, 15, 150, 83, 236, 213,
-			117, 51, 60, 54, 147, 186, 43, 41, 62, 31, 16, 142, 54, 59,
-			194, 209, 246, 231, 8, 203, 73, 217, 56, 72, 226, 211, 35, 70,
-			247, 140, 88, 223, 62, 136, 223, 87, 38, 204, 189, 50, 209, 33,
-			149, 185, 183, 32, 149, 231, 102, 25, 75, 244, 165, 85, 96, 230,
-			234, 252, 143, 175, 14, 116, 89, 140, 229, 175, 86, 22, 231, 170,
-			207, 13, 16, 171, 143, 21, 174, 207, 173, 206, 221, 172, 206, 61,
-			51, 64, 159, 254, 103, 55, 88, 183, 149, 51, 187, 254, 17, 189,
-			111, 4, 254, 241, 191, 10, 17, 248, 67, 233, 8, 60, 252, 36,
-			150, 209, 211, 61, 193, 56, 163, 185, 46, 203, 236, 235, 26, 32,
-			246, 176, 10, 90, 168, 56, 11, 172, 29, 101, 14, 38, 92, 14,
-			172, 164, 190, 92, 63, 108, 190, 115, 104, 194, 29, 162, 189, 176,
-			230, 230, 164, 1, 117, 136, 230, 53, 68, 45, 227, 80, 15, 83,
-			21, 137, 101, 28, 150, 161, 149, 156, 92, 170, 15, 211, 130, 134,
-			168, 101, 28, 238, 237, 83, 21, 169, 101, 244, 211, 126, 245, 8,
-			94, 235, 167, 76, 67, 240, 236, 208, 97, 246, 146, 220, 174, 143,
-			116, 189, 155, 216, 226, 28, 70, 250, 227, 144, 101, 60, 183, 208,
-			225, 95, 230, 171, 24, 111, 147, 198, 200, 70, 187, 209, 224, 161,
-			192, 221, 179, 235, 73, 255, 63, 112, 17, 24, 200, 212, 171, 235,
-			34, 148, 97, 155, 77, 215, 75, 133, 125, 181, 241, 58, 82, 24,
-			99, 127, 24, 103, 104, 141, 211, 97, 251, 247, 8, 75, 5, 192,
-			39, 66, 46, 229, 152, 79, 6, 226, 165, 182, 27, 136, 250, 148,
-			74, 70, 8, 185, 31, 184, 155, 174, 231, 96, 146, 175, 220, 179,
-			106, 147, 233, 106, 59, 106, 136, 128, 187, 94, 24, 57, 176, 239,
-			222, 193, 200, 247, 22, 216, 127, 14, 151, 179, 25, 90, 153, 3,
-			99, 204, 173, 235, 46, 226, 200, 185, 195, 165, 56, 47, 66, 91,
-			154, 14, 16, 131, 75, 73, 176, 226, 32, 227, 167, 230, 55, 155,
-			190, 167, 109, 32, 24, 232, 48, 109, 45, 143, 211, 145, 19, 41,
-			107, 121, 92, 142, 154, 178, 150, 199, 123, 210, 214, 242, 184, 53,
-			196, 254, 56, 78, 240, 154, 162, 150, 253, 31, 21, 111, 50, 33,
-			92, 116, 134, 103, 185, 163, 7, 73, 123, 186, 219, 158, 251, 82,
-			91, 52, 118, 185, 91, 23, 94, 228, 110, 236, 202, 33, 137, 3,
-			164, 110, 180, 165, 68, 60, 172, 249, 45, 17, 123, 207, 91, 123,
-			56, 133, 157, 253, 101, 243, 9, 204, 210, 41, 58, 158, 54, 75,
-			167, 98, 62, 129, 172, 79, 245, 164, 205, 210, 169, 129, 65, 246,
-			78, 157, 65, 81, 162, 39, 236, 243, 123, 153, 164, 214, 21, 156,
-			120, 105, 102, 241, 84, 14, 68, 137, 78, 233, 141, 12, 205, 67,
-			75, 125, 169, 28, 136, 82, 156, 91, 66, 13, 203, 40, 141, 29,
-			103, 255, 145, 232, 176, 234, 227, 212, 182, 255, 215, 78, 177, 61,
-			168, 71, 61, 60, 202, 212, 231, 142, 199, 111, 173, 174, 46, 243,
-			107, 178, 254, 52, 168, 80, 201, 97, 237, 96, 106, 58, 117, 193,
-			157, 109, 199, 109, 96, 170, 77, 228, 131, 244, 95, 247, 55, 153,
-			142, 98, 202, 96, 245, 75, 109, 17, 236, 38, 147, 140, 55, 69,
-			228, 200, 57, 91, 137, 228, 4, 112, 26, 161, 143, 93, 182, 90,
-			13, 87, 133, 69, 85, 180, 151, 233, 8, 17, 112, 13, 223, 74,
-			199, 116, 31, 167, 37, 45, 180, 70, 14, 232, 45, 164, 98, 186,
-			143, 247, 164, 99, 186, 143, 143, 30, 99, 255, 51, 209, 65, 221,
-			43, 244, 156, 253, 207, 247, 19, 218, 117, 39, 20, 169, 236, 206,
-			125, 248, 227, 249, 58, 10, 44, 125, 10, 232, 15, 220, 147, 8,
-			35, 151, 3, 101, 90, 185, 34, 228, 226, 30, 108, 239, 240, 69,
-			55, 96, 169, 46, 156, 144, 55, 221, 90, 160, 183, 146, 114, 153,
-			11, 181, 222, 208, 81, 238, 76, 64, 248, 10, 125, 60, 14, 8,
-			231, 129, 152, 177, 84, 64, 248, 202, 241, 51, 169, 128, 240, 149,
-			201, 41, 246, 243, 146, 236, 156, 101, 92, 167, 227, 246, 223, 0,
-			178, 29, 76, 188, 113, 60, 238, 4, 235, 110, 20, 0, 131, 95,
-			20, 187, 51, 114, 2, 69, 206, 38, 119, 194, 208, 175, 185, 78,
-			148, 196, 191, 220, 48, 155, 32, 163, 6, 59, 30, 107, 88, 152,
-			112, 168, 113, 11, 153, 84, 149, 60, 173, 115, 208, 191, 206, 166,
-			114, 41, 198, 228, 228, 76, 203, 188, 78, 175, 156, 83, 40, 231,
-			242, 128, 164, 30, 183, 28, 177, 140, 235, 35, 154, 212, 156, 97,
-			25, 215, 79, 156, 100, 127, 95, 146, 147, 183, 140, 167, 233, 9,
-			251, 111, 19, 198, 43, 27, 160, 237, 75, 106, 80, 148, 234, 104,
-			52, 64, 164, 94, 240, 93, 15, 37, 106, 83, 96, 148, 174, 222,
-			14, 240, 60, 137, 78, 158, 136, 124, 30, 8, 153, 165, 40, 99,
-			124, 74, 119, 235, 124, 36, 220, 112, 119, 8, 186, 19, 241, 167,
-			164, 6, 122, 215, 204, 249, 153, 167, 64, 245, 188, 171, 12, 27,
-			13, 77, 84, 222, 180, 204, 167, 233, 245, 113, 133, 120, 62, 7,
-			168, 106, 209, 204, 19, 203, 120, 186, 71, 79, 218, 188, 97, 25,
-			79, 143, 29, 103, 69, 6, 131, 103, 46, 118, 253, 36, 177, 71,
-			56, 88, 181, 26, 1, 53, 95, 229, 170, 108, 130, 150, 89, 44,
-			244, 177, 203, 204, 52, 137, 209, 101, 153, 203, 244, 39, 12, 123,
-			26, 103, 169, 187, 217, 246, 219, 33, 7, 83, 152, 227, 110, 71,
-			71, 67, 221, 128, 199, 187, 152, 176, 204, 177, 107, 130, 185, 77,
-			203, 236, 48, 187, 193, 242, 0, 193, 58, 87, 53, 143, 216, 239,
-			144, 147, 194, 245, 196, 132, 106, 75, 97, 80, 194, 100, 21, 220,
-			172, 215, 129, 135, 46, 134, 35, 85, 179, 101, 142, 233, 32, 68,
-			37, 52, 85, 205, 229, 1, 76, 247, 32, 42, 161, 169, 170, 178,
-			114, 136, 74, 104, 170, 246, 166, 158, 27, 150, 81, 29, 26, 102,
-			255, 130, 40, 76, 136, 101, 60, 111, 30, 179, 255, 123, 61, 65,
-			37, 46, 113, 95, 234, 188, 11, 200, 96, 69, 89, 86, 82, 152,
-			69, 179, 21, 237, 170, 167, 113, 136, 216, 195, 21, 1, 104, 112,
-			189, 182, 136, 109, 38, 15, 89, 140, 166, 42, 236, 163, 24, 214,
-			44, 197, 241, 99, 213, 167, 182, 101, 117, 238, 75, 221, 23, 50,
-			103, 199, 169, 111, 195, 2, 174, 114, 109, 136, 202, 148, 122, 222,
-			172, 30, 137, 201, 130, 229, 225, 249, 20, 217, 48, 116, 207, 171,
-			60, 46, 162, 50, 165, 158, 63, 58, 10, 230, 144, 137, 129, 160,
-			247, 82, 41, 234, 4, 88, 104, 188, 87, 154, 67, 0, 229, 45,
-			227, 189, 189, 253, 26, 34, 150, 241, 222, 129, 35, 26, 50, 44,
-			227, 189, 163, 199, 216, 105, 70, 77, 106, 153, 119, 187, 4, 177,
-			71, 185, 220, 240, 236, 47, 65, 192, 221, 187, 133, 195, 236, 38,
-			51, 77, 140, 22, 173, 211, 97, 251, 18, 50, 122, 125, 23, 211,
-			89, 64, 1, 105, 54, 169, 38, 148, 126, 219, 112, 3, 88, 28,
-			100, 53, 101, 120, 161, 204, 83, 28, 245, 117, 122, 119, 0, 241,
-			162, 56, 230, 235, 74, 230, 101, 16, 106, 93, 217, 16, 20, 113,
-			94, 183, 134, 216, 36, 98, 64, 44, 163, 78, 7, 237, 49, 137,
-			65, 26, 113, 149, 204, 17, 119, 1, 28, 174, 211, 245, 97, 213,
-			12, 240, 183, 174, 188, 66, 20, 185, 91, 87, 153, 49, 20, 121,
-			91, 239, 31, 96, 231, 25, 104, 65, 115, 171, 235, 175, 19, 123,
-			156, 235, 205, 93, 7, 99, 82, 86, 175, 9, 11, 199, 86, 97,
-			128, 21, 153, 105, 98, 228, 235, 5, 58, 104, 31, 145, 154, 95,
-			239, 7, 211, 88, 25, 72, 248, 11, 116, 75, 174, 208, 6, 18,
-			254, 130, 194, 74, 198, 202, 94, 80, 88, 25, 72, 248, 11, 253,
-			3, 236, 85, 208, 96, 6, 204, 222, 22, 253, 41, 195, 14, 50,
-			114, 140, 210, 197, 213, 78, 63, 238, 179, 148, 206, 120, 66, 229,
-			38, 39, 162, 224, 129, 80, 185, 114, 187, 32, 188, 76, 37, 37,
-			117, 230, 54, 162, 73, 172, 27, 83, 233, 141, 6, 170, 128, 22,
-			27, 100, 14, 203, 3, 4, 244, 182, 205, 35, 118, 85, 206, 59,
-			220, 146, 149, 228, 50, 7, 19, 30, 23, 130, 151, 69, 224, 151,
-			226, 29, 77, 204, 146, 141, 192, 217, 108, 10, 47, 150, 27, 232,
-			143, 165, 59, 132, 105, 98, 40, 237, 208, 54, 91, 50, 39, 207,
-			80, 218, 161, 173, 166, 137, 161, 180, 67, 91, 105, 7, 67, 105,
-			135, 246, 208, 48, 43, 43, 28, 137, 101, 220, 51, 135, 237, 113,
-			105, 213, 184, 47, 199, 139, 111, 134, 68, 30, 119, 8, 82, 115,
-			207, 108, 31, 137, 27, 4, 185, 185, 151, 234, 16, 36, 231, 94,
-			111, 127, 2, 27, 150, 113, 207, 26, 98, 171, 170, 67, 106, 25,
-			239, 55, 45, 123, 62, 73, 17, 140, 82, 123, 13, 216, 90, 239,
-			25, 45, 205, 5, 216, 224, 57, 105, 198, 39, 104, 129, 97, 247,
-			126, 243, 222, 112, 220, 45, 172, 207, 239, 87, 169, 121, 134, 202,
-			93, 124, 127, 207, 161, 4, 54, 44, 227, 253, 3, 131, 168, 46,
-			12, 120, 248, 10, 29, 81, 34, 8, 36, 190, 66, 127, 202, 80,
-			98, 70, 242, 240, 176, 71, 67, 80, 149, 13, 106, 200, 176, 140,
-			87, 134, 143, 176, 95, 39, 12, 76, 137, 252, 207, 146, 174, 207,
-			18, 98, 255, 10, 57, 199, 248, 156, 135, 9, 139, 219, 110, 189,
-			237, 36, 57, 144, 187, 177, 229, 19, 39, 218, 161, 98, 104, 183,
-			68, 160, 246, 88, 81, 224, 120, 33, 230, 150, 192, 242, 24, 91,
-			106, 188, 18, 37, 230, 38, 74, 110, 200, 120, 184, 229, 183, 27,
-			117, 88, 154, 227, 83, 150, 137, 82, 198, 202, 247, 162, 125, 243,
-			138, 51, 147, 21, 118, 155, 134, 9, 43, 230, 207, 146, 194, 0,
-			251, 167, 48, 161, 76, 218, 101, 153, 31, 38, 244, 188, 253, 209,
-			140, 205, 171, 45, 52, 84, 107, 113, 158, 182, 86, 138, 154, 56,
-			173, 246, 66, 21, 209, 196, 195, 153, 251, 25, 135, 197, 216, 116,
-			43, 74, 235, 33, 244, 27, 219, 202, 120, 72, 172, 186, 36, 31,
-			92, 39, 227, 92, 139, 59, 236, 103, 57, 64, 214, 180, 242, 31,
-			38, 244, 103, 137, 133, 163, 104, 130, 154, 7, 244, 109, 13, 18,
-			0, 199, 206, 106, 208, 0, 112, 234, 156, 52, 239, 65, 139, 155,
-			191, 72, 168, 109, 127, 81, 209, 170, 178, 173, 85, 170, 113, 106,
-			231, 180, 188, 223, 54, 85, 111, 196, 226, 29, 147, 220, 137, 73,
-			170, 221, 80, 27, 174, 187, 220, 1, 203, 84, 142, 56, 44, 148,
-			129, 208, 251, 106, 229, 162, 98, 184, 123, 85, 7, 131, 19, 78,
-			169, 173, 172, 218, 40, 232, 237, 94, 93, 132, 238, 166, 7, 234,
-			169, 237, 57, 205, 117, 101, 175, 52, 96, 199, 224, 7, 117, 161,
-			22, 117, 201, 30, 98, 90, 249, 95, 36, 244, 195, 228, 188, 98,
-			0, 201, 33, 197, 5, 13, 34, 3, 122, 142, 104, 208, 0, 112,
-			244, 24, 251, 3, 201, 30, 106, 153, 111, 0, 123, 222, 188, 31,
-			123, 192, 130, 81, 167, 9, 246, 97, 79, 39, 111, 20, 43, 96,
-			62, 235, 220, 224, 12, 237, 176, 215, 84, 204, 6, 43, 66, 54,
-			204, 56, 236, 65, 31, 154, 17, 49, 31, 50, 123, 95, 109, 114,
-			75, 206, 80, 211, 202, 191, 65, 232, 47, 18, 45, 41, 52, 135,
-			196, 106, 206, 128, 104, 188, 145, 112, 134, 26, 0, 142, 30, 99,
-			191, 77, 145, 51, 134, 101, 254, 50, 161, 35, 246, 175, 83, 53,
-			73, 58, 76, 156, 204, 82, 175, 39, 29, 42, 2, 57, 75, 83,
-			210, 129, 172, 18, 247, 162, 75, 25, 239, 139, 52, 185, 210, 231,
-			3, 100, 91, 106, 193, 170, 99, 133, 50, 95, 80, 213, 220, 26,
-			38, 89, 111, 186, 158, 12, 33, 58, 17, 174, 49, 101, 166, 172,
-			152, 108, 227, 105, 19, 101, 175, 81, 162, 24, 22, 247, 36, 55,
-			140, 241, 186, 159, 109, 42, 131, 98, 162, 161, 87, 227, 38, 99,
-			109, 190, 229, 168, 20, 126, 137, 161, 68, 79, 141, 134, 97, 90,
-			249, 95, 38, 244, 141, 120, 52, 140, 28, 50, 88, 143, 134, 65,
-			0, 236, 25, 212, 32, 178, 127, 248, 8, 139, 96, 48, 10, 93,
-			86, 254, 87, 8, 253, 85, 98, 216, 117, 57, 26, 154, 225, 10,
-			173, 248, 12, 133, 196, 10, 22, 127, 244, 2, 186, 33, 111, 249,
-			173, 182, 76, 240, 192, 12, 122, 216, 182, 51, 222, 116, 162, 218,
-			150, 86, 92, 19, 33, 191, 155, 10, 36, 221, 85, 251, 19, 179,
-			0, 170, 229, 87, 72, 161, 159, 205, 160, 68, 152, 150, 249, 73,
-			98, 14, 217, 167, 228, 190, 67, 10, 238, 37, 28, 160, 80, 103,
-			123, 131, 90, 86, 38, 143, 9, 155, 78, 120, 67, 147, 8, 106,
-			248, 147, 164, 231, 144, 6, 13, 0, 7, 44, 86, 194, 214, 115,
-			150, 249, 47, 137, 121, 212, 62, 153, 181, 74, 47, 201, 68, 221,
-			80, 160, 217, 16, 55, 157, 203, 99, 117, 166, 65, 2, 96, 175,
-			230, 94, 206, 0, 112, 120, 132, 157, 199, 166, 243, 150, 249, 63,
-			17, 115, 204, 62, 209, 105, 217, 93, 138, 11, 194, 184, 229, 188,
-			172, 221, 167, 65, 2, 224, 33, 61, 75, 242, 6, 128, 163, 54,
-			251, 58, 101, 212, 204, 89, 249, 223, 32, 93, 255, 136, 18, 251,
-			63, 80, 233, 119, 172, 196, 39, 56, 60, 125, 154, 195, 139, 124,
-			220, 65, 71, 211, 129, 8, 35, 181, 82, 96, 146, 190, 84, 33,
-			153, 4, 118, 208, 8, 120, 220, 5, 223, 117, 2, 193, 55, 133,
-			39, 2, 28, 191, 245, 93, 233, 251, 197, 61, 169, 11, 130, 151,
-			221, 164, 162, 159, 203, 139, 93, 34, 157, 121, 241, 60, 20, 168,
-			140, 100, 34, 172, 222, 5, 198, 26, 124, 35, 112, 154, 34, 44,
-			39, 22, 29, 72, 73, 75, 57, 63, 39, 80, 235, 184, 53, 185,
-			222, 199, 169, 20, 160, 24, 101, 15, 37, 229, 107, 83, 59, 35,
-			183, 41, 112, 122, 71, 190, 116, 196, 97, 227, 176, 50, 202, 161,
-			205, 238, 29, 246, 67, 120, 189, 225, 175, 171, 213, 27, 198, 246,
-			55, 96, 245, 254, 34, 168, 236, 28, 172, 222, 191, 73, 232, 184,
-			253, 175, 149, 202, 222, 39, 142, 146, 44, 171, 169, 38, 59, 85,
-			183, 158, 217, 48, 24, 177, 167, 70, 173, 75, 251, 181, 169, 111,
-			134, 112, 148, 43, 131, 215, 28, 143, 241, 23, 61, 127, 103, 143,
-			51, 25, 122, 213, 238, 43, 24, 182, 186, 191, 227, 53, 124, 39,
-			222, 14, 99, 199, 74, 53, 228, 112, 133, 255, 77, 66, 127, 67,
-			173, 240, 57, 92, 225, 127, 147, 208, 35, 26, 36, 0, 142, 216,
-			26, 52, 0, 60, 113, 146, 253, 15, 200, 15, 163, 203, 202, 127,
-			137, 208, 255, 135, 24, 246, 47, 16, 198, 229, 96, 202, 241, 118,
-			189, 205, 134, 166, 50, 101, 162, 137, 228, 156, 81, 205, 111, 182,
-			124, 244, 186, 108, 100, 4, 68, 45, 92, 37, 46, 156, 218, 22,
-			175, 249, 129, 60, 10, 87, 87, 215, 81, 56, 44, 181, 37, 230,
-			161, 231, 180, 194, 45, 31, 41, 223, 235, 224, 146, 83, 52, 7,
-			123, 8, 243, 75, 132, 245, 179, 159, 134, 237, 123, 14, 119, 17,
-			230, 87, 136, 57, 98, 191, 196, 14, 218, 85, 10, 149, 206, 158,
-			26, 69, 213, 65, 85, 212, 252, 160, 94, 89, 82, 43, 142, 218,
-			190, 176, 68, 156, 247, 224, 140, 226, 160, 151, 163, 65, 214, 45,
-			81, 48, 173, 252, 87, 136, 249, 37, 50, 136, 6, 119, 78, 238,
-			51, 0, 45, 150, 20, 16, 40, 232, 77, 213, 48, 160, 0, 140,
-			99, 170, 40, 33, 150, 249, 71, 196, 28, 181, 255, 249, 91, 94,
-			43, 223, 182, 165, 81, 46, 57, 184, 62, 254, 213, 89, 26, 245,
-			48, 128, 17, 247, 71, 196, 252, 10, 25, 137, 153, 12, 102, 220,
-			31, 165, 135, 129, 32, 147, 123, 135, 146, 2, 3, 10, 70, 142,
-			178, 127, 166, 5, 138, 90, 230, 183, 137, 121, 220, 254, 135, 41,
-			143, 173, 68, 81, 229, 50, 226, 149, 49, 32, 29, 73, 108, 231,
-			62, 129, 135, 245, 221, 216, 105, 9, 122, 44, 9, 61, 196, 182,
-			122, 44, 109, 202, 10, 115, 148, 2, 96, 177, 79, 83, 91, 126,
-			169, 168, 141, 38, 26, 236, 179, 111, 19, 243, 143, 200, 104, 76,
-			18, 44, 130, 223, 78, 19, 13, 146, 245, 109, 210, 123, 52, 41,
-			48, 160, 192, 30, 99, 63, 167, 137, 54, 44, 243, 187, 64, 244,
-			171, 138, 232, 244, 46, 70, 111, 191, 227, 61, 218, 219, 77, 46,
-			218, 228, 241, 68, 215, 148, 129, 173, 243, 93, 98, 126, 155, 28,
-			143, 241, 6, 107, 231, 187, 105, 202, 192, 222, 249, 110, 154, 50,
-			3, 9, 177, 199, 216, 119, 52, 101, 166, 101, 126, 143, 152, 211,
-			246, 215, 30, 134, 178, 18, 72, 110, 202, 65, 30, 166, 233, 203,
-			108, 213, 146, 144, 224, 68, 152, 217, 165, 41, 187, 41, 69, 59,
-			170, 148, 152, 252, 100, 215, 150, 234, 61, 99, 178, 31, 196, 66,
-			182, 15, 15, 215, 229, 101, 77, 41, 182, 193, 62, 251, 123, 196,
-			252, 110, 138, 109, 96, 65, 125, 143, 152, 169, 2, 2, 5, 39,
-			38, 147, 2, 3, 10, 206, 151, 216, 215, 40, 174, 142, 196, 50,
-			63, 72, 233, 9, 251, 119, 40, 227, 115, 41, 141, 238, 132, 53,
-			129, 186, 112, 26, 183, 14, 162, 174, 86, 10, 101, 57, 134, 73,
-			94, 25, 134, 96, 148, 74, 151, 175, 122, 108, 191, 53, 26, 24,
-			124, 91, 239, 62, 96, 7, 43, 135, 37, 219, 172, 116, 182, 23,
-			229, 168, 21, 75, 188, 152, 142, 244, 23, 75, 140, 23, 211, 113,
-			253, 162, 52, 31, 138, 169, 64, 190, 26, 150, 48, 246, 212, 199,
-			132, 232, 197, 108, 35, 144, 218, 118, 119, 111, 239, 218, 79, 86,
-			23, 27, 174, 39, 234, 151, 185, 43, 199, 170, 165, 101, 33, 182,
-			165, 24, 111, 5, 126, 13, 3, 47, 62, 175, 109, 249, 126, 40,
-			184, 147, 52, 173, 87, 49, 116, 161, 124, 144, 210, 24, 204, 3,
-			216, 59, 160, 65, 228, 254, 224, 168, 6, 13, 0, 199, 142, 179,
-			95, 151, 150, 11, 181, 204, 143, 80, 58, 46, 189, 40, 171, 177,
-			83, 72, 238, 5, 181, 181, 184, 119, 253, 140, 197, 216, 111, 201,
-			67, 239, 120, 84, 90, 58, 216, 34, 52, 234, 65, 175, 201, 211,
-			69, 158, 159, 137, 100, 59, 235, 126, 59, 138, 253, 79, 44, 211,
-			87, 137, 203, 69, 19, 79, 166, 10, 108, 47, 222, 176, 42, 52,
-			226, 248, 169, 52, 84, 64, 99, 125, 132, 210, 15, 210, 19, 138,
-			64, 208, 87, 31, 161, 106, 15, 147, 67, 109, 245, 17, 218, 163,
-			13, 21, 80, 77, 31, 161, 39, 78, 106, 242, 13, 203, 124, 125,
-			47, 249, 153, 131, 226, 127, 201, 228, 167, 251, 122, 8, 242, 51,
-			27, 234, 28, 170, 181, 215, 41, 253, 8, 29, 87, 4, 130, 82,
-			123, 61, 33, 31, 84, 218, 235, 9, 249, 6, 210, 123, 226, 36,
-			251, 95, 36, 249, 166, 101, 190, 1, 51, 243, 95, 104, 242, 179,
-			231, 100, 15, 234, 251, 109, 33, 95, 118, 197, 58, 250, 122, 235,
-			44, 0, 21, 245, 6, 165, 175, 199, 44, 48, 115, 72, 149, 102,
-			1, 168, 167, 55, 104, 143, 158, 0, 160, 156, 222, 160, 99, 199,
-			227, 172, 203, 87, 95, 39, 236, 221, 63, 244, 69, 38, 240, 111,
-			199, 61, 38, 111, 235, 237, 40, 246, 15, 144, 25, 250, 195, 167,
-			132, 254, 109, 131, 177, 155, 34, 170, 202, 203, 39, 172, 81, 214,
-			221, 10, 252, 23, 68, 45, 82, 185, 135, 26, 180, 44, 102, 182,
-			156, 104, 75, 231, 130, 194, 111, 107, 152, 229, 144, 0, 149, 181,
-			39, 129, 36, 233, 207, 196, 131, 185, 42, 233, 239, 4, 99, 96,
-			234, 165, 18, 186, 114, 213, 30, 40, 193, 100, 46, 107, 140, 245,
-			52, 252, 77, 245, 52, 143, 79, 11, 13, 127, 83, 62, 60, 195,
-			14, 123, 190, 119, 39, 217, 64, 98, 158, 102, 161, 122, 200, 243,
-			189, 36, 182, 104, 85, 88, 255, 166, 136, 238, 200, 3, 50, 119,
-			218, 65, 35, 28, 45, 96, 66, 214, 41, 125, 81, 75, 66, 105,
-			121, 197, 221, 244, 214, 170, 11, 10, 172, 30, 218, 20, 145, 204,
-			169, 94, 11, 26, 161, 221, 102, 135, 179, 21, 172, 199, 89, 161,
-			225, 110, 8, 224, 239, 131, 147, 15, 227, 170, 214, 8, 203, 75,
-			105, 70, 198, 21, 170, 10, 74, 152, 164, 88, 39, 87, 162, 247,
-			176, 222, 85, 199, 109, 188, 141, 163, 81, 252, 58, 101, 189, 72,
-			182, 188, 85, 228, 62, 109, 150, 244, 251, 50, 209, 115, 100, 255,
-			219, 109, 244, 40, 235, 244, 66, 227, 33, 211, 11, 31, 97, 38,
-			8, 253, 168, 137, 185, 133, 253, 73, 125, 149, 145, 11, 15, 173,
-			191, 198, 122, 211, 163, 39, 211, 233, 78, 102, 70, 79, 146, 81,
-			78, 198, 170, 202, 194, 100, 220, 182, 25, 75, 158, 88, 151, 24,
-			195, 107, 7, 112, 80, 226, 68, 200, 131, 179, 138, 83, 181, 59,
-			6, 174, 103, 255, 129, 235, 209, 3, 247, 122, 142, 245, 189, 167,
-			45, 130, 221, 183, 121, 34, 33, 146, 234, 194, 30, 9, 96, 82,
-			182, 184, 39, 167, 80, 79, 21, 127, 91, 227, 172, 183, 233, 220,
-			187, 19, 136, 176, 221, 136, 66, 53, 127, 88, 211, 185, 87, 149,
-			37, 123, 50, 176, 217, 222, 12, 236, 27, 217, 196, 110, 153, 164,
-			122, 70, 243, 62, 77, 92, 42, 205, 251, 134, 219, 136, 68, 144,
-			73, 246, 158, 101, 57, 79, 236, 136, 64, 229, 174, 222, 143, 223,
-			178, 34, 188, 225, 55, 234, 34, 24, 61, 244, 224, 55, 176, 226,
-			222, 203, 160, 14, 239, 115, 25, 212, 5, 149, 24, 222, 143, 18,
-			119, 114, 95, 74, 58, 83, 194, 47, 198, 183, 52, 13, 96, 98,
-			251, 241, 253, 223, 10, 112, 135, 27, 223, 225, 116, 153, 13, 116,
-			178, 196, 154, 72, 231, 112, 239, 155, 33, 47, 159, 255, 224, 217,
-			229, 167, 89, 183, 66, 196, 42, 48, 243, 234, 210, 234, 173, 129,
-			46, 171, 155, 25, 207, 205, 175, 12, 16, 43, 207, 232, 226, 210,
-			0, 45, 254, 28, 101, 135, 20, 242, 15, 212, 0, 79, 176, 110,
-			181, 133, 84, 41, 192, 157, 228, 235, 201, 135, 149, 170, 186, 114,
-			44, 146, 70, 34, 146, 246, 223, 39, 44, 47, 235, 197, 18, 79,
-			82, 18, 255, 151, 171, 108, 78, 48, 6, 255, 222, 73, 166, 79,
-			95, 181, 7, 74, 240, 110, 134, 226, 127, 38, 172, 119, 193, 13,
-			31, 98, 213, 27, 99, 61, 128, 238, 157, 117, 39, 212, 35, 80,
-			128, 130, 171, 78, 40, 14, 152, 181, 154, 25, 102, 118, 126, 170,
-			185, 229, 123, 141, 93, 117, 3, 152, 154, 52, 75, 94, 99, 23,
-			86, 56, 229, 252, 184, 163, 228, 47, 47, 87, 56, 85, 186, 140,
-			133, 169, 68, 231, 110, 156, 226, 58, 209, 185, 99, 254, 23, 58,
-			231, 127, 241, 255, 165, 172, 79, 82, 252, 64, 33, 184, 47, 201,
-			251, 140, 180, 245, 46, 198, 208, 163, 231, 193, 22, 84, 233, 247,
-			120, 182, 165, 59, 45, 95, 211, 213, 170, 169, 55, 236, 255, 68,
-			88, 79, 252, 36, 62, 115, 66, 82, 103, 78, 222, 193, 76, 212,
-			73, 20, 167, 210, 35, 247, 111, 187, 140, 147, 11, 95, 72, 164,
-			204, 120, 43, 82, 102, 62, 156, 148, 21, 167, 152, 169, 83, 183,
-			151, 231, 112, 246, 49, 150, 95, 89, 173, 206, 207, 61, 51, 64,
-			172, 94, 214, 189, 92, 93, 122, 122, 254, 218, 234, 0, 189, 240,
-			187, 132, 153, 11, 176, 194, 149, 153, 113, 83, 68, 150, 181, 215,
-			34, 177, 135, 246, 89, 231, 172, 89, 102, 130, 69, 96, 197, 15,
-			83, 246, 193, 254, 111, 92, 100, 57, 156, 173, 214, 240, 126, 186,
-			203, 62, 178, 239, 148, 182, 30, 101, 38, 176, 51, 233, 39, 53,
-			63, 236, 225, 253, 56, 254, 244, 63, 126, 149, 200, 116, 244, 191,
-			184, 127, 58, 250, 95, 181, 11, 225, 214, 146, 116, 244, 203, 248,
-			147, 90, 6, 83, 73, 234, 134, 101, 244, 118, 79, 176, 127, 75,
-			101, 242, 247, 112, 215, 123, 136, 253, 171, 148, 39, 163, 168, 157,
-			148, 234, 54, 55, 117, 137, 91, 59, 72, 206, 174, 181, 67, 17,
-			192, 11, 92, 239, 12, 226, 84, 177, 248, 173, 172, 243, 89, 220,
-			115, 195, 40, 44, 113, 71, 101, 19, 167, 58, 147, 25, 218, 237,
-			90, 77, 8, 60, 172, 189, 233, 4, 117, 60, 195, 231, 111, 240,
-			157, 45, 17, 95, 253, 145, 109, 23, 175, 116, 198, 11, 158, 226,
-			180, 65, 192, 97, 209, 143, 68, 198, 103, 41, 209, 195, 11, 64,
-			2, 17, 181, 3, 143, 111, 192, 122, 13, 109, 168, 83, 130, 73,
-			187, 117, 25, 159, 151, 91, 66, 166, 27, 118, 27, 110, 180, 203,
-			245, 165, 213, 158, 211, 224, 53, 223, 11, 163, 192, 113, 189, 204,
-			197, 118, 195, 5, 139, 149, 117, 62, 251, 8, 61, 98, 159, 66,
-			153, 209, 76, 84, 170, 73, 102, 52, 200, 37, 56, 157, 29, 62,
-			66, 135, 135, 83, 217, 225, 35, 153, 236, 240, 145, 158, 129, 84,
-			118, 248, 200, 208, 48, 251, 151, 84, 103, 135, 143, 83, 203, 254,
-			24, 197, 174, 64, 195, 237, 141, 35, 64, 143, 155, 34, 201, 195,
-			0, 129, 82, 187, 222, 117, 193, 29, 157, 101, 170, 42, 203, 54,
-			36, 199, 87, 110, 205, 93, 120, 252, 9, 190, 229, 132, 91, 50,
-			204, 161, 179, 143, 83, 117, 161, 217, 21, 191, 41, 120, 59, 2,
-			70, 193, 6, 24, 120, 189, 225, 122, 117, 222, 114, 194, 80, 134,
-			217, 80, 120, 29, 25, 233, 81, 253, 97, 71, 17, 102, 37, 215,
-			112, 143, 29, 250, 77, 193, 244, 24, 184, 81, 200, 229, 181, 0,
-			232, 20, 223, 69, 172, 253, 22, 178, 15, 154, 213, 109, 2, 154,
-			136, 159, 235, 133, 145, 112, 234, 50, 67, 43, 16, 176, 21, 223,
-			22, 114, 148, 229, 181, 234, 110, 148, 57, 252, 56, 78, 71, 142,
-			164, 178, 204, 199, 51, 89, 230, 227, 153, 44, 243, 241, 129, 65,
-			86, 209, 89, 230, 69, 58, 104, 63, 149, 36, 42, 169, 161, 220,
-			247, 250, 177, 137, 80, 165, 138, 225, 85, 1, 32, 123, 162, 158,
-			185, 122, 175, 72, 199, 173, 212, 213, 123, 69, 121, 224, 67, 165,
-			157, 23, 187, 251, 82, 105, 231, 197, 254, 1, 149, 233, 110, 88,
-			198, 25, 106, 169, 76, 119, 215, 115, 49, 144, 148, 14, 88, 74,
-			183, 189, 31, 51, 33, 147, 207, 125, 134, 22, 7, 83, 249, 220,
-			103, 226, 51, 159, 160, 43, 206, 196, 103, 62, 241, 206, 137, 129,
-			65, 246, 127, 82, 157, 207, 61, 77, 143, 218, 95, 145, 98, 214,
-			116, 238, 185, 205, 118, 51, 229, 133, 129, 45, 112, 168, 250, 108,
-			7, 94, 89, 95, 52, 38, 125, 45, 210, 117, 168, 83, 207, 97,
-			198, 178, 212, 20, 130, 215, 48, 237, 52, 229, 110, 81, 222, 22,
-			197, 85, 121, 118, 55, 230, 159, 202, 38, 242, 26, 122, 70, 135,
-			241, 253, 110, 248, 82, 153, 207, 233, 75, 237, 177, 13, 60, 250,
-			161, 117, 4, 98, 3, 26, 135, 105, 117, 224, 68, 188, 33, 156,
-			48, 194, 195, 187, 192, 199, 73, 177, 45, 60, 238, 110, 64, 205,
-			109, 215, 111, 196, 119, 146, 97, 42, 92, 130, 248, 148, 60, 42,
-			28, 50, 222, 116, 188, 93, 238, 212, 229, 13, 73, 114, 52, 66,
-			160, 83, 221, 225, 160, 111, 13, 18, 247, 64, 197, 201, 108, 22,
-			153, 31, 165, 90, 202, 164, 158, 79, 211, 51, 86, 234, 46, 178,
-			233, 120, 132, 76, 98, 25, 211, 5, 43, 149, 122, 62, 125, 100,
-			132, 253, 61, 170, 83, 207, 47, 210, 17, 251, 181, 131, 70, 8,
-			85, 38, 70, 254, 194, 172, 6, 138, 19, 35, 227, 100, 28, 57,
-			104, 158, 175, 46, 220, 72, 141, 84, 236, 72, 150, 67, 89, 206,
-			190, 203, 224, 229, 228, 240, 117, 220, 76, 218, 69, 167, 91, 136,
-			135, 51, 81, 73, 201, 245, 76, 140, 111, 136, 168, 182, 149, 22,
-			234, 36, 242, 16, 198, 236, 196, 74, 168, 94, 188, 221, 52, 125,
-			153, 204, 247, 139, 116, 250, 168, 206, 110, 71, 30, 117, 167, 50,
-			223, 47, 22, 6, 83, 153, 239, 23, 135, 143, 176, 87, 77, 157,
-			249, 126, 149, 218, 246, 119, 141, 204, 165, 133, 120, 181, 58, 104,
-			116, 92, 122, 180, 94, 143, 165, 30, 37, 62, 149, 31, 144, 160,
-			195, 231, 120, 166, 92, 190, 56, 89, 23, 27, 78, 187, 17, 77,
-			169, 108, 211, 8, 147, 21, 244, 109, 201, 97, 234, 92, 83, 91,
-			242, 155, 113, 153, 35, 1, 98, 23, 70, 126, 11, 100, 52, 117,
-			53, 150, 192, 179, 13, 169, 188, 5, 225, 225, 8, 98, 200, 34,
-			118, 87, 186, 56, 253, 48, 149, 208, 203, 234, 140, 50, 159, 227,
-			139, 105, 95, 84, 140, 41, 226, 23, 136, 166, 191, 173, 238, 145,
-			116, 162, 8, 22, 200, 248, 86, 48, 160, 241, 134, 31, 112, 33,
-			175, 150, 40, 241, 208, 217, 237, 92, 133, 64, 142, 192, 6, 224,
-			254, 198, 37, 198, 127, 226, 177, 18, 191, 88, 226, 79, 148, 248,
-			59, 126, 242, 32, 6, 97, 54, 172, 36, 249, 49, 141, 3, 48,
-			250, 146, 124, 251, 39, 75, 200, 133, 22, 208, 177, 46, 106, 78,
-			59, 20, 140, 63, 142, 199, 102, 36, 117, 64, 208, 158, 49, 201,
-			80, 132, 195, 150, 70, 37, 115, 192, 224, 42, 189, 56, 146, 58,
-			96, 112, 53, 86, 207, 121, 98, 25, 87, 187, 143, 164, 14, 24,
-			92, 29, 61, 198, 190, 66, 244, 213, 168, 55, 233, 146, 97, 255,
-			123, 188, 241, 82, 143, 93, 73, 153, 48, 234, 90, 92, 236, 95,
-			101, 123, 114, 135, 39, 238, 158, 56, 141, 46, 185, 19, 45, 177,
-			79, 164, 143, 136, 175, 85, 23, 66, 156, 123, 41, 24, 181, 156,
-			231, 71, 216, 161, 31, 164, 146, 94, 48, 7, 133, 197, 215, 184,
-			235, 91, 46, 67, 188, 163, 190, 3, 173, 164, 115, 57, 77, 49,
-			221, 63, 115, 85, 235, 77, 54, 202, 110, 37, 87, 181, 86, 204,
-			115, 246, 147, 234, 8, 129, 116, 22, 38, 11, 97, 130, 93, 220,
-			222, 186, 92, 179, 253, 50, 46, 234, 217, 27, 90, 43, 230, 205,
-			228, 154, 209, 174, 60, 180, 125, 60, 115, 67, 107, 229, 196, 153,
-			204, 13, 173, 149, 201, 41, 246, 116, 114, 67, 235, 130, 57, 108,
-			95, 230, 85, 165, 212, 211, 189, 167, 110, 159, 157, 8, 147, 252,
-			135, 248, 134, 97, 117, 232, 41, 125, 33, 235, 130, 89, 57, 151,
-			185, 144, 117, 33, 117, 165, 39, 88, 8, 11, 61, 253, 153, 11,
-			89, 23, 172, 33, 54, 159, 92, 200, 186, 104, 14, 217, 79, 60,
-			4, 50, 113, 222, 83, 236, 38, 202, 222, 189, 186, 104, 46, 12,
-			103, 238, 94, 93, 76, 225, 1, 84, 47, 246, 28, 206, 220, 189,
-			186, 56, 104, 97, 254, 114, 23, 237, 182, 140, 101, 122, 70, 137,
-			115, 183, 105, 153, 203, 116, 73, 95, 207, 217, 157, 135, 135, 218,
-			166, 236, 38, 150, 177, 60, 200, 53, 100, 88, 198, 242, 35, 167,
-			217, 63, 32, 242, 192, 204, 90, 87, 157, 216, 31, 38, 60, 181,
-			103, 123, 200, 253, 1, 188, 145, 108, 16, 120, 37, 210, 235, 53,
-			75, 242, 186, 101, 162, 1, 119, 248, 166, 11, 171, 110, 58, 159,
-			75, 74, 81, 250, 240, 87, 178, 106, 233, 131, 58, 107, 133, 33,
-			180, 185, 241, 116, 199, 237, 135, 183, 185, 241, 0, 136, 121, 155,
-			174, 197, 167, 60, 114, 240, 126, 33, 117, 2, 228, 182, 178, 185,
-			229, 9, 144, 219, 218, 230, 38, 120, 186, 227, 71, 54, 247, 91,
-			179, 185, 9, 206, 170, 187, 244, 182, 230, 55, 204, 169, 187, 49,
-			191, 97, 40, 239, 42, 155, 155, 224, 124, 186, 171, 108, 110, 2,
-			179, 169, 246, 182, 216, 220, 4, 103, 84, 141, 222, 181, 84, 63,
-			48, 159, 106, 74, 169, 19, 156, 77, 53, 101, 115, 19, 156, 75,
-			181, 254, 1, 182, 132, 167, 126, 114, 155, 93, 31, 34, 196, 190,
-			202, 83, 62, 138, 100, 18, 100, 174, 58, 127, 208, 46, 89, 31,
-			16, 218, 44, 128, 194, 80, 7, 132, 92, 122, 196, 126, 39, 95,
-			86, 210, 154, 220, 128, 142, 32, 30, 188, 213, 164, 134, 138, 191,
-			235, 2, 63, 57, 18, 249, 138, 56, 121, 60, 200, 165, 155, 71,
-			82, 199, 131, 220, 204, 241, 32, 183, 103, 32, 117, 60, 200, 29,
-			26, 150, 43, 22, 18, 238, 211, 49, 251, 77, 210, 153, 82, 153,
-			58, 2, 46, 109, 14, 101, 159, 116, 220, 195, 14, 51, 91, 89,
-			112, 201, 90, 181, 190, 203, 67, 17, 69, 58, 119, 91, 61, 152,
-			8, 185, 188, 67, 62, 206, 24, 129, 33, 213, 23, 152, 51, 121,
-			237, 172, 122, 8, 108, 80, 135, 65, 132, 124, 107, 34, 228, 233,
-			76, 197, 228, 164, 14, 44, 124, 235, 73, 234, 85, 204, 19, 16,
-			58, 159, 186, 154, 39, 36, 15, 148, 14, 164, 206, 51, 249, 131,
-			35, 169, 243, 76, 254, 49, 155, 253, 223, 146, 39, 212, 50, 118,
-			232, 25, 251, 91, 146, 39, 226, 94, 203, 241, 234, 162, 190, 111,
-			118, 99, 172, 186, 227, 203, 229, 34, 225, 233, 15, 93, 61, 189,
-			178, 180, 40, 119, 59, 237, 102, 75, 155, 74, 202, 81, 146, 248,
-			64, 48, 159, 63, 67, 121, 250, 142, 107, 189, 122, 198, 249, 200,
-			151, 153, 188, 75, 117, 199, 13, 21, 123, 66, 188, 82, 213, 125,
-			89, 94, 96, 43, 151, 53, 253, 154, 190, 157, 84, 37, 110, 36,
-			152, 99, 151, 44, 115, 250, 149, 226, 28, 217, 161, 254, 152, 98,
-			11, 152, 194, 59, 52, 134, 136, 101, 236, 28, 231, 26, 50, 44,
-			99, 231, 145, 211, 236, 175, 49, 153, 4, 111, 188, 76, 31, 177,
-			47, 224, 29, 2, 169, 203, 254, 36, 122, 152, 224, 162, 85, 68,
-			189, 195, 92, 151, 205, 25, 38, 180, 16, 67, 121, 203, 120, 185,
-			247, 152, 134, 136, 101, 188, 108, 159, 212, 16, 244, 117, 170, 200,
-			222, 13, 29, 27, 93, 86, 238, 21, 250, 55, 136, 97, 63, 197,
-			111, 249, 141, 122, 120, 80, 30, 67, 70, 71, 200, 5, 89, 102,
-			118, 173, 85, 23, 52, 18, 104, 227, 188, 194, 134, 217, 101, 150,
-			7, 8, 38, 231, 7, 204, 105, 187, 180, 231, 35, 1, 110, 184,
-			199, 192, 145, 183, 131, 171, 21, 156, 42, 179, 230, 3, 230, 43,
-			242, 218, 110, 170, 204, 154, 15, 152, 39, 18, 152, 88, 198, 7,
-			78, 78, 38, 176, 97, 25, 31, 56, 95, 98, 151, 84, 239, 196,
-			50, 127, 134, 152, 35, 246, 57, 117, 20, 11, 251, 75, 77, 208,
-			181, 234, 66, 9, 118, 1, 241, 180, 43, 51, 54, 160, 218, 34,
-			166, 149, 251, 25, 98, 126, 96, 58, 70, 135, 228, 176, 185, 4,
-			63, 130, 237, 171, 252, 76, 170, 18, 3, 127, 134, 12, 31, 97,
-			79, 42, 4, 168, 101, 190, 70, 204, 35, 246, 84, 39, 2, 210,
-			203, 176, 95, 255, 131, 170, 45, 106, 90, 249, 215, 136, 249, 51,
-			42, 51, 145, 170, 36, 189, 215, 210, 8, 0, 129, 175, 145, 222,
-			129, 164, 192, 128, 130, 161, 97, 188, 214, 12, 101, 241, 131, 132,
-			158, 176, 215, 249, 156, 23, 103, 113, 164, 209, 144, 3, 184, 215,
-			164, 219, 131, 24, 223, 118, 29, 198, 139, 240, 170, 186, 148, 165,
-			29, 52, 238, 104, 163, 181, 168, 210, 52, 40, 166, 105, 124, 144,
-			128, 56, 169, 249, 96, 230, 17, 135, 62, 13, 18, 0, 15, 141,
-			106, 208, 0, 112, 236, 56, 171, 226, 49, 200, 252, 223, 33, 93,
-			223, 32, 196, 190, 206, 211, 238, 234, 135, 52, 151, 240, 149, 244,
-			82, 209, 43, 79, 75, 154, 127, 135, 20, 134, 217, 227, 204, 52,
-			13, 179, 203, 202, 255, 93, 66, 255, 91, 98, 216, 103, 184, 138,
-			155, 101, 47, 214, 140, 84, 161, 188, 70, 64, 78, 106, 116, 79,
-			154, 127, 151, 116, 203, 147, 200, 6, 122, 40, 205, 143, 16, 243,
-			144, 253, 4, 191, 234, 71, 91, 201, 109, 103, 160, 230, 227, 235,
-			206, 84, 36, 102, 239, 166, 93, 157, 53, 148, 206, 73, 104, 168,
-			144, 20, 80, 40, 232, 237, 67, 9, 50, 208, 67, 105, 254, 61,
-			98, 246, 217, 83, 124, 201, 107, 236, 38, 61, 61, 68, 227, 68,
-			190, 219, 157, 20, 80, 40, 96, 189, 113, 227, 212, 50, 127, 129,
-			152, 189, 186, 241, 183, 130, 57, 32, 246, 11, 196, 204, 39, 5,
-			216, 88, 15, 195, 35, 27, 6, 112, 232, 151, 200, 195, 25, 148,
-			253, 250, 148, 106, 254, 151, 112, 108, 244, 177, 213, 28, 54, 81,
-			208, 32, 1, 176, 103, 64, 131, 6, 128, 67, 195, 236, 255, 40,
-			168, 227, 134, 230, 39, 9, 181, 236, 223, 46, 96, 135, 242, 156,
-			127, 203, 9, 156, 166, 136, 68, 160, 51, 246, 208, 128, 83, 23,
-			29, 128, 122, 3, 179, 47, 108, 175, 135, 145, 27, 181, 35, 48,
-			44, 55, 27, 254, 58, 159, 44, 158, 43, 78, 161, 112, 165, 82,
-			80, 225, 85, 198, 227, 144, 21, 152, 120, 120, 204, 165, 132, 254,
-			19, 109, 182, 66, 25, 30, 113, 17, 90, 102, 155, 142, 235, 41,
-			87, 129, 146, 218, 151, 218, 78, 195, 221, 192, 163, 105, 89, 111,
-			61, 24, 144, 202, 141, 164, 46, 65, 77, 122, 199, 113, 199, 233,
-			171, 215, 27, 152, 211, 109, 15, 183, 132, 120, 7, 66, 163, 94,
-			115, 130, 58, 146, 228, 180, 90, 194, 9, 244, 181, 175, 10, 99,
-			189, 35, 80, 126, 142, 117, 95, 111, 144, 91, 201, 145, 48, 180,
-			149, 36, 239, 146, 224, 92, 153, 23, 207, 157, 43, 198, 100, 57,
-			210, 153, 161, 200, 74, 85, 75, 86, 102, 189, 107, 151, 252, 150,
-			237, 197, 7, 211, 147, 109, 59, 62, 13, 5, 140, 18, 24, 2,
-			147, 197, 243, 197, 169, 148, 131, 113, 93, 240, 72, 126, 157, 9,
-			157, 129, 27, 169, 196, 64, 80, 9, 128, 84, 234, 62, 214, 240,
-			18, 227, 156, 79, 243, 121, 60, 98, 63, 89, 44, 78, 101, 188,
-			20, 78, 252, 1, 151, 176, 44, 43, 158, 59, 55, 115, 126, 230,
-			220, 185, 7, 212, 218, 240, 253, 153, 117, 39, 184, 79, 197, 228,
-			155, 54, 69, 85, 185, 24, 167, 47, 118, 52, 49, 115, 126, 102,
-			221, 121, 249, 192, 134, 48, 135, 222, 139, 15, 50, 103, 154, 148,
-			217, 235, 29, 67, 85, 231, 197, 117, 231, 229, 34, 159, 20, 229,
-			205, 114, 41, 174, 60, 243, 82, 251, 222, 76, 195, 111, 200, 238,
-			138, 83, 89, 52, 238, 71, 180, 190, 215, 241, 62, 148, 60, 136,
-			8, 213, 66, 180, 227, 79, 39, 162, 167, 240, 222, 217, 242, 67,
-			33, 41, 145, 89, 250, 177, 211, 20, 58, 44, 34, 93, 88, 71,
-			10, 34, 148, 3, 1, 89, 62, 30, 220, 115, 246, 77, 77, 130,
-			30, 238, 153, 7, 141, 96, 6, 99, 188, 12, 63, 86, 78, 196,
-			180, 242, 159, 36, 244, 151, 200, 17, 125, 160, 57, 135, 250, 70,
-			43, 39, 146, 62, 96, 134, 103, 152, 205, 79, 146, 129, 65, 86,
-			71, 221, 68, 45, 243, 211, 132, 14, 218, 207, 166, 247, 96, 210,
-			243, 158, 120, 123, 36, 42, 19, 234, 52, 79, 231, 30, 44, 222,
-			43, 250, 27, 252, 5, 60, 72, 7, 202, 98, 89, 110, 102, 37,
-			142, 96, 48, 124, 154, 208, 79, 170, 195, 60, 6, 154, 11, 159,
-			38, 52, 175, 65, 2, 96, 119, 159, 6, 13, 0, 251, 7, 216,
-			7, 241, 172, 63, 128, 159, 5, 36, 95, 78, 144, 68, 159, 96,
-			216, 17, 60, 148, 168, 201, 77, 112, 140, 29, 126, 216, 119, 143,
-			9, 205, 244, 167, 46, 98, 212, 235, 34, 85, 13, 108, 251, 68,
-			45, 38, 204, 54, 76, 43, 255, 89, 66, 63, 77, 6, 21, 170,
-			70, 14, 113, 211, 132, 192, 154, 254, 217, 132, 16, 3, 49, 239,
-			31, 96, 127, 29, 233, 48, 45, 243, 115, 176, 16, 180, 248, 162,
-			184, 23, 161, 21, 3, 90, 7, 93, 113, 165, 189, 223, 187, 113,
-			67, 165, 159, 212, 177, 111, 125, 13, 135, 214, 150, 104, 76, 176,
-			212, 71, 128, 90, 129, 216, 118, 253, 182, 126, 173, 33, 54, 192,
-			54, 223, 136, 177, 7, 11, 232, 115, 132, 126, 54, 198, 222, 204,
-			33, 70, 90, 84, 192, 2, 250, 92, 34, 42, 96, 1, 125, 14,
-			68, 229, 15, 228, 48, 228, 44, 243, 243, 132, 142, 194, 110, 242,
-			153, 56, 117, 67, 91, 64, 123, 227, 18, 18, 9, 189, 94, 39,
-			17, 36, 169, 139, 179, 45, 196, 17, 133, 118, 171, 37, 2, 229,
-			76, 212, 75, 188, 102, 76, 189, 204, 111, 249, 59, 98, 91, 4,
-			37, 237, 62, 85, 99, 44, 59, 81, 81, 141, 248, 163, 88, 235,
-			160, 237, 215, 245, 162, 126, 64, 136, 87, 114, 38, 103, 90, 249,
-			207, 19, 250, 185, 88, 64, 115, 146, 216, 110, 13, 18, 0, 11,
-			67, 26, 52, 0, 28, 57, 202, 182, 144, 49, 121, 203, 252, 119,
-			132, 142, 217, 207, 235, 115, 234, 171, 187, 45, 209, 57, 188, 250,
-			227, 197, 97, 154, 37, 123, 244, 116, 18, 16, 232, 56, 178, 47,
-			241, 204, 155, 86, 254, 223, 17, 250, 121, 50, 170, 48, 201, 231,
-			176, 111, 61, 130, 121, 2, 96, 143, 86, 5, 121, 3, 192, 81,
-			91, 26, 62, 70, 151, 149, 127, 147, 208, 223, 38, 134, 190, 0,
-			34, 185, 183, 142, 227, 14, 181, 17, 193, 208, 121, 218, 184, 196,
-			227, 105, 111, 18, 102, 179, 139, 241, 29, 23, 230, 111, 17, 115,
-			220, 62, 141, 239, 39, 201, 98, 74, 91, 118, 52, 50, 152, 92,
-			91, 145, 255, 45, 98, 190, 169, 206, 112, 24, 114, 187, 4, 45,
-			13, 39, 5, 4, 10, 142, 216, 73, 129, 1, 5, 39, 96, 99,
-			8, 60, 238, 182, 204, 223, 33, 244, 180, 226, 67, 183, 105, 229,
-			127, 7, 73, 81, 148, 118, 231, 241, 185, 30, 190, 110, 2, 224,
-			208, 73, 13, 26, 0, 158, 122, 132, 173, 98, 91, 5, 203, 252,
-			93, 66, 39, 236, 27, 124, 17, 19, 6, 238, 59, 52, 53, 189,
-			206, 111, 68, 42, 26, 163, 44, 31, 121, 14, 44, 25, 155, 130,
-			105, 229, 127, 151, 208, 223, 33, 167, 85, 175, 133, 60, 246, 51,
-			166, 65, 2, 224, 241, 83, 26, 52, 0, 60, 125, 150, 173, 33,
-			78, 61, 150, 249, 37, 192, 233, 38, 95, 106, 212, 31, 22, 39,
-			245, 201, 222, 251, 32, 213, 99, 226, 65, 200, 223, 37, 19, 170,
-			219, 158, 60, 118, 164, 145, 234, 193, 3, 136, 49, 82, 61, 6,
-			128, 167, 207, 178, 151, 17, 41, 102, 153, 191, 79, 232, 113, 187,
-			33, 163, 190, 177, 44, 199, 83, 40, 246, 147, 105, 12, 35, 92,
-			232, 228, 114, 183, 231, 59, 127, 242, 114, 33, 150, 177, 66, 99,
-			123, 76, 85, 138, 49, 103, 166, 149, 255, 125, 66, 191, 20, 99,
-			206, 114, 136, 141, 22, 117, 70, 0, 236, 209, 215, 120, 48, 3,
-			192, 99, 99, 236, 63, 81, 68, 189, 215, 50, 255, 144, 80, 110,
-			255, 30, 229, 171, 206, 102, 172, 166, 244, 49, 41, 40, 138, 252,
-			132, 16, 124, 40, 53, 22, 204, 69, 25, 217, 91, 117, 244, 54,
-			20, 44, 215, 216, 201, 123, 137, 241, 105, 62, 151, 186, 243, 11,
-			223, 195, 179, 86, 242, 243, 237, 53, 188, 7, 45, 197, 24, 39,
-			72, 69, 151, 165, 7, 173, 225, 111, 226, 241, 68, 201, 170, 200,
-			217, 212, 46, 46, 165, 254, 147, 214, 91, 142, 27, 148, 227, 46,
-			149, 17, 227, 197, 113, 190, 73, 207, 109, 76, 201, 249, 247, 0,
-			20, 160, 187, 148, 242, 211, 88, 232, 111, 110, 109, 107, 87, 164,
-			179, 9, 29, 149, 14, 218, 1, 196, 35, 212, 107, 90, 249, 63,
-			36, 244, 247, 201, 113, 53, 6, 189, 121, 100, 186, 214, 62, 189,
-			4, 64, 117, 68, 215, 160, 189, 6, 128, 39, 198, 217, 109, 28,
-			160, 62, 203, 252, 58, 161, 35, 118, 133, 203, 228, 193, 148, 196,
-			39, 99, 145, 146, 249, 4, 75, 63, 192, 127, 189, 137, 40, 253,
-			149, 176, 24, 173, 62, 211, 202, 127, 157, 208, 63, 36, 250, 146,
-			161, 190, 60, 118, 213, 163, 65, 2, 32, 211, 187, 181, 62, 3,
-			192, 161, 35, 108, 85, 94, 234, 242, 77, 210, 245, 183, 40, 177,
-			111, 240, 76, 2, 218, 195, 186, 132, 247, 221, 233, 195, 186, 250,
-			77, 82, 56, 130, 110, 111, 188, 105, 229, 91, 176, 255, 188, 252,
-			96, 183, 176, 220, 208, 200, 46, 179, 158, 225, 228, 30, 148, 111,
-			17, 250, 77, 114, 84, 223, 116, 146, 195, 198, 11, 169, 123, 80,
-			190, 165, 119, 166, 242, 30, 148, 111, 193, 206, 244, 26, 32, 2,
-			235, 193, 31, 19, 250, 211, 212, 176, 31, 211, 87, 121, 116, 124,
-			202, 3, 207, 74, 199, 11, 121, 76, 121, 114, 132, 217, 196, 53,
-			226, 143, 129, 157, 101, 150, 7, 16, 200, 251, 14, 49, 135, 237,
-			147, 104, 3, 198, 167, 119, 179, 49, 17, 181, 58, 152, 106, 117,
-			248, 14, 49, 255, 152, 88, 168, 251, 77, 117, 220, 248, 59, 218,
-			155, 100, 170, 213, 225, 59, 164, 183, 63, 41, 48, 160, 64, 126,
-			44, 89, 118, 75, 44, 243, 207, 136, 121, 194, 254, 18, 225, 241,
-			183, 76, 163, 255, 106, 156, 222, 154, 89, 96, 237, 255, 25, 49,
-			191, 67, 134, 99, 86, 144, 60, 82, 158, 112, 143, 32, 43, 134,
-			70, 147, 2, 3, 10, 198, 142, 179, 111, 82, 197, 44, 106, 153,
-			223, 39, 230, 132, 253, 101, 25, 233, 2, 115, 119, 186, 229, 212,
-			94, 204, 250, 32, 235, 251, 120, 194, 81, 49, 166, 176, 22, 29,
-			7, 203, 67, 237, 140, 198, 193, 79, 236, 26, 220, 250, 168, 19,
-			254, 122, 47, 174, 5, 107, 63, 86, 202, 179, 147, 165, 142, 195,
-			169, 218, 38, 100, 137, 199, 60, 254, 68, 234, 254, 110, 246, 235,
-			123, 222, 61, 200, 217, 222, 225, 61, 223, 91, 61, 69, 77, 178,
-			83, 72, 112, 99, 105, 38, 233, 241, 130, 157, 207, 247, 137, 249,
-			103, 228, 68, 60, 26, 96, 46, 126, 159, 152, 169, 2, 2, 5,
-			39, 139, 73, 129, 1, 5, 103, 206, 178, 31, 83, 195, 101, 88,
-			230, 171, 212, 28, 181, 103, 213, 65, 250, 184, 247, 212, 96, 237,
-			23, 182, 72, 240, 128, 141, 203, 171, 212, 252, 62, 153, 136, 123,
-			129, 173, 203, 171, 212, 236, 73, 10, 8, 20, 176, 68, 178, 12,
-			236, 119, 228, 40, 154, 77, 120, 107, 210, 107, 148, 158, 180, 111,
-			168, 172, 0, 249, 253, 184, 140, 126, 198, 79, 122, 170, 35, 190,
-			42, 35, 44, 89, 132, 116, 44, 21, 135, 93, 235, 15, 12, 228,
-			188, 166, 15, 143, 154, 40, 205, 175, 209, 248, 210, 18, 130, 157,
-			90, 199, 82, 55, 21, 189, 70, 143, 159, 96, 255, 123, 124, 83,
-			209, 135, 40, 181, 236, 223, 36, 29, 22, 202, 222, 143, 135, 170,
-			243, 124, 77, 63, 16, 29, 91, 147, 84, 150, 41, 80, 22, 38,
-			139, 230, 126, 90, 32, 16, 45, 225, 196, 122, 224, 61, 105, 17,
-			78, 101, 118, 200, 11, 141, 96, 10, 36, 159, 158, 64, 83, 103,
-			55, 78, 18, 75, 185, 211, 4, 110, 8, 99, 247, 89, 114, 85,
-			209, 135, 40, 125, 141, 158, 76, 93, 85, 244, 33, 154, 185, 170,
-			232, 67, 52, 190, 57, 6, 132, 228, 67, 116, 96, 144, 125, 204,
-			148, 151, 176, 188, 78, 187, 254, 53, 37, 246, 235, 38, 79, 229,
-			74, 63, 164, 195, 26, 222, 200, 36, 0, 99, 138, 116, 186, 144,
-			11, 15, 88, 6, 203, 132, 252, 242, 180, 31, 236, 78, 71, 129,
-			128, 245, 97, 183, 33, 120, 20, 56, 96, 209, 57, 13, 61, 234,
-			106, 113, 99, 241, 109, 46, 74, 110, 195, 150, 131, 87, 65, 102,
-			179, 140, 220, 141, 120, 53, 44, 54, 119, 225, 103, 145, 111, 57,
-			245, 88, 218, 138, 142, 242, 32, 149, 184, 131, 162, 136, 174, 41,
-			216, 38, 235, 109, 33, 90, 105, 138, 234, 75, 186, 177, 43, 197,
-			98, 9, 215, 31, 252, 161, 23, 247, 75, 252, 253, 178, 143, 87,
-			248, 228, 114, 106, 13, 14, 167, 246, 111, 67, 33, 180, 127, 75,
-			14, 52, 2, 3, 159, 184, 31, 31, 174, 25, 39, 219, 206, 249,
-			61, 237, 60, 100, 51, 51, 231, 179, 13, 173, 59, 47, 191, 194,
-			39, 245, 135, 191, 147, 198, 226, 123, 100, 94, 167, 133, 33, 246,
-			83, 250, 26, 153, 143, 82, 122, 196, 246, 164, 75, 58, 113, 137,
-			235, 57, 155, 190, 108, 81, 77, 181, 72, 43, 131, 140, 107, 62,
-			220, 242, 119, 208, 124, 81, 141, 164, 252, 73, 40, 97, 120, 79,
-			140, 83, 171, 137, 48, 204, 220, 249, 242, 81, 74, 95, 79, 46,
-			121, 201, 33, 62, 133, 212, 157, 47, 31, 165, 61, 3, 169, 59,
-			95, 62, 74, 135, 134, 217, 39, 137, 62, 229, 255, 49, 74, 143,
-			218, 255, 132, 36, 14, 117, 121, 37, 113, 10, 255, 140, 148, 165,
-			46, 218, 69, 113, 73, 252, 144, 235, 206, 203, 186, 224, 252, 204,
-			75, 237, 123, 184, 236, 197, 54, 105, 124, 0, 69, 57, 7, 211,
-			206, 187, 34, 250, 38, 241, 196, 62, 12, 4, 250, 178, 59, 28,
-			244, 9, 197, 176, 156, 127, 140, 210, 143, 198, 20, 147, 28, 18,
-			81, 72, 157, 157, 255, 24, 237, 177, 82, 103, 231, 63, 70, 143,
-			140, 176, 59, 250, 232, 252, 199, 41, 29, 180, 223, 35, 87, 78,
-			156, 53, 111, 197, 135, 151, 245, 219, 201, 75, 137, 82, 126, 59,
-			121, 182, 253, 227, 148, 126, 140, 30, 77, 157, 109, 255, 56, 85,
-			238, 46, 121, 182, 253, 227, 84, 185, 187, 228, 217, 246, 143, 211,
-			254, 1, 118, 79, 31, 109, 255, 4, 40, 231, 23, 30, 164, 155,
-			31, 202, 209, 37, 253, 92, 236, 33, 28, 93, 242, 80, 250, 39,
-			40, 253, 56, 29, 76, 29, 74, 255, 68, 246, 80, 250, 39, 180,
-			234, 148, 135, 210, 63, 1, 170, 51, 208, 103, 210, 63, 5, 114,
-			84, 79, 220, 141, 233, 1, 198, 244, 100, 237, 137, 231, 75, 137,
-			1, 145, 249, 228, 116, 43, 171, 2, 88, 246, 178, 238, 76, 212,
-			74, 159, 33, 255, 20, 165, 159, 160, 86, 234, 12, 249, 167, 18,
-			78, 195, 22, 226, 83, 180, 219, 74, 157, 33, 255, 20, 8, 194,
-			71, 164, 232, 231, 44, 243, 51, 148, 218, 246, 79, 167, 190, 197,
-			221, 193, 230, 248, 179, 203, 250, 14, 22, 100, 53, 78, 211, 118,
-			43, 190, 99, 64, 106, 82, 149, 239, 192, 240, 94, 224, 32, 240,
-			3, 144, 44, 249, 181, 35, 252, 228, 178, 156, 194, 169, 100, 157,
-			248, 36, 126, 76, 80, 206, 180, 242, 159, 161, 244, 83, 177, 232,
-			228, 36, 142, 154, 32, 80, 60, 159, 161, 221, 90, 238, 115, 6,
-			128, 163, 199, 216, 223, 148, 4, 229, 45, 243, 215, 40, 29, 178,
-			119, 185, 252, 26, 5, 202, 246, 187, 174, 240, 217, 18, 138, 109,
-			208, 214, 251, 68, 105, 134, 180, 132, 250, 12, 25, 108, 24, 95,
-			116, 91, 89, 215, 66, 234, 160, 191, 210, 81, 251, 36, 39, 195,
-			250, 215, 114, 228, 167, 7, 82, 116, 228, 77, 43, 255, 107, 148,
-			126, 134, 234, 27, 12, 242, 57, 68, 173, 91, 131, 4, 192, 194,
-			97, 13, 26, 0, 14, 90, 236, 87, 37, 29, 221, 150, 249, 175,
-			40, 29, 181, 127, 153, 28, 144, 189, 45, 69, 100, 31, 7, 233,
-			83, 72, 236, 91, 115, 137, 118, 122, 68, 217, 15, 236, 18, 205,
-			161, 139, 237, 95, 81, 250, 107, 116, 72, 145, 214, 157, 67, 90,
-			52, 225, 221, 4, 192, 66, 252, 212, 0, 112, 228, 40, 134, 203,
-			243, 86, 254, 243, 180, 235, 255, 162, 196, 190, 206, 211, 103, 178,
-			30, 118, 23, 221, 105, 126, 192, 74, 5, 140, 254, 60, 45, 12,
-			179, 127, 11, 156, 205, 195, 82, 245, 5, 88, 170, 62, 67, 178,
-			107, 85, 135, 198, 203, 108, 154, 59, 206, 49, 225, 25, 35, 221,
-			113, 220, 128, 155, 220, 77, 133, 194, 181, 229, 138, 192, 9, 106,
-			91, 187, 169, 156, 29, 22, 167, 208, 38, 183, 94, 39, 147, 93,
-			190, 151, 73, 4, 214, 173, 139, 134, 104, 166, 86, 128, 60, 174,
-			121, 95, 160, 244, 243, 234, 62, 218, 60, 174, 121, 95, 208, 170,
-			42, 143, 107, 222, 23, 244, 154, 151, 199, 53, 239, 11, 176, 230,
-			61, 129, 76, 32, 150, 249, 38, 168, 170, 73, 228, 65, 140, 169,
-			90, 246, 208, 34, 119, 194, 76, 14, 135, 236, 21, 214, 157, 55,
-			41, 253, 130, 90, 119, 242, 184, 238, 188, 153, 244, 74, 176, 97,
-			181, 238, 228, 113, 221, 121, 19, 212, 205, 87, 36, 239, 169, 101,
-			126, 17, 52, 251, 191, 127, 107, 102, 119, 198, 74, 248, 97, 172,
-			238, 5, 217, 208, 219, 110, 116, 231, 113, 197, 251, 34, 165, 111,
-			42, 181, 149, 199, 21, 239, 139, 9, 99, 128, 227, 95, 212, 43,
-			71, 30, 87, 188, 47, 194, 202, 129, 195, 97, 116, 89, 249, 223,
-			163, 244, 59, 212, 176, 207, 170, 157, 154, 188, 249, 206, 85, 225,
-			169, 172, 9, 166, 143, 51, 228, 209, 135, 242, 123, 148, 13, 177,
-			1, 150, 7, 208, 236, 178, 242, 95, 162, 230, 239, 211, 28, 238,
-			197, 176, 132, 88, 230, 151, 160, 227, 1, 86, 144, 5, 48, 3,
-			254, 3, 205, 247, 179, 65, 214, 163, 75, 8, 22, 177, 116, 17,
-			133, 162, 67, 135, 83, 239, 17, 203, 252, 223, 104, 126, 48, 85,
-			137, 200, 162, 190, 116, 17, 133, 162, 254, 129, 212, 123, 212, 50,
-			191, 76, 243, 86, 170, 18, 52, 245, 101, 154, 63, 148, 46, 194,
-			90, 3, 131, 160, 200, 145, 22, 64, 243, 171, 212, 28, 182, 119,
-			240, 54, 87, 173, 2, 244, 215, 190, 113, 84, 178, 139, 101, 153,
-			243, 219, 91, 194, 131, 130, 117, 116, 151, 166, 19, 230, 149, 226,
-			184, 234, 100, 115, 232, 240, 27, 232, 201, 141, 131, 123, 28, 77,
-			121, 229, 104, 250, 106, 134, 171, 56, 217, 190, 74, 149, 163, 41,
-			175, 28, 77, 95, 165, 202, 209, 148, 87, 142, 166, 175, 82, 107,
-			136, 149, 20, 57, 196, 50, 191, 70, 77, 203, 62, 46, 243, 198,
-			118, 91, 113, 14, 115, 66, 64, 210, 39, 76, 180, 175, 81, 243,
-			171, 116, 56, 110, 17, 118, 184, 95, 163, 42, 177, 38, 175, 252,
-			53, 95, 131, 161, 141, 11, 12, 40, 24, 24, 100, 255, 144, 170,
-			78, 169, 101, 126, 131, 154, 39, 236, 191, 69, 127, 56, 231, 150,
-			254, 106, 8, 147, 86, 204, 186, 224, 27, 110, 163, 33, 234, 50,
-			133, 65, 221, 11, 198, 229, 25, 225, 12, 61, 255, 127, 245, 137,
-			229, 149, 143, 229, 27, 212, 252, 26, 181, 98, 14, 130, 253, 240,
-			13, 106, 166, 10, 8, 20, 40, 159, 88, 94, 249, 88, 190, 65,
-			199, 142, 179, 95, 210, 60, 54, 44, 243, 79, 168, 57, 97, 255,
-			77, 154, 198, 64, 49, 250, 45, 120, 200, 126, 112, 30, 191, 109,
-			142, 181, 70, 74, 63, 254, 192, 126, 181, 132, 193, 96, 78, 255,
-			9, 53, 191, 65, 79, 196, 236, 51, 242, 200, 173, 84, 1, 129,
-			2, 229, 196, 202, 43, 231, 209, 159, 208, 51, 103, 49, 126, 151,
-			7, 238, 254, 103, 74, 79, 43, 189, 105, 152, 8, 50, 13, 230,
-			1, 236, 29, 214, 32, 1, 240, 200, 184, 6, 241, 221, 226, 35,
-			108, 145, 209, 124, 151, 149, 255, 83, 218, 245, 23, 148, 216, 63,
-			38, 191, 23, 165, 6, 8, 236, 134, 233, 13, 167, 166, 206, 6,
-			170, 173, 37, 78, 133, 151, 50, 62, 121, 30, 138, 96, 219, 173,
-			169, 107, 208, 243, 48, 225, 255, 148, 22, 250, 216, 77, 102, 230,
-			81, 89, 253, 57, 165, 37, 251, 73, 76, 238, 214, 219, 40, 57,
-			153, 180, 227, 2, 51, 7, 84, 10, 150, 244, 144, 119, 222, 236,
-			153, 151, 138, 228, 207, 105, 190, 71, 131, 20, 64, 54, 172, 65,
-			3, 192, 241, 115, 236, 54, 246, 74, 44, 243, 191, 80, 90, 182,
-			43, 242, 96, 69, 178, 123, 203, 30, 165, 232, 152, 241, 247, 61,
-			70, 33, 251, 33, 216, 114, 62, 6, 41, 128, 189, 35, 26, 52,
-			0, 60, 85, 98, 139, 136, 5, 181, 204, 239, 81, 122, 193, 254,
-			177, 216, 171, 37, 209, 232, 112, 221, 135, 169, 84, 178, 142, 4,
-			140, 148, 103, 47, 47, 231, 220, 247, 104, 190, 87, 131, 216, 126,
-			223, 168, 6, 13, 0, 31, 153, 85, 157, 27, 150, 249, 125, 74,
-			103, 96, 84, 165, 240, 30, 208, 119, 224, 251, 81, 230, 152, 61,
-			174, 29, 177, 229, 19, 119, 14, 50, 244, 253, 132, 114, 131, 2,
-			24, 83, 110, 96, 119, 167, 166, 245, 29, 92, 255, 95, 0, 0,
-			0, 255, 255, 167, 145, 129, 151, 215, 163, 0, 0},
+			8, 0, 0, 9, 110, 136, 0, 255, 236, 189, 11, 116, 29, 199,
+			117, 32, 136, 174, 170, 247, 65, 129, 32, 128, 6, 8, 130, 77,
+			130, 44, 62, 146, 2, 72, 2, 15, 20, 37, 81, 22, 101, 105,
+			2, 146, 32, 9, 27, 2, 232, 7, 80, 178, 157, 196, 84, 227,
+			189, 6, 216, 214, 67, 55, 220, 221, 32, 8, 205, 120, 29, 123,
+			227, 149, 119, 164, 120, 71, 142, 29, 59, 163, 29, 59, 59, 89,
+			249, 36, 138, 146, 172, 142, 237, 137, 125, 86, 158, 120, 54, 242,
+			38, 182, 35, 103, 236, 236, 56, 154, 156, 209, 172, 29, 39, 158,
+			245, 241, 204, 100, 199, 123, 124, 156, 113, 156, 205, 158, 123, 235,
+			86, 119, 191, 7, 128, 31, 71, 217, 51, 153, 99, 125, 200, 87,
+			213, 213, 85, 183, 110, 221, 186, 117, 235, 254, 90, 190, 111, 68,
+			30, 88, 14, 195, 229, 166, 55, 177, 26, 133, 73, 184, 184, 182,
+			52, 145, 248, 43, 94, 156, 184, 43, 171, 85, 172, 178, 123, 116,
+			131, 170, 105, 80, 185, 95, 118, 46, 152, 54, 246, 144, 44, 197,
+			94, 61, 12, 26, 241, 144, 165, 172, 81, 94, 51, 69, 123, 64,
+			22, 2, 55, 8, 227, 33, 166, 172, 209, 66, 77, 23, 206, 44,
+			200, 254, 122, 184, 82, 109, 235, 243, 204, 206, 180, 199, 75, 80,
+			117, 201, 250, 37, 203, 250, 11, 203, 250, 167, 140, 95, 184, 116,
+			230, 57, 182, 255, 130, 110, 127, 137, 218, 87, 31, 241, 154, 205,
+			55, 6, 225, 122, 176, 176, 177, 234, 197, 111, 248, 244, 17, 89,
+			180, 197, 254, 142, 21, 75, 254, 254, 14, 105, 237, 176, 249, 254,
+			14, 251, 228, 191, 216, 161, 240, 133, 122, 216, 84, 103, 214, 150,
+			150, 188, 40, 86, 227, 74, 119, 53, 18, 171, 134, 155, 184, 202,
+			15, 18, 47, 170, 95, 117, 131, 101, 79, 45, 133, 209, 138, 155,
+			72, 117, 54, 92, 221, 136, 252, 229, 171, 137, 58, 121, 226, 196,
+			235, 232, 5, 53, 29, 212, 171, 74, 77, 54, 155, 10, 159, 197,
+			42, 242, 98, 47, 186, 230, 53, 170, 82, 93, 77, 146, 213, 248,
+			244, 196, 68, 195, 187, 230, 53, 195, 85, 47, 138, 205, 12, 235,
+			225, 138, 70, 109, 61, 108, 142, 47, 106, 32, 38, 164, 84, 53,
+			175, 225, 199, 73, 228, 47, 174, 37, 126, 24, 40, 55, 104, 168,
+			181, 216, 83, 126, 160, 226, 112, 45, 170, 123, 88, 179, 232, 7,
+			110, 180, 129, 112, 197, 99, 106, 221, 79, 174, 170, 48, 194, 191,
+			195, 181, 68, 170, 149, 176, 225, 47, 249, 117, 23, 122, 24, 83,
+			110, 228, 169, 85, 47, 90, 241, 147, 196, 107, 168, 213, 40, 188,
+			230, 55, 188, 134, 74, 174, 186, 137, 74, 174, 194, 236, 154, 205,
+			112, 221, 15, 150, 21, 44, 151, 15, 47, 197, 240, 146, 84, 43,
+			94, 114, 90, 74, 5, 255, 28, 107, 3, 44, 86, 225, 146, 129,
+			168, 30, 54, 60, 181, 178, 22, 39, 42, 242, 18, 215, 15, 176,
+			87, 119, 49, 188, 6, 143, 8, 99, 82, 5, 97, 226, 215, 189,
+			49, 149, 92, 245, 99, 213, 244, 227, 4, 122, 200, 143, 24, 52,
+			218, 192, 105, 248, 113, 189, 233, 250, 43, 94, 84, 221, 14, 8,
+			63, 200, 227, 194, 0, 177, 26, 133, 141, 181, 186, 151, 193, 33,
+			51, 64, 254, 70, 112, 72, 69, 179, 107, 132, 245, 181, 21, 47,
+			72, 92, 179, 72, 19, 97, 164, 194, 228, 170, 23, 169, 21, 55,
+			241, 34, 223, 109, 198, 25, 170, 97, 97, 160, 79, 169, 242, 208,
+			167, 147, 154, 245, 124, 124, 19, 58, 14, 220, 21, 15, 0, 202,
+			211, 86, 16, 102, 207, 16, 239, 126, 18, 195, 140, 2, 221, 85,
+			24, 197, 106, 197, 221, 80, 139, 30, 80, 74, 67, 37, 161, 242,
+			130, 70, 24, 197, 30, 16, 197, 106, 20, 174, 132, 137, 7, 192,
+			52, 214, 234, 73, 172, 26, 94, 228, 95, 243, 26, 106, 41, 10,
+			87, 164, 198, 66, 28, 46, 37, 235, 64, 38, 68, 65, 42, 94,
+			245, 234, 64, 65, 106, 53, 242, 129, 176, 34, 160, 157, 64, 83,
+			81, 28, 35, 236, 82, 45, 92, 156, 158, 87, 243, 115, 231, 23,
+			30, 153, 172, 77, 169, 233, 121, 117, 169, 54, 247, 240, 244, 185,
+			169, 115, 234, 204, 91, 212, 194, 197, 41, 117, 118, 238, 210, 91,
+			106, 211, 23, 46, 46, 168, 139, 115, 51, 231, 166, 106, 243, 106,
+			114, 246, 156, 58, 59, 55, 187, 80, 155, 62, 115, 121, 97, 174,
+			54, 47, 85, 101, 114, 94, 77, 207, 87, 240, 201, 228, 236, 91,
+			212, 212, 155, 47, 213, 166, 230, 231, 213, 92, 77, 77, 63, 116,
+			105, 102, 122, 234, 156, 122, 100, 178, 86, 155, 156, 93, 152, 158,
+			154, 31, 83, 211, 179, 103, 103, 46, 159, 155, 158, 189, 48, 166,
+			206, 92, 94, 80, 179, 115, 11, 82, 205, 76, 63, 52, 189, 48,
+			117, 78, 45, 204, 141, 225, 176, 155, 223, 83, 115, 231, 213, 67,
+			83, 181, 179, 23, 39, 103, 23, 38, 207, 76, 207, 76, 47, 188,
+			5, 7, 60, 63, 189, 48, 11, 131, 157, 159, 171, 73, 53, 169,
+			46, 77, 214, 22, 166, 207, 94, 158, 153, 172, 169, 75, 151, 107,
+			151, 230, 230, 167, 20, 204, 236, 220, 244, 252, 217, 153, 201, 233,
+			135, 166, 206, 85, 213, 244, 172, 154, 157, 83, 83, 15, 79, 205,
+			46, 168, 249, 139, 147, 51, 51, 173, 19, 149, 106, 238, 145, 217,
+			169, 26, 64, 159, 159, 166, 58, 51, 165, 102, 166, 39, 207, 204,
+			76, 169, 243, 115, 53, 156, 231, 185, 233, 218, 212, 217, 5, 152,
+			80, 246, 235, 236, 244, 185, 169, 217, 133, 201, 153, 49, 169, 230,
+			47, 77, 157, 157, 158, 156, 25, 83, 83, 111, 158, 122, 232, 210,
+			204, 100, 237, 45, 99, 212, 233, 252, 212, 155, 46, 79, 205, 46,
+			76, 79, 206, 168, 115, 147, 15, 77, 94, 152, 154, 87, 163, 55,
+			195, 202, 165, 218, 220, 217, 203, 181, 169, 135, 0, 234, 185, 243,
+			106, 254, 242, 153, 249, 133, 233, 133, 203, 11, 83, 234, 194, 220,
+			220, 57, 68, 246, 252, 84, 237, 225, 233, 179, 83, 243, 247, 171,
+			153, 57, 64, 255, 121, 117, 121, 126, 106, 76, 170, 115, 147, 11,
+			147, 56, 244, 165, 218, 220, 249, 233, 133, 249, 251, 225, 247, 153,
+			203, 243, 211, 136, 184, 233, 217, 133, 169, 90, 237, 242, 165, 133,
+			233, 185, 217, 163, 234, 226, 220, 35, 83, 15, 79, 213, 212, 217,
+			201, 203, 243, 83, 231, 16, 195, 115, 179, 48, 91, 160, 149, 169,
+			185, 218, 91, 160, 91, 192, 3, 174, 192, 152, 122, 228, 226, 212,
+			194, 197, 169, 26, 32, 21, 177, 53, 9, 104, 152, 95, 168, 77,
+			159, 93, 200, 55, 155, 171, 169, 133, 185, 218, 130, 204, 205, 83,
+			205, 78, 93, 152, 153, 190, 48, 53, 123, 118, 10, 224, 153, 131,
+			110, 30, 153, 158, 159, 58, 170, 38, 107, 211, 243, 208, 96, 26,
+			7, 86, 143, 76, 190, 69, 205, 93, 198, 89, 195, 66, 93, 158,
+			159, 146, 250, 119, 142, 116, 199, 112, 61, 213, 244, 121, 53, 121,
+			238, 225, 105, 128, 156, 90, 95, 154, 155, 159, 159, 38, 114, 65,
+			180, 157, 189, 72, 56, 175, 74, 89, 150, 22, 179, 185, 42, 239,
+			134, 95, 101, 155, 87, 58, 238, 151, 93, 82, 148, 255, 93, 169,
+			67, 23, 118, 200, 2, 20, 152, 205, 43, 165, 221, 178, 91, 22,
+			177, 4, 15, 75, 187, 229, 78, 89, 210, 69, 75, 151, 169, 113,
+			201, 230, 21, 231, 52, 245, 120, 168, 227, 0, 245, 104, 233, 130,
+			110, 4, 195, 30, 74, 123, 180, 88, 135, 46, 234, 30, 45, 236,
+			241, 80, 218, 163, 197, 109, 126, 200, 217, 79, 61, 30, 238, 24,
+			163, 30, 153, 46, 232, 70, 12, 74, 165, 126, 234, 145, 65, 143,
+			135, 75, 253, 212, 35, 195, 30, 161, 76, 141, 75, 54, 63, 60,
+			120, 156, 122, 60, 210, 49, 65, 61, 114, 93, 208, 141, 56, 179,
+			249, 145, 210, 94, 234, 145, 67, 143, 80, 212, 61, 114, 236, 17,
+			202, 212, 184, 100, 243, 35, 251, 171, 212, 227, 29, 29, 21, 234,
+			81, 232, 130, 110, 36, 152, 205, 239, 40, 57, 212, 163, 128, 30,
+			161, 168, 123, 20, 216, 35, 148, 169, 49, 183, 249, 29, 195, 7,
+			169, 199, 145, 116, 214, 5, 155, 143, 164, 179, 46, 48, 155, 143,
+			148, 14, 83, 143, 5, 232, 17, 138, 186, 199, 2, 246, 8, 101,
+			106, 204, 109, 62, 50, 98, 102, 61, 218, 113, 144, 122, 44, 234,
+			130, 110, 84, 100, 54, 31, 45, 13, 81, 143, 69, 232, 17, 138,
+			186, 199, 34, 246, 8, 101, 106, 92, 178, 249, 232, 94, 37, 127,
+			174, 87, 50, 209, 97, 11, 183, 99, 197, 114, 126, 166, 87, 77,
+			170, 84, 226, 193, 147, 204, 139, 189, 32, 137, 149, 171, 86, 67,
+			63, 72, 240, 252, 241, 87, 64, 30, 104, 120, 171, 94, 208, 240,
+			2, 60, 71, 221, 96, 67, 129, 124, 166, 30, 15, 3, 79, 2,
+			223, 175, 187, 77, 47, 104, 184, 209, 88, 214, 139, 215, 80, 110,
+			172, 72, 12, 195, 115, 110, 41, 114, 235, 217, 105, 110, 30, 36,
+			82, 161, 76, 134, 101, 144, 102, 194, 38, 30, 88, 48, 248, 229,
+			133, 179, 106, 106, 53, 172, 95, 197, 225, 170, 106, 58, 81, 126,
+			172, 188, 0, 100, 0, 144, 84, 224, 220, 198, 147, 238, 82, 20,
+			54, 189, 213, 196, 175, 171, 11, 145, 183, 28, 70, 190, 27, 168,
+			179, 4, 147, 90, 191, 234, 215, 175, 42, 239, 122, 226, 1, 36,
+			112, 182, 101, 141, 12, 224, 82, 45, 186, 245, 199, 214, 221, 8,
+			90, 132, 106, 195, 115, 35, 21, 6, 155, 134, 116, 227, 120, 109,
+			5, 70, 117, 155, 77, 181, 226, 7, 107, 137, 135, 210, 139, 58,
+			117, 66, 166, 83, 106, 134, 193, 242, 152, 242, 171, 94, 85, 53,
+			61, 119, 53, 155, 106, 228, 169, 74, 188, 226, 185, 145, 215, 168,
+			168, 56, 212, 66, 81, 16, 230, 91, 73, 149, 184, 139, 77, 15,
+			198, 12, 60, 15, 134, 92, 10, 35, 45, 30, 174, 130, 188, 3,
+			152, 169, 170, 26, 10, 138, 126, 76, 199, 234, 137, 19, 39, 238,
+			28, 199, 255, 22, 78, 156, 56, 141, 255, 189, 21, 102, 113, 223,
+			125, 247, 221, 55, 126, 231, 201, 241, 187, 238, 92, 56, 121, 215,
+			233, 123, 238, 59, 125, 207, 125, 213, 251, 204, 63, 111, 173, 74,
+			117, 102, 3, 16, 158, 68, 126, 61, 129, 73, 37, 4, 82, 4,
+			221, 143, 169, 117, 79, 121, 65, 188, 22, 129, 104, 227, 38, 80,
+			172, 187, 1, 72, 2, 215, 188, 40, 81, 73, 40, 105, 85, 195,
+			21, 165, 106, 231, 207, 170, 187, 238, 186, 235, 62, 16, 103, 61,
+			5, 66, 83, 176, 28, 87, 165, 154, 247, 60, 245, 147, 70, 46,
+			93, 95, 95, 175, 250, 94, 178, 84, 13, 163, 229, 137, 104, 169,
+			14, 255, 195, 75, 213, 228, 122, 242, 211, 163, 183, 210, 234, 104,
+			85, 74, 53, 117, 221, 93, 89, 109, 122, 234, 206, 211, 234, 108,
+			184, 178, 186, 150, 120, 57, 42, 6, 140, 168, 75, 115, 243, 211,
+			111, 86, 143, 2, 209, 140, 30, 125, 180, 74, 82, 101, 214, 40,
+			189, 92, 220, 175, 159, 100, 151, 141, 216, 75, 174, 208, 122, 141,
+			66, 237, 232, 236, 229, 153, 153, 163, 71, 183, 108, 135, 100, 59,
+			122, 226, 232, 253, 57, 152, 78, 222, 12, 166, 101, 47, 129, 126,
+			195, 165, 134, 187, 145, 131, 45, 78, 162, 181, 122, 130, 3, 92,
+			115, 155, 42, 185, 70, 35, 182, 52, 191, 35, 185, 54, 166, 16,
+			160, 251, 127, 212, 41, 93, 171, 38, 215, 96, 130, 55, 154, 145,
+			110, 180, 22, 123, 117, 117, 76, 221, 121, 226, 68, 235, 12, 239,
+			218, 118, 134, 143, 248, 193, 93, 39, 213, 163, 23, 188, 100, 126,
+			35, 78, 188, 21, 120, 60, 25, 159, 247, 155, 222, 66, 235, 66,
+			156, 159, 158, 153, 90, 152, 126, 104, 74, 45, 37, 4, 198, 118,
+			239, 220, 177, 148, 24, 72, 47, 79, 207, 46, 156, 186, 91, 37,
+			126, 253, 177, 88, 61, 160, 70, 71, 71, 117, 205, 209, 165, 164,
+			218, 88, 191, 232, 47, 95, 61, 231, 38, 248, 214, 81, 245, 250,
+			215, 171, 187, 78, 30, 85, 255, 64, 225, 179, 153, 112, 221, 60,
+			50, 120, 155, 152, 80, 147, 234, 17, 63, 104, 132, 235, 49, 118,
+			9, 27, 238, 206, 19, 39, 114, 172, 40, 174, 166, 13, 60, 100,
+			65, 119, 158, 218, 188, 203, 210, 222, 224, 245, 59, 79, 221, 125,
+			247, 221, 247, 222, 117, 234, 196, 137, 116, 203, 47, 122, 75, 97,
+			228, 169, 203, 129, 127, 221, 244, 114, 223, 189, 39, 218, 123, 169,
+			254, 104, 139, 57, 170, 231, 175, 70, 71, 97, 6, 177, 154, 192,
+			197, 130, 255, 142, 170, 241, 60, 56, 55, 161, 96, 232, 231, 174,
+			147, 89, 63, 71, 114, 253, 32, 1, 28, 109, 33, 128, 187, 183,
+			37, 128, 55, 184, 215, 92, 245, 168, 94, 252, 106, 125, 45, 138,
+			188, 32, 129, 38, 15, 249, 205, 166, 31, 231, 8, 0, 56, 164,
+			90, 193, 90, 245, 128, 218, 254, 133, 27, 144, 185, 122, 32, 171,
+			173, 6, 222, 250, 153, 53, 191, 217, 240, 162, 209, 163, 48, 177,
+			121, 194, 16, 13, 161, 17, 115, 84, 247, 5, 255, 66, 155, 89,
+			164, 245, 81, 63, 72, 96, 230, 212, 82, 79, 157, 166, 13, 40,
+			56, 122, 180, 186, 8, 61, 35, 44, 25, 14, 238, 217, 22, 7,
+			52, 11, 115, 110, 170, 75, 27, 201, 85, 125, 131, 129, 129, 131,
+			112, 93, 61, 128, 207, 170, 240, 199, 40, 193, 100, 200, 229, 1,
+			224, 244, 163, 65, 184, 78, 245, 72, 141, 84, 11, 213, 106, 220,
+			80, 150, 6, 241, 216, 177, 251, 142, 182, 173, 107, 30, 47, 163,
+			212, 248, 1, 250, 123, 76, 147, 247, 3, 248, 231, 81, 137, 255,
+			112, 1, 146, 130, 91, 238, 147, 255, 147, 37, 133, 232, 0, 57,
+			98, 137, 13, 56, 63, 111, 169, 154, 57, 202, 179, 99, 60, 92,
+			194, 51, 25, 96, 87, 177, 31, 212, 243, 164, 45, 183, 166, 109,
+			245, 16, 92, 147, 23, 61, 125, 80, 224, 31, 219, 156, 87, 114,
+			171, 3, 235, 173, 202, 15, 234, 205, 181, 216, 191, 230, 85, 165,
+			236, 150, 5, 0, 81, 216, 98, 137, 185, 40, 36, 66, 177, 0,
+			32, 151, 76, 201, 178, 249, 82, 185, 199, 148, 184, 205, 151, 236,
+			126, 249, 167, 122, 114, 150, 205, 155, 204, 118, 190, 102, 169, 217,
+			48, 24, 15, 188, 101, 55, 241, 175, 121, 173, 146, 137, 75, 179,
+			85, 110, 178, 181, 100, 82, 85, 179, 244, 162, 57, 243, 213, 53,
+			183, 185, 230, 197, 168, 19, 201, 117, 134, 10, 130, 56, 241, 155,
+			77, 117, 213, 189, 230, 169, 32, 63, 38, 118, 77, 47, 194, 205,
+			216, 77, 84, 61, 92, 11, 18, 208, 45, 128, 28, 98, 132, 175,
+			54, 4, 158, 160, 131, 125, 140, 254, 151, 91, 224, 199, 18, 182,
+			104, 178, 165, 1, 194, 129, 85, 128, 89, 27, 252, 88, 128, 131,
+			114, 183, 41, 113, 155, 55, 123, 251, 22, 139, 168, 29, 186, 75,
+			254, 235, 1, 57, 179, 236, 39, 87, 215, 22, 81, 103, 212, 92,
+			171, 251, 248, 199, 248, 114, 56, 209, 12, 151, 27, 225, 242, 132,
+			187, 234, 79, 120, 65, 3, 37, 196, 120, 162, 30, 134, 81, 195,
+			15, 220, 36, 140, 160, 65, 60, 113, 237, 206, 137, 56, 113, 19,
+			210, 170, 217, 69, 253, 150, 115, 51, 5, 95, 229, 231, 185, 220,
+			57, 19, 46, 207, 39, 145, 231, 174, 204, 67, 15, 246, 33, 217,
+			141, 205, 175, 92, 243, 34, 208, 3, 160, 110, 175, 179, 182, 3,
+			43, 31, 214, 117, 246, 221, 178, 84, 143, 60, 55, 241, 26, 168,
+			226, 235, 58, 233, 180, 171, 245, 170, 233, 134, 168, 153, 166, 246,
+			17, 185, 51, 1, 253, 66, 224, 54, 175, 128, 116, 123, 125, 136,
+			163, 222, 176, 219, 212, 78, 67, 165, 253, 122, 89, 114, 163, 250,
+			85, 255, 154, 55, 36, 176, 243, 74, 85, 207, 167, 218, 10, 106,
+			117, 82, 183, 154, 14, 150, 194, 154, 121, 197, 30, 148, 197, 213,
+			181, 104, 217, 107, 12, 21, 148, 53, 90, 174, 81, 201, 249, 21,
+			75, 118, 229, 94, 176, 247, 202, 78, 132, 225, 202, 90, 212, 164,
+			57, 150, 177, 226, 114, 212, 180, 135, 165, 140, 113, 32, 124, 202,
+			240, 105, 167, 174, 129, 199, 123, 100, 25, 52, 136, 248, 144, 227,
+			195, 18, 148, 225, 145, 35, 203, 245, 16, 164, 146, 68, 67, 95,
+			174, 165, 101, 251, 14, 217, 211, 12, 151, 175, 120, 65, 18, 109,
+			92, 65, 186, 67, 24, 121, 173, 187, 25, 46, 79, 65, 237, 89,
+			168, 124, 195, 191, 232, 5, 149, 166, 232, 56, 97, 201, 79, 89,
+			168, 210, 20, 29, 246, 201, 231, 172, 22, 237, 228, 157, 167, 212,
+			194, 85, 79, 205, 92, 62, 59, 173, 38, 215, 146, 171, 97, 20,
+			87, 183, 81, 81, 94, 6, 61, 209, 146, 81, 4, 101, 10, 61,
+			63, 86, 203, 225, 53, 47, 10, 188, 134, 90, 11, 26, 164, 159,
+			154, 92, 117, 235, 208, 177, 95, 247, 130, 216, 27, 83, 180, 230,
+			234, 100, 245, 132, 217, 50, 110, 128, 91, 35, 92, 11, 26, 70,
+			93, 54, 51, 125, 118, 106, 118, 126, 74, 45, 249, 77, 47, 189,
+			59, 23, 203, 59, 101, 167, 100, 188, 195, 230, 229, 210, 168, 252,
+			168, 165, 47, 66, 221, 29, 39, 44, 231, 105, 75, 181, 46, 39,
+			72, 3, 174, 90, 244, 27, 126, 228, 225, 94, 118, 155, 10, 137,
+			90, 239, 87, 173, 249, 130, 251, 201, 42, 136, 187, 154, 100, 85,
+			221, 109, 54, 99, 96, 244, 155, 251, 242, 86, 22, 189, 70, 67,
+			11, 246, 129, 154, 50, 155, 71, 69, 222, 59, 214, 188, 56, 153,
+			136, 188, 120, 53, 12, 98, 20, 156, 65, 109, 86, 205, 56, 115,
+			119, 121, 80, 158, 51, 140, 185, 167, 124, 208, 185, 87, 107, 147,
+			13, 42, 124, 125, 163, 49, 180, 174, 104, 171, 0, 23, 33, 44,
+			35, 40, 45, 188, 179, 167, 220, 61, 148, 227, 157, 61, 229, 157,
+			166, 100, 217, 188, 167, 103, 159, 41, 113, 155, 247, 28, 80, 242,
+			146, 97, 157, 118, 185, 234, 156, 197, 165, 6, 198, 164, 214, 175,
+			122, 26, 225, 205, 112, 153, 134, 81, 235, 46, 44, 247, 178, 31,
+			39, 94, 148, 211, 69, 170, 179, 25, 155, 104, 97, 83, 118, 185,
+			231, 160, 97, 83, 69, 24, 33, 45, 89, 54, 183, 43, 71, 77,
+			137, 219, 220, 30, 27, 151, 215, 16, 20, 102, 243, 193, 242, 65,
+			199, 71, 80, 104, 96, 220, 47, 154, 180, 242, 0, 141, 196, 202,
+			236, 104, 181, 226, 197, 177, 187, 12, 119, 58, 221, 74, 175, 165,
+			31, 171, 241, 59, 199, 100, 250, 30, 162, 12, 88, 182, 238, 192,
+			15, 150, 83, 128, 153, 176, 197, 96, 217, 174, 18, 80, 172, 0,
+			112, 24, 78, 10, 8, 26, 220, 105, 112, 199, 184, 205, 7, 15,
+			40, 121, 17, 0, 230, 29, 182, 216, 195, 70, 185, 115, 90, 229,
+			182, 61, 220, 164, 64, 129, 13, 23, 72, 172, 84, 13, 80, 104,
+			55, 99, 90, 187, 252, 52, 170, 82, 247, 203, 129, 36, 246, 200,
+			93, 242, 77, 178, 8, 37, 32, 138, 189, 98, 143, 115, 6, 81,
+			65, 106, 220, 249, 36, 140, 220, 101, 79, 93, 174, 205, 192, 26,
+			69, 94, 91, 103, 35, 160, 201, 6, 108, 249, 233, 208, 141, 170,
+			148, 61, 178, 164, 187, 20, 182, 216, 43, 246, 160, 146, 71, 87,
+			20, 96, 16, 153, 149, 45, 155, 239, 237, 26, 200, 202, 220, 230,
+			123, 119, 15, 201, 159, 36, 152, 44, 155, 15, 11, 199, 153, 185,
+			77, 152, 34, 119, 157, 10, 100, 19, 217, 18, 58, 56, 219, 134,
+			197, 222, 61, 233, 232, 112, 186, 13, 231, 160, 131, 243, 109, 184,
+			107, 87, 86, 230, 54, 31, 30, 218, 35, 223, 74, 208, 49, 155,
+			31, 16, 67, 206, 27, 111, 19, 58, 55, 142, 189, 149, 197, 166,
+			215, 184, 17, 112, 64, 32, 7, 196, 176, 147, 14, 14, 36, 114,
+			32, 7, 28, 16, 201, 129, 174, 254, 172, 204, 109, 126, 96, 112,
+			183, 124, 213, 34, 232, 184, 205, 15, 139, 65, 231, 101, 11, 137,
+			52, 90, 243, 198, 80, 213, 0, 160, 0, 175, 246, 189, 88, 45,
+			122, 201, 186, 231, 5, 234, 4, 94, 191, 13, 117, 235, 83, 76,
+			173, 3, 240, 41, 100, 106, 122, 73, 170, 37, 183, 25, 27, 11,
+			132, 31, 52, 192, 82, 227, 197, 153, 65, 38, 155, 37, 110, 222,
+			32, 4, 57, 68, 159, 26, 205, 13, 213, 12, 93, 80, 66, 248,
+			1, 92, 247, 81, 13, 177, 226, 53, 124, 224, 132, 49, 225, 44,
+			229, 2, 122, 84, 183, 9, 210, 170, 23, 193, 45, 214, 187, 190,
+			234, 71, 45, 8, 226, 194, 22, 135, 197, 129, 161, 20, 65, 188,
+			0, 19, 46, 103, 101, 80, 255, 117, 246, 101, 101, 64, 200, 192,
+			46, 121, 136, 240, 35, 108, 62, 34, 246, 59, 3, 184, 122, 193,
+			218, 202, 162, 23, 193, 166, 135, 73, 100, 163, 8, 97, 139, 17,
+			113, 120, 48, 237, 69, 128, 46, 78, 116, 102, 101, 80, 183, 201,
+			140, 134, 4, 40, 220, 246, 13, 75, 23, 118, 43, 108, 221, 227,
+			204, 113, 22, 96, 9, 80, 100, 243, 155, 99, 237, 168, 202, 173,
+			255, 24, 25, 113, 64, 29, 227, 123, 205, 70, 251, 190, 118, 155,
+			210, 236, 236, 148, 147, 0, 30, 142, 179, 81, 78, 220, 130, 23,
+			97, 72, 195, 73, 184, 101, 243, 227, 59, 119, 153, 18, 128, 51,
+			180, 71, 190, 11, 97, 19, 54, 159, 40, 15, 57, 145, 154, 206,
+			173, 164, 167, 180, 96, 65, 103, 20, 202, 176, 205, 112, 185, 170,
+			38, 113, 215, 227, 82, 95, 117, 129, 114, 188, 192, 52, 245, 99,
+			21, 6, 205, 13, 169, 220, 250, 99, 65, 184, 222, 244, 26, 80,
+			155, 132, 202, 109, 172, 248, 1, 24, 142, 180, 152, 90, 111, 250,
+			160, 18, 76, 33, 7, 220, 78, 148, 143, 59, 4, 29, 96, 118,
+			162, 188, 195, 148, 44, 155, 79, 116, 27, 185, 28, 176, 58, 49,
+			184, 59, 149, 45, 255, 159, 131, 114, 127, 187, 20, 216, 88, 139,
+			208, 170, 181, 157, 149, 247, 180, 44, 159, 163, 38, 183, 109, 228,
+			189, 180, 181, 145, 183, 219, 116, 152, 217, 120, 111, 213, 192, 251,
+			171, 74, 27, 120, 175, 252, 216, 192, 251, 99, 3, 239, 143, 13,
+			188, 63, 54, 240, 254, 216, 192, 251, 99, 3, 239, 223, 25, 3,
+			175, 49, 76, 130, 205, 54, 53, 76, 194, 221, 248, 80, 169, 191,
+			213, 192, 219, 223, 102, 224, 53, 230, 88, 171, 100, 243, 67, 131,
+			198, 48, 121, 184, 163, 74, 61, 130, 73, 183, 163, 74, 141, 180,
+			129, 119, 111, 171, 129, 215, 152, 99, 141, 129, 215, 152, 99, 209,
+			192, 187, 127, 156, 122, 60, 146, 154, 99, 209, 192, 107, 204, 177,
+			218, 192, 235, 180, 26, 120, 157, 54, 3, 175, 49, 199, 114, 120,
+			53, 53, 199, 222, 145, 154, 99, 209, 192, 107, 102, 173, 13, 188,
+			135, 91, 13, 188, 135, 219, 12, 188, 198, 28, 11, 114, 204, 29,
+			169, 57, 118, 36, 53, 199, 130, 80, 153, 154, 99, 181, 129, 119,
+			168, 213, 192, 59, 212, 102, 224, 53, 230, 216, 66, 201, 230, 35,
+			123, 149, 252, 166, 212, 90, 136, 90, 199, 21, 203, 249, 26, 48,
+			13, 35, 155, 180, 90, 99, 99, 127, 57, 240, 26, 99, 106, 201,
+			191, 238, 53, 198, 155, 94, 176, 156, 92, 85, 241, 170, 27, 0,
+			111, 199, 187, 120, 218, 220, 107, 72, 176, 187, 186, 164, 76, 12,
+			151, 110, 197, 4, 155, 211, 115, 202, 22, 69, 167, 182, 126, 110,
+			97, 254, 53, 118, 83, 236, 181, 30, 6, 117, 111, 53, 1, 7,
+			167, 199, 60, 85, 105, 184, 27, 21, 180, 10, 87, 86, 194, 32,
+			185, 90, 49, 221, 68, 94, 19, 116, 111, 112, 162, 164, 26, 57,
+			56, 114, 83, 209, 161, 225, 131, 220, 226, 5, 117, 207, 92, 114,
+			164, 74, 214, 243, 173, 73, 207, 10, 210, 119, 134, 42, 0, 193,
+			79, 85, 65, 110, 3, 196, 145, 48, 82, 241, 218, 98, 2, 211,
+			5, 140, 192, 233, 164, 220, 172, 163, 156, 9, 213, 93, 93, 141,
+			194, 235, 62, 156, 179, 205, 13, 117, 124, 252, 206, 19, 99, 39,
+			78, 156, 64, 11, 112, 188, 141, 181, 49, 29, 25, 187, 109, 129,
+			16, 144, 165, 86, 99, 111, 173, 17, 162, 98, 203, 40, 254, 211,
+			6, 32, 163, 71, 137, 122, 64, 85, 171, 213, 251, 219, 159, 121,
+			65, 163, 229, 73, 58, 144, 17, 147, 205, 83, 253, 98, 42, 60,
+			155, 149, 124, 0, 124, 177, 210, 210, 184, 30, 203, 148, 239, 111,
+			123, 201, 24, 23, 224, 21, 253, 219, 188, 128, 37, 51, 136, 191,
+			164, 70, 55, 13, 244, 122, 117, 66, 221, 113, 71, 123, 95, 15,
+			170, 19, 71, 213, 223, 55, 102, 150, 77, 47, 29, 127, 64, 221,
+			121, 255, 166, 167, 52, 244, 3, 169, 209, 233, 196, 9, 106, 244,
+			78, 229, 53, 99, 175, 5, 128, 56, 237, 236, 193, 45, 33, 120,
+			253, 141, 33, 24, 191, 1, 4, 199, 183, 130, 224, 150, 44, 187,
+			89, 241, 120, 70, 26, 183, 79, 6, 219, 46, 246, 246, 68, 162,
+			95, 204, 175, 249, 3, 173, 107, 174, 142, 103, 211, 164, 42, 234,
+			47, 91, 117, 243, 10, 161, 33, 123, 97, 19, 25, 100, 239, 180,
+			226, 185, 133, 232, 242, 40, 206, 94, 56, 126, 227, 245, 205, 26,
+			62, 152, 111, 184, 205, 24, 199, 183, 30, 99, 124, 171, 49, 114,
+			70, 174, 90, 185, 87, 174, 26, 85, 234, 195, 108, 192, 169, 171,
+			121, 100, 172, 41, 39, 36, 245, 97, 158, 179, 182, 153, 95, 198,
+			239, 186, 243, 158, 177, 123, 238, 61, 5, 60, 2, 254, 151, 192,
+			205, 142, 183, 85, 110, 99, 178, 122, 152, 213, 108, 186, 26, 131,
+			218, 245, 225, 22, 147, 213, 195, 45, 38, 171, 135, 237, 126, 249,
+			223, 114, 163, 119, 125, 27, 179, 157, 239, 49, 3, 236, 237, 25,
+			171, 140, 74, 20, 230, 36, 179, 73, 25, 98, 139, 85, 211, 139,
+			81, 27, 20, 128, 191, 75, 218, 91, 212, 114, 164, 232, 43, 135,
+			171, 78, 72, 245, 40, 225, 234, 81, 82, 118, 0, 243, 5, 119,
+			161, 216, 71, 117, 65, 24, 169, 212, 194, 245, 40, 174, 40, 53,
+			172, 170, 243, 97, 148, 210, 86, 140, 160, 228, 6, 12, 35, 181,
+			18, 70, 160, 237, 66, 43, 217, 227, 94, 20, 146, 126, 214, 40,
+			67, 91, 122, 211, 247, 195, 69, 79, 166, 211, 3, 79, 86, 56,
+			38, 225, 240, 131, 138, 54, 56, 219, 151, 177, 197, 130, 6, 75,
+			152, 171, 216, 198, 162, 246, 54, 246, 112, 222, 162, 246, 182, 22,
+			139, 218, 219, 90, 44, 106, 111, 203, 89, 212, 94, 89, 149, 39,
+			111, 193, 162, 214, 12, 151, 87, 23, 39, 64, 107, 131, 239, 217,
+			5, 172, 184, 169, 217, 204, 185, 137, 70, 165, 242, 65, 46, 251,
+			83, 131, 196, 57, 47, 174, 71, 254, 106, 18, 70, 104, 155, 138,
+			188, 37, 255, 58, 25, 156, 168, 100, 219, 82, 192, 197, 16, 109,
+			105, 157, 53, 252, 109, 159, 148, 93, 100, 130, 74, 54, 86, 61,
+			180, 148, 237, 60, 217, 7, 150, 176, 213, 197, 234, 60, 62, 1,
+			253, 72, 141, 12, 85, 240, 219, 62, 40, 119, 192, 149, 210, 11,
+			18, 253, 18, 24, 160, 58, 107, 93, 84, 135, 77, 94, 39, 59,
+			211, 217, 12, 21, 110, 106, 187, 203, 26, 219, 175, 147, 34, 113,
+			151, 227, 161, 162, 226, 163, 93, 39, 15, 19, 36, 91, 76, 179,
+			186, 224, 46, 199, 104, 206, 170, 225, 27, 96, 247, 210, 55, 252,
+			43, 96, 29, 186, 226, 93, 79, 134, 74, 8, 89, 183, 174, 6,
+			111, 149, 169, 235, 9, 216, 7, 65, 234, 185, 238, 53, 174, 32,
+			253, 196, 67, 101, 197, 161, 25, 213, 158, 199, 74, 231, 94, 217,
+			153, 142, 96, 247, 74, 254, 152, 183, 65, 248, 132, 159, 160, 151,
+			66, 34, 38, 108, 234, 194, 105, 246, 58, 171, 242, 118, 41, 22,
+			188, 235, 137, 125, 135, 44, 52, 253, 192, 3, 141, 22, 76, 165,
+			151, 166, 2, 207, 170, 51, 126, 224, 213, 244, 99, 231, 180, 20,
+			80, 204, 122, 180, 114, 61, 218, 251, 100, 103, 195, 107, 250, 43,
+			126, 226, 69, 52, 86, 86, 81, 185, 91, 22, 207, 224, 228, 96,
+			209, 195, 165, 165, 216, 75, 16, 72, 81, 163, 18, 44, 58, 104,
+			169, 240, 213, 29, 53, 252, 93, 249, 199, 150, 44, 159, 115, 19,
+			119, 57, 114, 87, 210, 6, 86, 214, 192, 190, 83, 150, 86, 221,
+			40, 241, 221, 38, 25, 94, 119, 19, 240, 230, 173, 234, 37, 253,
+			184, 102, 218, 57, 23, 100, 137, 234, 96, 34, 136, 74, 132, 164,
+			187, 166, 11, 48, 78, 236, 63, 238, 97, 135, 162, 134, 191, 161,
+			174, 233, 198, 9, 146, 93, 185, 134, 191, 43, 95, 98, 82, 206,
+			163, 203, 212, 90, 228, 53, 236, 147, 178, 20, 121, 245, 48, 106,
+			24, 60, 14, 17, 40, 89, 155, 106, 13, 27, 212, 76, 67, 231,
+			101, 75, 22, 112, 21, 183, 88, 183, 67, 114, 7, 232, 166, 130,
+			229, 43, 185, 229, 187, 216, 81, 131, 109, 224, 7, 203, 15, 67,
+			165, 61, 12, 86, 219, 132, 90, 0, 112, 252, 98, 71, 173, 236,
+			7, 137, 126, 124, 72, 238, 104, 132, 107, 139, 77, 143, 90, 192,
+			6, 176, 160, 15, 93, 171, 27, 29, 144, 114, 49, 12, 155, 212,
+			4, 246, 64, 249, 98, 71, 173, 19, 234, 210, 6, 111, 143, 195,
+			128, 26, 20, 9, 142, 78, 168, 195, 6, 103, 74, 68, 16, 206,
+			125, 178, 168, 167, 104, 79, 200, 34, 209, 172, 38, 170, 221, 155,
+			145, 129, 19, 175, 81, 179, 202, 55, 153, 44, 207, 144, 217, 215,
+			62, 45, 187, 96, 163, 93, 201, 17, 74, 215, 201, 61, 155, 246,
+			165, 57, 64, 106, 18, 90, 207, 97, 99, 216, 244, 154, 141, 144,
+			77, 29, 240, 38, 106, 93, 186, 78, 91, 212, 15, 34, 106, 193,
+			156, 157, 153, 221, 69, 141, 248, 139, 110, 226, 200, 114, 12, 134,
+			209, 160, 174, 237, 214, 162, 150, 150, 237, 131, 82, 36, 176, 105,
+			37, 130, 213, 69, 51, 131, 237, 114, 177, 163, 134, 143, 236, 17,
+			89, 212, 123, 121, 168, 11, 27, 117, 83, 35, 189, 7, 46, 118,
+			212, 232, 177, 61, 174, 77, 231, 64, 170, 67, 59, 176, 105, 79,
+			27, 5, 195, 130, 154, 38, 246, 93, 104, 136, 39, 12, 14, 117,
+			227, 11, 125, 155, 80, 123, 177, 163, 150, 107, 118, 166, 83, 150,
+			136, 229, 85, 126, 141, 35, 150, 245, 28, 171, 82, 52, 188, 184,
+			78, 232, 117, 182, 231, 96, 53, 108, 103, 79, 200, 18, 217, 127,
+			134, 24, 46, 234, 174, 236, 21, 236, 177, 138, 171, 87, 51, 173,
+			236, 99, 178, 15, 118, 202, 149, 150, 245, 208, 200, 238, 129, 7,
+			151, 114, 107, 98, 218, 182, 44, 140, 200, 218, 206, 231, 22, 103,
+			27, 199, 1, 209, 230, 56, 224, 124, 214, 146, 5, 4, 105, 91,
+			166, 147, 95, 102, 182, 105, 153, 91, 9, 137, 223, 156, 144, 196,
+			102, 66, 106, 35, 229, 194, 109, 144, 114, 229, 159, 89, 218, 29,
+			197, 3, 35, 142, 158, 250, 9, 89, 0, 251, 151, 217, 86, 249,
+			69, 203, 90, 85, 23, 188, 104, 165, 166, 27, 110, 130, 17, 150,
+			174, 13, 198, 97, 41, 129, 195, 19, 42, 185, 226, 163, 221, 181,
+			78, 168, 209, 104, 60, 1, 231, 68, 132, 12, 24, 136, 155, 152,
+			148, 38, 244, 1, 115, 118, 64, 183, 221, 116, 82, 28, 251, 9,
+			41, 179, 35, 217, 46, 75, 177, 48, 245, 230, 133, 222, 14, 91,
+			202, 226, 153, 233, 217, 201, 218, 91, 122, 45, 123, 135, 44, 131,
+			38, 238, 66, 109, 242, 161, 94, 102, 239, 148, 114, 126, 161, 118,
+			249, 236, 194, 229, 218, 212, 185, 94, 254, 134, 143, 94, 145, 37,
+			187, 32, 58, 222, 195, 111, 232, 244, 113, 207, 223, 5, 167, 143,
+			238, 188, 211, 7, 252, 180, 108, 222, 89, 26, 145, 74, 178, 66,
+			135, 45, 118, 116, 244, 89, 206, 128, 154, 204, 155, 246, 64, 92,
+			169, 42, 184, 53, 20, 64, 107, 179, 163, 208, 3, 250, 158, 2,
+			42, 219, 186, 89, 23, 136, 121, 80, 176, 108, 222, 205, 138, 166,
+			196, 108, 222, 221, 41, 169, 161, 101, 243, 157, 172, 155, 26, 130,
+			61, 122, 39, 43, 155, 18, 179, 249, 206, 174, 29, 212, 144, 217,
+			188, 135, 245, 80, 67, 144, 244, 123, 152, 52, 37, 120, 214, 189,
+			147, 26, 114, 155, 247, 178, 62, 122, 4, 246, 193, 94, 182, 195,
+			148, 152, 205, 123, 123, 122, 229, 59, 180, 42, 105, 119, 199, 101,
+			203, 241, 142, 161, 23, 138, 153, 81, 35, 229, 38, 104, 188, 174,
+			170, 5, 112, 12, 33, 207, 145, 165, 53, 240, 117, 240, 80, 179,
+			227, 7, 16, 228, 135, 226, 35, 92, 9, 18, 73, 175, 46, 130,
+			151, 59, 224, 104, 217, 15, 114, 46, 9, 230, 98, 181, 187, 188,
+			87, 126, 61, 245, 30, 84, 108, 192, 249, 170, 37, 115, 206, 25,
+			35, 96, 188, 0, 118, 163, 70, 193, 227, 5, 108, 194, 71, 201,
+			81, 38, 86, 97, 228, 47, 251, 129, 139, 14, 232, 40, 155, 167,
+			226, 252, 153, 181, 164, 233, 129, 243, 123, 156, 184, 160, 19, 90,
+			71, 175, 140, 171, 96, 89, 112, 149, 230, 95, 208, 203, 36, 92,
+			20, 252, 134, 25, 34, 245, 234, 112, 149, 222, 7, 179, 238, 138,
+			151, 206, 3, 232, 229, 116, 102, 72, 219, 78, 48, 175, 135, 43,
+			43, 97, 96, 228, 115, 160, 136, 56, 127, 147, 83, 108, 247, 48,
+			137, 251, 112, 147, 83, 172, 156, 187, 201, 169, 206, 252, 77, 78,
+			217, 253, 242, 223, 167, 206, 135, 199, 152, 237, 252, 91, 194, 77,
+			70, 114, 35, 177, 2, 57, 187, 13, 59, 102, 145, 140, 21, 102,
+			45, 240, 223, 177, 230, 53, 55, 148, 15, 113, 24, 254, 210, 134,
+			114, 115, 125, 224, 21, 141, 246, 66, 92, 15, 87, 189, 212, 178,
+			179, 186, 9, 83, 56, 216, 223, 54, 158, 192, 81, 227, 24, 83,
+			249, 43, 211, 177, 20, 79, 176, 41, 142, 117, 230, 175, 76, 199,
+			122, 251, 228, 235, 140, 119, 207, 56, 27, 118, 142, 111, 70, 18,
+			157, 164, 10, 214, 35, 143, 44, 69, 67, 130, 251, 197, 56, 59,
+			102, 46, 217, 172, 8, 61, 25, 219, 52, 108, 175, 241, 212, 239,
+			9, 140, 252, 227, 123, 247, 201, 127, 107, 25, 147, 255, 41, 230,
+			56, 255, 178, 157, 108, 183, 27, 209, 44, 15, 93, 67, 149, 27,
+			168, 139, 11, 11, 151, 212, 89, 221, 126, 124, 1, 32, 68, 12,
+			27, 229, 231, 138, 219, 240, 148, 123, 205, 245, 155, 232, 6, 150,
+			132, 64, 253, 231, 194, 101, 105, 44, 236, 224, 170, 19, 168, 119,
+			172, 121, 209, 70, 182, 201, 192, 102, 234, 234, 61, 59, 157, 232,
+			13, 224, 54, 227, 16, 135, 92, 93, 109, 250, 100, 178, 39, 79,
+			4, 105, 172, 151, 64, 90, 248, 150, 89, 12, 240, 55, 56, 197,
+			198, 13, 209, 242, 130, 205, 79, 165, 139, 1, 252, 228, 84, 103,
+			222, 223, 224, 212, 208, 30, 249, 191, 90, 198, 225, 224, 65, 118,
+			204, 249, 245, 173, 136, 118, 209, 141, 189, 156, 231, 241, 22, 248,
+			9, 66, 227, 161, 128, 58, 38, 108, 108, 174, 236, 89, 87, 218,
+			89, 144, 36, 80, 223, 139, 149, 119, 29, 84, 15, 248, 162, 31,
+			201, 220, 16, 110, 172, 86, 252, 122, 100, 212, 28, 250, 96, 143,
+			13, 223, 48, 30, 24, 233, 181, 93, 8, 91, 60, 200, 78, 165,
+			206, 10, 69, 155, 63, 200, 246, 230, 156, 21, 30, 220, 119, 196,
+			148, 184, 205, 31, 28, 61, 42, 127, 94, 79, 187, 96, 243, 41,
+			118, 192, 121, 47, 76, 219, 69, 167, 48, 55, 80, 110, 180, 232,
+			39, 17, 32, 248, 49, 111, 99, 2, 151, 87, 37, 238, 178, 114,
+			227, 56, 172, 131, 83, 76, 106, 155, 245, 227, 252, 244, 52, 171,
+			59, 23, 46, 167, 107, 13, 186, 106, 92, 106, 48, 52, 231, 154,
+			106, 156, 54, 84, 24, 96, 199, 56, 68, 230, 123, 81, 16, 182,
+			152, 98, 15, 30, 35, 144, 11, 69, 0, 210, 172, 91, 193, 178,
+			249, 212, 160, 153, 106, 129, 219, 124, 106, 120, 191, 252, 69, 61,
+			157, 162, 205, 223, 200, 134, 157, 39, 45, 169, 166, 65, 241, 159,
+			140, 209, 162, 16, 235, 104, 54, 129, 164, 222, 30, 250, 160, 96,
+			74, 194, 101, 15, 67, 132, 27, 107, 112, 7, 74, 221, 88, 128,
+			212, 34, 79, 123, 208, 194, 235, 210, 240, 110, 227, 43, 135, 198,
+			128, 54, 66, 119, 19, 245, 122, 205, 129, 30, 156, 56, 62, 241,
+			122, 96, 61, 15, 86, 225, 118, 107, 38, 85, 20, 182, 120, 35,
+			155, 58, 64, 147, 42, 22, 0, 84, 67, 154, 69, 203, 230, 111,
+			236, 52, 155, 182, 200, 109, 254, 198, 189, 251, 228, 127, 214, 147,
+			42, 217, 124, 129, 29, 113, 190, 67, 164, 249, 152, 183, 145, 106,
+			240, 244, 197, 6, 74, 174, 202, 100, 27, 3, 38, 28, 109, 232,
+			199, 64, 151, 249, 31, 113, 129, 140, 11, 97, 230, 76, 21, 123,
+			210, 12, 157, 184, 143, 121, 70, 54, 73, 55, 76, 206, 141, 171,
+			170, 230, 130, 230, 198, 102, 224, 128, 83, 108, 192, 185, 91, 111,
+			230, 32, 164, 94, 141, 27, 32, 43, 9, 152, 123, 90, 42, 216,
+			124, 161, 171, 207, 148, 44, 155, 47, 216, 202, 148, 184, 205, 23,
+			14, 29, 150, 21, 9, 222, 57, 226, 205, 29, 203, 150, 51, 168,
+			22, 188, 235, 9, 13, 104, 120, 156, 22, 121, 4, 112, 230, 55,
+			151, 119, 200, 251, 165, 16, 22, 56, 46, 190, 149, 121, 220, 25,
+			71, 206, 230, 47, 175, 133, 107, 224, 80, 121, 61, 81, 40, 108,
+			26, 239, 6, 63, 82, 169, 186, 33, 174, 234, 161, 45, 244, 85,
+			124, 171, 220, 41, 207, 203, 34, 116, 5, 242, 211, 79, 137, 93,
+			206, 189, 184, 90, 240, 254, 8, 245, 69, 16, 140, 65, 180, 188,
+			86, 190, 53, 128, 238, 252, 36, 206, 186, 173, 42, 116, 239, 178,
+			200, 65, 241, 167, 196, 91, 123, 193, 184, 166, 59, 46, 64, 207,
+			50, 43, 91, 54, 255, 169, 174, 220, 115, 110, 243, 159, 234, 31,
+			144, 159, 176, 8, 18, 203, 230, 13, 177, 199, 249, 101, 195, 212,
+			52, 44, 233, 88, 20, 191, 6, 100, 49, 77, 98, 171, 102, 0,
+			222, 202, 106, 178, 65, 79, 83, 151, 143, 0, 79, 81, 152, 131,
+			31, 172, 121, 169, 64, 26, 192, 204, 244, 189, 21, 116, 31, 18,
+			91, 26, 95, 177, 116, 76, 115, 227, 49, 190, 108, 141, 208, 139,
+			17, 13, 110, 227, 26, 8, 61, 228, 59, 103, 145, 231, 99, 67,
+			252, 212, 174, 116, 90, 112, 164, 54, 114, 211, 134, 165, 107, 144,
+			95, 166, 69, 158, 143, 141, 221, 67, 32, 66, 10, 140, 220, 93,
+			98, 154, 61, 88, 160, 142, 230, 75, 68, 63, 22, 235, 40, 218,
+			124, 169, 171, 199, 148, 32, 128, 162, 119, 151, 41, 113, 155, 47,
+			13, 237, 145, 135, 37, 19, 204, 22, 111, 239, 8, 45, 103, 72,
+			233, 187, 244, 214, 20, 4, 7, 237, 219, 203, 59, 229, 5, 41,
+			4, 131, 97, 155, 108, 192, 57, 141, 136, 94, 220, 64, 247, 52,
+			96, 218, 173, 123, 35, 219, 183, 17, 28, 168, 186, 25, 9, 171,
+			200, 39, 24, 128, 44, 154, 236, 237, 189, 8, 23, 195, 53, 111,
+			18, 159, 208, 70, 229, 38, 201, 93, 12, 53, 232, 77, 187, 95,
+			142, 34, 4, 150, 205, 3, 214, 231, 236, 213, 16, 228, 1, 31,
+			137, 91, 135, 0, 12, 7, 172, 57, 64, 221, 0, 126, 3, 210,
+			242, 50, 212, 242, 6, 228, 233, 198, 16, 183, 65, 79, 175, 28,
+			147, 112, 114, 20, 162, 142, 247, 89, 150, 115, 64, 25, 197, 65,
+			27, 102, 114, 119, 10, 1, 167, 109, 84, 238, 149, 21, 41, 4,
+			154, 178, 19, 214, 231, 236, 210, 226, 132, 209, 53, 228, 193, 226,
+			56, 243, 132, 69, 90, 172, 225, 56, 243, 132, 192, 210, 198, 239,
+			132, 192, 226, 56, 243, 164, 167, 87, 190, 27, 56, 36, 231, 29,
+			118, 225, 113, 246, 223, 89, 220, 137, 90, 40, 25, 233, 75, 145,
+			82, 46, 29, 148, 8, 26, 57, 141, 62, 18, 244, 86, 4, 75,
+			0, 121, 191, 110, 0, 249, 74, 114, 51, 108, 247, 86, 198, 139,
+			132, 233, 140, 56, 21, 71, 38, 240, 184, 236, 147, 174, 44, 10,
+			174, 153, 192, 187, 196, 46, 167, 166, 119, 30, 178, 183, 49, 232,
+			48, 194, 11, 0, 30, 159, 160, 252, 31, 75, 47, 140, 166, 71,
+			176, 93, 47, 67, 22, 12, 67, 57, 48, 158, 76, 161, 167, 141,
+			194, 137, 63, 188, 75, 60, 142, 78, 12, 122, 204, 2, 12, 42,
+			179, 178, 101, 243, 119, 17, 127, 224, 196, 31, 222, 213, 63, 32,
+			39, 8, 70, 203, 22, 239, 177, 196, 128, 115, 0, 97, 140, 253,
+			199, 233, 250, 218, 54, 71, 37, 123, 169, 3, 75, 216, 133, 247,
+			88, 226, 93, 187, 82, 24, 172, 2, 246, 145, 1, 101, 97, 167,
+			93, 61, 89, 5, 135, 10, 187, 95, 94, 166, 81, 153, 45, 222,
+			107, 9, 219, 153, 202, 92, 127, 205, 130, 193, 116, 155, 110, 156,
+			108, 90, 51, 131, 11, 8, 157, 112, 243, 232, 87, 178, 143, 198,
+			97, 194, 46, 190, 215, 18, 239, 177, 6, 210, 161, 89, 1, 135,
+			42, 103, 21, 22, 84, 116, 118, 103, 21, 28, 42, 122, 225, 72,
+			1, 42, 181, 108, 241, 132, 197, 6, 101, 15, 44, 41, 24, 67,
+			138, 79, 88, 64, 87, 68, 160, 86, 17, 159, 119, 154, 34, 54,
+			151, 125, 166, 200, 161, 56, 176, 75, 30, 147, 76, 8, 187, 248,
+			164, 213, 241, 235, 150, 229, 236, 83, 153, 210, 172, 109, 187, 84,
+			149, 236, 146, 92, 192, 121, 245, 164, 85, 182, 229, 157, 82, 8,
+			193, 59, 236, 226, 207, 89, 236, 227, 22, 119, 14, 170, 73, 136,
+			62, 3, 207, 109, 216, 88, 141, 156, 56, 70, 198, 30, 28, 90,
+			0, 241, 137, 159, 179, 100, 143, 140, 101, 17, 122, 96, 29, 182,
+			248, 160, 37, 250, 157, 186, 38, 63, 108, 61, 18, 195, 251, 85,
+			217, 42, 69, 4, 94, 12, 242, 92, 184, 248, 118, 175, 158, 152,
+			67, 221, 141, 114, 66, 18, 29, 124, 171, 46, 68, 45, 198, 35,
+			208, 137, 142, 0, 147, 170, 82, 173, 84, 37, 174, 0, 14, 42,
+			236, 226, 7, 45, 241, 115, 86, 31, 226, 23, 171, 10, 8, 136,
+			204, 42, 44, 168, 232, 218, 153, 85, 112, 168, 232, 179, 229, 29,
+			26, 244, 114, 135, 93, 252, 176, 37, 158, 181, 10, 206, 64, 11,
+			236, 116, 203, 48, 47, 150, 97, 206, 31, 134, 57, 31, 164, 57,
+			91, 182, 248, 69, 171, 184, 223, 233, 3, 172, 225, 209, 213, 254,
+			14, 82, 235, 47, 90, 197, 29, 89, 5, 190, 212, 189, 39, 171,
+			224, 80, 177, 111, 24, 29, 177, 1, 96, 102, 139, 103, 172, 162,
+			227, 244, 171, 201, 0, 189, 91, 151, 189, 104, 83, 191, 64, 104,
+			207, 88, 197, 174, 172, 194, 130, 138, 29, 187, 178, 10, 14, 21,
+			67, 123, 228, 81, 234, 151, 219, 226, 159, 0, 180, 123, 212, 164,
+			90, 106, 134, 90, 47, 128, 225, 50, 155, 122, 231, 5, 108, 155,
+			65, 205, 45, 168, 200, 65, 205, 177, 183, 125, 195, 178, 66, 189,
+			11, 91, 124, 12, 160, 182, 213, 164, 2, 37, 187, 231, 6, 155,
+			186, 21, 5, 108, 148, 173, 13, 208, 225, 199, 172, 174, 12, 104,
+			193, 161, 98, 104, 143, 92, 164, 110, 11, 182, 248, 159, 173, 226,
+			62, 224, 106, 147, 234, 13, 243, 115, 179, 227, 38, 97, 0, 93,
+			18, 140, 7, 118, 16, 146, 91, 103, 106, 92, 37, 175, 218, 120,
+			173, 126, 21, 76, 151, 46, 250, 144, 130, 100, 173, 130, 181, 102,
+			147, 184, 26, 12, 90, 208, 131, 100, 179, 45, 88, 80, 209, 189,
+			59, 171, 224, 80, 225, 236, 149, 211, 184, 95, 44, 187, 248, 171,
+			22, 251, 53, 139, 59, 247, 101, 251, 37, 83, 77, 43, 109, 8,
+			25, 67, 111, 255, 16, 47, 29, 75, 42, 140, 26, 94, 148, 137,
+			155, 102, 31, 1, 243, 250, 85, 75, 2, 199, 132, 9, 131, 48,
+			33, 158, 179, 196, 126, 26, 26, 229, 9, 168, 48, 192, 161, 72,
+			33, 158, 179, 186, 237, 172, 194, 178, 197, 115, 86, 191, 89, 27,
+			20, 44, 196, 115, 214, 62, 184, 146, 10, 1, 30, 88, 226, 121,
+			139, 237, 215, 3, 98, 127, 207, 91, 76, 154, 98, 209, 22, 207,
+			91, 93, 125, 166, 104, 65, 209, 222, 99, 138, 28, 138, 251, 134,
+			229, 63, 183, 36, 19, 5, 187, 248, 41, 171, 227, 63, 88, 150,
+			243, 155, 214, 49, 169, 169, 179, 225, 95, 243, 27, 107, 110, 22,
+			71, 65, 219, 157, 228, 54, 116, 214, 7, 54, 26, 175, 65, 210,
+			44, 173, 11, 75, 34, 55, 136, 209, 63, 21, 238, 235, 233, 141,
+			90, 77, 39, 153, 90, 64, 179, 5, 169, 226, 171, 225, 90, 179,
+			1, 87, 168, 52, 83, 67, 38, 8, 34, 83, 187, 158, 152, 35,
+			100, 91, 141, 70, 21, 212, 135, 92, 192, 178, 126, 202, 42, 247,
+			202, 95, 131, 51, 28, 28, 201, 196, 139, 22, 59, 238, 252, 143,
+			36, 172, 18, 171, 164, 155, 52, 138, 82, 105, 172, 23, 117, 151,
+			78, 206, 136, 90, 49, 121, 69, 37, 225, 102, 16, 224, 194, 169,
+			42, 233, 21, 187, 2, 156, 13, 221, 7, 174, 209, 37, 47, 125,
+			68, 39, 189, 31, 103, 14, 189, 164, 239, 64, 50, 45, 136, 2,
+			172, 91, 241, 69, 139, 125, 202, 178, 113, 109, 10, 72, 7, 47,
+			90, 204, 49, 69, 203, 22, 47, 90, 123, 239, 48, 69, 14, 197,
+			163, 199, 180, 26, 166, 192, 44, 91, 124, 222, 98, 142, 243, 7,
+			52, 87, 138, 216, 162, 112, 165, 156, 134, 235, 210, 86, 234, 68,
+			163, 48, 75, 53, 91, 90, 99, 6, 19, 66, 236, 24, 19, 151,
+			114, 65, 131, 160, 87, 28, 132, 243, 200, 51, 250, 79, 186, 155,
+			193, 130, 186, 17, 37, 23, 201, 48, 69, 42, 71, 82, 232, 24,
+			181, 92, 195, 3, 183, 3, 136, 136, 89, 11, 220, 149, 69, 186,
+			35, 53, 65, 179, 131, 187, 73, 95, 36, 224, 228, 44, 224, 201,
+			249, 121, 139, 189, 104, 29, 39, 4, 0, 227, 253, 188, 197, 202,
+			166, 136, 8, 232, 220, 101, 138, 28, 138, 67, 123, 228, 191, 214,
+			232, 97, 182, 120, 25, 208, 243, 197, 27, 161, 7, 110, 77, 20,
+			145, 184, 5, 122, 218, 113, 67, 168, 0, 217, 129, 38, 223, 58,
+			119, 119, 37, 69, 54, 220, 92, 116, 199, 82, 129, 78, 245, 150,
+			17, 145, 226, 161, 69, 71, 105, 84, 35, 26, 51, 32, 165, 188,
+			108, 177, 207, 91, 134, 82, 224, 232, 120, 57, 195, 12, 144, 198,
+			203, 25, 102, 24, 135, 226, 208, 30, 249, 235, 224, 36, 83, 128,
+			226, 31, 91, 108, 208, 249, 40, 167, 77, 210, 118, 173, 50, 226,
+			27, 94, 47, 204, 166, 131, 9, 111, 232, 93, 154, 163, 14, 68,
+			149, 119, 61, 57, 221, 162, 37, 135, 235, 26, 225, 185, 165, 47,
+			18, 145, 27, 120, 159, 171, 170, 25, 106, 230, 215, 49, 80, 107,
+			217, 15, 180, 27, 146, 155, 160, 84, 91, 149, 116, 115, 106, 237,
+			60, 127, 45, 106, 233, 29, 31, 16, 194, 210, 145, 144, 13, 201,
+			244, 170, 209, 218, 85, 11, 136, 153, 52, 184, 144, 118, 105, 234,
+			240, 56, 194, 214, 26, 66, 2, 47, 147, 201, 110, 208, 111, 219,
+			212, 245, 65, 82, 85, 218, 252, 108, 166, 175, 187, 93, 244, 150,
+			253, 32, 155, 62, 173, 54, 23, 118, 241, 143, 45, 246, 114, 186,
+			218, 112, 148, 255, 113, 182, 218, 112, 144, 255, 177, 213, 217, 103,
+			138, 184, 188, 3, 187, 100, 2, 139, 13, 146, 208, 215, 45, 246,
+			239, 45, 238, 52, 244, 106, 155, 5, 37, 240, 104, 91, 152, 89,
+			195, 117, 6, 21, 52, 126, 172, 86, 195, 213, 53, 237, 132, 138,
+			113, 145, 160, 190, 149, 144, 67, 15, 50, 12, 105, 198, 56, 18,
+			171, 71, 201, 102, 7, 178, 229, 163, 164, 167, 42, 160, 92, 245,
+			117, 171, 220, 35, 39, 0, 8, 80, 53, 255, 9, 72, 146, 7,
+			181, 46, 69, 111, 140, 211, 72, 0, 177, 137, 72, 75, 188, 235,
+			9, 157, 161, 5, 38, 138, 248, 134, 153, 34, 8, 21, 127, 98,
+			117, 118, 155, 34, 135, 98, 175, 45, 199, 176, 247, 130, 45, 254,
+			204, 18, 187, 157, 253, 173, 55, 237, 211, 184, 124, 42, 246, 240,
+			34, 148, 118, 93, 40, 98, 115, 130, 148, 193, 9, 242, 103, 230,
+			180, 44, 160, 88, 240, 103, 214, 192, 160, 60, 142, 93, 23, 109,
+			241, 127, 89, 98, 175, 51, 220, 126, 89, 61, 157, 86, 152, 131,
+			191, 192, 138, 186, 245, 14, 83, 180, 160, 216, 109, 118, 97, 145,
+			67, 113, 200, 145, 167, 176, 231, 146, 45, 190, 99, 137, 3, 206,
+			232, 102, 201, 254, 116, 190, 74, 211, 75, 54, 72, 169, 136, 47,
+			238, 52, 69, 11, 138, 61, 123, 76, 145, 67, 113, 223, 126, 249,
+			77, 38, 1, 137, 197, 191, 176, 58, 254, 144, 89, 206, 255, 193,
+			180, 145, 107, 58, 13, 101, 13, 136, 74, 33, 88, 17, 74, 110,
+			50, 14, 217, 141, 232, 184, 195, 104, 69, 35, 229, 103, 39, 32,
+			220, 104, 177, 133, 126, 23, 36, 252, 101, 47, 240, 34, 36, 146,
+			197, 13, 36, 11, 29, 180, 11, 177, 61, 109, 26, 81, 232, 110,
+			50, 160, 162, 215, 200, 119, 11, 0, 169, 216, 3, 91, 60, 69,
+			4, 37, 196, 17, 211, 99, 104, 41, 114, 87, 188, 184, 154, 93,
+			132, 129, 20, 87, 201, 210, 166, 181, 133, 126, 93, 11, 45, 169,
+			79, 41, 112, 119, 13, 248, 24, 25, 118, 72, 165, 228, 175, 120,
+			192, 130, 128, 17, 163, 213, 7, 59, 31, 137, 141, 194, 195, 72,
+			2, 249, 80, 205, 86, 128, 23, 155, 225, 34, 137, 32, 69, 203,
+			22, 127, 1, 34, 200, 31, 192, 185, 3, 169, 197, 196, 95, 91,
+			236, 128, 243, 57, 58, 119, 182, 112, 83, 200, 100, 131, 92, 151,
+			237, 231, 143, 97, 35, 16, 58, 234, 197, 173, 135, 235, 86, 125,
+			154, 20, 89, 46, 169, 101, 65, 43, 43, 21, 132, 43, 102, 151,
+			113, 226, 153, 48, 170, 177, 149, 168, 197, 13, 213, 8, 215, 3,
+			136, 93, 53, 122, 68, 28, 152, 248, 79, 17, 197, 148, 191, 182,
+			216, 95, 144, 152, 82, 68, 49, 229, 175, 45, 182, 203, 20, 45,
+			91, 252, 181, 53, 232, 152, 34, 135, 226, 240, 126, 249, 44, 226,
+			3, 110, 162, 79, 48, 246, 191, 48, 238, 124, 208, 146, 10, 79,
+			13, 90, 111, 45, 100, 227, 96, 121, 57, 211, 84, 249, 113, 139,
+			180, 157, 39, 16, 58, 125, 199, 148, 231, 214, 175, 170, 122, 24,
+			233, 156, 0, 13, 202, 203, 229, 202, 156, 46, 81, 197, 129, 187,
+			26, 95, 13, 113, 230, 196, 244, 50, 180, 19, 199, 42, 226, 237,
+			247, 9, 38, 123, 64, 27, 84, 20, 69, 184, 84, 218, 226, 41,
+			38, 6, 157, 119, 200, 237, 212, 113, 30, 197, 245, 229, 86, 145,
+			6, 208, 220, 125, 122, 142, 142, 77, 210, 250, 200, 244, 220, 220,
+			12, 51, 30, 171, 230, 76, 133, 203, 112, 145, 46, 195, 79, 49,
+			241, 4, 211, 151, 225, 34, 93, 134, 159, 98, 116, 25, 46, 210,
+			101, 248, 41, 214, 149, 107, 193, 161, 98, 96, 151, 252, 231, 140,
+			102, 98, 217, 226, 23, 152, 24, 114, 126, 157, 221, 238, 129, 255,
+			154, 157, 239, 91, 30, 112, 255, 133, 159, 239, 233, 50, 128, 36,
+			250, 11, 76, 60, 133, 74, 29, 170, 42, 32, 78, 179, 101, 128,
+			91, 223, 47, 176, 174, 254, 172, 130, 67, 197, 224, 110, 249, 188,
+			33, 40, 102, 139, 143, 50, 177, 207, 121, 134, 56, 67, 198, 72,
+			201, 36, 226, 199, 68, 47, 198, 176, 28, 111, 35, 180, 163, 20,
+			185, 184, 145, 26, 96, 128, 143, 101, 118, 238, 244, 194, 145, 82,
+			27, 137, 146, 46, 49, 0, 73, 212, 154, 137, 175, 57, 23, 1,
+			51, 105, 16, 50, 63, 202, 196, 47, 176, 161, 116, 74, 32, 102,
+			126, 52, 63, 105, 16, 52, 63, 202, 186, 118, 103, 21, 28, 42,
+			156, 189, 242, 105, 51, 105, 110, 139, 143, 195, 164, 127, 134, 38,
+			157, 191, 138, 25, 173, 101, 122, 209, 124, 173, 167, 139, 23, 139,
+			116, 163, 155, 153, 129, 64, 245, 113, 38, 62, 202, 246, 165, 112,
+			131, 72, 245, 241, 252, 204, 64, 168, 250, 120, 126, 102, 160, 29,
+			249, 56, 115, 246, 202, 255, 104, 102, 38, 108, 241, 155, 76, 140,
+			59, 175, 222, 202, 204, 198, 128, 114, 115, 214, 216, 56, 63, 191,
+			150, 251, 102, 230, 127, 50, 18, 183, 92, 53, 73, 56, 203, 205,
+			29, 89, 74, 58, 253, 180, 105, 126, 244, 150, 123, 199, 118, 40,
+			148, 91, 224, 16, 78, 115, 200, 188, 149, 161, 13, 212, 145, 191,
+			201, 196, 199, 115, 104, 3, 49, 237, 55, 153, 200, 85, 88, 80,
+			49, 60, 154, 85, 112, 168, 56, 62, 38, 95, 101, 112, 26, 0,
+			193, 188, 200, 216, 176, 243, 101, 6, 218, 159, 140, 163, 187, 113,
+			221, 67, 94, 56, 110, 180, 42, 26, 12, 18, 79, 227, 204, 193,
+			30, 120, 188, 97, 233, 40, 60, 194, 49, 183, 197, 25, 13, 8,
+			126, 196, 92, 161, 252, 216, 156, 125, 173, 221, 130, 214, 216, 83,
+			21, 189, 106, 149, 49, 85, 201, 59, 210, 85, 198, 164, 170, 228,
+			93, 210, 42, 90, 124, 168, 228, 252, 228, 104, 89, 226, 212, 44,
+			156, 78, 196, 28, 102, 75, 64, 210, 94, 80, 223, 216, 60, 186,
+			49, 47, 52, 188, 37, 80, 147, 222, 175, 124, 125, 89, 94, 53,
+			180, 144, 202, 82, 224, 152, 18, 214, 209, 202, 31, 170, 250, 213,
+			48, 140, 193, 173, 39, 237, 218, 156, 98, 112, 103, 22, 47, 50,
+			82, 5, 21, 81, 215, 252, 34, 235, 234, 53, 69, 196, 126, 223,
+			144, 41, 130, 66, 129, 237, 221, 7, 170, 32, 88, 27, 102, 139,
+			151, 24, 59, 160, 85, 65, 11, 169, 22, 29, 49, 66, 140, 138,
+			216, 111, 43, 150, 13, 25, 135, 171, 32, 120, 185, 205, 42, 232,
+			228, 225, 10, 131, 216, 5, 187, 108, 172, 40, 204, 58, 8, 91,
+			220, 166, 220, 69, 136, 120, 54, 10, 123, 227, 125, 99, 12, 29,
+			250, 208, 4, 7, 166, 200, 67, 150, 156, 222, 186, 9, 140, 212,
+			89, 7, 46, 74, 69, 228, 88, 47, 49, 246, 34, 27, 166, 9,
+			2, 191, 122, 137, 209, 69, 169, 136, 250, 212, 151, 88, 167, 17,
+			84, 24, 135, 167, 195, 251, 205, 244, 185, 45, 190, 180, 121, 250,
+			116, 174, 255, 255, 50, 253, 252, 88, 183, 48, 253, 20, 4, 61,
+			125, 96, 107, 95, 98, 236, 37, 118, 128, 38, 8, 76, 237, 75,
+			217, 244, 129, 165, 125, 41, 155, 62, 48, 180, 47, 177, 225, 253,
+			242, 127, 211, 211, 23, 182, 248, 42, 236, 204, 79, 152, 233, 103,
+			194, 129, 225, 98, 91, 141, 253, 154, 76, 95, 15, 37, 219, 198,
+			186, 125, 20, 0, 139, 250, 42, 99, 95, 74, 81, 0, 234, 233,
+			175, 102, 40, 128, 123, 228, 87, 89, 167, 217, 0, 160, 154, 254,
+			42, 108, 128, 255, 147, 73, 38, 74, 118, 241, 85, 6, 126, 156,
+			206, 191, 164, 219, 82, 206, 83, 53, 189, 51, 93, 243, 34, 184,
+			236, 180, 136, 22, 232, 191, 10, 12, 222, 85, 224, 63, 186, 137,
+			62, 64, 226, 130, 158, 204, 38, 138, 111, 227, 214, 164, 92, 200,
+			255, 24, 251, 13, 82, 48, 102, 125, 35, 47, 74, 61, 154, 213,
+			20, 136, 194, 36, 153, 229, 112, 3, 163, 185, 49, 40, 155, 32,
+			199, 112, 0, 234, 37, 112, 66, 25, 147, 90, 227, 18, 0, 59,
+			37, 129, 12, 207, 40, 122, 11, 185, 112, 238, 182, 165, 47, 91,
+			40, 110, 195, 108, 13, 131, 162, 222, 226, 52, 180, 8, 0, 32,
+			23, 15, 82, 22, 43, 63, 161, 155, 82, 201, 178, 197, 171, 172,
+			60, 136, 38, 170, 18, 92, 12, 190, 193, 216, 255, 205, 184, 115,
+			16, 132, 204, 104, 101, 243, 181, 0, 116, 101, 94, 100, 132, 244,
+			18, 10, 233, 223, 96, 114, 167, 108, 200, 162, 40, 129, 240, 107,
+			139, 111, 49, 49, 224, 44, 208, 17, 236, 69, 43, 228, 39, 81,
+			197, 46, 181, 21, 170, 25, 174, 123, 209, 120, 221, 5, 49, 34,
+			90, 211, 241, 188, 77, 47, 1, 255, 139, 49, 213, 240, 151, 253,
+			36, 70, 206, 46, 181, 99, 109, 92, 135, 27, 23, 157, 124, 37,
+			18, 195, 191, 197, 196, 55, 88, 47, 158, 107, 37, 18, 195, 191,
+			101, 4, 134, 18, 137, 225, 223, 98, 100, 178, 44, 145, 24, 254,
+			45, 102, 247, 203, 167, 25, 1, 107, 217, 226, 207, 153, 216, 239,
+			252, 12, 137, 225, 6, 121, 55, 192, 29, 158, 92, 132, 130, 214,
+			119, 220, 40, 119, 214, 0, 240, 224, 253, 145, 184, 198, 150, 114,
+			58, 39, 56, 167, 106, 76, 114, 67, 194, 81, 205, 160, 90, 182,
+			206, 220, 45, 112, 137, 227, 181, 58, 28, 54, 16, 163, 150, 127,
+			57, 23, 115, 44, 211, 204, 74, 112, 97, 36, 255, 12, 211, 181,
+			113, 219, 88, 141, 188, 107, 126, 184, 214, 254, 156, 236, 52, 37,
+			114, 211, 248, 115, 70, 166, 144, 18, 201, 213, 127, 206, 186, 251,
+			179, 10, 196, 218, 192, 158, 172, 130, 67, 197, 190, 97, 121, 31,
+			80, 17, 80, 192, 247, 24, 115, 156, 227, 185, 171, 35, 145, 77,
+			60, 166, 226, 48, 202, 54, 153, 31, 161, 7, 141, 161, 38, 184,
+			216, 138, 239, 153, 195, 178, 132, 215, 218, 239, 193, 10, 82, 209,
+			130, 158, 123, 119, 153, 34, 135, 226, 208, 30, 121, 25, 135, 181,
+			108, 241, 3, 198, 14, 57, 23, 104, 127, 231, 56, 55, 96, 22,
+			177, 152, 202, 82, 45, 27, 18, 119, 89, 182, 114, 122, 163, 25,
+			24, 0, 31, 63, 200, 64, 2, 108, 252, 128, 145, 114, 170, 132,
+			231, 247, 15, 152, 189, 223, 20, 57, 60, 61, 88, 209, 6, 129,
+			18, 99, 182, 120, 55, 103, 7, 83, 131, 64, 142, 129, 227, 174,
+			244, 131, 86, 184, 198, 192, 66, 238, 54, 155, 94, 19, 182, 115,
+			171, 164, 3, 244, 6, 234, 185, 118, 130, 35, 70, 146, 132, 173,
+			93, 141, 196, 217, 24, 56, 35, 64, 61, 153, 89, 64, 62, 203,
+			229, 71, 89, 113, 87, 65, 105, 147, 235, 155, 212, 48, 105, 103,
+			72, 205, 217, 29, 51, 69, 14, 168, 17, 223, 205, 83, 228, 192,
+			233, 254, 110, 158, 34, 7, 78, 247, 119, 115, 123, 159, 41, 114,
+			40, 30, 80, 105, 104, 225, 179, 159, 181, 228, 27, 183, 115, 205,
+			189, 229, 108, 157, 144, 181, 179, 45, 89, 231, 107, 154, 2, 212,
+			249, 17, 194, 31, 255, 230, 113, 143, 79, 114, 41, 47, 120, 73,
+			13, 196, 201, 56, 129, 220, 81, 171, 81, 8, 182, 124, 10, 161,
+			48, 69, 219, 150, 98, 213, 77, 174, 82, 216, 28, 254, 134, 224,
+			52, 244, 113, 161, 152, 51, 93, 200, 66, 214, 32, 212, 132, 155,
+			144, 181, 97, 41, 225, 26, 159, 139, 133, 41, 212, 58, 161, 6,
+			3, 56, 32, 183, 39, 36, 218, 212, 79, 139, 248, 180, 220, 12,
+			151, 245, 195, 35, 114, 103, 16, 6, 87, 50, 229, 32, 6, 35,
+			150, 107, 221, 65, 24, 100, 14, 119, 246, 180, 236, 89, 134, 188,
+			207, 72, 168, 144, 233, 19, 162, 17, 33, 150, 229, 160, 201, 70,
+			154, 205, 180, 10, 81, 194, 151, 107, 51, 84, 172, 117, 47, 123,
+			9, 84, 121, 141, 203, 81, 51, 118, 214, 228, 206, 214, 6, 246,
+			61, 178, 220, 244, 151, 60, 192, 239, 205, 131, 189, 210, 166, 16,
+			213, 163, 119, 23, 34, 174, 92, 163, 82, 134, 36, 66, 29, 22,
+			42, 111, 146, 93, 11, 174, 223, 124, 13, 87, 3, 130, 214, 186,
+			112, 218, 160, 114, 138, 189, 27, 244, 57, 102, 222, 135, 78, 187,
+			78, 14, 26, 164, 165, 42, 71, 204, 54, 75, 253, 166, 145, 89,
+			252, 22, 35, 179, 14, 73, 1, 59, 104, 72, 40, 158, 139, 32,
+			51, 27, 191, 134, 15, 237, 191, 39, 187, 242, 171, 167, 35, 145,
+			246, 183, 172, 158, 158, 70, 53, 91, 171, 154, 140, 179, 117, 187,
+			38, 101, 246, 196, 62, 45, 37, 230, 214, 195, 69, 73, 99, 200,
+			182, 15, 157, 205, 181, 110, 91, 184, 206, 173, 23, 174, 211, 44,
+			220, 83, 69, 185, 227, 77, 107, 94, 180, 241, 26, 46, 29, 12,
+			133, 164, 69, 89, 105, 117, 1, 54, 34, 248, 76, 226, 22, 234,
+			172, 225, 111, 251, 128, 236, 90, 113, 175, 95, 137, 188, 120, 173,
+			153, 196, 180, 127, 228, 138, 123, 189, 166, 107, 54, 133, 25, 203,
+			205, 97, 198, 231, 91, 163, 151, 117, 80, 224, 17, 131, 251, 252,
+			228, 114, 177, 204, 231, 253, 102, 226, 69, 45, 17, 205, 39, 100,
+			33, 240, 214, 189, 104, 104, 199, 77, 241, 173, 27, 218, 39, 100,
+			33, 108, 54, 188, 104, 168, 251, 230, 111, 96, 195, 205, 25, 143,
+			119, 110, 145, 241, 248, 36, 69, 63, 247, 40, 158, 167, 162, 150,
+			153, 180, 199, 61, 223, 157, 166, 34, 238, 197, 232, 237, 125, 91,
+			191, 21, 161, 246, 210, 36, 42, 182, 95, 151, 70, 146, 246, 225,
+			88, 106, 203, 183, 48, 152, 148, 70, 163, 246, 206, 253, 178, 183,
+			29, 153, 246, 72, 62, 118, 121, 203, 0, 114, 10, 101, 253, 81,
+			163, 170, 157, 251, 100, 87, 14, 152, 219, 121, 181, 114, 88, 150,
+			104, 246, 16, 51, 119, 102, 110, 225, 98, 111, 135, 93, 146, 252,
+			45, 83, 243, 189, 150, 93, 148, 108, 118, 174, 151, 85, 158, 102,
+			178, 155, 230, 126, 83, 182, 115, 74, 150, 72, 39, 73, 33, 155,
+			237, 56, 55, 59, 30, 27, 213, 76, 227, 116, 31, 240, 108, 31,
+			56, 191, 104, 201, 162, 198, 83, 186, 205, 40, 18, 16, 126, 255,
+			45, 115, 184, 97, 41, 129, 35, 94, 201, 246, 236, 142, 90, 39,
+			212, 96, 214, 195, 202, 159, 91, 178, 107, 198, 143, 111, 225, 168,
+			221, 43, 59, 1, 220, 43, 224, 114, 66, 43, 80, 134, 138, 51,
+			110, 236, 109, 195, 42, 12, 50, 68, 134, 12, 251, 64, 186, 161,
+			33, 131, 37, 229, 214, 166, 157, 10, 78, 245, 58, 120, 31, 181,
+			233, 87, 136, 232, 129, 113, 148, 107, 221, 84, 123, 9, 43, 115,
+			129, 169, 112, 234, 22, 210, 192, 212, 54, 166, 83, 110, 103, 58,
+			149, 191, 98, 114, 135, 158, 241, 77, 137, 224, 134, 83, 222, 98,
+			165, 237, 7, 165, 68, 135, 172, 0, 116, 154, 67, 162, 117, 139,
+			231, 7, 173, 158, 53, 205, 106, 185, 55, 156, 111, 88, 178, 51,
+			125, 2, 196, 18, 184, 116, 176, 155, 108, 14, 247, 74, 129, 172,
+			18, 22, 96, 231, 201, 67, 55, 238, 187, 138, 251, 18, 95, 200,
+			168, 140, 223, 14, 149, 137, 91, 163, 178, 202, 81, 41, 76, 196,
+			234, 165, 73, 220, 125, 82, 22, 231, 23, 106, 83, 147, 15, 245,
+			90, 118, 151, 44, 93, 170, 205, 189, 97, 234, 236, 66, 47, 171,
+			252, 154, 37, 187, 181, 34, 227, 71, 59, 149, 76, 48, 45, 225,
+			29, 110, 80, 192, 121, 201, 81, 235, 10, 200, 233, 49, 158, 77,
+			133, 218, 14, 170, 132, 139, 67, 220, 126, 28, 21, 54, 29, 71,
+			102, 69, 139, 217, 138, 86, 254, 51, 147, 59, 13, 180, 55, 165,
+			151, 123, 219, 153, 198, 176, 193, 114, 107, 23, 183, 196, 53, 158,
+			180, 100, 225, 33, 112, 110, 216, 20, 136, 12, 163, 242, 173, 3,
+			145, 179, 200, 253, 130, 14, 68, 214, 143, 33, 11, 131, 31, 120,
+			166, 127, 120, 0, 27, 72, 127, 224, 4, 233, 180, 179, 70, 37,
+			216, 202, 238, 18, 164, 162, 40, 96, 181, 46, 56, 209, 13, 89,
+			216, 41, 89, 66, 63, 12, 111, 19, 183, 108, 155, 56, 78, 168,
+			102, 26, 219, 251, 100, 103, 18, 173, 5, 144, 212, 184, 65, 172,
+			35, 171, 56, 249, 15, 153, 20, 51, 32, 128, 85, 37, 191, 224,
+			37, 182, 189, 89, 96, 118, 250, 91, 234, 104, 133, 32, 238, 218,
+			245, 155, 118, 250, 48, 39, 190, 110, 253, 198, 221, 178, 128, 39,
+			131, 61, 208, 198, 230, 245, 59, 187, 218, 106, 233, 173, 59, 33,
+			175, 71, 156, 100, 227, 228, 56, 169, 51, 176, 213, 222, 180, 239,
+			149, 69, 141, 18, 123, 87, 59, 138, 244, 107, 131, 237, 213, 122,
+			172, 55, 252, 199, 95, 178, 116, 96, 247, 171, 252, 191, 170, 108,
+			254, 151, 179, 192, 238, 251, 241, 39, 179, 185, 164, 112, 111, 110,
+			243, 174, 210, 136, 252, 60, 104, 67, 59, 108, 49, 208, 241, 38,
+			203, 249, 52, 83, 217, 242, 27, 61, 16, 165, 226, 207, 28, 100,
+			201, 163, 1, 111, 249, 17, 188, 160, 204, 141, 55, 141, 11, 74,
+			223, 106, 53, 152, 123, 215, 253, 24, 213, 111, 20, 110, 155, 27,
+			12, 77, 42, 168, 138, 242, 48, 211, 222, 178, 27, 53, 48, 1,
+			83, 184, 4, 241, 159, 105, 222, 214, 214, 126, 241, 123, 92, 152,
+			157, 59, 13, 32, 5, 24, 102, 225, 75, 155, 121, 59, 171, 254,
+			172, 0, 4, 144, 169, 200, 75, 214, 162, 64, 45, 129, 28, 10,
+			176, 81, 138, 167, 172, 223, 134, 118, 140, 212, 106, 108, 105, 2,
+			246, 252, 166, 159, 108, 128, 142, 26, 253, 88, 3, 183, 9, 106,
+			59, 200, 5, 13, 23, 253, 92, 196, 247, 64, 217, 150, 85, 19,
+			240, 61, 200, 118, 57, 7, 213, 66, 14, 137, 196, 205, 96, 0,
+			170, 34, 141, 7, 42, 30, 197, 32, 27, 48, 97, 193, 16, 204,
+			50, 152, 134, 251, 65, 176, 200, 96, 103, 175, 41, 65, 14, 253,
+			254, 1, 249, 73, 176, 179, 129, 174, 145, 31, 96, 182, 243, 113,
+			134, 67, 1, 211, 72, 181, 140, 25, 238, 147, 80, 45, 123, 73,
+			170, 196, 7, 130, 210, 83, 68, 67, 150, 137, 242, 163, 198, 186,
+			15, 77, 156, 243, 23, 39, 79, 222, 115, 10, 188, 226, 176, 91,
+			211, 52, 181, 96, 64, 91, 232, 118, 62, 92, 241, 212, 90, 2,
+			136, 242, 189, 24, 113, 189, 228, 7, 13, 181, 10, 138, 36, 80,
+			60, 71, 72, 188, 174, 246, 78, 161, 241, 224, 101, 64, 198, 162,
+			167, 234, 168, 193, 138, 195, 21, 72, 223, 187, 102, 252, 117, 148,
+			206, 233, 136, 218, 233, 13, 112, 115, 1, 219, 3, 60, 131, 110,
+			77, 159, 0, 38, 194, 7, 17, 241, 158, 11, 186, 111, 36, 34,
+			48, 31, 92, 67, 44, 196, 244, 77, 60, 63, 11, 175, 4, 245,
+			219, 1, 54, 104, 162, 68, 33, 166, 233, 64, 138, 111, 136, 105,
+			58, 208, 18, 134, 125, 160, 183, 79, 78, 155, 48, 236, 10, 235,
+			115, 94, 159, 69, 163, 208, 82, 146, 62, 183, 21, 241, 35, 49,
+			69, 5, 249, 49, 209, 158, 151, 133, 225, 130, 150, 171, 194, 14,
+			164, 113, 217, 5, 155, 87, 88, 49, 23, 151, 93, 41, 165, 81,
+			218, 220, 230, 149, 158, 94, 10, 5, 135, 84, 158, 204, 166, 80,
+			112, 63, 240, 209, 249, 37, 183, 211, 200, 213, 32, 76, 145, 144,
+			14, 9, 1, 207, 71, 88, 197, 196, 68, 66, 192, 243, 17, 138,
+			153, 66, 75, 56, 63, 146, 38, 236, 130, 128, 231, 35, 189, 125,
+			242, 91, 204, 4, 60, 143, 179, 221, 206, 43, 154, 204, 86, 220,
+			235, 254, 202, 218, 74, 206, 114, 4, 170, 157, 152, 198, 92, 139,
+			32, 71, 230, 82, 154, 178, 108, 140, 204, 157, 38, 54, 27, 118,
+			172, 204, 109, 33, 120, 13, 99, 12, 85, 210, 110, 141, 34, 172,
+			130, 74, 50, 135, 63, 114, 227, 134, 72, 81, 93, 27, 167, 201,
+			249, 241, 165, 170, 154, 52, 95, 36, 132, 34, 154, 6, 104, 43,
+			55, 61, 132, 6, 56, 142, 164, 151, 193, 15, 179, 233, 129, 9,
+			16, 50, 175, 1, 30, 71, 189, 107, 94, 0, 25, 248, 252, 68,
+			93, 243, 195, 102, 154, 80, 30, 131, 158, 50, 192, 143, 2, 173,
+			41, 55, 6, 15, 181, 96, 3, 188, 201, 125, 250, 44, 138, 30,
+			54, 54, 9, 56, 77, 202, 103, 239, 122, 221, 243, 192, 248, 157,
+			58, 166, 83, 79, 233, 10, 65, 108, 246, 56, 59, 98, 136, 2,
+			18, 201, 143, 167, 43, 4, 177, 217, 227, 229, 244, 25, 4, 235,
+			239, 26, 148, 31, 96, 38, 54, 251, 110, 54, 232, 252, 236, 118,
+			43, 4, 19, 35, 31, 195, 86, 14, 148, 198, 192, 165, 22, 0,
+			189, 104, 65, 72, 217, 82, 51, 48, 51, 227, 183, 94, 202, 106,
+			235, 187, 82, 249, 249, 204, 121, 105, 55, 121, 179, 162, 233, 33,
+			93, 206, 140, 37, 101, 186, 99, 169, 150, 188, 164, 126, 53, 79,
+			212, 153, 183, 68, 156, 162, 19, 27, 1, 54, 221, 96, 35, 63,
+			191, 20, 155, 16, 26, 126, 55, 27, 223, 109, 66, 195, 17, 71,
+			6, 155, 16, 26, 126, 119, 217, 236, 5, 8, 13, 191, 123, 96,
+			151, 252, 25, 97, 66, 195, 207, 48, 199, 249, 46, 207, 54, 186,
+			219, 108, 226, 119, 241, 128, 163, 227, 209, 67, 40, 204, 168, 30,
+			41, 62, 231, 211, 152, 129, 163, 38, 243, 190, 142, 230, 197, 209,
+			134, 183, 228, 174, 53, 147, 163, 20, 88, 152, 160, 169, 208, 124,
+			234, 42, 13, 224, 247, 84, 188, 166, 241, 45, 21, 138, 157, 72,
+			118, 113, 18, 174, 2, 141, 18, 35, 7, 242, 132, 68, 169, 225,
+			82, 202, 6, 224, 240, 195, 21, 196, 224, 149, 212, 196, 10, 59,
+			81, 42, 140, 225, 200, 50, 42, 32, 207, 128, 79, 35, 204, 230,
+			117, 172, 41, 164, 8, 95, 228, 173, 192, 247, 196, 97, 164, 166,
+			11, 70, 53, 153, 165, 116, 135, 93, 9, 169, 12, 61, 157, 23,
+			116, 76, 197, 238, 70, 251, 41, 4, 116, 228, 199, 73, 172, 194,
+			165, 211, 82, 253, 228, 93, 99, 234, 238, 49, 117, 106, 76, 221,
+			251, 211, 219, 33, 8, 22, 154, 166, 124, 151, 129, 1, 16, 125,
+			90, 191, 253, 211, 16, 35, 25, 174, 174, 2, 9, 44, 122, 117,
+			119, 13, 34, 205, 239, 1, 34, 164, 217, 193, 132, 54, 173, 73,
+			203, 140, 160, 183, 22, 80, 82, 218, 129, 8, 252, 51, 236, 238,
+			65, 162, 143, 98, 1, 40, 194, 176, 103, 136, 192, 63, 83, 50,
+			199, 7, 68, 224, 159, 25, 218, 35, 95, 177, 204, 119, 109, 46,
+			176, 57, 238, 124, 1, 63, 87, 98, 214, 110, 140, 68, 24, 250,
+			166, 17, 142, 111, 172, 127, 46, 101, 177, 4, 53, 166, 113, 26,
+			201, 37, 180, 39, 152, 33, 34, 16, 155, 193, 55, 108, 98, 220,
+			123, 185, 50, 114, 57, 112, 50, 129, 1, 243, 214, 48, 216, 25,
+			35, 177, 76, 191, 193, 71, 78, 191, 42, 198, 15, 12, 182, 129,
+			149, 13, 174, 183, 41, 198, 118, 83, 216, 106, 7, 152, 101, 249,
+			5, 57, 36, 47, 210, 119, 71, 58, 108, 62, 45, 142, 57, 247,
+			81, 188, 184, 214, 151, 103, 7, 97, 6, 93, 218, 223, 34, 202,
+			1, 73, 88, 197, 67, 61, 251, 56, 9, 8, 64, 211, 226, 66,
+			246, 141, 24, 136, 190, 158, 22, 251, 178, 178, 101, 243, 233, 225,
+			35, 89, 153, 219, 124, 122, 244, 168, 124, 3, 129, 98, 217, 124,
+			70, 12, 56, 247, 171, 26, 49, 245, 252, 232, 70, 104, 69, 76,
+			144, 71, 254, 244, 92, 246, 121, 40, 202, 10, 98, 128, 1, 233,
+			96, 70, 76, 31, 75, 7, 3, 249, 96, 38, 247, 61, 22, 144,
+			16, 102, 58, 123, 178, 50, 183, 249, 140, 221, 47, 167, 8, 24,
+			102, 243, 89, 209, 239, 156, 186, 5, 96, 82, 95, 237, 84, 253,
+			153, 193, 1, 18, 194, 172, 152, 201, 190, 41, 4, 31, 206, 153,
+			205, 193, 1, 210, 223, 108, 231, 206, 172, 204, 109, 62, 219, 103,
+			99, 108, 59, 102, 130, 184, 196, 142, 16, 57, 151, 132, 45, 46,
+			177, 57, 243, 109, 149, 82, 209, 230, 151, 152, 145, 41, 75, 150,
+			205, 47, 245, 229, 147, 35, 92, 58, 116, 88, 254, 99, 8, 28,
+			179, 108, 113, 185, 163, 97, 57, 63, 103, 169, 220, 101, 239, 22,
+			239, 7, 240, 70, 118, 65, 0, 15, 53, 58, 175, 101, 234, 12,
+			67, 94, 230, 202, 85, 203, 62, 156, 186, 57, 126, 65, 84, 68,
+			238, 118, 249, 241, 72, 230, 134, 101, 184, 92, 238, 71, 153, 27,
+			162, 239, 248, 35, 183, 46, 115, 235, 232, 188, 71, 216, 229, 52,
+			164, 191, 96, 243, 71, 72, 6, 212, 225, 254, 143, 144, 204, 141,
+			81, 121, 252, 17, 35, 115, 91, 128, 245, 71, 127, 44, 115, 223,
+			158, 204, 109, 161, 203, 218, 163, 236, 17, 131, 111, 216, 83, 143,
+			166, 248, 134, 165, 124, 148, 100, 110, 11, 247, 211, 163, 36, 115,
+			91, 144, 250, 168, 254, 154, 200, 220, 22, 120, 142, 137, 58, 123,
+			212, 166, 113, 96, 63, 213, 137, 169, 91, 40, 115, 215, 73, 230,
+			182, 112, 47, 213, 123, 122, 229, 28, 166, 120, 40, 44, 119, 252,
+			247, 150, 229, 156, 81, 57, 229, 70, 182, 9, 168, 124, 107, 183,
+			100, 147, 13, 98, 185, 12, 12, 131, 178, 65, 248, 108, 151, 243,
+			58, 117, 137, 168, 149, 58, 54, 196, 27, 184, 57, 174, 26, 19,
+			126, 23, 61, 240, 23, 82, 73, 72, 147, 211, 185, 32, 124, 182,
+			188, 43, 151, 11, 194, 39, 4, 51, 36, 104, 159, 8, 90, 231,
+			130, 240, 251, 7, 244, 137, 133, 19, 15, 217, 94, 231, 139, 86,
+			123, 24, 72, 38, 117, 145, 204, 65, 242, 9, 41, 55, 82, 215,
+			167, 233, 84, 75, 65, 75, 163, 125, 49, 98, 248, 78, 51, 5,
+			205, 209, 131, 17, 136, 195, 195, 94, 140, 151, 43, 44, 169, 249,
+			250, 156, 4, 20, 36, 33, 61, 244, 227, 52, 226, 31, 190, 70,
+			230, 38, 144, 143, 36, 83, 145, 82, 43, 60, 90, 224, 224, 91,
+			204, 220, 197, 83, 156, 0, 209, 133, 204, 55, 56, 177, 138, 54,
+			15, 137, 237, 233, 228, 21, 97, 223, 96, 46, 121, 69, 184, 199,
+			145, 223, 215, 56, 97, 54, 95, 103, 71, 156, 111, 107, 156, 120,
+			215, 87, 221, 0, 156, 119, 183, 80, 213, 166, 231, 136, 241, 193,
+			5, 53, 65, 96, 190, 82, 14, 129, 207, 40, 40, 197, 107, 43,
+			171, 70, 84, 34, 69, 73, 166, 3, 25, 137, 219, 103, 158, 255,
+			64, 153, 57, 61, 211, 64, 173, 251, 165, 142, 152, 94, 247, 99,
+			66, 15, 120, 216, 186, 77, 255, 113, 253, 245, 33, 4, 39, 125,
+			205, 124, 90, 134, 124, 185, 50, 200, 113, 72, 74, 206, 147, 162,
+			12, 246, 200, 58, 11, 247, 18, 90, 64, 20, 94, 167, 244, 80,
+			12, 73, 101, 125, 159, 50, 37, 110, 243, 245, 67, 135, 229, 223,
+			67, 140, 113, 155, 63, 206, 14, 57, 39, 129, 97, 101, 110, 188,
+			116, 115, 210, 190, 210, 134, 69, 52, 218, 196, 117, 221, 29, 23,
+			208, 67, 90, 42, 218, 252, 241, 174, 61, 166, 4, 25, 51, 156,
+			253, 166, 4, 99, 29, 172, 200, 55, 194, 192, 188, 195, 46, 188,
+			147, 189, 215, 226, 206, 235, 213, 197, 16, 50, 18, 108, 227, 123,
+			217, 194, 35, 180, 116, 0, 215, 146, 13, 56, 150, 13, 16, 40,
+			227, 188, 83, 14, 200, 251, 101, 17, 74, 112, 172, 188, 75, 140,
+			59, 99, 153, 235, 55, 125, 219, 205, 143, 55, 9, 56, 104, 80,
+			54, 217, 105, 24, 137, 53, 239, 18, 239, 212, 223, 92, 99, 36,
+			214, 188, 75, 12, 103, 101, 72, 186, 177, 127, 52, 43, 115, 155,
+			191, 235, 248, 152, 60, 77, 163, 99, 126, 12, 49, 232, 28, 163,
+			164, 27, 8, 116, 110, 131, 94, 174, 205, 140, 193, 45, 32, 221,
+			118, 85, 41, 123, 169, 47, 147, 127, 99, 60, 5, 167, 37, 255,
+			6, 203, 242, 111, 244, 101, 21, 28, 42, 6, 118, 201, 251, 8,
+			0, 102, 139, 159, 181, 196, 46, 231, 104, 59, 0, 120, 99, 216,
+			114, 252, 62, 26, 31, 220, 116, 127, 214, 18, 239, 177, 6, 211,
+			238, 193, 149, 231, 103, 243, 0, 128, 51, 207, 207, 90, 93, 189,
+			89, 5, 135, 138, 254, 1, 204, 73, 143, 252, 250, 125, 22, 27,
+			118, 22, 33, 100, 221, 120, 158, 230, 193, 208, 11, 184, 89, 164,
+			219, 4, 152, 186, 230, 187, 224, 230, 237, 47, 7, 148, 167, 117,
+			45, 106, 94, 49, 66, 107, 133, 92, 75, 25, 38, 227, 120, 159,
+			5, 228, 68, 251, 1, 124, 223, 223, 103, 177, 29, 166, 104, 65,
+			177, 123, 200, 20, 57, 20, 247, 238, 147, 53, 204, 121, 83, 124,
+			202, 234, 248, 174, 101, 57, 231, 84, 94, 207, 125, 139, 226, 18,
+			190, 146, 63, 42, 192, 153, 18, 188, 121, 159, 178, 202, 3, 242,
+			30, 72, 94, 35, 58, 236, 226, 251, 45, 246, 33, 139, 59, 71,
+			20, 153, 102, 243, 123, 205, 85, 9, 85, 226, 141, 156, 54, 53,
+			38, 164, 20, 239, 183, 74, 58, 237, 20, 7, 165, 162, 45, 254,
+			145, 37, 186, 157, 83, 234, 76, 152, 92, 205, 82, 213, 3, 155,
+			79, 115, 213, 147, 73, 39, 101, 36, 185, 243, 20, 214, 11, 251,
+			177, 176, 35, 202, 154, 2, 21, 12, 42, 186, 118, 32, 5, 65,
+			11, 203, 22, 31, 176, 196, 14, 231, 168, 2, 51, 100, 54, 210,
+			45, 116, 14, 228, 249, 1, 75, 148, 178, 10, 6, 21, 178, 43,
+			237, 156, 217, 226, 131, 150, 232, 50, 157, 223, 14, 228, 64, 122,
+			31, 180, 68, 49, 171, 192, 206, 58, 37, 198, 178, 66, 18, 34,
+			241, 17, 235, 214, 4, 202, 30, 147, 145, 168, 248, 17, 92, 27,
+			202, 241, 2, 190, 163, 31, 49, 241, 187, 152, 148, 72, 124, 196,
+			234, 236, 53, 69, 14, 79, 251, 7, 228, 191, 41, 155, 116, 50,
+			47, 88, 204, 118, 94, 46, 227, 128, 58, 17, 30, 56, 238, 173,
+			120, 144, 233, 139, 252, 66, 65, 250, 51, 153, 0, 129, 189, 129,
+			170, 53, 94, 91, 140, 19, 63, 89, 75, 64, 176, 92, 110, 134,
+			139, 106, 180, 114, 172, 114, 20, 143, 159, 92, 216, 12, 188, 10,
+			39, 145, 49, 98, 170, 5, 144, 158, 124, 208, 201, 7, 38, 150,
+			86, 75, 84, 100, 70, 34, 154, 93, 113, 253, 128, 146, 47, 18,
+			213, 190, 99, 205, 109, 250, 75, 152, 19, 160, 85, 91, 239, 39,
+			169, 26, 9, 236, 20, 110, 254, 43, 103, 184, 238, 184, 125, 205,
+			121, 3, 123, 122, 45, 192, 43, 33, 126, 192, 173, 217, 168, 195,
+			39, 190, 97, 74, 238, 234, 170, 7, 223, 209, 1, 138, 78, 33,
+			86, 110, 146, 215, 115, 44, 134, 230, 130, 188, 154, 197, 226, 163,
+			172, 164, 113, 151, 190, 23, 87, 85, 229, 216, 177, 74, 58, 45,
+			136, 235, 207, 166, 149, 107, 150, 157, 204, 230, 214, 174, 5, 102,
+			221, 95, 154, 133, 44, 187, 182, 227, 211, 216, 131, 85, 2, 65,
+			96, 180, 114, 188, 114, 52, 167, 96, 92, 244, 20, 156, 55, 192,
+			125, 32, 174, 124, 201, 56, 203, 98, 156, 69, 140, 64, 85, 179,
+			143, 169, 196, 167, 33, 120, 110, 92, 77, 97, 62, 181, 209, 74,
+			229, 104, 139, 150, 2, 160, 38, 187, 102, 85, 55, 60, 118, 108,
+			226, 248, 196, 177, 99, 55, 105, 181, 20, 134, 19, 139, 110, 116,
+			131, 134, 169, 170, 65, 85, 168, 113, 133, 160, 220, 212, 197, 196,
+			241, 137, 69, 247, 241, 109, 59, 202, 92, 205, 55, 119, 9, 93,
+			169, 246, 165, 106, 168, 202, 162, 251, 120, 69, 141, 122, 213, 229,
+			234, 88, 218, 120, 226, 29, 107, 215, 39, 154, 97, 83, 15, 87,
+			57, 218, 10, 198, 141, 38, 109, 62, 202, 113, 131, 153, 220, 108,
+			18, 212, 67, 178, 30, 142, 167, 180, 97, 224, 94, 191, 26, 130,
+			146, 9, 102, 210, 230, 115, 13, 3, 86, 144, 4, 177, 13, 206,
+			14, 248, 62, 206, 175, 21, 143, 219, 143, 220, 250, 166, 153, 2,
+			189, 125, 108, 226, 102, 43, 216, 2, 49, 0, 16, 167, 204, 201,
+			18, 118, 241, 5, 139, 125, 196, 218, 69, 204, 9, 100, 129, 23,
+			50, 230, 4, 172, 246, 5, 19, 121, 207, 225, 6, 38, 94, 176,
+			122, 251, 100, 3, 121, 19, 179, 197, 167, 44, 214, 231, 60, 156,
+			191, 131, 1, 248, 185, 43, 24, 129, 50, 66, 17, 200, 237, 119,
+			176, 244, 174, 24, 46, 169, 183, 175, 65, 16, 16, 48, 139, 75,
+			218, 128, 164, 97, 4, 129, 225, 83, 22, 123, 129, 2, 144, 57,
+			138, 11, 159, 178, 88, 209, 20, 49, 9, 76, 105, 135, 41, 114,
+			40, 246, 244, 202, 247, 129, 196, 206, 25, 183, 197, 103, 0, 200,
+			199, 51, 32, 81, 39, 216, 114, 246, 166, 223, 131, 77, 194, 252,
+			185, 0, 156, 102, 11, 17, 90, 154, 239, 148, 166, 160, 55, 188,
+			92, 51, 144, 237, 51, 182, 152, 33, 27, 34, 116, 62, 3, 9,
+			95, 76, 182, 47, 136, 208, 249, 76, 54, 17, 56, 211, 63, 147,
+			77, 4, 34, 116, 62, 99, 245, 244, 202, 255, 6, 231, 33, 108,
+			241, 89, 56, 8, 86, 213, 172, 119, 61, 65, 241, 10, 174, 55,
+			168, 138, 27, 219, 252, 177, 98, 63, 38, 254, 68, 249, 118, 76,
+			206, 69, 195, 45, 81, 152, 144, 185, 47, 56, 167, 222, 250, 250,
+			181, 166, 183, 4, 178, 249, 82, 10, 61, 72, 64, 159, 181, 216,
+			103, 82, 232, 33, 184, 230, 179, 25, 169, 64, 112, 205, 103, 51,
+			82, 129, 224, 154, 207, 2, 169, 96, 62, 22, 14, 107, 246, 59,
+			22, 27, 130, 219, 228, 67, 169, 15, 136, 145, 128, 54, 219, 37,
+			52, 16, 230, 188, 206, 44, 72, 154, 23, 183, 246, 144, 90, 20,
+			214, 86, 87, 65, 145, 2, 39, 71, 122, 196, 27, 196, 52, 170,
+			234, 98, 184, 238, 93, 243, 34, 188, 25, 25, 51, 15, 132, 132,
+			96, 79, 100, 213, 72, 191, 104, 190, 8, 220, 126, 209, 28, 234,
+			219, 152, 120, 245, 186, 22, 132, 93, 252, 29, 139, 125, 54, 37,
+			80, 72, 63, 245, 59, 22, 43, 153, 162, 5, 197, 114, 191, 41,
+			114, 40, 14, 238, 150, 87, 17, 49, 69, 91, 252, 174, 197, 246,
+			58, 111, 53, 9, 130, 22, 54, 86, 189, 246, 229, 133, 220, 12,
+			145, 95, 79, 226, 60, 74, 90, 119, 121, 238, 120, 146, 237, 185,
+			146, 52, 156, 69, 97, 23, 127, 215, 98, 191, 99, 13, 17, 36,
+			197, 2, 142, 109, 86, 16, 82, 25, 252, 174, 201, 27, 195, 89,
+			145, 67, 113, 200, 209, 130, 15, 196, 235, 124, 17, 210, 144, 112,
+			147, 234, 143, 36, 131, 141, 85, 84, 18, 45, 161, 19, 168, 10,
+			3, 35, 92, 194, 149, 73, 124, 209, 146, 142, 188, 155, 146, 246,
+			117, 216, 226, 247, 33, 231, 197, 97, 124, 63, 115, 101, 36, 110,
+			217, 214, 73, 31, 165, 215, 3, 249, 233, 247, 45, 241, 69, 75,
+			135, 153, 98, 85, 17, 123, 202, 146, 244, 193, 80, 191, 111, 237,
+			114, 178, 10, 14, 21, 195, 251, 41, 39, 95, 201, 22, 95, 182,
+			216, 97, 162, 228, 146, 176, 139, 95, 198, 169, 208, 76, 33, 169,
+			198, 151, 45, 102, 150, 15, 2, 149, 190, 108, 245, 239, 55, 69,
+			14, 197, 131, 135, 228, 2, 224, 129, 149, 109, 241, 21, 139, 141,
+			56, 231, 213, 44, 58, 12, 220, 112, 105, 234, 230, 156, 7, 175,
+			30, 250, 182, 20, 74, 62, 58, 118, 61, 91, 155, 178, 176, 139,
+			95, 177, 216, 151, 173, 195, 180, 54, 229, 34, 142, 179, 215, 20,
+			45, 40, 238, 59, 104, 138, 28, 138, 135, 239, 192, 112, 20, 206,
+			58, 109, 241, 135, 0, 211, 5, 53, 215, 108, 220, 42, 76, 218,
+			1, 233, 70, 64, 117, 10, 187, 248, 135, 22, 251, 138, 53, 66,
+			195, 118, 22, 113, 32, 3, 84, 167, 5, 197, 20, 168, 78, 14,
+			197, 195, 119, 200, 199, 17, 40, 105, 139, 63, 178, 216, 62, 167,
+			169, 166, 91, 104, 57, 221, 66, 134, 3, 167, 16, 38, 120, 208,
+			233, 131, 50, 189, 178, 145, 119, 47, 165, 227, 147, 45, 82, 104,
+			42, 143, 81, 163, 20, 114, 41, 236, 226, 31, 89, 236, 15, 83,
+			200, 101, 1, 161, 49, 164, 46, 45, 40, 118, 14, 154, 34, 135,
+			226, 158, 189, 242, 27, 160, 202, 229, 172, 203, 22, 95, 183, 152,
+			114, 190, 202, 32, 163, 117, 154, 80, 128, 98, 136, 193, 161, 24,
+			72, 62, 157, 8, 62, 212, 28, 11, 246, 34, 8, 111, 147, 240,
+			34, 93, 67, 65, 114, 77, 149, 188, 167, 165, 26, 87, 147, 185,
+			44, 140, 248, 30, 48, 112, 181, 126, 213, 135, 116, 22, 144, 99,
+			44, 143, 24, 176, 224, 164, 67, 193, 186, 161, 85, 51, 134, 211,
+			95, 163, 42, 129, 164, 219, 90, 197, 69, 236, 63, 235, 125, 213,
+			245, 163, 106, 58, 36, 9, 49, 129, 177, 132, 169, 209, 192, 111,
+			30, 213, 251, 239, 38, 32, 192, 112, 41, 20, 73, 108, 160, 48,
+			233, 250, 174, 25, 85, 164, 187, 12, 115, 27, 219, 238, 6, 144,
+			174, 80, 151, 192, 204, 68, 127, 100, 237, 163, 53, 232, 42, 34,
+			210, 13, 247, 233, 194, 20, 66, 148, 86, 132, 179, 46, 14, 197,
+			225, 3, 242, 17, 92, 160, 29, 182, 248, 38, 228, 176, 154, 86,
+			218, 63, 53, 71, 241, 217, 90, 228, 104, 62, 131, 50, 140, 240,
+			239, 96, 36, 201, 127, 226, 61, 5, 107, 135, 176, 139, 223, 180,
+			216, 215, 45, 69, 96, 237, 40, 226, 80, 38, 95, 231, 14, 11,
+			138, 210, 220, 214, 118, 112, 40, 246, 239, 146, 255, 59, 24, 136,
+			57, 235, 182, 197, 127, 178, 88, 197, 249, 45, 161, 180, 115, 117,
+			27, 233, 152, 248, 52, 138, 26, 111, 33, 162, 173, 178, 111, 227,
+			68, 100, 142, 170, 176, 215, 191, 117, 186, 202, 208, 149, 6, 212,
+			65, 77, 235, 71, 230, 30, 243, 54, 182, 160, 44, 60, 176, 110,
+			137, 162, 218, 6, 7, 2, 203, 40, 42, 151, 24, 227, 70, 228,
+			4, 194, 149, 154, 247, 33, 123, 153, 11, 49, 170, 160, 111, 30,
+			73, 20, 124, 46, 221, 15, 150, 215, 252, 24, 132, 126, 67, 236,
+			4, 126, 20, 174, 100, 128, 134, 58, 240, 81, 243, 38, 73, 77,
+			192, 22, 222, 92, 119, 55, 224, 154, 169, 211, 14, 212, 189, 113,
+			240, 159, 214, 72, 61, 221, 14, 167, 215, 72, 187, 48, 46, 15,
+			128, 36, 23, 17, 163, 33, 90, 212, 68, 233, 147, 250, 55, 135,
+			78, 226, 189, 117, 183, 217, 220, 72, 201, 176, 91, 216, 197, 255,
+			100, 177, 111, 90, 134, 67, 117, 23, 145, 178, 204, 238, 232, 182,
+			160, 56, 104, 246, 78, 55, 135, 226, 129, 131, 114, 65, 167, 141,
+			253, 158, 213, 241, 17, 102, 57, 231, 141, 186, 233, 246, 44, 19,
+			91, 42, 156, 64, 188, 251, 158, 85, 222, 133, 214, 23, 76, 65,
+			249, 125, 80, 131, 220, 127, 115, 235, 4, 92, 72, 204, 144, 173,
+			6, 10, 152, 171, 0, 3, 69, 241, 251, 22, 251, 158, 181, 219,
+			228, 168, 44, 216, 226, 251, 134, 87, 227, 7, 134, 197, 247, 141,
+			130, 68, 224, 209, 254, 125, 171, 127, 64, 158, 53, 153, 110, 127,
+			96, 177, 255, 129, 113, 231, 46, 74, 44, 214, 170, 248, 162, 120,
+			226, 84, 158, 76, 103, 158, 101, 255, 209, 185, 111, 127, 96, 201,
+			94, 89, 165, 36, 165, 29, 182, 248, 33, 100, 53, 222, 143, 87,
+			17, 51, 183, 156, 134, 149, 204, 109, 249, 180, 181, 63, 180, 196,
+			15, 44, 147, 196, 19, 189, 245, 160, 143, 44, 41, 41, 12, 242,
+			67, 171, 171, 167, 37, 109, 237, 15, 45, 187, 95, 254, 169, 69,
+			195, 130, 94, 151, 137, 97, 231, 15, 45, 50, 135, 108, 30, 248,
+			239, 176, 237, 197, 32, 11, 46, 157, 239, 97, 226, 135, 148, 101,
+			25, 171, 138, 56, 243, 12, 123, 112, 241, 124, 15, 235, 31, 202,
+			42, 64, 5, 13, 193, 250, 255, 142, 17, 178, 24, 228, 57, 18,
+			35, 206, 215, 180, 193, 21, 110, 93, 227, 171, 110, 253, 49, 175,
+			177, 13, 190, 140, 72, 1, 232, 153, 204, 67, 237, 181, 229, 100,
+			34, 97, 196, 211, 139, 159, 137, 215, 238, 74, 22, 147, 106, 84,
+			66, 134, 176, 182, 66, 165, 31, 103, 159, 143, 200, 193, 65, 87,
+			19, 153, 25, 110, 12, 178, 183, 177, 246, 156, 219, 244, 238, 118,
+			54, 159, 172, 165, 238, 105, 83, 243, 220, 108, 178, 11, 107, 6,
+			155, 76, 229, 174, 220, 122, 193, 5, 252, 41, 38, 222, 195, 134,
+			211, 213, 96, 69, 68, 126, 174, 2, 244, 209, 108, 127, 37, 171,
+			192, 52, 84, 71, 238, 144, 63, 65, 203, 197, 109, 241, 126, 200,
+			66, 117, 66, 45, 180, 142, 158, 91, 172, 115, 91, 46, 150, 129,
+			3, 238, 207, 239, 103, 226, 41, 54, 146, 142, 2, 55, 232, 247,
+			51, 209, 153, 85, 88, 80, 33, 51, 202, 130, 91, 244, 251, 217,
+			224, 110, 148, 222, 5, 168, 83, 63, 192, 216, 126, 231, 60, 66,
+			1, 249, 131, 51, 223, 40, 98, 233, 235, 152, 181, 66, 231, 83,
+			34, 199, 196, 236, 228, 50, 38, 125, 92, 118, 195, 63, 208, 136,
+			253, 1, 19, 183, 45, 48, 239, 202, 7, 76, 220, 182, 0, 131,
+			162, 248, 0, 75, 83, 240, 130, 18, 229, 3, 108, 223, 176, 252,
+			99, 184, 25, 11, 136, 219, 254, 48, 99, 182, 243, 37, 75, 77,
+			223, 248, 78, 111, 82, 97, 192, 215, 95, 83, 194, 163, 243, 52,
+			115, 118, 134, 153, 197, 217, 73, 187, 21, 23, 136, 188, 85, 207,
+			77, 249, 192, 155, 242, 36, 156, 18, 136, 164, 132, 166, 176, 5,
+			178, 207, 215, 162, 196, 189, 145, 250, 42, 210, 121, 9, 90, 93,
+			15, 245, 18, 169, 213, 80, 243, 118, 32, 157, 15, 51, 246, 129,
+			52, 89, 49, 232, 1, 62, 108, 50, 114, 128, 37, 22, 138, 164,
+			52, 0, 11, 30, 20, 123, 251, 228, 199, 133, 206, 78, 252, 44,
+			235, 248, 50, 179, 156, 15, 11, 149, 243, 245, 55, 236, 216, 64,
+			188, 205, 49, 6, 111, 228, 79, 49, 192, 75, 107, 165, 242, 2,
+			64, 25, 28, 19, 13, 63, 242, 234, 73, 24, 109, 140, 39, 145,
+			7, 231, 195, 6, 36, 76, 143, 92, 184, 88, 100, 217, 18, 232,
+			112, 147, 105, 34, 68, 162, 219, 120, 213, 173, 123, 155, 156, 221,
+			252, 165, 244, 52, 172, 172, 108, 192, 207, 138, 186, 234, 154, 52,
+			143, 177, 170, 184, 164, 200, 28, 163, 84, 214, 128, 214, 117, 208,
+			214, 24, 237, 4, 10, 117, 52, 235, 211, 166, 179, 7, 42, 21,
+			8, 221, 79, 174, 226, 15, 115, 184, 159, 86, 127, 95, 143, 241,
+			78, 53, 106, 142, 100, 56, 131, 227, 163, 91, 247, 65, 0, 109,
+			221, 147, 11, 157, 192, 194, 167, 122, 195, 91, 236, 198, 109, 237,
+			231, 248, 166, 126, 110, 177, 155, 137, 227, 173, 29, 45, 186, 143,
+			191, 83, 141, 210, 249, 158, 235, 44, 205, 2, 253, 44, 43, 247,
+			203, 127, 96, 146, 64, 63, 199, 216, 46, 39, 192, 5, 167, 33,
+			128, 163, 155, 61, 155, 186, 167, 250, 177, 217, 106, 137, 97, 6,
+			102, 215, 208, 247, 211, 194, 117, 212, 196, 82, 39, 217, 70, 212,
+			130, 18, 166, 88, 116, 49, 87, 6, 81, 124, 1, 165, 153, 231,
+			24, 123, 150, 242, 35, 22, 80, 14, 120, 206, 80, 188, 206, 234,
+			252, 28, 35, 105, 166, 128, 50, 192, 115, 172, 127, 64, 190, 144,
+			102, 117, 254, 36, 99, 187, 157, 127, 106, 101, 118, 29, 136, 135,
+			107, 129, 191, 133, 202, 50, 50, 4, 75, 65, 94, 29, 190, 232,
+			62, 110, 42, 142, 131, 234, 28, 143, 189, 244, 106, 148, 134, 218,
+			161, 70, 185, 210, 162, 67, 174, 160, 138, 28, 136, 188, 2, 11,
+			129, 38, 149, 54, 59, 145, 81, 127, 233, 68, 205, 159, 100, 236,
+			185, 116, 198, 160, 67, 254, 100, 54, 99, 96, 127, 159, 100, 157,
+			38, 203, 53, 176, 191, 79, 178, 93, 131, 242, 138, 201, 211, 252,
+			105, 198, 250, 156, 55, 105, 113, 2, 21, 95, 183, 163, 74, 110,
+			85, 31, 195, 205, 64, 230, 213, 199, 58, 91, 242, 167, 25, 251,
+			36, 219, 157, 203, 150, 252, 105, 70, 90, 215, 2, 178, 160, 79,
+			51, 210, 186, 22, 144, 5, 125, 154, 245, 244, 202, 235, 38, 89,
+			242, 139, 192, 156, 223, 174, 166, 95, 3, 125, 171, 86, 183, 202,
+			91, 208, 183, 22, 240, 180, 123, 145, 177, 79, 179, 52, 179, 111,
+			1, 97, 49, 104, 5, 109, 241, 139, 134, 117, 22, 192, 247, 65,
+			188, 200, 122, 251, 100, 100, 82, 238, 126, 14, 232, 168, 145, 105,
+			189, 243, 11, 140, 151, 25, 194, 103, 85, 205, 101, 2, 4, 42,
+			58, 87, 188, 6, 124, 161, 75, 173, 182, 108, 221, 88, 182, 126,
+			84, 205, 40, 80, 83, 136, 225, 230, 241, 57, 72, 192, 101, 150,
+			26, 52, 196, 159, 203, 48, 13, 87, 136, 207, 177, 82, 250, 148,
+			67, 113, 215, 160, 252, 71, 150, 201, 227, 251, 18, 164, 114, 121,
+			183, 149, 193, 220, 134, 102, 29, 161, 106, 8, 193, 160, 58, 190,
+			26, 174, 67, 214, 52, 82, 196, 16, 39, 37, 183, 27, 224, 213,
+			202, 139, 162, 48, 2, 202, 114, 209, 241, 215, 109, 172, 248, 129,
+			222, 194, 116, 134, 64, 255, 105, 18, 171, 116, 66, 5, 157, 81,
+			236, 115, 41, 233, 128, 98, 247, 165, 108, 66, 5, 204, 40, 86,
+			50, 116, 15, 201, 131, 95, 98, 67, 123, 228, 19, 150, 201, 30,
+			252, 123, 140, 245, 59, 27, 74, 127, 39, 21, 105, 251, 193, 7,
+			212, 9, 64, 179, 62, 180, 72, 188, 135, 67, 41, 92, 5, 63,
+			111, 208, 116, 129, 222, 226, 49, 127, 181, 85, 195, 149, 203, 145,
+			69, 60, 138, 62, 77, 73, 231, 59, 102, 248, 131, 243, 111, 213,
+			93, 246, 3, 183, 101, 30, 160, 248, 253, 61, 72, 13, 102, 82,
+			72, 131, 226, 247, 247, 24, 41, 168, 117, 170, 226, 223, 99, 101,
+			147, 84, 184, 200, 225, 105, 159, 45, 63, 109, 153, 92, 197, 47,
+			51, 54, 228, 252, 170, 181, 77, 16, 129, 38, 145, 45, 244, 244,
+			175, 199, 201, 222, 158, 102, 190, 93, 49, 47, 127, 100, 205, 124,
+			1, 62, 253, 85, 124, 153, 177, 223, 99, 253, 52, 181, 82, 193,
+			22, 47, 103, 19, 7, 77, 239, 203, 140, 52, 243, 58, 125, 242,
+			203, 32, 43, 130, 215, 70, 209, 46, 126, 133, 117, 252, 191, 12,
+			188, 54, 242, 49, 133, 183, 122, 139, 110, 23, 63, 76, 178, 224,
+			175, 176, 242, 128, 252, 124, 154, 44, 248, 107, 112, 84, 253, 51,
+			171, 245, 172, 106, 227, 120, 45, 151, 102, 115, 85, 48, 210, 15,
+			132, 186, 153, 129, 211, 14, 252, 44, 173, 43, 108, 7, 117, 213,
+			247, 34, 8, 131, 220, 200, 185, 142, 201, 212, 147, 59, 251, 210,
+			86, 182, 217, 245, 123, 45, 254, 232, 166, 119, 175, 233, 173, 228,
+			78, 0, 157, 34, 248, 107, 140, 125, 133, 13, 154, 20, 193, 5,
+			91, 124, 205, 176, 42, 157, 34, 248, 107, 230, 204, 211, 41, 130,
+			191, 6, 103, 222, 41, 147, 19, 242, 21, 96, 85, 163, 136, 131,
+			20, 82, 250, 98, 34, 160, 2, 166, 72, 211, 77, 217, 77, 17,
+			207, 157, 87, 24, 251, 26, 157, 59, 69, 60, 119, 94, 201, 70,
+			133, 115, 231, 21, 115, 238, 232, 116, 135, 175, 192, 185, 243, 74,
+			154, 238, 240, 85, 224, 236, 95, 184, 61, 177, 187, 69, 74, 248,
+			155, 72, 221, 72, 31, 127, 11, 66, 183, 78, 132, 248, 42, 99,
+			175, 16, 219, 42, 162, 193, 244, 213, 12, 49, 112, 226, 189, 106,
+			78, 14, 157, 8, 241, 85, 56, 57, 78, 153, 132, 205, 95, 103,
+			236, 135, 140, 59, 119, 208, 77, 13, 206, 37, 36, 135, 148, 245,
+			230, 232, 132, 238, 64, 58, 131, 242, 215, 153, 236, 151, 189, 58,
+			65, 42, 248, 36, 125, 131, 137, 63, 99, 5, 147, 13, 84, 64,
+			147, 111, 192, 192, 189, 178, 172, 43, 64, 88, 251, 19, 86, 236,
+			145, 125, 178, 211, 212, 64, 178, 119, 86, 148, 249, 42, 6, 85,
+			221, 59, 115, 239, 129, 26, 150, 21, 251, 114, 141, 96, 185, 191,
+			201, 138, 59, 242, 85, 12, 170, 122, 122, 115, 239, 49, 91, 252,
+			41, 43, 218, 185, 70, 128, 141, 63, 101, 197, 238, 124, 21, 182,
+			234, 237, 147, 79, 228, 146, 65, 127, 27, 18, 205, 173, 227, 215,
+			28, 12, 11, 0, 249, 58, 141, 233, 109, 61, 44, 171, 74, 61,
+			2, 14, 129, 245, 112, 101, 17, 21, 156, 217, 10, 167, 140, 3,
+			242, 26, 228, 246, 35, 176, 143, 149, 92, 178, 238, 77, 138, 38,
+			147, 18, 250, 219, 45, 88, 69, 1, 243, 219, 249, 228, 181, 128,
+			230, 111, 155, 92, 116, 38, 37, 244, 183, 33, 23, 221, 24, 77,
+			7, 178, 198, 51, 97, 59, 251, 112, 133, 193, 72, 104, 184, 69,
+			54, 129, 108, 76, 216, 104, 223, 97, 226, 219, 108, 32, 237, 17,
+			110, 184, 223, 97, 162, 156, 85, 96, 151, 157, 221, 45, 249, 143,
+			191, 3, 84, 245, 140, 73, 67, 205, 108, 241, 93, 80, 110, 253,
+			67, 70, 74, 18, 194, 98, 238, 30, 117, 75, 202, 45, 243, 117,
+			87, 169, 165, 24, 136, 129, 246, 155, 77, 112, 37, 200, 196, 122,
+			212, 165, 79, 77, 62, 212, 50, 159, 255, 82, 117, 98, 38, 221,
+			242, 119, 153, 248, 14, 179, 179, 116, 203, 69, 68, 88, 174, 194,
+			130, 10, 210, 137, 21, 73, 199, 242, 93, 208, 137, 125, 196, 224,
+			152, 219, 226, 47, 153, 24, 113, 158, 96, 121, 8, 8, 209, 183,
+			161, 33, 251, 209, 113, 252, 154, 41, 214, 136, 209, 254, 205, 244,
+			106, 25, 130, 65, 156, 254, 75, 38, 190, 75, 74, 44, 172, 42,
+			34, 182, 114, 21, 22, 84, 236, 175, 180, 100, 125, 254, 75, 118,
+			228, 14, 52, 35, 99, 138, 216, 191, 98, 236, 48, 241, 77, 8,
+			155, 253, 43, 163, 244, 41, 50, 94, 132, 98, 215, 128, 41, 90,
+			80, 220, 101, 50, 145, 130, 120, 254, 87, 172, 114, 72, 254, 16,
+			194, 103, 74, 118, 241, 189, 188, 227, 151, 184, 229, 252, 7, 75,
+			181, 36, 36, 48, 107, 117, 19, 221, 134, 126, 39, 47, 94, 80,
+			55, 42, 174, 187, 65, 220, 250, 225, 130, 182, 164, 164, 116, 174,
+			161, 29, 213, 56, 171, 229, 62, 223, 10, 201, 59, 76, 138, 1,
+			58, 248, 91, 4, 81, 200, 240, 81, 85, 243, 249, 174, 192, 160,
+			70, 180, 237, 229, 190, 240, 64, 161, 103, 146, 210, 159, 122, 141,
+			92, 34, 208, 247, 242, 242, 46, 121, 135, 73, 225, 248, 4, 103,
+			187, 156, 161, 246, 251, 186, 126, 141, 142, 181, 18, 74, 25, 79,
+			112, 246, 94, 190, 155, 114, 252, 1, 227, 123, 130, 211, 177, 166,
+			51, 54, 62, 193, 73, 202, 208, 25, 27, 159, 224, 253, 3, 114,
+			221, 100, 108, 124, 146, 51, 219, 241, 179, 123, 53, 93, 133, 97,
+			6, 177, 215, 164, 251, 125, 158, 31, 197, 57, 40, 96, 71, 164,
+			94, 67, 177, 11, 225, 49, 241, 70, 144, 184, 215, 193, 71, 40,
+			239, 213, 59, 18, 27, 158, 221, 99, 114, 58, 22, 159, 228, 236,
+			9, 110, 82, 73, 130, 152, 242, 100, 6, 54, 156, 91, 79, 114,
+			58, 141, 117, 86, 199, 39, 121, 111, 159, 252, 112, 154, 213, 241,
+			105, 128, 251, 125, 22, 165, 56, 189, 158, 195, 13, 240, 70, 216,
+			50, 147, 58, 161, 162, 49, 141, 235, 24, 101, 90, 61, 186, 218,
+			192, 139, 99, 202, 95, 14, 66, 204, 47, 11, 217, 80, 171, 89,
+			143, 250, 227, 46, 102, 189, 91, 67, 159, 49, 83, 42, 132, 33,
+			97, 170, 212, 116, 94, 192, 177, 158, 230, 236, 73, 110, 19, 228,
+			32, 101, 60, 157, 205, 11, 48, 254, 116, 54, 47, 216, 60, 79,
+			195, 188, 222, 134, 211, 226, 182, 248, 16, 103, 142, 115, 105, 203,
+			84, 149, 244, 17, 16, 154, 43, 221, 89, 201, 95, 1, 4, 34,
+			76, 146, 162, 83, 80, 226, 156, 209, 49, 0, 80, 144, 66, 7,
+			219, 253, 67, 156, 61, 157, 66, 7, 154, 226, 15, 113, 146, 252,
+			75, 184, 61, 63, 4, 52, 72, 69, 132, 103, 104, 143, 190, 242,
+			148, 224, 250, 252, 12, 191, 209, 149, 103, 107, 34, 209, 186, 165,
+			31, 197, 31, 137, 122, 72, 37, 172, 77, 183, 158, 91, 187, 242,
+			148, 208, 252, 247, 12, 103, 31, 226, 14, 77, 13, 46, 225, 207,
+			100, 19, 135, 75, 248, 51, 156, 174, 60, 37, 112, 78, 23, 207,
+			240, 193, 221, 232, 179, 81, 130, 37, 252, 24, 80, 91, 243, 102,
+			50, 49, 192, 69, 12, 231, 118, 253, 203, 232, 189, 54, 133, 71,
+			137, 21, 132, 93, 252, 24, 103, 207, 240, 33, 130, 13, 110, 219,
+			31, 203, 8, 10, 110, 219, 31, 203, 8, 10, 110, 219, 31, 3,
+			130, 122, 88, 50, 81, 182, 139, 191, 204, 59, 190, 200, 45, 231,
+			98, 202, 79, 73, 202, 74, 25, 234, 141, 175, 107, 155, 57, 42,
+			92, 216, 202, 150, 45, 126, 153, 151, 7, 209, 234, 89, 6, 86,
+			245, 44, 127, 45, 172, 158, 101, 228, 102, 207, 114, 246, 203, 52,
+			219, 50, 138, 113, 207, 154, 217, 150, 145, 155, 61, 107, 184, 89,
+			25, 185, 217, 179, 192, 205, 224, 75, 193, 101, 176, 122, 254, 10,
+			103, 159, 228, 220, 185, 87, 97, 146, 159, 150, 228, 201, 176, 19,
+			90, 56, 184, 241, 65, 192, 41, 230, 146, 224, 150, 81, 106, 255,
+			21, 46, 123, 228, 27, 101, 81, 148, 65, 86, 180, 197, 115, 92,
+			236, 117, 238, 223, 50, 177, 173, 225, 143, 218, 98, 182, 121, 8,
+			218, 131, 112, 232, 150, 73, 90, 125, 142, 139, 95, 225, 58, 148,
+			164, 76, 210, 234, 115, 156, 44, 54, 101, 146, 86, 159, 227, 114,
+			48, 171, 0, 149, 40, 223, 227, 200, 7, 8, 36, 203, 22, 207,
+			115, 177, 199, 25, 167, 212, 22, 121, 88, 96, 166, 185, 143, 185,
+			165, 176, 229, 128, 0, 6, 252, 60, 23, 207, 241, 189, 233, 16,
+			192, 130, 159, 207, 3, 1, 76, 248, 121, 78, 102, 163, 50, 137,
+			175, 207, 243, 221, 67, 242, 48, 1, 193, 108, 241, 27, 92, 12,
+			208, 7, 69, 13, 215, 105, 159, 48, 48, 197, 223, 224, 226, 121,
+			190, 39, 237, 9, 246, 212, 111, 112, 18, 207, 203, 36, 198, 253,
+			6, 39, 241, 188, 76, 98, 220, 111, 112, 187, 95, 78, 211, 88,
+			224, 101, 203, 197, 129, 52, 130, 26, 178, 247, 174, 70, 94, 221,
+			36, 137, 104, 27, 127, 115, 226, 238, 108, 48, 16, 82, 94, 224,
+			148, 97, 185, 76, 22, 179, 23, 56, 101, 88, 46, 147, 208, 243,
+			2, 31, 112, 178, 10, 28, 126, 120, 127, 10, 142, 176, 197, 39,
+			184, 216, 223, 2, 206, 82, 8, 121, 15, 110, 27, 28, 72, 100,
+			241, 137, 60, 56, 192, 157, 62, 145, 7, 7, 248, 211, 39, 56,
+			37, 124, 46, 211, 39, 36, 62, 193, 247, 13, 203, 75, 72, 249,
+			150, 93, 252, 45, 206, 62, 199, 185, 243, 19, 198, 222, 159, 39,
+			253, 148, 141, 102, 12, 26, 175, 18, 224, 128, 212, 2, 101, 156,
+			110, 1, 88, 251, 223, 226, 100, 252, 47, 195, 231, 55, 109, 241,
+			25, 126, 139, 198, 127, 108, 15, 126, 189, 92, 252, 22, 183, 9,
+			104, 12, 27, 134, 62, 204, 196, 49, 114, 88, 124, 38, 91, 116,
+			12, 30, 22, 159, 129, 69, 191, 136, 139, 14, 225, 195, 226, 69,
+			88, 244, 215, 109, 38, 176, 52, 223, 125, 6, 195, 246, 72, 214,
+			33, 181, 47, 102, 72, 182, 208, 30, 249, 34, 167, 15, 140, 98,
+			5, 14, 214, 111, 214, 28, 67, 107, 197, 139, 124, 120, 191, 124,
+			51, 65, 195, 108, 241, 219, 92, 236, 118, 46, 102, 186, 216, 54,
+			36, 128, 87, 27, 237, 125, 181, 18, 70, 155, 64, 166, 28, 74,
+			169, 142, 216, 96, 11, 182, 200, 111, 115, 241, 34, 63, 144, 142,
+			14, 91, 228, 183, 57, 221, 38, 177, 141, 5, 21, 157, 25, 192,
+			176, 69, 126, 155, 239, 26, 148, 255, 4, 206, 231, 50, 32, 235,
+			37, 206, 246, 59, 239, 183, 212, 66, 254, 240, 204, 224, 139, 91,
+			184, 83, 27, 112, 248, 105, 1, 237, 60, 71, 76, 113, 29, 242,
+			181, 164, 185, 208, 76, 142, 132, 236, 250, 129, 153, 104, 140, 170,
+			60, 135, 6, 237, 201, 20, 234, 47, 65, 25, 146, 194, 21, 120,
+			201, 164, 170, 46, 35, 254, 95, 50, 169, 170, 203, 104, 15, 126,
+			137, 147, 61, 184, 140, 184, 127, 9, 8, 28, 61, 165, 203, 160,
+			152, 250, 2, 156, 193, 95, 188, 77, 197, 212, 150, 18, 9, 160,
+			103, 67, 154, 107, 111, 138, 167, 77, 218, 40, 58, 254, 204, 181,
+			163, 245, 106, 134, 219, 0, 131, 185, 23, 82, 109, 83, 124, 251,
+			154, 169, 50, 106, 166, 190, 192, 217, 75, 124, 63, 205, 29, 86,
+			254, 11, 217, 161, 7, 235, 250, 5, 115, 196, 151, 81, 51, 245,
+			5, 56, 226, 103, 37, 43, 118, 216, 197, 151, 121, 199, 171, 220,
+			114, 126, 2, 66, 73, 83, 207, 58, 184, 15, 141, 47, 185, 117,
+			202, 235, 67, 246, 56, 132, 238, 29, 45, 142, 76, 42, 246, 162,
+			107, 62, 218, 105, 187, 36, 47, 194, 142, 124, 153, 151, 119, 192,
+			183, 247, 139, 120, 238, 253, 1, 103, 99, 206, 125, 24, 152, 109,
+			108, 79, 58, 79, 147, 177, 246, 162, 215, 63, 92, 146, 178, 147,
+			54, 69, 186, 94, 123, 232, 200, 178, 197, 31, 240, 98, 167, 41,
+			50, 40, 202, 1, 83, 228, 80, 60, 112, 12, 252, 24, 139, 24,
+			5, 247, 85, 206, 170, 206, 52, 166, 81, 160, 237, 18, 111, 74,
+			131, 208, 182, 245, 110, 152, 2, 65, 143, 3, 52, 246, 85, 94,
+			76, 139, 12, 138, 93, 131, 166, 8, 95, 190, 224, 7, 199, 228,
+			44, 66, 193, 108, 241, 175, 56, 59, 233, 252, 68, 234, 10, 160,
+			103, 159, 27, 18, 174, 52, 68, 119, 217, 39, 32, 105, 80, 143,
+			48, 157, 14, 14, 211, 250, 87, 188, 216, 101, 138, 216, 255, 142,
+			33, 83, 228, 80, 60, 116, 130, 6, 231, 182, 120, 133, 179, 9,
+			88, 85, 125, 227, 223, 102, 236, 40, 12, 147, 150, 20, 121, 0,
+			82, 166, 216, 78, 7, 135, 243, 236, 149, 108, 230, 156, 65, 49,
+			157, 57, 220, 195, 95, 225, 7, 199, 229, 34, 14, 46, 108, 241,
+			111, 56, 187, 199, 89, 48, 242, 159, 25, 222, 8, 23, 200, 122,
+			211, 27, 109, 6, 84, 27, 123, 33, 197, 158, 65, 71, 38, 98,
+			193, 24, 22, 12, 82, 220, 97, 138, 12, 138, 221, 142, 41, 114,
+			40, 30, 185, 107, 177, 184, 26, 133, 73, 120, 215, 255, 55, 0,
+			7, 230, 214, 206, 225, 197, 0, 0},
 	)
 }
 
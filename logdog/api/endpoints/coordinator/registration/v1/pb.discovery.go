@@ -12,249 +12,255 @@ func init() {
 			"logdog.Registration",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 164, 89, 79, 115, 219, 72,
-			118, 39, 0, 234, 143, 219, 150, 199, 3, 121, 102, 189, 156, 216,
-			251, 204, 209, 216, 154, 49, 69, 201, 158, 154, 77, 198, 26, 79,
-			133, 34, 97, 11, 187, 20, 201, 0, 160, 52, 206, 97, 60, 32,
-			208, 36, 145, 5, 209, 8, 186, 41, 89, 155, 202, 105, 191, 65,
-			62, 193, 86, 170, 114, 200, 30, 83, 149, 91, 170, 146, 220, 183,
-			114, 204, 23, 200, 33, 31, 32, 149, 123, 234, 117, 3, 36, 40,
-			203, 59, 149, 90, 85, 201, 198, 67, 119, 255, 250, 247, 254, 224,
-			189, 215, 45, 242, 63, 15, 201, 131, 9, 99, 147, 152, 238, 167,
-			25, 19, 108, 52, 31, 239, 135, 243, 204, 23, 17, 75, 154, 242,
-			141, 249, 129, 26, 111, 22, 227, 245, 231, 100, 179, 147, 79, 49,
-			239, 145, 13, 78, 3, 150, 132, 252, 158, 6, 218, 174, 225, 20,
-			162, 121, 151, 172, 37, 126, 194, 248, 61, 29, 180, 221, 53, 71,
-			9, 71, 3, 178, 29, 176, 89, 243, 10, 228, 209, 86, 1, 56,
-			192, 55, 3, 237, 183, 154, 246, 247, 186, 241, 106, 112, 244, 59,
-			253, 193, 43, 53, 119, 144, 207, 109, 158, 209, 56, 254, 101, 194,
-			46, 18, 239, 50, 165, 252, 23, 255, 8, 100, 221, 172, 62, 168,
-			188, 209, 200, 239, 111, 17, 237, 150, 105, 60, 168, 152, 207, 254,
-			253, 22, 200, 5, 1, 139, 225, 104, 62, 30, 211, 140, 195, 30,
-			40, 168, 199, 28, 66, 95, 248, 16, 37, 130, 102, 193, 212, 79,
-			38, 20, 198, 44, 155, 249, 130, 64, 155, 165, 151, 89, 52, 153,
-			10, 120, 118, 112, 240, 103, 249, 2, 176, 147, 160, 9, 208, 138,
-			99, 144, 99, 28, 50, 202, 105, 118, 78, 195, 38, 129, 169, 16,
-			41, 127, 190, 191, 31, 210, 115, 26, 179, 148, 102, 188, 208, 46,
-			96, 51, 101, 212, 128, 197, 123, 35, 69, 98, 159, 16, 112, 104,
-			24, 113, 145, 69, 163, 57, 106, 12, 126, 18, 194, 156, 83, 136,
-			18, 224, 108, 158, 5, 84, 190, 25, 69, 137, 159, 93, 74, 94,
-			188, 1, 23, 145, 152, 2, 203, 228, 255, 108, 46, 8, 204, 88,
-			24, 141, 163, 64, 218, 172, 1, 126, 70, 33, 165, 217, 44, 18,
-			130, 134, 144, 102, 236, 60, 10, 105, 8, 98, 234, 11, 16, 83,
-			212, 46, 142, 217, 69, 148, 76, 0, 125, 19, 225, 34, 142, 139,
-			8, 204, 168, 120, 78, 8, 224, 207, 23, 87, 136, 113, 96, 227,
-			130, 81, 192, 66, 10, 179, 57, 23, 144, 81, 225, 71, 137, 68,
-			245, 71, 236, 28, 135, 114, 139, 17, 72, 152, 136, 2, 218, 0,
-			49, 141, 56, 196, 17, 23, 136, 80, 222, 49, 9, 175, 208, 9,
-			35, 30, 196, 126, 52, 163, 89, 243, 125, 36, 162, 164, 108, 139,
-			130, 68, 154, 177, 112, 30, 208, 37, 15, 178, 36, 242, 71, 241,
-			32, 144, 107, 23, 178, 96, 62, 163, 137, 240, 11, 39, 237, 179,
-			12, 152, 152, 210, 12, 102, 190, 160, 89, 228, 199, 124, 105, 106,
-			233, 32, 49, 165, 4, 202, 236, 23, 74, 245, 104, 36, 87, 34,
-			112, 226, 207, 40, 18, 42, 199, 86, 194, 150, 99, 210, 238, 145,
-			224, 168, 81, 162, 160, 88, 198, 97, 230, 95, 194, 136, 98, 164,
-			132, 32, 24, 208, 36, 100, 25, 167, 24, 20, 105, 198, 102, 76,
-			80, 80, 54, 17, 28, 66, 154, 69, 231, 52, 132, 113, 198, 102,
-			68, 89, 129, 179, 177, 184, 192, 48, 201, 35, 8, 120, 74, 3,
-			140, 32, 72, 179, 8, 3, 43, 195, 216, 73, 84, 20, 113, 46,
-			185, 19, 240, 142, 109, 23, 220, 254, 75, 239, 172, 229, 88, 96,
-			187, 48, 112, 250, 167, 118, 199, 234, 192, 209, 107, 240, 142, 45,
-			104, 247, 7, 175, 29, 251, 213, 177, 7, 199, 253, 110, 199, 114,
-			92, 104, 245, 58, 208, 238, 247, 60, 199, 62, 26, 122, 125, 199,
-			37, 80, 111, 185, 96, 187, 117, 57, 210, 234, 189, 6, 235, 187,
-			129, 99, 185, 46, 244, 29, 176, 79, 6, 93, 219, 234, 192, 89,
-			203, 113, 90, 61, 207, 182, 220, 6, 216, 189, 118, 119, 216, 177,
-			123, 175, 26, 112, 52, 244, 160, 215, 247, 8, 116, 237, 19, 219,
-			179, 58, 224, 245, 27, 114, 219, 119, 215, 65, 255, 37, 156, 88,
-			78, 251, 184, 213, 243, 90, 71, 118, 215, 246, 94, 203, 13, 95,
-			218, 94, 15, 55, 123, 217, 119, 8, 180, 96, 208, 114, 60, 187,
-			61, 236, 182, 28, 24, 12, 157, 65, 223, 181, 0, 53, 235, 216,
-			110, 187, 219, 178, 79, 172, 78, 19, 236, 30, 244, 250, 96, 157,
-			90, 61, 15, 220, 227, 86, 183, 187, 170, 40, 129, 254, 89, 207,
-			114, 144, 125, 89, 77, 56, 178, 160, 107, 183, 142, 186, 22, 110,
-			37, 245, 236, 216, 142, 213, 246, 80, 161, 229, 83, 219, 238, 88,
-			61, 175, 213, 109, 16, 112, 7, 86, 219, 110, 117, 27, 96, 125,
-			103, 157, 12, 186, 45, 231, 117, 35, 7, 117, 173, 191, 24, 90,
-			61, 207, 110, 117, 161, 211, 58, 105, 189, 178, 92, 216, 253, 49,
-			171, 12, 156, 126, 123, 232, 88, 39, 200, 186, 255, 18, 220, 225,
-			145, 235, 217, 222, 208, 179, 224, 85, 191, 223, 145, 198, 118, 45,
-			231, 212, 110, 91, 238, 33, 116, 251, 174, 52, 216, 208, 181, 26,
-			4, 58, 45, 175, 37, 183, 30, 56, 253, 151, 182, 231, 30, 226,
-			243, 209, 208, 181, 165, 225, 236, 158, 103, 57, 206, 112, 224, 217,
-			253, 222, 231, 112, 220, 63, 179, 78, 45, 7, 218, 173, 161, 107,
-			117, 164, 133, 251, 61, 212, 22, 99, 197, 234, 59, 175, 17, 22,
-			237, 32, 61, 208, 128, 179, 99, 203, 59, 182, 28, 52, 170, 180,
-			86, 11, 205, 224, 122, 142, 221, 246, 202, 211, 250, 14, 120, 125,
-			199, 35, 37, 61, 161, 103, 189, 234, 218, 175, 172, 94, 219, 194,
-			225, 62, 194, 156, 217, 174, 245, 57, 180, 28, 219, 197, 9, 182,
-			220, 24, 206, 90, 175, 161, 63, 148, 90, 163, 163, 134, 174, 69,
-			212, 115, 41, 116, 27, 210, 159, 96, 191, 132, 86, 231, 212, 70,
-			230, 249, 236, 65, 223, 117, 237, 60, 92, 164, 217, 218, 199, 185,
-			205, 155, 132, 108, 18, 77, 55, 13, 216, 252, 9, 62, 109, 154,
-			70, 189, 114, 72, 110, 146, 234, 230, 127, 111, 84, 148, 112, 139,
-			172, 161, 160, 155, 70, 125, 227, 39, 100, 139, 172, 75, 169, 162,
-			196, 219, 100, 67, 137, 154, 146, 243, 201, 27, 166, 81, 175, 61,
-			207, 17, 63, 173, 52, 114, 68, 77, 9, 106, 18, 110, 251, 233,
-			198, 118, 142, 168, 33, 34, 138, 10, 81, 147, 136, 40, 231, 147,
-			55, 76, 227, 211, 143, 159, 228, 136, 59, 149, 102, 142, 168, 43,
-			65, 77, 210, 81, 218, 248, 36, 71, 212, 17, 17, 69, 133, 168,
-			75, 68, 148, 243, 201, 27, 166, 177, 243, 96, 47, 71, 252, 172,
-			82, 207, 17, 13, 37, 168, 73, 134, 110, 26, 159, 109, 212, 114,
-			68, 3, 17, 81, 84, 136, 134, 68, 68, 57, 159, 140, 75, 239,
-			63, 204, 17, 31, 45, 180, 174, 42, 65, 77, 170, 234, 166, 241,
-			104, 99, 39, 71, 172, 34, 34, 138, 10, 177, 42, 17, 81, 206,
-			39, 27, 166, 241, 232, 113, 161, 245, 227, 202, 195, 28, 113, 77,
-			9, 106, 210, 154, 110, 26, 143, 55, 238, 229, 136, 107, 136, 136,
-			162, 66, 92, 147, 136, 40, 231, 147, 55, 76, 227, 241, 39, 64,
-			254, 139, 16, 189, 90, 49, 171, 78, 229, 141, 86, 251, 79, 76,
-			26, 69, 111, 34, 203, 14, 229, 52, 17, 28, 124, 224, 209, 36,
-			161, 97, 3, 198, 209, 91, 26, 238, 197, 52, 153, 136, 41, 240,
-			212, 79, 48, 125, 139, 104, 70, 151, 211, 105, 72, 192, 199, 53,
-			1, 155, 39, 178, 24, 229, 77, 146, 172, 68, 227, 204, 15, 150,
-			245, 182, 24, 16, 32, 27, 38, 41, 18, 236, 55, 88, 172, 74,
-			10, 216, 2, 34, 44, 139, 33, 77, 105, 18, 82, 5, 232, 39,
-			151, 16, 248, 49, 77, 66, 63, 147, 168, 1, 75, 2, 154, 10,
-			172, 127, 191, 162, 80, 15, 253, 203, 58, 193, 98, 81, 159, 177,
-			68, 76, 235, 5, 76, 70, 99, 95, 168, 138, 226, 69, 51, 202,
-			133, 63, 75, 85, 5, 204, 91, 135, 48, 194, 190, 133, 38, 1,
-			133, 17, 21, 23, 148, 38, 4, 196, 69, 121, 246, 185, 31, 207,
-			41, 71, 48, 127, 105, 42, 164, 16, 9, 8, 252, 4, 139, 150,
-			31, 98, 141, 100, 25, 240, 249, 72, 160, 186, 104, 17, 172, 78,
-			224, 47, 129, 154, 224, 200, 86, 12, 129, 210, 52, 99, 111, 35,
-			172, 179, 241, 37, 60, 217, 123, 122, 208, 56, 56, 56, 128, 75,
-			234, 103, 28, 235, 146, 245, 214, 159, 165, 49, 133, 167, 207, 161,
-			205, 102, 233, 92, 208, 229, 206, 18, 118, 133, 161, 236, 33, 82,
-			78, 231, 33, 147, 141, 76, 51, 111, 120, 150, 42, 112, 225, 103,
-			2, 94, 64, 179, 217, 60, 188, 58, 70, 147, 112, 101, 100, 177,
-			81, 209, 38, 23, 163, 106, 120, 209, 60, 23, 158, 124, 129, 8,
-			11, 105, 79, 237, 85, 200, 135, 87, 22, 73, 159, 231, 75, 212,
-			115, 177, 64, 74, 197, 38, 209, 24, 118, 223, 217, 232, 27, 56,
-			128, 71, 143, 174, 98, 125, 11, 7, 159, 195, 223, 168, 101, 215,
-			176, 123, 242, 2, 158, 30, 190, 51, 154, 111, 253, 2, 158, 30,
-			20, 63, 249, 164, 191, 5, 26, 99, 175, 90, 34, 192, 23, 96,
-			223, 94, 203, 224, 155, 63, 204, 96, 239, 15, 48, 120, 114, 29,
-			131, 146, 255, 159, 45, 253, 191, 116, 152, 12, 128, 165, 248, 100,
-			233, 177, 255, 127, 24, 188, 215, 217, 239, 15, 18, 53, 84, 246,
-			249, 139, 85, 159, 195, 147, 119, 140, 112, 184, 92, 84, 68, 64,
-			201, 235, 229, 5, 239, 132, 193, 114, 205, 170, 157, 87, 130, 174,
-			108, 226, 229, 130, 107, 173, 187, 244, 239, 114, 226, 183, 229, 137,
-			239, 217, 227, 201, 245, 123, 92, 27, 67, 4, 127, 140, 42, 230,
-			95, 103, 243, 14, 73, 73, 181, 42, 203, 230, 169, 126, 183, 22,
-			128, 43, 19, 235, 34, 19, 98, 54, 157, 210, 149, 204, 218, 132,
-			19, 60, 5, 140, 168, 242, 245, 222, 151, 79, 191, 106, 124, 245,
-			167, 63, 199, 28, 129, 191, 4, 179, 217, 147, 43, 47, 33, 74,
-			130, 120, 206, 163, 115, 244, 251, 22, 89, 195, 29, 171, 102, 245,
-			84, 119, 76, 44, 0, 40, 174, 33, 131, 141, 66, 210, 76, 227,
-			116, 243, 131, 66, 50, 76, 227, 212, 220, 38, 191, 49, 36, 87,
-			205, 52, 190, 215, 205, 218, 255, 234, 5, 217, 149, 28, 238, 231,
-			220, 87, 147, 120, 41, 135, 151, 117, 34, 75, 165, 138, 96, 227,
-			16, 83, 206, 49, 5, 39, 192, 18, 186, 64, 203, 86, 74, 138,
-			58, 114, 248, 112, 64, 224, 135, 220, 86, 63, 192, 56, 162, 113,
-			40, 147, 175, 15, 41, 227, 145, 136, 206, 229, 17, 33, 161, 19,
-			95, 62, 255, 32, 9, 229, 19, 155, 240, 146, 101, 139, 216, 226,
-			146, 74, 105, 67, 150, 193, 140, 101, 180, 1, 62, 36, 44, 217,
-			251, 53, 205, 152, 74, 246, 120, 18, 147, 10, 172, 160, 169, 163,
-			217, 136, 146, 133, 122, 120, 208, 193, 50, 137, 197, 79, 78, 95,
-			229, 121, 213, 141, 95, 127, 253, 117, 35, 255, 85, 46, 44, 189,
-			184, 198, 125, 90, 213, 172, 126, 175, 159, 222, 205, 93, 164, 173,
-			161, 83, 10, 247, 105, 232, 162, 205, 173, 66, 50, 76, 227, 251,
-			59, 31, 142, 214, 229, 113, 252, 75, 242, 47, 31, 147, 211, 73,
-			36, 166, 243, 145, 60, 164, 199, 243, 32, 146, 255, 236, 77, 216,
-			126, 204, 38, 33, 155, 236, 251, 105, 180, 79, 147, 48, 101, 81,
-			34, 248, 126, 192, 88, 22, 70, 137, 47, 88, 182, 159, 209, 9,
-			30, 241, 164, 205, 246, 207, 159, 238, 115, 154, 157, 71, 65, 126,
-			153, 97, 174, 171, 245, 181, 31, 185, 85, 169, 255, 131, 70, 62,
-			114, 36, 18, 205, 6, 25, 29, 71, 111, 29, 250, 215, 115, 202,
-			133, 121, 143, 108, 164, 25, 251, 43, 26, 8, 121, 165, 114, 195,
-			41, 68, 243, 99, 178, 158, 202, 169, 242, 78, 229, 134, 147, 75,
-			230, 207, 200, 77, 117, 78, 127, 19, 37, 99, 118, 207, 0, 99,
-			247, 134, 67, 212, 43, 59, 25, 51, 243, 107, 66, 232, 219, 52,
-			82, 4, 238, 17, 208, 118, 111, 62, 251, 233, 213, 75, 152, 102,
-			17, 127, 78, 105, 114, 253, 47, 201, 199, 87, 105, 242, 148, 37,
-			156, 34, 27, 78, 131, 140, 42, 154, 183, 156, 92, 50, 119, 201,
-			157, 152, 77, 222, 140, 230, 73, 24, 211, 55, 130, 165, 81, 144,
-			243, 189, 29, 179, 201, 145, 124, 237, 225, 219, 103, 111, 200, 45,
-			167, 100, 76, 179, 79, 110, 175, 238, 101, 222, 111, 42, 115, 54,
-			175, 53, 85, 237, 193, 251, 134, 21, 197, 95, 252, 222, 36, 235,
-			102, 181, 90, 57, 212, 200, 63, 107, 242, 110, 168, 90, 49, 159,
-			253, 78, 91, 185, 230, 121, 250, 115, 240, 166, 20, 186, 195, 182,
-			13, 173, 185, 152, 178, 140, 55, 223, 115, 215, 51, 228, 84, 5,
-			183, 60, 81, 47, 111, 70, 34, 14, 19, 118, 78, 51, 76, 5,
-			243, 36, 204, 15, 250, 173, 212, 15, 16, 56, 10, 104, 194, 105,
-			3, 78, 105, 134, 7, 107, 120, 214, 196, 20, 133, 13, 86, 222,
-			30, 141, 217, 28, 219, 37, 117, 239, 208, 181, 219, 86, 207, 181,
-			96, 28, 197, 116, 113, 8, 89, 223, 188, 77, 110, 16, 221, 168,
-			152, 198, 230, 198, 99, 50, 80, 45, 234, 205, 202, 3, 173, 214,
-			129, 107, 109, 3, 89, 254, 22, 219, 178, 132, 94, 128, 26, 93,
-			92, 84, 64, 123, 25, 210, 205, 101, 62, 190, 185, 121, 159, 236,
-			22, 249, 120, 75, 255, 168, 246, 137, 52, 78, 204, 38, 192, 69,
-			70, 253, 217, 99, 121, 233, 129, 225, 184, 146, 71, 183, 244, 155,
-			63, 43, 229, 209, 45, 125, 179, 148, 71, 183, 110, 220, 41, 229,
-			209, 173, 237, 187, 228, 105, 145, 70, 239, 232, 119, 107, 59, 87,
-			182, 0, 21, 214, 152, 2, 10, 37, 86, 62, 250, 59, 250, 214,
-			71, 165, 143, 254, 206, 98, 47, 252, 232, 239, 220, 248, 160, 244,
-			209, 223, 49, 183, 201, 159, 203, 189, 116, 211, 216, 214, 235, 181,
-			47, 161, 159, 98, 172, 249, 49, 224, 183, 146, 205, 242, 118, 117,
-			196, 230, 170, 223, 45, 118, 140, 146, 9, 248, 19, 154, 160, 154,
-			10, 79, 175, 34, 196, 66, 90, 51, 141, 237, 155, 31, 22, 146,
-			102, 26, 219, 230, 253, 66, 50, 76, 99, 27, 30, 146, 223, 170,
-			106, 97, 152, 198, 125, 253, 73, 237, 239, 12, 169, 103, 174, 220,
-			242, 19, 203, 179, 191, 61, 134, 111, 94, 192, 65, 67, 178, 200,
-			77, 252, 152, 67, 72, 199, 254, 60, 22, 249, 50, 82, 94, 151,
-			210, 44, 98, 88, 7, 226, 88, 54, 217, 105, 26, 71, 24, 166,
-			164, 188, 79, 49, 26, 196, 140, 211, 16, 70, 151, 87, 157, 15,
-			254, 88, 208, 12, 34, 193, 223, 197, 110, 18, 232, 99, 231, 175,
-			22, 55, 100, 28, 229, 62, 42, 103, 65, 200, 84, 204, 113, 181,
-			91, 194, 32, 102, 201, 132, 102, 146, 85, 128, 199, 16, 69, 203,
-			30, 3, 159, 43, 150, 249, 229, 156, 42, 39, 124, 202, 230, 113,
-			8, 244, 109, 64, 169, 186, 153, 67, 147, 160, 71, 242, 74, 18,
-			179, 192, 143, 65, 248, 252, 87, 13, 217, 159, 16, 224, 108, 70,
-			139, 81, 124, 255, 152, 231, 196, 22, 23, 101, 97, 198, 210, 148,
-			134, 16, 206, 41, 70, 210, 216, 143, 98, 244, 105, 153, 247, 34,
-			170, 140, 170, 89, 189, 175, 111, 215, 115, 255, 25, 235, 232, 177,
-			90, 33, 105, 166, 113, 255, 147, 207, 10, 9, 189, 185, 251, 5,
-			249, 150, 232, 85, 205, 172, 62, 172, 124, 174, 213, 158, 73, 131,
-			103, 121, 214, 129, 25, 229, 220, 159, 44, 171, 228, 234, 247, 9,
-			206, 160, 157, 127, 112, 24, 175, 15, 55, 31, 144, 223, 104, 164,
-			90, 149, 199, 252, 29, 125, 187, 118, 14, 174, 76, 164, 152, 85,
-			196, 194, 145, 168, 160, 124, 221, 4, 15, 45, 151, 23, 91, 85,
-			23, 241, 116, 117, 78, 179, 145, 47, 162, 25, 230, 145, 163, 185,
-			136, 105, 70, 64, 229, 96, 142, 250, 251, 156, 211, 76, 0, 187,
-			72, 104, 198, 167, 81, 186, 200, 99, 10, 62, 55, 133, 38, 63,
-			230, 29, 253, 33, 72, 117, 53, 249, 49, 239, 228, 85, 85, 221,
-			60, 236, 108, 222, 46, 36, 195, 52, 118, 62, 52, 9, 149, 236,
-			53, 211, 216, 213, 31, 212, 190, 147, 182, 40, 238, 56, 145, 255,
-			96, 62, 218, 119, 231, 35, 144, 149, 0, 169, 164, 243, 81, 28,
-			241, 41, 140, 36, 75, 89, 126, 246, 212, 167, 136, 109, 141, 226,
-			142, 233, 160, 204, 127, 193, 15, 19, 192, 174, 190, 179, 157, 115,
-			192, 4, 176, 155, 39, 0, 77, 38, 128, 221, 27, 63, 45, 36,
-			195, 52, 118, 255, 228, 62, 249, 87, 141, 232, 235, 21, 179, 218,
-			172, 28, 106, 181, 127, 210, 160, 92, 115, 32, 175, 222, 234, 248,
-			218, 101, 147, 14, 155, 172, 124, 29, 69, 15, 160, 18, 182, 252,
-			147, 193, 216, 15, 40, 151, 137, 148, 20, 43, 114, 210, 81, 194,
-			133, 159, 224, 40, 182, 96, 52, 241, 71, 200, 126, 53, 171, 173,
-			124, 56, 56, 173, 112, 150, 154, 134, 49, 26, 37, 145, 136, 252,
-			56, 250, 117, 17, 165, 132, 24, 235, 104, 251, 230, 230, 93, 242,
-			31, 85, 82, 93, 151, 233, 249, 185, 126, 82, 251, 183, 234, 34,
-			188, 92, 181, 141, 31, 199, 236, 2, 149, 185, 194, 169, 156, 78,
-			193, 47, 167, 219, 229, 221, 117, 185, 38, 192, 48, 69, 243, 204,
-			131, 128, 114, 222, 120, 39, 107, 200, 47, 61, 163, 98, 158, 37,
-			43, 42, 145, 213, 188, 154, 132, 37, 189, 82, 63, 243, 103, 84,
-			214, 36, 193, 36, 162, 226, 168, 82, 22, 214, 84, 149, 8, 48,
-			93, 249, 113, 172, 210, 213, 181, 138, 76, 150, 127, 131, 136, 226,
-			72, 92, 150, 194, 74, 182, 142, 126, 97, 109, 158, 250, 1, 109,
-			202, 152, 148, 144, 153, 250, 112, 166, 254, 57, 133, 51, 199, 246,
-			44, 153, 160, 184, 36, 132, 41, 112, 145, 120, 11, 127, 20, 8,
-			246, 120, 117, 126, 196, 33, 97, 50, 45, 99, 59, 158, 167, 51,
-			105, 20, 204, 52, 37, 155, 214, 7, 139, 43, 245, 14, 77, 34,
-			26, 214, 97, 226, 12, 218, 139, 35, 104, 235, 74, 124, 228, 233,
-			171, 112, 21, 149, 199, 136, 25, 227, 2, 88, 130, 68, 90, 161,
-			250, 51, 134, 31, 95, 177, 251, 106, 18, 94, 97, 1, 245, 86,
-			156, 81, 63, 188, 180, 222, 70, 92, 240, 85, 6, 183, 200, 218,
-			186, 170, 211, 207, 215, 239, 22, 146, 110, 26, 207, 63, 250, 162,
-			144, 12, 211, 120, 254, 213, 47, 139, 246, 249, 255, 2, 0, 0,
-			255, 255, 175, 225, 78, 14, 53, 28, 0, 0},
+			8, 0, 0, 9, 110, 136, 0, 255, 164, 89, 205, 115, 219, 72,
+			118, 39, 0, 234, 195, 237, 143, 245, 64, 158, 25, 47, 29, 123,
+			159, 57, 26, 203, 51, 162, 40, 201, 83, 179, 201, 216, 35, 87,
+			40, 18, 178, 176, 75, 147, 12, 8, 74, 235, 28, 198, 211, 4,
+			154, 36, 178, 32, 26, 65, 55, 101, 107, 83, 57, 237, 127, 144,
+			191, 96, 43, 85, 57, 100, 143, 169, 202, 121, 147, 123, 42, 185,
+			165, 42, 231, 28, 246, 15, 72, 229, 158, 122, 141, 6, 63, 100,
+			123, 182, 82, 203, 42, 217, 124, 64, 247, 175, 127, 239, 163, 223,
+			235, 126, 36, 255, 243, 144, 60, 24, 115, 62, 142, 217, 126, 154,
+			113, 201, 135, 179, 209, 126, 56, 203, 168, 140, 120, 82, 87, 79,
+			236, 31, 229, 239, 235, 197, 251, 234, 83, 178, 217, 210, 67, 236,
+			187, 100, 67, 176, 128, 39, 161, 184, 107, 128, 241, 216, 242, 10,
+			209, 190, 67, 214, 18, 154, 112, 113, 215, 4, 227, 241, 154, 151,
+			11, 199, 61, 178, 21, 240, 105, 253, 10, 228, 241, 205, 2, 176,
+			135, 79, 122, 198, 111, 12, 227, 239, 77, 235, 69, 239, 248, 183,
+			230, 131, 23, 249, 216, 158, 30, 91, 63, 103, 113, 252, 243, 132,
+			191, 73, 252, 203, 148, 137, 159, 253, 35, 144, 117, 187, 252, 160,
+			244, 218, 32, 255, 118, 131, 24, 55, 108, 235, 65, 201, 126, 242,
+			175, 55, 64, 77, 8, 120, 12, 199, 179, 209, 136, 101, 2, 246,
+			32, 135, 218, 17, 16, 82, 73, 33, 74, 36, 203, 130, 9, 77,
+			198, 12, 70, 60, 155, 82, 73, 160, 201, 211, 203, 44, 26, 79,
+			36, 60, 57, 56, 248, 51, 61, 1, 220, 36, 168, 3, 52, 226,
+			24, 212, 59, 1, 25, 19, 44, 187, 96, 97, 157, 192, 68, 202,
+			84, 60, 221, 223, 15, 217, 5, 139, 121, 202, 50, 81, 104, 23,
+			240, 105, 110, 212, 128, 199, 123, 195, 156, 196, 62, 33, 224, 177,
+			48, 18, 50, 139, 134, 51, 212, 24, 104, 18, 194, 76, 48, 136,
+			18, 16, 124, 150, 5, 76, 61, 25, 70, 9, 205, 46, 21, 47,
+			81, 131, 55, 145, 156, 0, 207, 212, 255, 124, 38, 9, 76, 121,
+			24, 141, 162, 64, 57, 161, 6, 52, 99, 144, 178, 108, 26, 73,
+			201, 66, 72, 51, 126, 17, 133, 44, 4, 57, 161, 18, 228, 4,
+			181, 139, 99, 254, 38, 74, 198, 128, 174, 138, 112, 146, 192, 73,
+			4, 166, 76, 62, 37, 4, 240, 243, 229, 21, 98, 2, 248, 168,
+			96, 20, 240, 144, 193, 116, 38, 36, 100, 76, 210, 40, 81, 168,
+			116, 200, 47, 240, 149, 182, 24, 129, 132, 203, 40, 96, 53, 144,
+			147, 72, 64, 28, 9, 137, 8, 203, 43, 38, 225, 21, 58, 97,
+			36, 130, 152, 70, 83, 150, 213, 63, 68, 34, 74, 150, 109, 81,
+			144, 72, 51, 30, 206, 2, 182, 224, 65, 22, 68, 254, 40, 30,
+			4, 180, 118, 33, 15, 102, 83, 150, 72, 90, 56, 105, 159, 103,
+			192, 229, 132, 101, 48, 165, 146, 101, 17, 141, 197, 194, 212, 232,
+			24, 196, 36, 176, 204, 126, 174, 84, 135, 69, 106, 38, 2, 39,
+			116, 202, 144, 208, 114, 108, 37, 124, 241, 78, 217, 61, 146, 2,
+			53, 74, 114, 40, 158, 9, 152, 210, 75, 24, 50, 140, 148, 16,
+			36, 7, 150, 132, 60, 19, 12, 131, 34, 205, 248, 148, 75, 134,
+			100, 194, 89, 32, 5, 132, 44, 139, 46, 88, 8, 163, 140, 79,
+			73, 110, 5, 193, 71, 242, 13, 134, 137, 142, 32, 16, 41, 11,
+			48, 130, 32, 205, 34, 12, 172, 12, 99, 39, 201, 163, 72, 8,
+			197, 157, 128, 127, 234, 246, 161, 223, 61, 241, 207, 27, 158, 3,
+			110, 31, 122, 94, 247, 204, 109, 57, 45, 56, 126, 5, 254, 169,
+			3, 205, 110, 239, 149, 231, 190, 56, 245, 225, 180, 219, 110, 57,
+			94, 31, 26, 157, 22, 52, 187, 29, 223, 115, 143, 7, 126, 215,
+			235, 19, 168, 54, 250, 224, 246, 171, 234, 77, 163, 243, 10, 156,
+			95, 244, 60, 167, 223, 135, 174, 7, 238, 203, 94, 219, 117, 90,
+			112, 222, 240, 188, 70, 199, 119, 157, 126, 13, 220, 78, 179, 61,
+			104, 185, 157, 23, 53, 56, 30, 248, 208, 233, 250, 4, 218, 238,
+			75, 215, 119, 90, 224, 119, 107, 106, 217, 119, 231, 65, 247, 4,
+			94, 58, 94, 243, 180, 209, 241, 27, 199, 110, 219, 245, 95, 169,
+			5, 79, 92, 191, 131, 139, 157, 116, 61, 2, 13, 232, 53, 60,
+			223, 109, 14, 218, 13, 15, 122, 3, 175, 215, 237, 59, 128, 154,
+			181, 220, 126, 179, 221, 112, 95, 58, 173, 58, 184, 29, 232, 116,
+			193, 57, 115, 58, 62, 244, 79, 27, 237, 246, 170, 162, 4, 186,
+			231, 29, 199, 67, 246, 203, 106, 194, 177, 3, 109, 183, 113, 220,
+			118, 224, 164, 235, 41, 61, 91, 174, 231, 52, 125, 84, 104, 241,
+			173, 233, 182, 156, 142, 223, 104, 215, 8, 244, 123, 78, 211, 109,
+			180, 107, 224, 252, 194, 121, 217, 107, 55, 188, 87, 53, 13, 218,
+			119, 254, 98, 224, 116, 124, 183, 209, 134, 86, 227, 101, 227, 133,
+			211, 135, 199, 127, 200, 42, 61, 175, 219, 28, 120, 206, 75, 100,
+			221, 61, 129, 254, 224, 184, 239, 187, 254, 192, 119, 224, 69, 183,
+			219, 82, 198, 238, 59, 222, 153, 219, 116, 250, 207, 160, 221, 69,
+			243, 159, 192, 160, 239, 212, 8, 180, 26, 126, 67, 45, 221, 243,
+			186, 39, 174, 223, 127, 134, 223, 143, 7, 125, 87, 25, 206, 237,
+			248, 142, 231, 13, 122, 190, 219, 237, 124, 1, 167, 221, 115, 231,
+			204, 241, 160, 217, 24, 244, 157, 150, 178, 112, 183, 131, 218, 98,
+			172, 56, 93, 239, 21, 194, 162, 29, 148, 7, 106, 112, 126, 234,
+			248, 167, 142, 135, 70, 85, 214, 106, 160, 25, 250, 190, 231, 54,
+			253, 229, 97, 93, 15, 252, 174, 231, 147, 37, 61, 161, 227, 188,
+			104, 187, 47, 156, 78, 211, 65, 62, 93, 132, 57, 119, 251, 206,
+			23, 208, 240, 220, 62, 14, 112, 213, 194, 112, 222, 120, 5, 221,
+			129, 210, 26, 29, 53, 232, 59, 36, 255, 190, 20, 186, 53, 229,
+			79, 112, 79, 160, 209, 58, 115, 145, 185, 30, 221, 235, 246, 251,
+			174, 14, 23, 101, 182, 230, 169, 182, 121, 157, 144, 77, 98, 152,
+			182, 5, 155, 159, 226, 183, 77, 219, 170, 150, 158, 145, 235, 164,
+			188, 249, 251, 141, 82, 46, 220, 32, 107, 40, 152, 182, 85, 221,
+			248, 148, 220, 36, 235, 74, 194, 151, 27, 159, 146, 91, 100, 35,
+			23, 141, 92, 214, 131, 55, 108, 171, 90, 121, 170, 17, 63, 43,
+			213, 52, 162, 145, 11, 249, 32, 92, 246, 179, 141, 45, 141, 104,
+			152, 165, 92, 204, 17, 13, 133, 136, 178, 30, 188, 97, 91, 159,
+			125, 178, 171, 17, 183, 75, 117, 141, 104, 230, 66, 62, 200, 68,
+			105, 227, 158, 70, 52, 17, 113, 123, 227, 158, 70, 52, 21, 34,
+			202, 122, 240, 134, 109, 109, 63, 216, 211, 136, 159, 151, 170, 26,
+			209, 202, 133, 124, 144, 101, 218, 214, 231, 27, 21, 141, 104, 33,
+			34, 138, 57, 162, 165, 16, 81, 214, 131, 113, 234, 253, 135, 26,
+			241, 209, 92, 235, 178, 109, 61, 154, 107, 93, 54, 109, 235, 209,
+			198, 182, 70, 44, 35, 34, 138, 57, 98, 89, 33, 162, 172, 7,
+			91, 182, 245, 104, 167, 208, 122, 167, 244, 80, 35, 174, 229, 66,
+			62, 104, 205, 180, 173, 157, 141, 187, 26, 113, 13, 17, 81, 204,
+			17, 215, 20, 34, 202, 122, 240, 134, 109, 237, 220, 3, 242, 223,
+			132, 152, 229, 146, 93, 246, 74, 175, 141, 202, 127, 98, 210, 40,
+			206, 38, 170, 236, 48, 193, 18, 41, 128, 130, 136, 198, 9, 11,
+			107, 48, 138, 222, 178, 112, 47, 102, 201, 88, 78, 64, 164, 52,
+			193, 244, 45, 163, 41, 91, 12, 103, 33, 1, 138, 115, 2, 62,
+			75, 84, 81, 212, 135, 36, 85, 137, 70, 25, 13, 22, 245, 182,
+			120, 33, 65, 29, 152, 148, 72, 240, 188, 193, 227, 188, 164, 128,
+			43, 33, 194, 178, 24, 178, 148, 37, 33, 203, 1, 105, 114, 9,
+			1, 141, 89, 18, 210, 76, 161, 6, 60, 9, 88, 42, 177, 254,
+			253, 146, 65, 53, 164, 151, 85, 130, 197, 162, 58, 229, 137, 156,
+			84, 11, 152, 140, 197, 20, 207, 12, 146, 131, 31, 77, 153, 144,
+			116, 154, 230, 21, 80, 31, 29, 194, 8, 207, 45, 44, 9, 24,
+			12, 153, 124, 195, 88, 66, 64, 190, 89, 30, 125, 65, 227, 25,
+			19, 8, 70, 23, 166, 66, 10, 145, 132, 128, 38, 48, 100, 64,
+			67, 172, 145, 60, 3, 49, 27, 74, 84, 23, 45, 130, 213, 9,
+			232, 2, 168, 14, 158, 58, 138, 33, 80, 154, 102, 252, 109, 132,
+			117, 54, 190, 132, 221, 189, 195, 131, 218, 193, 193, 1, 92, 50,
+			154, 137, 58, 33, 224, 188, 165, 211, 52, 102, 112, 248, 20, 154,
+			124, 154, 206, 36, 91, 172, 172, 96, 87, 24, 162, 177, 32, 21,
+			108, 22, 114, 117, 144, 169, 235, 3, 207, 124, 0, 8, 73, 51,
+			9, 71, 80, 175, 215, 159, 93, 125, 199, 146, 112, 229, 205, 124,
+			161, 226, 152, 92, 188, 205, 39, 206, 15, 207, 133, 39, 143, 176,
+			84, 207, 165, 189, 124, 173, 66, 126, 118, 101, 146, 242, 185, 158,
+			146, 127, 47, 38, 40, 169, 88, 36, 26, 193, 227, 119, 22, 250,
+			22, 14, 224, 209, 163, 171, 88, 207, 225, 224, 11, 248, 155, 124,
+			218, 123, 216, 237, 30, 193, 225, 179, 119, 222, 234, 165, 143, 224,
+			240, 160, 248, 232, 65, 127, 11, 44, 22, 108, 133, 128, 152, 131,
+			61, 127, 47, 131, 111, 127, 152, 193, 222, 15, 48, 216, 125, 31,
+			131, 37, 255, 63, 89, 248, 127, 225, 48, 21, 0, 11, 113, 119,
+			17, 26, 255, 255, 48, 248, 160, 179, 63, 28, 36, 249, 196, 101,
+			159, 31, 173, 250, 28, 118, 23, 106, 234, 71, 26, 111, 225, 245,
+			98, 138, 54, 195, 98, 194, 59, 97, 176, 152, 179, 106, 231, 149,
+			160, 91, 54, 241, 98, 194, 238, 15, 251, 119, 49, 240, 249, 242,
+			192, 15, 172, 177, 251, 254, 53, 246, 222, 183, 6, 193, 143, 85,
+			198, 252, 235, 109, 222, 38, 41, 41, 151, 75, 152, 156, 207, 204,
+			59, 149, 0, 250, 42, 177, 206, 51, 33, 102, 211, 9, 91, 201,
+			172, 117, 120, 137, 183, 128, 33, 83, 39, 92, 216, 251, 234, 240,
+			235, 218, 215, 127, 250, 83, 204, 17, 248, 71, 240, 124, 188, 123,
+			229, 33, 68, 73, 16, 207, 68, 116, 193, 234, 132, 220, 36, 107,
+			184, 98, 217, 46, 159, 153, 158, 77, 110, 228, 226, 26, 50, 216,
+			40, 36, 195, 182, 206, 54, 127, 84, 72, 150, 109, 157, 217, 91,
+			228, 215, 150, 226, 106, 216, 214, 119, 166, 93, 249, 95, 179, 32,
+			187, 146, 195, 169, 230, 190, 154, 196, 151, 114, 248, 178, 78, 100,
+			161, 84, 17, 108, 2, 98, 38, 4, 222, 222, 18, 224, 9, 155,
+			163, 101, 43, 37, 69, 221, 5, 129, 194, 1, 129, 239, 181, 173,
+			190, 135, 81, 196, 226, 80, 229, 127, 10, 41, 23, 145, 140, 46,
+			212, 21, 33, 97, 99, 170, 190, 127, 175, 8, 233, 129, 117, 56,
+			225, 217, 60, 182, 132, 162, 178, 180, 32, 207, 96, 202, 51, 86,
+			3, 10, 9, 79, 246, 126, 197, 50, 158, 39, 123, 188, 137, 41,
+			167, 172, 160, 229, 87, 179, 33, 35, 115, 245, 240, 162, 131, 101,
+			18, 139, 159, 26, 190, 202, 243, 170, 27, 191, 249, 230, 155, 154,
+			254, 203, 93, 184, 244, 224, 61, 238, 51, 202, 118, 249, 59, 243,
+			236, 142, 118, 145, 177, 134, 78, 41, 220, 103, 160, 139, 54, 111,
+			22, 146, 101, 91, 223, 221, 254, 104, 184, 174, 174, 227, 95, 145,
+			223, 125, 74, 206, 198, 145, 156, 204, 134, 234, 146, 30, 207, 130,
+			72, 253, 179, 55, 230, 251, 49, 31, 135, 124, 188, 79, 211, 104,
+			159, 37, 97, 202, 163, 68, 138, 253, 128, 243, 44, 140, 18, 42,
+			121, 182, 159, 177, 49, 94, 241, 148, 205, 246, 47, 14, 247, 177,
+			19, 16, 5, 186, 153, 97, 175, 231, 243, 43, 127, 160, 171, 82,
+			253, 7, 131, 124, 236, 41, 36, 150, 245, 50, 54, 138, 222, 122,
+			236, 175, 103, 76, 72, 108, 169, 164, 25, 255, 43, 22, 72, 213,
+			82, 185, 230, 21, 162, 253, 9, 89, 79, 213, 80, 213, 83, 185,
+			230, 105, 201, 254, 9, 185, 158, 223, 211, 95, 71, 201, 136, 223,
+			181, 192, 122, 124, 205, 35, 249, 35, 55, 25, 113, 251, 27, 66,
+			216, 219, 52, 202, 195, 235, 46, 1, 227, 241, 245, 39, 63, 190,
+			218, 132, 169, 23, 241, 231, 45, 13, 174, 254, 37, 249, 228, 42,
+			77, 145, 242, 68, 48, 100, 35, 88, 144, 177, 156, 230, 13, 79,
+			75, 246, 99, 114, 59, 230, 227, 215, 195, 89, 18, 198, 236, 181,
+			228, 105, 20, 104, 190, 183, 98, 62, 62, 86, 143, 125, 124, 250,
+			228, 53, 185, 225, 45, 25, 211, 238, 146, 91, 171, 107, 217, 247,
+			235, 185, 57, 235, 87, 57, 40, 83, 85, 30, 124, 232, 117, 78,
+			241, 103, 255, 177, 69, 214, 237, 114, 185, 244, 220, 32, 255, 108,
+			168, 222, 80, 185, 100, 63, 249, 173, 177, 210, 230, 57, 252, 41,
+			248, 19, 6, 237, 65, 211, 133, 198, 76, 78, 120, 38, 234, 31,
+			232, 245, 12, 240, 194, 61, 42, 110, 212, 139, 206, 72, 36, 96,
+			204, 47, 88, 150, 176, 16, 102, 73, 168, 47, 250, 141, 148, 6,
+			8, 28, 5, 44, 17, 172, 6, 103, 44, 195, 139, 53, 60, 169,
+			31, 224, 173, 156, 206, 143, 71, 35, 62, 195, 227, 18, 158, 186,
+			24, 180, 221, 166, 211, 233, 59, 48, 138, 98, 54, 191, 132, 172,
+			111, 222, 34, 215, 136, 105, 149, 108, 107, 115, 99, 135, 244, 242,
+			35, 234, 245, 210, 3, 163, 210, 130, 247, 218, 6, 50, 253, 20,
+			143, 101, 9, 123, 3, 249, 219, 121, 163, 2, 154, 139, 144, 174,
+			47, 242, 241, 245, 205, 251, 228, 113, 145, 143, 111, 154, 31, 87,
+			238, 41, 227, 196, 124, 12, 66, 102, 140, 78, 119, 84, 211, 3,
+			195, 113, 37, 143, 222, 52, 175, 255, 68, 111, 182, 210, 26, 206,
+			220, 44, 36, 195, 182, 110, 94, 187, 93, 72, 150, 109, 221, 220,
+			186, 67, 14, 139, 52, 122, 219, 188, 83, 217, 190, 178, 4, 228,
+			97, 141, 41, 160, 80, 98, 101, 211, 223, 54, 111, 126, 172, 241,
+			140, 53, 132, 40, 214, 194, 77, 127, 251, 90, 145, 179, 13, 203,
+			182, 110, 219, 91, 228, 207, 213, 90, 166, 109, 109, 153, 213, 202,
+			87, 208, 77, 49, 214, 104, 12, 184, 87, 176, 233, 135, 46, 161,
+			67, 236, 137, 160, 89, 138, 21, 177, 59, 69, 199, 44, 145, 117,
+			162, 241, 204, 50, 66, 204, 165, 53, 219, 218, 186, 254, 81, 33,
+			25, 182, 181, 101, 223, 47, 36, 203, 182, 182, 224, 33, 249, 77,
+			94, 45, 44, 219, 186, 111, 238, 86, 254, 206, 82, 122, 106, 229,
+			22, 91, 76, 103, 127, 119, 4, 223, 30, 193, 1, 118, 207, 88,
+			97, 98, 236, 84, 178, 17, 157, 197, 82, 219, 132, 44, 207, 75,
+			89, 22, 113, 108, 61, 197, 49, 38, 81, 154, 166, 113, 132, 97,
+			74, 150, 215, 41, 222, 6, 49, 23, 44, 132, 225, 229, 85, 231,
+			3, 29, 73, 150, 65, 36, 197, 187, 216, 117, 2, 93, 60, 249,
+			231, 147, 107, 42, 142, 180, 143, 150, 179, 32, 100, 121, 234, 18,
+			57, 151, 132, 67, 204, 147, 49, 203, 112, 93, 26, 224, 53, 36,
+			167, 229, 142, 64, 204, 114, 150, 186, 57, 151, 151, 19, 49, 225,
+			179, 56, 4, 246, 54, 96, 120, 21, 153, 48, 101, 18, 244, 136,
+			174, 36, 49, 15, 104, 12, 146, 138, 95, 214, 212, 249, 147, 128,
+			224, 83, 189, 21, 153, 122, 190, 35, 52, 177, 121, 163, 44, 204,
+			120, 154, 178, 16, 194, 25, 195, 72, 26, 209, 40, 70, 159, 46,
+			243, 158, 71, 149, 85, 182, 203, 247, 205, 173, 170, 142, 42, 107,
+			29, 61, 86, 41, 36, 195, 182, 238, 223, 251, 188, 144, 208, 155,
+			143, 191, 36, 207, 137, 89, 54, 236, 242, 195, 82, 205, 168, 60,
+			81, 6, 207, 116, 214, 129, 41, 19, 130, 230, 61, 101, 197, 126,
+			117, 127, 130, 215, 107, 234, 13, 135, 241, 250, 112, 243, 1, 249,
+			181, 65, 202, 101, 117, 205, 223, 54, 183, 42, 23, 208, 87, 137,
+			20, 111, 84, 114, 238, 72, 84, 80, 61, 174, 131, 143, 13, 61,
+			93, 108, 243, 186, 136, 183, 171, 11, 150, 13, 169, 140, 166, 152,
+			71, 142, 103, 50, 102, 25, 129, 60, 7, 11, 212, 159, 10, 193,
+			50, 9, 252, 77, 194, 50, 49, 137, 210, 121, 30, 203, 225, 181,
+			41, 12, 181, 153, 183, 205, 135, 160, 212, 53, 212, 161, 104, 91,
+			87, 213, 188, 243, 176, 189, 121, 171, 144, 44, 219, 218, 254, 200,
+			38, 191, 207, 233, 27, 182, 181, 107, 62, 168, 252, 151, 1, 254,
+			82, 151, 19, 53, 232, 205, 134, 251, 253, 217, 16, 84, 45, 64,
+			50, 233, 108, 24, 71, 98, 2, 67, 197, 83, 21, 160, 189, 124,
+			51, 226, 193, 38, 103, 143, 9, 97, 89, 3, 29, 64, 108, 154,
+			202, 203, 218, 59, 65, 28, 114, 38, 146, 29, 137, 237, 81, 160,
+			139, 5, 51, 154, 136, 148, 103, 178, 166, 206, 68, 26, 140, 204,
+			141, 167, 121, 168, 160, 203, 248, 108, 60, 81, 219, 224, 88, 175,
+			169, 11, 59, 68, 137, 144, 140, 134, 115, 19, 225, 193, 99, 215,
+			220, 222, 210, 102, 192, 28, 180, 171, 115, 144, 97, 162, 79, 119,
+			175, 253, 184, 144, 44, 219, 218, 253, 147, 251, 228, 119, 6, 49,
+			215, 75, 118, 249, 176, 244, 220, 168, 252, 147, 1, 222, 242, 238,
+			153, 175, 131, 169, 186, 205, 199, 45, 62, 94, 209, 173, 56, 134,
+			228, 53, 67, 253, 106, 49, 162, 1, 195, 221, 38, 39, 164, 152,
+			161, 173, 134, 100, 105, 130, 111, 81, 99, 150, 208, 161, 82, 101,
+			37, 177, 174, 236, 93, 28, 86, 196, 75, 190, 133, 112, 155, 68,
+			73, 36, 35, 26, 71, 191, 42, 54, 10, 33, 214, 58, 186, 255,
+			112, 243, 14, 249, 247, 50, 41, 175, 171, 19, 251, 145, 249, 178,
+			242, 47, 229, 121, 5, 234, 171, 249, 64, 177, 189, 143, 202, 92,
+			225, 180, 156, 209, 129, 46, 103, 252, 69, 251, 124, 73, 241, 58,
+			12, 82, 158, 128, 152, 5, 1, 19, 226, 93, 159, 171, 100, 147,
+			49, 57, 203, 146, 149, 109, 77, 86, 83, 123, 18, 46, 233, 149,
+			210, 140, 78, 153, 42, 139, 146, 43, 196, 156, 35, 70, 151, 218,
+			87, 58, 23, 13, 25, 4, 52, 142, 243, 140, 249, 94, 69, 198,
+			139, 159, 65, 162, 56, 146, 151, 75, 113, 173, 78, 175, 180, 176,
+			182, 72, 105, 192, 112, 215, 106, 200, 44, 15, 191, 9, 189, 96,
+			112, 238, 185, 190, 163, 114, 164, 80, 132, 34, 57, 47, 175, 59,
+			162, 240, 71, 129, 224, 142, 86, 199, 71, 2, 127, 233, 0, 125,
+			35, 208, 25, 85, 25, 5, 147, 221, 146, 77, 171, 189, 121, 87,
+			191, 197, 146, 136, 133, 85, 24, 123, 189, 230, 252, 22, 220, 184,
+			18, 31, 58, 131, 22, 174, 98, 234, 38, 51, 229, 248, 59, 74,
+			130, 68, 26, 97, 24, 233, 34, 186, 106, 247, 213, 58, 176, 194,
+			2, 170, 141, 56, 99, 52, 188, 116, 222, 70, 66, 138, 85, 6,
+			55, 200, 26, 198, 147, 97, 91, 71, 235, 119, 10, 201, 180, 173,
+			163, 143, 191, 44, 36, 203, 182, 142, 190, 254, 249, 112, 61, 205,
+			184, 228, 95, 253, 223, 0, 26, 159, 127, 143, 184, 28, 0, 0},
 	)
 }
 
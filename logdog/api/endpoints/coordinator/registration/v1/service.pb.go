@@ -96,6 +96,9 @@ type RegisterPrefixResponse struct {
 	Secret []byte `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 	// The name of the Pub/Sub topic to publish butlerproto-formatted Butler log
 	// bundles to.
+	//
+	// If empty, the Coordinator doesn't use a Pub/Sub transport, and bundles
+	// must be published through its Bundles service instead.
 	LogBundleTopic string `protobuf:"bytes,2,opt,name=log_bundle_topic,json=logBundleTopic" json:"log_bundle_topic,omitempty"`
 }
 
@@ -12,588 +12,643 @@ func init() {
 			"logdog.Services",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 9, 110, 136, 0, 255, 236, 124, 93, 108, 28, 71,
-			147, 216, 206, 207, 46, 151, 45, 137, 63, 195, 31, 81, 67, 89,
-			106, 81, 178, 180, 180, 150, 75, 82, 180, 236, 147, 101, 249, 251,
-			86, 228, 82, 90, 125, 20, 73, 239, 46, 37, 217, 241, 89, 26,
-			238, 244, 238, 142, 53, 59, 179, 55, 51, 75, 138, 58, 31, 156,
-			47, 1, 14, 57, 224, 11, 252, 240, 1, 73, 46, 192, 225, 146,
-			32, 8, 238, 238, 33, 111, 7, 228, 37, 64, 130, 188, 6, 184,
-			183, 3, 242, 112, 47, 73, 128, 60, 6, 72, 16, 32, 64, 128,
-			32, 168, 234, 238, 217, 153, 37, 41, 203, 206, 247, 114, 119, 18,
-			44, 121, 107, 166, 187, 170, 186, 170, 186, 186, 186, 186, 122, 200,
-			31, 159, 33, 187, 109, 39, 234, 244, 247, 75, 77, 191, 187, 236,
-			246, 155, 14, 254, 179, 212, 246, 151, 93, 191, 109, 251, 237, 101,
-			171, 231, 44, 51, 207, 238, 249, 142, 23, 133, 203, 77, 223, 15,
-			108, 199, 179, 34, 63, 88, 14, 89, 112, 224, 52, 89, 184, 124,
-			176, 186, 28, 70, 86, 196, 74, 189, 192, 143, 124, 35, 199, 123,
-			46, 252, 137, 66, 198, 182, 252, 118, 61, 10, 152, 213, 173, 67,
-			3, 227, 42, 57, 135, 109, 158, 31, 176, 32, 116, 124, 111, 78,
-			161, 74, 97, 180, 118, 22, 31, 62, 225, 207, 140, 89, 146, 11,
-			89, 51, 96, 209, 156, 74, 149, 194, 217, 154, 128, 140, 247, 201,
-			88, 196, 130, 174, 227, 89, 238, 115, 199, 179, 217, 171, 57, 141,
-			42, 5, 173, 118, 78, 62, 173, 194, 67, 195, 36, 121, 43, 104,
-			118, 156, 3, 102, 207, 233, 84, 41, 228, 107, 49, 12, 168, 123,
-			253, 160, 205, 236, 185, 44, 190, 17, 208, 163, 239, 71, 73, 206,
-			208, 245, 204, 123, 10, 249, 115, 133, 40, 103, 13, 77, 207, 24,
-			183, 254, 76, 161, 235, 126, 239, 40, 112, 218, 157, 136, 222, 90,
-			89, 253, 136, 54, 58, 140, 110, 237, 173, 87, 105, 185, 31, 117,
-			252, 32, 44, 209, 178, 235, 82, 108, 16, 210, 128, 129, 68, 152,
-			93, 34, 116, 47, 100, 212, 111, 209, 168, 227, 132, 52, 244, 251,
-			65, 147, 209, 166, 111, 51, 234, 132, 180, 237, 31, 176, 192, 99,
-			54, 237, 123, 54, 11, 104, 212, 97, 180, 220, 179, 154, 128, 216,
-			105, 50, 47, 100, 69, 42, 4, 65, 111, 149, 86, 8, 141, 58,
-			86, 68, 155, 150, 71, 247, 25, 109, 249, 125, 207, 166, 142, 135,
-			189, 182, 170, 235, 149, 237, 122, 133, 182, 28, 151, 149, 8, 201,
-			19, 69, 53, 180, 92, 126, 140, 52, 136, 170, 103, 12, 125, 52,
-			243, 158, 98, 62, 164, 105, 13, 0, 7, 208, 217, 245, 219, 52,
-			196, 199, 20, 85, 71, 15, 44, 183, 15, 76, 118, 187, 125, 207,
-			105, 90, 17, 179, 105, 228, 83, 169, 226, 18, 33, 132, 104, 122,
-			70, 49, 180, 209, 252, 44, 217, 32, 186, 158, 81, 51, 134, 118,
-			70, 157, 55, 63, 166, 187, 9, 237, 73, 2, 168, 209, 253, 126,
-			139, 10, 77, 211, 150, 31, 8, 121, 32, 217, 18, 33, 231, 72,
-			22, 176, 232, 134, 126, 70, 29, 157, 35, 103, 57, 152, 5, 172,
-			121, 9, 41, 134, 118, 102, 116, 86, 66, 154, 161, 157, 185, 96,
-			146, 63, 82, 145, 190, 98, 104, 83, 234, 148, 249, 189, 138, 106,
-			25, 140, 232, 70, 72, 185, 197, 148, 8, 161, 219, 126, 196, 184,
-			12, 129, 43, 254, 28, 120, 12, 88, 212, 7, 53, 92, 161, 13,
-			96, 202, 9, 169, 255, 210, 58, 42, 210, 208, 241, 154, 140, 51,
-			42, 173, 30, 95, 122, 238, 17, 161, 86, 179, 201, 194, 208, 217,
-			119, 25, 8, 39, 10, 250, 33, 200, 41, 22, 18, 242, 33, 72,
-			8, 141, 53, 65, 179, 54, 221, 63, 138, 91, 209, 200, 39, 32,
-			109, 199, 6, 177, 11, 29, 56, 94, 203, 15, 186, 86, 4, 130,
-			58, 116, 162, 142, 223, 143, 168, 199, 152, 237, 120, 109, 160, 212,
-			195, 255, 119, 24, 93, 31, 76, 63, 234, 120, 132, 238, 179, 232,
-			144, 49, 143, 50, 171, 217, 161, 253, 30, 160, 140, 5, 171, 232,
-			134, 62, 165, 158, 153, 23, 194, 83, 178, 32, 174, 17, 9, 129,
-			240, 242, 99, 18, 210, 12, 109, 106, 210, 32, 7, 40, 87, 213,
-			208, 206, 171, 243, 166, 195, 135, 35, 25, 180, 217, 43, 110, 210,
-			67, 162, 150, 147, 143, 118, 89, 24, 90, 109, 86, 162, 85, 222,
-			138, 91, 148, 19, 210, 165, 213, 34, 137, 251, 161, 1, 56, 174,
-			43, 16, 56, 94, 59, 102, 88, 213, 13, 253, 188, 58, 53, 37,
-			152, 82, 179, 192, 135, 100, 24, 180, 125, 62, 47, 45, 65, 213,
-			12, 237, 252, 5, 147, 172, 33, 195, 154, 161, 153, 234, 180, 121,
-			93, 82, 78, 24, 119, 199, 10, 233, 62, 72, 72, 186, 128, 152,
-			154, 166, 27, 186, 169, 158, 151, 226, 209, 178, 128, 36, 39, 33,
-			197, 208, 204, 145, 113, 9, 1, 1, 99, 138, 172, 34, 53, 221,
-			208, 46, 170, 134, 121, 237, 77, 212, 184, 91, 137, 105, 233, 186,
-			161, 95, 84, 205, 105, 129, 79, 207, 2, 10, 73, 75, 87, 12,
-			237, 226, 200, 57, 9, 105, 134, 118, 113, 98, 114, 63, 135, 19,
-			104, 141, 252, 207, 43, 228, 82, 219, 247, 219, 46, 91, 150, 83,
-			106, 217, 238, 7, 104, 42, 194, 223, 142, 243, 247, 37, 249, 126,
-			225, 19, 146, 223, 16, 77, 140, 57, 50, 18, 178, 166, 239, 217,
-			33, 250, 90, 173, 38, 65, 99, 154, 100, 61, 203, 243, 67, 244,
-			178, 217, 26, 7, 238, 239, 146, 169, 166, 223, 45, 13, 161, 188,
-			127, 78, 34, 196, 185, 190, 171, 252, 51, 69, 249, 23, 170, 246,
-			96, 247, 254, 159, 169, 151, 30, 240, 182, 187, 162, 109, 233, 41,
-			115, 221, 95, 120, 254, 161, 215, 56, 234, 177, 240, 209, 159, 82,
-			240, 173, 151, 50, 207, 21, 242, 31, 207, 162, 111, 189, 148, 49,
-			110, 253, 251, 179, 220, 109, 52, 125, 151, 222, 239, 183, 90, 44,
-			8, 233, 18, 229, 168, 110, 132, 212, 182, 34, 139, 58, 94, 196,
-			130, 102, 199, 242, 218, 224, 250, 96, 126, 144, 148, 67, 94, 249,
-			45, 209, 129, 86, 189, 102, 137, 158, 226, 139, 59, 81, 212, 11,
-			63, 89, 94, 182, 217, 1, 115, 253, 30, 11, 66, 57, 58, 88,
-			242, 122, 130, 137, 165, 125, 206, 196, 50, 33, 180, 198, 108, 39,
-			140, 2, 103, 191, 143, 19, 210, 242, 108, 218, 15, 25, 248, 93,
-			225, 203, 225, 201, 190, 227, 89, 193, 17, 242, 21, 22, 113, 210,
-			82, 63, 144, 147, 151, 208, 174, 111, 59, 45, 112, 164, 142, 239,
-			21, 169, 21, 48, 218, 131, 137, 18, 129, 195, 232, 5, 254, 129,
-			99, 131, 135, 149, 126, 169, 229, 187, 174, 127, 8, 179, 28, 116,
-			227, 64, 167, 16, 58, 17, 218, 101, 209, 39, 132, 80, 248, 243,
-			193, 16, 99, 33, 204, 199, 228, 234, 210, 237, 135, 17, 56, 54,
-			75, 172, 16, 214, 190, 127, 0, 175, 132, 196, 8, 245, 252, 200,
-			105, 178, 34, 119, 111, 174, 19, 70, 128, 33, 73, 209, 179, 135,
-			216, 177, 157, 176, 233, 90, 78, 151, 5, 165, 211, 152, 112, 188,
-			164, 44, 36, 19, 189, 192, 183, 251, 232, 73, 5, 31, 100, 192,
-			200, 255, 23, 31, 68, 174, 127, 182, 223, 236, 119, 153, 23, 89,
-			82, 73, 203, 126, 64, 253, 168, 195, 2, 218, 181, 34, 22, 56,
-			150, 27, 14, 68, 141, 10, 138, 58, 140, 208, 36, 247, 241, 160,
-			182, 153, 131, 61, 1, 177, 103, 117, 113, 245, 78, 218, 150, 231,
-			15, 222, 161, 220, 157, 40, 132, 17, 121, 28, 149, 31, 132, 180,
-			107, 29, 129, 199, 239, 135, 124, 233, 100, 158, 237, 7, 16, 6,
-			4, 192, 68, 23, 150, 33, 46, 147, 40, 164, 54, 11, 192, 23,
-			209, 86, 224, 119, 137, 140, 18, 90, 209, 33, 152, 137, 116, 255,
-			97, 143, 53, 193, 130, 104, 47, 112, 192, 176, 2, 176, 29, 143,
-			91, 81, 24, 34, 239, 132, 54, 30, 86, 235, 180, 190, 179, 217,
-			120, 90, 174, 85, 104, 181, 78, 119, 107, 59, 79, 170, 27, 149,
-			13, 122, 255, 11, 218, 120, 88, 161, 235, 59, 187, 95, 212, 170,
-			15, 30, 54, 232, 195, 157, 173, 141, 74, 173, 78, 203, 219, 27,
-			116, 125, 103, 187, 81, 171, 222, 223, 107, 236, 212, 234, 132, 46,
-			148, 235, 180, 90, 95, 192, 55, 229, 237, 47, 104, 229, 217, 110,
-			173, 82, 175, 211, 157, 26, 173, 62, 222, 221, 170, 86, 54, 232,
-			211, 114, 173, 86, 222, 110, 84, 43, 245, 34, 173, 110, 175, 111,
-			237, 109, 84, 183, 31, 20, 233, 253, 189, 6, 221, 222, 105, 16,
-			186, 85, 125, 92, 109, 84, 54, 104, 99, 167, 136, 100, 143, 247,
-			163, 59, 155, 244, 113, 165, 182, 254, 176, 188, 221, 40, 223, 175,
-			110, 85, 27, 95, 32, 193, 205, 106, 99, 27, 136, 109, 238, 212,
-			8, 45, 211, 221, 114, 173, 81, 93, 223, 219, 42, 215, 232, 238,
-			94, 109, 119, 167, 94, 161, 48, 178, 141, 106, 125, 125, 171, 92,
-			125, 92, 217, 40, 209, 234, 54, 221, 222, 161, 149, 39, 149, 237,
-			6, 173, 63, 44, 111, 109, 165, 7, 74, 232, 206, 211, 237, 74,
-			13, 184, 79, 14, 147, 222, 175, 208, 173, 106, 249, 254, 86, 5,
-			72, 225, 56, 55, 170, 181, 202, 122, 3, 6, 52, 248, 181, 94,
-			221, 168, 108, 55, 202, 91, 69, 66, 235, 187, 149, 245, 106, 121,
-			171, 72, 43, 207, 42, 143, 119, 183, 202, 181, 47, 138, 2, 105,
-			189, 242, 249, 94, 101, 187, 81, 45, 111, 209, 141, 242, 227, 242,
-			131, 74, 157, 22, 126, 72, 42, 187, 181, 157, 245, 189, 90, 229,
-			49, 112, 189, 179, 73, 235, 123, 247, 235, 141, 106, 99, 175, 81,
-			161, 15, 118, 118, 54, 80, 216, 245, 74, 237, 73, 117, 189, 82,
-			191, 75, 183, 118, 234, 40, 176, 189, 122, 165, 72, 232, 70, 185,
-			81, 70, 210, 187, 181, 157, 205, 106, 163, 126, 23, 126, 223, 223,
-			171, 87, 81, 112, 213, 237, 70, 165, 86, 219, 219, 109, 84, 119,
-			182, 23, 233, 195, 157, 167, 149, 39, 149, 26, 93, 47, 239, 213,
-			43, 27, 40, 225, 157, 109, 24, 45, 216, 74, 101, 167, 246, 5,
-			160, 5, 57, 160, 6, 138, 244, 233, 195, 74, 227, 97, 165, 6,
-			66, 69, 105, 149, 65, 12, 245, 70, 173, 186, 222, 72, 54, 219,
-			169, 209, 198, 78, 173, 65, 18, 227, 164, 219, 149, 7, 91, 213,
-			7, 149, 237, 245, 10, 188, 222, 1, 52, 79, 171, 245, 202, 34,
-			45, 215, 170, 117, 104, 80, 69, 194, 244, 105, 249, 11, 186, 179,
-			135, 163, 6, 69, 237, 213, 43, 132, 255, 78, 152, 110, 17, 245,
-			73, 171, 155, 180, 188, 241, 164, 10, 156, 139, 214, 187, 59, 245,
-			122, 85, 152, 11, 138, 109, 253, 161, 144, 121, 28, 242, 210, 252,
-			121, 248, 149, 55, 180, 133, 204, 93, 114, 134, 232, 249, 255, 54,
-			146, 225, 192, 89, 146, 5, 64, 53, 180, 133, 145, 243, 228, 28,
-			201, 33, 148, 225, 224, 24, 25, 225, 160, 194, 97, 209, 120, 196,
-			208, 22, 204, 79, 4, 198, 171, 153, 162, 192, 168, 112, 128, 55,
-			2, 178, 87, 71, 166, 4, 70, 5, 48, 2, 200, 49, 42, 136,
-			17, 96, 209, 120, 196, 208, 174, 206, 222, 20, 24, 175, 101, 74,
-			2, 163, 202, 1, 222, 8, 130, 174, 107, 35, 243, 2, 163, 10,
-			24, 1, 228, 24, 85, 196, 8, 176, 104, 60, 98, 104, 215, 46,
-			45, 9, 140, 239, 103, 22, 4, 70, 141, 3, 188, 145, 166, 26,
-			218, 251, 35, 166, 192, 168, 1, 70, 0, 57, 70, 13, 49, 2,
-			44, 26, 67, 215, 247, 174, 8, 140, 215, 227, 81, 235, 28, 224,
-			141, 116, 213, 208, 174, 143, 92, 19, 24, 117, 192, 8, 32, 199,
-			168, 35, 70, 128, 69, 99, 205, 208, 174, 223, 144, 163, 190, 145,
-			185, 34, 48, 102, 57, 192, 27, 101, 85, 67, 187, 49, 50, 39,
-			48, 102, 1, 35, 128, 28, 99, 22, 49, 2, 44, 26, 143, 24,
-			218, 141, 121, 74, 254, 11, 225, 251, 155, 90, 230, 185, 98, 254,
-			37, 56, 13, 25, 155, 224, 178, 195, 66, 230, 69, 33, 181, 104,
-			232, 180, 61, 102, 23, 105, 203, 121, 197, 236, 37, 151, 121, 237,
-			168, 67, 195, 158, 229, 97, 24, 235, 116, 217, 160, 57, 179, 9,
-			181, 160, 79, 211, 239, 123, 184, 24, 137, 32, 9, 87, 162, 86,
-			96, 53, 7, 235, 173, 124, 17, 81, 12, 152, 16, 36, 16, 111,
-			248, 46, 95, 82, 104, 21, 119, 12, 16, 49, 247, 152, 103, 51,
-			142, 208, 242, 142, 104, 211, 114, 153, 103, 91, 1, 98, 109, 250,
-			94, 147, 245, 34, 88, 255, 94, 50, 186, 96, 91, 71, 11, 4,
-			22, 139, 133, 174, 239, 69, 157, 5, 137, 38, 96, 174, 220, 140,
-			53, 156, 46, 11, 35, 171, 219, 227, 43, 160, 8, 29, 108, 7,
-			226, 22, 6, 187, 22, 177, 27, 32, 52, 58, 76, 182, 198, 64,
-			28, 183, 56, 214, 64, 84, 192, 130, 19, 111, 83, 44, 27, 214,
-			72, 63, 160, 97, 127, 63, 130, 225, 130, 68, 96, 117, 162, 214,
-			0, 81, 137, 214, 48, 20, 3, 68, 189, 94, 224, 191, 114, 96,
-			157, 117, 143, 232, 205, 165, 213, 149, 226, 202, 202, 10, 61, 98,
-			86, 0, 123, 69, 90, 121, 101, 117, 123, 46, 163, 171, 159, 208,
-			117, 191, 219, 235, 71, 108, 64, 25, 209, 166, 56, 196, 24, 162,
-			23, 178, 190, 237, 99, 32, 83, 18, 1, 207, 96, 8, 97, 100,
-			5, 17, 189, 71, 75, 165, 210, 221, 225, 119, 204, 179, 83, 111,
-			98, 66, 50, 76, 150, 111, 249, 235, 56, 120, 150, 154, 188, 7,
-			24, 98, 104, 137, 211, 146, 240, 221, 161, 78, 168, 115, 209, 133,
-			255, 150, 29, 16, 146, 68, 156, 22, 45, 28, 35, 244, 41, 93,
-			161, 215, 175, 15, 227, 250, 140, 174, 44, 210, 223, 229, 221, 78,
-			224, 238, 230, 61, 186, 122, 247, 216, 91, 65, 250, 30, 93, 93,
-			145, 127, 68, 163, 223, 163, 204, 133, 88, 53, 193, 64, 24, 35,
-			251, 236, 68, 14, 62, 125, 51, 7, 75, 111, 224, 224, 230, 73,
-			28, 36, 244, 127, 107, 160, 255, 129, 194, 208, 0, 6, 224, 205,
-			129, 198, 126, 188, 25, 156, 170, 236, 211, 141, 132, 191, 74, 234,
-			252, 94, 90, 231, 244, 230, 49, 33, 220, 29, 116, 146, 22, 144,
-			208, 122, 178, 195, 49, 51, 24, 244, 73, 203, 57, 101, 116, 73,
-			17, 15, 58, 156, 40, 221, 129, 126, 7, 13, 63, 75, 54, 60,
-			133, 198, 205, 147, 105, 156, 104, 67, 100, 144, 238, 169, 229, 39,
-			72, 79, 166, 123, 158, 168, 211, 102, 147, 214, 209, 177, 198, 158,
-			80, 36, 5, 146, 158, 181, 68, 31, 195, 46, 96, 159, 113, 93,
-			47, 173, 173, 222, 46, 222, 254, 248, 35, 240, 17, 240, 151, 128,
-			55, 187, 57, 244, 144, 58, 94, 211, 237, 135, 206, 1, 75, 165,
-			134, 158, 168, 53, 35, 145, 26, 122, 18, 39, 4, 128, 185, 39,
-			249, 241, 68, 106, 232, 137, 49, 69, 254, 190, 38, 83, 67, 95,
-			171, 134, 249, 191, 84, 201, 108, 202, 135, 91, 130, 247, 180, 19,
-			79, 248, 240, 228, 152, 200, 96, 80, 210, 216, 66, 234, 178, 48,
-			4, 23, 236, 81, 223, 99, 49, 182, 32, 181, 164, 240, 45, 135,
-			69, 87, 8, 125, 33, 100, 245, 130, 182, 28, 230, 218, 232, 124,
-			45, 218, 243, 67, 39, 114, 14, 112, 139, 224, 177, 182, 133, 191,
-			95, 32, 67, 162, 97, 137, 110, 250, 65, 108, 91, 33, 178, 146,
-			32, 232, 7, 180, 235, 7, 172, 72, 45, 234, 249, 222, 210, 107,
-			22, 248, 34, 235, 210, 18, 219, 149, 20, 54, 190, 53, 219, 103,
-			36, 30, 30, 108, 116, 96, 153, 132, 197, 15, 155, 167, 249, 28,
-			86, 227, 157, 59, 119, 138, 226, 47, 87, 97, 226, 193, 9, 234,
-			83, 116, 67, 255, 90, 125, 50, 157, 72, 64, 125, 157, 74, 64,
-			125, 157, 63, 151, 72, 64, 125, 157, 200, 122, 252, 219, 25, 50,
-			63, 156, 245, 96, 221, 94, 116, 116, 90, 202, 99, 132, 100, 43,
-			240, 254, 180, 252, 5, 193, 183, 131, 228, 197, 255, 254, 17, 249,
-			139, 105, 158, 191, 88, 155, 120, 151, 191, 120, 151, 191, 120, 151,
-			191, 120, 151, 191, 120, 151, 191, 120, 151, 191, 248, 219, 155, 191,
-			184, 153, 204, 95, 220, 252, 233, 249, 139, 15, 254, 150, 230, 47,
-			56, 198, 66, 230, 178, 192, 152, 227, 0, 111, 148, 83, 13, 173,
-			16, 235, 58, 7, 24, 11, 177, 174, 115, 136, 177, 16, 235, 58,
-			167, 25, 90, 193, 188, 68, 254, 143, 138, 25, 17, 109, 45, 51,
-			97, 254, 119, 149, 150, 105, 155, 121, 44, 112, 154, 20, 67, 38,
-			121, 48, 199, 87, 235, 35, 191, 143, 155, 254, 128, 45, 65, 76,
-			16, 249, 212, 58, 240, 29, 155, 218, 172, 229, 120, 184, 82, 245,
-			123, 46, 63, 0, 38, 233, 254, 184, 82, 30, 249, 253, 128, 150,
-			119, 171, 97, 137, 150, 105, 116, 212, 115, 154, 150, 75, 153, 216,
-			237, 57, 33, 224, 195, 80, 35, 146, 33, 101, 192, 126, 167, 207,
-			194, 8, 83, 27, 28, 14, 123, 190, 7, 148, 143, 122, 140, 39,
-			70, 0, 31, 196, 9, 29, 95, 4, 188, 142, 23, 70, 150, 215,
-			100, 50, 112, 16, 71, 167, 116, 211, 247, 7, 123, 155, 160, 215,
-			164, 247, 173, 160, 48, 20, 233, 149, 48, 208, 91, 20, 231, 187,
-			33, 61, 229, 125, 98, 175, 218, 232, 48, 250, 168, 190, 179, 61,
-			136, 221, 69, 158, 194, 15, 232, 11, 108, 253, 2, 70, 198, 101,
-			129, 13, 253, 253, 111, 88, 51, 162, 47, 126, 247, 247, 94, 36,
-			14, 198, 215, 242, 231, 226, 248, 245, 63, 124, 70, 106, 191, 153,
-			186, 10, 254, 59, 93, 89, 97, 254, 198, 107, 54, 204, 31, 56,
-			99, 52, 223, 20, 141, 47, 252, 59, 133, 76, 62, 96, 209, 186,
-			239, 181, 156, 118, 77, 40, 216, 40, 18, 163, 137, 79, 158, 11,
-			162, 207, 251, 129, 43, 10, 62, 38, 248, 155, 58, 127, 177, 23,
-			184, 198, 123, 132, 196, 173, 121, 225, 199, 104, 109, 84, 182, 138,
-			140, 18, 153, 146, 88, 68, 179, 158, 21, 117, 176, 0, 100, 180,
-			54, 41, 94, 113, 6, 118, 173, 168, 3, 237, 135, 136, 119, 252,
-			48, 194, 122, 144, 209, 218, 100, 138, 250, 67, 63, 140, 22, 254,
-			84, 33, 51, 53, 214, 118, 194, 136, 5, 188, 92, 162, 198, 13,
-			215, 152, 35, 35, 189, 192, 7, 133, 11, 222, 37, 120, 106, 157,
-			202, 177, 34, 23, 237, 132, 34, 23, 131, 232, 54, 11, 155, 200,
-			209, 217, 26, 254, 62, 161, 192, 37, 123, 66, 129, 203, 194, 19,
-			50, 59, 204, 170, 16, 249, 24, 81, 29, 91, 176, 169, 58, 182,
-			81, 36, 89, 84, 50, 50, 120, 230, 214, 108, 137, 219, 71, 41,
-			93, 19, 82, 227, 141, 22, 62, 39, 147, 91, 190, 101, 191, 237,
-			240, 57, 49, 53, 38, 38, 71, 164, 97, 101, 13, 254, 94, 248,
-			87, 10, 49, 146, 56, 99, 211, 16, 124, 41, 111, 193, 87, 140,
-			88, 77, 136, 234, 38, 209, 172, 54, 67, 90, 103, 110, 93, 24,
-			222, 238, 149, 228, 78, 189, 6, 173, 140, 43, 228, 44, 63, 254,
-			183, 220, 231, 47, 217, 145, 144, 249, 25, 249, 236, 23, 236, 104,
-			225, 151, 10, 153, 109, 112, 41, 71, 236, 167, 74, 96, 96, 16,
-			218, 15, 20, 46, 233, 39, 233, 245, 63, 169, 100, 186, 204, 203,
-			20, 126, 42, 7, 215, 201, 184, 235, 183, 159, 51, 47, 10, 142,
-			158, 99, 50, 91, 214, 72, 185, 126, 187, 2, 79, 215, 225, 225,
-			91, 114, 100, 76, 147, 44, 11, 2, 63, 64, 59, 28, 173, 113,
-			0, 166, 42, 175, 119, 192, 9, 77, 248, 84, 229, 79, 96, 38,
-			95, 38, 103, 196, 235, 208, 121, 205, 230, 206, 32, 98, 209, 163,
-			238, 188, 102, 198, 60, 25, 69, 154, 216, 125, 26, 187, 231, 241,
-			129, 240, 3, 252, 37, 118, 158, 193, 206, 188, 57, 246, 189, 64,
-			242, 176, 181, 198, 174, 151, 184, 44, 0, 134, 158, 243, 100, 20,
-			95, 97, 199, 203, 216, 17, 219, 66, 191, 91, 255, 67, 37, 121,
-			49, 223, 67, 227, 51, 50, 26, 187, 43, 99, 246, 152, 241, 160,
-			207, 55, 47, 72, 163, 60, 238, 217, 118, 200, 88, 122, 2, 26,
-			239, 201, 198, 39, 250, 16, 243, 210, 105, 175, 5, 194, 117, 66,
-			6, 179, 196, 184, 48, 152, 14, 67, 179, 209, 52, 79, 122, 37,
-			144, 84, 201, 248, 144, 5, 27, 49, 221, 147, 77, 219, 60, 101,
-			236, 70, 133, 156, 75, 25, 162, 113, 81, 34, 58, 201, 62, 79,
-			67, 243, 232, 207, 87, 200, 136, 145, 213, 51, 127, 168, 252, 77,
-			40, 171, 27, 37, 170, 150, 49, 180, 252, 200, 23, 248, 83, 49,
-			180, 209, 145, 27, 248, 83, 53, 52, 50, 114, 141, 252, 54, 63,
-			151, 26, 203, 204, 43, 230, 231, 244, 152, 217, 200, 202, 184, 56,
-			2, 10, 163, 160, 223, 140, 250, 193, 32, 93, 215, 15, 89, 64,
-			232, 66, 220, 117, 33, 174, 65, 75, 196, 25, 99, 249, 11, 196,
-			147, 25, 217, 73, 149, 154, 22, 202, 15, 130, 168, 189, 218, 150,
-			204, 237, 237, 91, 33, 163, 11, 24, 23, 52, 5, 46, 25, 75,
-			208, 106, 139, 71, 51, 69, 158, 135, 96, 45, 171, 239, 70, 36,
-			14, 179, 0, 203, 161, 227, 186, 50, 27, 144, 202, 199, 78, 170,
-			99, 243, 137, 124, 236, 100, 170, 84, 111, 114, 116, 62, 145, 143,
-			157, 188, 116, 153, 124, 38, 211, 177, 211, 234, 156, 185, 138, 140,
-			202, 244, 4, 208, 230, 172, 201, 212, 125, 200, 34, 8, 32, 93,
-			223, 226, 169, 133, 84, 38, 113, 90, 157, 164, 137, 76, 226, 116,
-			76, 88, 1, 236, 163, 83, 137, 76, 226, 244, 236, 121, 178, 45,
-			75, 217, 102, 213, 43, 102, 25, 9, 67, 196, 32, 9, 71, 236,
-			85, 180, 20, 98, 146, 197, 121, 61, 168, 225, 27, 98, 40, 182,
-			231, 100, 137, 218, 172, 58, 61, 151, 40, 81, 155, 141, 25, 129,
-			97, 206, 142, 94, 76, 148, 168, 205, 94, 166, 164, 53, 40, 81,
-			187, 98, 126, 129, 140, 64, 40, 242, 70, 49, 8, 61, 201, 202,
-			196, 94, 192, 90, 44, 8, 48, 47, 67, 232, 194, 241, 168, 106,
-			97, 168, 170, 109, 246, 74, 170, 170, 45, 159, 172, 106, 139, 25,
-			196, 170, 182, 203, 148, 124, 75, 84, 93, 49, 244, 203, 153, 146,
-			98, 246, 232, 137, 14, 76, 154, 46, 40, 200, 111, 209, 166, 229,
-			186, 44, 88, 10, 251, 189, 158, 235, 48, 155, 39, 59, 133, 13,
-			147, 33, 12, 169, 146, 69, 41, 229, 33, 171, 6, 13, 94, 206,
-			191, 71, 10, 68, 215, 113, 51, 122, 69, 157, 49, 231, 143, 87,
-			117, 138, 85, 79, 12, 86, 65, 123, 188, 162, 94, 198, 189, 19,
-			128, 89, 232, 153, 151, 144, 98, 104, 87, 70, 39, 36, 164, 25,
-			218, 149, 169, 105, 114, 3, 73, 192, 254, 86, 157, 50, 205, 55,
-			20, 142, 114, 10, 96, 120, 87, 213, 43, 51, 2, 11, 24, 222,
-			85, 145, 194, 86, 208, 240, 174, 138, 26, 74, 5, 13, 239, 234,
-			164, 65, 238, 34, 5, 216, 106, 170, 243, 38, 47, 9, 61, 86,
-			11, 27, 70, 1, 108, 181, 78, 46, 137, 85, 208, 202, 174, 171,
-			87, 167, 4, 102, 176, 178, 235, 241, 184, 128, 249, 235, 162, 36,
-			86, 65, 43, 187, 126, 193, 36, 191, 64, 170, 176, 31, 84, 39,
-			205, 207, 68, 33, 106, 108, 221, 113, 92, 181, 193, 194, 102, 224,
-			244, 34, 158, 212, 227, 76, 157, 198, 5, 152, 82, 65, 189, 62,
-			47, 40, 129, 41, 21, 226, 177, 131, 41, 21, 242, 103, 37, 4,
-			132, 199, 39, 200, 159, 40, 200, 134, 110, 104, 75, 234, 188, 249,
-			79, 149, 68, 5, 105, 178, 82, 20, 151, 115, 116, 67, 159, 221,
-			163, 43, 69, 49, 33, 147, 47, 99, 255, 19, 178, 40, 78, 152,
-			6, 104, 88, 241, 81, 63, 90, 102, 145, 111, 95, 101, 125, 172,
-			199, 152, 141, 3, 178, 60, 106, 217, 60, 29, 107, 185, 68, 98,
-			199, 158, 181, 221, 245, 120, 140, 186, 110, 232, 75, 106, 97, 82,
-			140, 67, 207, 2, 231, 114, 140, 186, 98, 104, 75, 121, 41, 105,
-			93, 51, 180, 165, 11, 38, 249, 140, 168, 186, 106, 232, 171, 153,
-			143, 20, 243, 22, 14, 48, 118, 232, 114, 163, 45, 221, 249, 208,
-			76, 224, 132, 193, 224, 65, 135, 171, 249, 75, 228, 67, 162, 235,
-			152, 43, 89, 83, 39, 204, 27, 136, 44, 57, 93, 170, 27, 199,
-			43, 110, 5, 235, 42, 26, 255, 154, 186, 202, 125, 162, 138, 198,
-			191, 38, 140, 132, 231, 91, 214, 70, 207, 72, 72, 51, 180, 181,
-			177, 113, 114, 27, 201, 41, 134, 118, 91, 157, 55, 11, 66, 57,
-			86, 20, 187, 33, 33, 83, 102, 159, 68, 15, 166, 194, 109, 117,
-			109, 66, 224, 84, 114, 128, 38, 134, 0, 233, 228, 172, 132, 52,
-			67, 187, 125, 193, 36, 15, 9, 72, 77, 191, 147, 41, 43, 230,
-			167, 244, 88, 52, 131, 142, 158, 59, 150, 102, 63, 8, 152, 23,
-			13, 216, 177, 210, 44, 128, 208, 192, 228, 238, 228, 47, 160, 151,
-			192, 116, 208, 221, 183, 241, 18, 26, 10, 234, 174, 122, 135, 219,
-			177, 134, 130, 186, 43, 4, 197, 211, 72, 119, 133, 151, 208, 80,
-			80, 119, 167, 166, 81, 47, 26, 8, 234, 94, 172, 151, 52, 9,
-			88, 73, 210, 154, 138, 201, 129, 156, 238, 169, 119, 103, 4, 74,
-			112, 25, 247, 98, 114, 32, 167, 123, 66, 47, 26, 202, 233, 222,
-			216, 56, 249, 4, 201, 169, 134, 246, 115, 117, 194, 92, 194, 186,
-			226, 160, 207, 138, 252, 72, 205, 102, 195, 85, 198, 118, 60, 137,
-			99, 162, 224, 49, 126, 174, 222, 147, 227, 0, 143, 241, 115, 81,
-			96, 172, 161, 198, 127, 62, 34, 137, 130, 147, 248, 57, 18, 69,
-			235, 223, 200, 212, 20, 225, 166, 78, 181, 227, 129, 230, 18, 54,
-			12, 179, 99, 35, 111, 162, 81, 97, 46, 109, 243, 71, 27, 149,
-			142, 186, 217, 84, 55, 248, 146, 164, 171, 153, 28, 160, 153, 144,
-			144, 98, 104, 155, 194, 168, 116, 212, 205, 230, 5, 147, 252, 145,
-			130, 4, 21, 67, 123, 164, 78, 154, 223, 43, 32, 174, 152, 70,
-			81, 44, 83, 177, 231, 59, 89, 108, 39, 122, 101, 18, 135, 150,
-			131, 150, 220, 15, 117, 173, 168, 137, 135, 47, 116, 33, 181, 177,
-			95, 16, 231, 170, 142, 71, 23, 248, 22, 58, 30, 24, 88, 193,
-			35, 117, 115, 94, 48, 15, 86, 240, 72, 56, 22, 29, 173, 224,
-			145, 112, 158, 58, 90, 193, 163, 241, 9, 178, 136, 227, 82, 13,
-			237, 177, 122, 213, 188, 136, 44, 130, 18, 78, 243, 0, 58, 42,
-			253, 177, 250, 104, 82, 224, 81, 115, 208, 213, 148, 144, 98, 104,
-			143, 231, 47, 73, 72, 51, 180, 199, 87, 22, 200, 63, 231, 194,
-			211, 12, 237, 115, 245, 130, 249, 107, 238, 160, 229, 62, 152, 190,
-			100, 71, 39, 144, 227, 117, 238, 78, 136, 175, 109, 159, 133, 222,
-			141, 40, 33, 18, 120, 234, 120, 188, 192, 63, 198, 20, 123, 231,
-			132, 5, 96, 233, 127, 191, 199, 130, 150, 219, 247, 251, 252, 144,
-			44, 236, 248, 125, 215, 6, 71, 111, 51, 151, 69, 113, 172, 169,
-			227, 234, 243, 185, 250, 248, 170, 24, 0, 172, 62, 159, 199, 2,
-			4, 87, 240, 121, 126, 90, 66, 48, 156, 243, 115, 36, 32, 224,
-			192, 245, 39, 153, 23, 138, 217, 162, 39, 111, 121, 126, 84, 36,
-			51, 132, 226, 180, 240, 37, 139, 149, 8, 151, 208, 49, 97, 14,
-			248, 217, 219, 56, 166, 44, 26, 255, 51, 245, 9, 247, 224, 89,
-			116, 76, 207, 132, 167, 224, 185, 227, 103, 194, 49, 101, 209, 248,
-			159, 9, 199, 148, 5, 213, 126, 249, 99, 29, 83, 22, 77, 242,
-			75, 245, 217, 140, 64, 9, 38, 249, 101, 76, 14, 76, 242, 75,
-			225, 152, 178, 104, 146, 95, 142, 141, 99, 180, 148, 5, 147, 252,
-			234, 45, 162, 165, 44, 26, 228, 87, 234, 151, 146, 105, 240, 66,
-			95, 9, 157, 101, 209, 32, 191, 18, 209, 82, 22, 13, 242, 171,
-			73, 131, 172, 32, 5, 205, 208, 158, 171, 243, 230, 85, 164, 48,
-			20, 9, 200, 90, 133, 164, 237, 103, 209, 60, 158, 171, 95, 77,
-			9, 116, 96, 30, 207, 99, 82, 96, 30, 207, 197, 194, 157, 69,
-			243, 120, 126, 193, 36, 62, 81, 245, 156, 145, 181, 51, 127, 79,
-			81, 204, 125, 122, 210, 78, 246, 71, 88, 71, 186, 63, 57, 205,
-			54, 114, 138, 161, 217, 249, 139, 104, 27, 152, 205, 111, 189, 141,
-			109, 228, 208, 54, 90, 170, 205, 231, 111, 14, 109, 163, 37, 148,
-			197, 79, 1, 90, 194, 54, 114, 104, 27, 173, 169, 105, 178, 137,
-			36, 20, 67, 235, 168, 19, 230, 157, 183, 10, 38, 248, 46, 248,
-			208, 10, 135, 175, 199, 228, 208, 90, 58, 106, 107, 70, 16, 1,
-			107, 233, 196, 12, 128, 181, 116, 132, 181, 228, 208, 90, 58, 99,
-			227, 228, 83, 100, 64, 53, 180, 111, 212, 139, 230, 50, 223, 235,
-			245, 187, 251, 44, 0, 194, 64, 148, 121, 81, 224, 176, 80, 80,
-			101, 1, 59, 78, 22, 76, 232, 27, 181, 35, 199, 6, 38, 244,
-			141, 208, 107, 14, 77, 232, 155, 252, 121, 9, 105, 134, 246, 141,
-			57, 143, 91, 204, 28, 0, 174, 58, 47, 182, 152, 29, 167, 221,
-			225, 1, 70, 59, 125, 129, 233, 244, 241, 130, 65, 185, 234, 55,
-			23, 5, 114, 48, 40, 55, 38, 12, 6, 229, 10, 131, 202, 161,
-			65, 185, 23, 76, 242, 87, 10, 82, 214, 13, 45, 80, 167, 204,
-			191, 192, 149, 200, 243, 163, 196, 222, 58, 96, 156, 152, 55, 240,
-			140, 152, 66, 195, 163, 111, 112, 169, 124, 253, 192, 252, 6, 158,
-			157, 56, 158, 141, 103, 47, 156, 81, 203, 227, 205, 169, 223, 108,
-			246, 97, 251, 87, 216, 103, 16, 233, 122, 190, 183, 196, 248, 49,
-			135, 229, 217, 88, 138, 131, 54, 122, 4, 61, 120, 1, 16, 31,
-			40, 222, 179, 234, 248, 135, 180, 223, 147, 65, 116, 210, 36, 134,
-			76, 56, 190, 239, 230, 250, 237, 48, 22, 12, 4, 9, 129, 234,
-			206, 139, 193, 67, 136, 28, 196, 134, 0, 65, 64, 48, 58, 38,
-			33, 205, 208, 130, 73, 131, 252, 12, 229, 146, 53, 180, 190, 122,
-			65, 196, 200, 66, 222, 201, 12, 69, 218, 147, 136, 53, 218, 138,
-			172, 152, 114, 86, 55, 244, 190, 26, 76, 9, 236, 89, 68, 40,
-			41, 131, 211, 237, 143, 198, 239, 52, 67, 235, 207, 206, 145, 223,
-			66, 202, 57, 67, 59, 84, 47, 152, 55, 121, 48, 226, 188, 62,
-			97, 17, 61, 133, 100, 78, 55, 244, 67, 181, 127, 65, 160, 205,
-			101, 1, 147, 180, 2, 152, 203, 135, 121, 73, 50, 167, 25, 218,
-			225, 236, 156, 48, 191, 17, 67, 123, 29, 103, 56, 126, 104, 176,
-			220, 22, 83, 132, 71, 116, 67, 127, 173, 30, 74, 194, 35, 89,
-			192, 39, 199, 58, 162, 24, 218, 235, 81, 67, 66, 154, 161, 189,
-			158, 57, 79, 62, 70, 194, 121, 67, 251, 86, 157, 51, 63, 120,
-			243, 88, 79, 160, 152, 215, 13, 253, 91, 245, 245, 156, 192, 154,
-			207, 2, 34, 57, 212, 188, 98, 104, 223, 230, 37, 197, 188, 102,
-			104, 223, 206, 156, 23, 122, 29, 53, 180, 239, 212, 243, 111, 169,
-			87, 81, 89, 147, 162, 60, 170, 27, 250, 119, 234, 183, 146, 242,
-			104, 22, 16, 202, 177, 142, 42, 134, 246, 221, 232, 164, 132, 52,
-			67, 251, 110, 122, 150, 220, 65, 202, 196, 208, 127, 169, 168, 231,
-			127, 72, 177, 105, 154, 99, 136, 136, 232, 70, 246, 151, 138, 250,
-			221, 121, 193, 3, 201, 34, 174, 17, 9, 42, 0, 230, 39, 37,
-			168, 1, 56, 61, 75, 66, 162, 230, 50, 70, 238, 247, 149, 204,
-			31, 42, 138, 201, 168, 76, 33, 199, 238, 30, 139, 170, 183, 252,
-			246, 134, 223, 78, 77, 173, 120, 46, 225, 68, 196, 66, 176, 150,
-			5, 29, 15, 157, 168, 67, 100, 143, 94, 224, 227, 133, 82, 175,
-			157, 188, 105, 123, 134, 104, 185, 140, 98, 232, 191, 175, 228, 39,
-			8, 35, 122, 46, 163, 102, 12, 253, 15, 20, 117, 221, 124, 58,
-			72, 43, 82, 203, 117, 253, 67, 44, 116, 23, 188, 68, 62, 13,
-			24, 120, 216, 3, 150, 222, 84, 157, 148, 219, 34, 180, 103, 5,
-			86, 151, 69, 44, 16, 115, 61, 135, 57, 60, 253, 15, 148, 220,
-			184, 4, 85, 0, 39, 222, 151, 160, 6, 224, 74, 153, 236, 34,
-			79, 138, 161, 255, 74, 81, 31, 155, 247, 135, 247, 185, 14, 122,
-			60, 199, 102, 221, 158, 31, 241, 93, 93, 226, 162, 113, 32, 26,
-			83, 191, 199, 196, 17, 163, 36, 175, 32, 202, 220, 180, 4, 85,
-			0, 103, 62, 144, 160, 6, 224, 237, 95, 144, 117, 36, 175, 26,
-			250, 247, 138, 90, 54, 111, 39, 183, 39, 111, 191, 163, 228, 56,
-			97, 16, 223, 43, 185, 9, 9, 34, 210, 201, 5, 9, 106, 0,
-			46, 253, 140, 236, 35, 69, 205, 208, 127, 173, 168, 219, 102, 227,
-			88, 96, 120, 108, 196, 241, 216, 240, 64, 28, 239, 229, 38, 162,
-			152, 68, 46, 132, 136, 100, 136, 100, 72, 83, 128, 72, 110, 70,
-			130, 42, 128, 179, 69, 9, 34, 11, 31, 111, 145, 127, 173, 32,
-			71, 186, 161, 255, 19, 69, 125, 100, 254, 177, 146, 246, 229, 63,
-			192, 80, 192, 154, 126, 96, 167, 36, 114, 35, 140, 151, 168, 148,
-			101, 136, 91, 8, 88, 20, 182, 127, 148, 8, 124, 156, 112, 96,
-			88, 253, 158, 239, 209, 176, 143, 151, 163, 91, 125, 121, 181, 151,
-			196, 24, 227, 209, 233, 10, 48, 156, 155, 146, 160, 10, 224, 116,
-			65, 130, 26, 128, 107, 15, 227, 83, 244, 127, 112, 131, 92, 30,
-			62, 119, 142, 226, 219, 8, 167, 84, 130, 222, 37, 163, 113, 217,
-			247, 143, 190, 253, 218, 56, 185, 122, 116, 44, 198, 248, 147, 42,
-			72, 255, 205, 251, 188, 130, 180, 251, 238, 6, 236, 187, 10, 210,
-			119, 21, 164, 239, 42, 72, 223, 85, 144, 190, 171, 32, 253, 107,
-			83, 65, 122, 57, 89, 65, 122, 57, 85, 65, 122, 62, 93, 65,
-			122, 126, 168, 130, 84, 98, 196, 163, 49, 243, 82, 92, 65, 90,
-			76, 86, 144, 22, 83, 21, 164, 83, 233, 10, 210, 169, 161, 10,
-			210, 169, 100, 5, 105, 92, 147, 250, 126, 102, 57, 89, 65, 186,
-			156, 170, 32, 157, 79, 87, 144, 206, 15, 85, 144, 202, 154, 84,
-			109, 196, 208, 222, 191, 84, 138, 43, 72, 23, 146, 21, 164, 11,
-			169, 10, 82, 51, 93, 65, 106, 14, 85, 144, 154, 201, 10, 210,
-			184, 38, 245, 70, 60, 234, 44, 7, 146, 21, 164, 215, 210, 21,
-			164, 215, 134, 42, 72, 101, 77, 42, 236, 114, 111, 196, 53, 169,
-			133, 184, 38, 53, 199, 129, 100, 5, 233, 92, 186, 130, 116, 110,
-			168, 130, 84, 214, 164, 230, 70, 12, 173, 48, 79, 201, 63, 156,
-			224, 181, 11, 86, 166, 171, 152, 127, 119, 130, 150, 19, 87, 231,
-			82, 151, 106, 197, 7, 113, 60, 126, 129, 246, 132, 59, 174, 248,
-			252, 181, 239, 49, 172, 248, 148, 55, 94, 139, 169, 139, 81, 86,
-			248, 54, 247, 107, 201, 41, 119, 179, 28, 143, 238, 53, 214, 105,
-			165, 231, 55, 59, 226, 98, 22, 15, 82, 153, 7, 49, 0, 68,
-			42, 226, 56, 144, 64, 128, 229, 178, 94, 228, 52, 233, 131, 128,
-			181, 253, 192, 177, 60, 186, 46, 111, 225, 30, 118, 156, 102, 135,
-			178, 87, 17, 243, 196, 166, 97, 208, 72, 50, 78, 232, 190, 213,
-			124, 121, 104, 5, 54, 230, 103, 142, 152, 21, 80, 223, 59, 70,
-			210, 10, 195, 126, 23, 168, 90, 174, 75, 187, 142, 215, 143, 24,
-			70, 47, 244, 163, 21, 18, 15, 201, 245, 189, 118, 145, 58, 37,
-			86, 162, 46, 179, 122, 131, 161, 6, 140, 46, 132, 93, 102, 5,
-			204, 94, 160, 161, 207, 131, 34, 207, 79, 182, 34, 52, 178, 246,
-			121, 137, 173, 199, 152, 45, 78, 57, 49, 60, 236, 65, 188, 195,
-			111, 30, 199, 247, 115, 249, 178, 186, 178, 178, 178, 186, 132, 255,
-			53, 86, 86, 62, 193, 255, 190, 132, 81, 220, 185, 115, 231, 206,
-			210, 234, 173, 165, 181, 213, 198, 173, 181, 79, 110, 223, 249, 228,
-			246, 157, 210, 29, 249, 231, 203, 18, 161, 247, 143, 64, 224, 81,
-			224, 52, 35, 241, 5, 34, 100, 41, 0, 244, 69, 122, 200, 40,
-			243, 194, 126, 32, 179, 76, 12, 235, 104, 154, 190, 119, 192, 130,
-			8, 75, 20, 184, 86, 253, 46, 165, 181, 205, 117, 186, 182, 182,
-			118, 135, 202, 175, 29, 57, 94, 59, 44, 17, 90, 103, 140, 254,
-			29, 25, 151, 30, 30, 30, 150, 28, 22, 181, 74, 126, 208, 94,
-			14, 90, 77, 248, 11, 157, 74, 209, 171, 232, 183, 11, 111, 211,
-			106, 241, 148, 75, 199, 67, 151, 78, 119, 119, 234, 213, 103, 244,
-			5, 24, 77, 97, 241, 197, 241, 251, 165, 241, 230, 66, 148, 7,
-			15, 54, 27, 33, 139, 158, 11, 125, 21, 176, 251, 246, 222, 214,
-			214, 226, 226, 137, 237, 208, 108, 11, 43, 139, 119, 223, 234, 34,
-			172, 224, 169, 205, 34, 192, 226, 183, 108, 235, 40, 193, 27, 175,
-			17, 66, 2, 7, 150, 75, 163, 3, 65, 49, 213, 252, 122, 116,
-			80, 164, 200, 208, 221, 159, 58, 164, 131, 82, 116, 0, 208, 155,
-			70, 196, 27, 245, 67, 214, 164, 31, 224, 165, 209, 212, 8, 215,
-			78, 29, 225, 83, 199, 91, 187, 69, 95, 60, 96, 81, 253, 40,
-			140, 88, 23, 94, 151, 195, 77, 199, 101, 141, 180, 34, 54, 171,
-			91, 149, 70, 245, 113, 133, 182, 34, 193, 198, 105, 125, 174, 183,
-			34, 201, 233, 94, 117, 187, 241, 209, 135, 52, 114, 154, 47, 67,
-			122, 143, 22, 10, 5, 254, 100, 177, 21, 149, 236, 195, 135, 78,
-			187, 179, 97, 69, 216, 107, 145, 126, 250, 41, 93, 187, 181, 72,
-			191, 165, 248, 110, 203, 63, 148, 175, 164, 220, 150, 151, 105, 25,
-			248, 181, 253, 195, 16, 81, 194, 100, 90, 93, 89, 73, 184, 162,
-			176, 20, 55, 96, 232, 130, 86, 63, 58, 62, 203, 98, 108, 208,
-			125, 245, 163, 15, 63, 252, 240, 227, 181, 143, 86, 86, 226, 41,
-			191, 207, 90, 126, 192, 232, 158, 231, 188, 146, 88, 238, 124, 188,
-			50, 140, 165, 244, 211, 148, 89, 224, 227, 167, 133, 2, 23, 202,
-			114, 124, 195, 119, 145, 46, 37, 217, 249, 1, 11, 6, 60, 32,
-			46, 137, 231, 253, 4, 30, 52, 128, 197, 148, 1, 124, 120, 170,
-			1, 60, 178, 14, 44, 250, 130, 43, 178, 36, 18, 51, 208, 228,
-			177, 227, 186, 78, 152, 48, 0, 240, 144, 180, 139, 79, 233, 61,
-			122, 122, 135, 55, 152, 57, 189, 151, 248, 96, 129, 199, 14, 239,
-			247, 29, 215, 102, 65, 97, 17, 6, 86, 23, 18, 18, 36, 184,
-			96, 22, 229, 141, 2, 74, 161, 205, 54, 31, 187, 227, 69, 48,
-			114, 209, 146, 15, 93, 12, 27, 37, 176, 88, 218, 7, 204, 200,
-			203, 64, 6, 183, 79, 149, 129, 204, 71, 137, 117, 147, 238, 30,
-			69, 29, 190, 131, 129, 63, 158, 127, 72, 239, 241, 197, 140, 59,
-			39, 121, 241, 65, 94, 80, 119, 188, 168, 224, 249, 135, 226, 185,
-			188, 131, 14, 79, 225, 49, 93, 146, 77, 57, 139, 31, 124, 112,
-			103, 113, 72, 175, 73, 185, 20, 68, 227, 123, 226, 255, 69, 142,
-			240, 30, 254, 187, 72, 18, 183, 191, 173, 252, 36, 63, 51, 206,
-			240, 179, 171, 105, 243, 215, 10, 173, 13, 2, 130, 196, 29, 112,
-			88, 147, 113, 112, 252, 11, 122, 3, 211, 38, 39, 219, 118, 250,
-			110, 49, 254, 115, 202, 122, 69, 78, 90, 176, 190, 60, 229, 190,
-			120, 75, 181, 166, 18, 245, 137, 173, 212, 125, 241, 86, 234, 190,
-			120, 203, 152, 34, 255, 85, 145, 5, 138, 174, 106, 152, 127, 169,
-			208, 109, 223, 91, 138, 47, 99, 255, 184, 91, 227, 37, 186, 45,
-			58, 202, 53, 95, 126, 122, 3, 183, 222, 3, 100, 152, 32, 224,
-			95, 193, 235, 88, 7, 12, 207, 115, 98, 154, 92, 183, 188, 163,
-			44, 83, 197, 175, 146, 180, 252, 0, 226, 16, 25, 124, 13, 9,
-			112, 69, 44, 236, 131, 11, 218, 39, 95, 200, 118, 213, 86, 242,
-			66, 182, 155, 186, 144, 237, 166, 46, 100, 187, 137, 11, 217, 255,
-			120, 250, 55, 244, 161, 208, 200, 10, 95, 134, 67, 215, 89, 126,
-			232, 242, 201, 15, 37, 1, 23, 126, 165, 146, 51, 34, 1, 218,
-			176, 194, 151, 63, 162, 96, 126, 130, 104, 47, 217, 145, 168, 215,
-			135, 159, 198, 167, 228, 108, 200, 162, 200, 101, 207, 109, 230, 90,
-			252, 178, 192, 27, 175, 24, 156, 225, 205, 55, 160, 181, 113, 159,
-			140, 55, 125, 112, 5, 17, 123, 222, 99, 129, 227, 243, 47, 141,
-			190, 17, 193, 152, 236, 177, 139, 29, 140, 159, 145, 115, 182, 19,
-			246, 172, 168, 217, 97, 246, 115, 43, 154, 203, 33, 6, 243, 24,
-			134, 120, 66, 215, 206, 14, 58, 148, 163, 71, 255, 121, 130, 127,
-			205, 116, 245, 111, 84, 217, 245, 141, 65, 217, 117, 129, 216, 124,
-			191, 114, 46, 179, 170, 152, 207, 104, 66, 247, 252, 68, 6, 172,
-			140, 254, 78, 159, 245, 25, 255, 41, 43, 122, 6, 31, 34, 77,
-			20, 171, 240, 185, 237, 120, 109, 151, 145, 227, 101, 103, 224, 55,
-			206, 229, 167, 6, 223, 60, 29, 87, 103, 204, 143, 143, 85, 50,
-			11, 3, 147, 9, 205, 184, 156, 18, 248, 217, 199, 129, 70, 126,
-			202, 81, 141, 171, 231, 102, 18, 142, 106, 60, 85, 72, 61, 46,
-			78, 247, 185, 163, 26, 159, 154, 198, 147, 77, 244, 83, 147, 234,
-			132, 56, 0, 235, 88, 97, 231, 148, 99, 125, 95, 30, 204, 165,
-			230, 254, 164, 58, 62, 147, 152, 251, 147, 169, 18, 234, 73, 113,
-			158, 207, 231, 254, 228, 216, 184, 116, 252, 170, 161, 205, 168, 19,
-			191, 249, 98, 161, 225, 79, 208, 254, 228, 98, 33, 94, 150, 61,
-			163, 78, 78, 36, 202, 178, 103, 82, 95, 14, 157, 201, 159, 73,
-			148, 101, 207, 140, 141, 147, 95, 169, 178, 46, 251, 162, 90, 52,
-			255, 175, 66, 55, 124, 96, 245, 208, 10, 35, 198, 215, 49, 62,
-			82, 89, 89, 154, 224, 182, 239, 69, 142, 75, 29, 228, 209, 138,
-			96, 151, 22, 10, 149, 251, 174, 29, 31, 237, 59, 252, 171, 70,
-			174, 197, 79, 230, 122, 1, 59, 192, 109, 242, 1, 11, 220, 163,
-			37, 171, 221, 14, 88, 8, 222, 57, 150, 40, 223, 181, 33, 7,
-			72, 212, 233, 50, 66, 163, 224, 200, 129, 93, 99, 212, 97, 30,
-			109, 89, 142, 139, 208, 62, 107, 246, 173, 240, 120, 97, 32, 30,
-			25, 200, 15, 172, 242, 90, 129, 166, 239, 186, 140, 127, 207, 201,
-			241, 176, 194, 32, 98, 65, 151, 217, 14, 223, 143, 249, 129, 213,
-			102, 169, 234, 241, 139, 234, 140, 148, 163, 150, 3, 233, 152, 137,
-			234, 241, 139, 243, 55, 18, 213, 227, 23, 63, 184, 73, 254, 74,
-			149, 31, 69, 189, 166, 46, 155, 127, 193, 191, 197, 107, 117, 229,
-			119, 180, 184, 36, 91, 145, 240, 13, 56, 25, 15, 173, 144, 54,
-			3, 198, 63, 107, 5, 211, 37, 49, 4, 233, 10, 61, 22, 134,
-			36, 117, 11, 129, 127, 160, 75, 156, 50, 209, 102, 224, 96, 130,
-			59, 254, 218, 44, 96, 190, 17, 98, 197, 28, 87, 4, 82, 180,
-			60, 84, 12, 225, 235, 105, 49, 133, 158, 99, 247, 124, 92, 67,
-			153, 215, 242, 131, 38, 19, 234, 75, 161, 139, 191, 228, 235, 54,
-			251, 252, 91, 92, 251, 71, 241, 119, 178, 64, 196, 78, 20, 162,
-			17, 48, 202, 94, 245, 28, 121, 204, 134, 234, 43, 224, 243, 216,
-			79, 47, 114, 21, 67, 7, 230, 161, 123, 178, 19, 17, 90, 65,
-			60, 27, 52, 79, 125, 64, 246, 154, 122, 177, 40, 132, 175, 231,
-			64, 220, 82, 49, 186, 98, 104, 215, 230, 63, 32, 131, 15, 200,
-			94, 91, 42, 145, 127, 169, 163, 98, 178, 134, 182, 162, 150, 204,
-			127, 164, 243, 97, 129, 58, 14, 193, 150, 208, 94, 229, 233, 125,
-			172, 150, 193, 50, 18, 219, 49, 15, 88, 67, 234, 247, 120, 49,
-			117, 17, 231, 162, 212, 204, 190, 5, 170, 241, 143, 213, 150, 220,
-			8, 105, 211, 245, 155, 47, 75, 162, 40, 134, 208, 215, 44, 240,
-			139, 137, 79, 142, 157, 124, 164, 24, 223, 71, 78, 77, 254, 193,
-			201, 228, 224, 192, 124, 255, 136, 90, 97, 200, 2, 62, 89, 192,
-			140, 170, 155, 124, 84, 146, 227, 166, 235, 243, 27, 206, 41, 18,
-			55, 66, 234, 250, 77, 64, 134, 12, 34, 158, 193, 81, 67, 212,
-			9, 88, 216, 241, 93, 27, 89, 197, 81, 246, 250, 94, 148, 94,
-			48, 64, 92, 32, 159, 251, 172, 105, 245, 195, 196, 11, 39, 41,
-			65, 192, 220, 232, 119, 247, 93, 225, 217, 172, 102, 212, 183, 92,
-			158, 50, 2, 158, 97, 217, 57, 225, 200, 21, 101, 221, 138, 152,
-			135, 134, 65, 49, 8, 97, 32, 15, 116, 161, 226, 180, 44, 225,
-			37, 19, 4, 33, 84, 222, 237, 239, 47, 215, 251, 251, 114, 135,
-			137, 199, 68, 86, 100, 193, 76, 103, 131, 239, 19, 243, 35, 233,
-			129, 3, 205, 234, 134, 190, 162, 94, 91, 22, 54, 148, 205, 129,
-			213, 200, 187, 60, 89, 197, 208, 86, 46, 46, 74, 72, 51, 180,
-			149, 226, 146, 140, 12, 255, 95, 0, 0, 0, 255, 255, 234, 190,
-			171, 151, 67, 94, 0, 0},
+			8, 0, 0, 9, 110, 136, 0, 255, 236, 125, 91, 108, 28, 73,
+			146, 88, 87, 86, 245, 131, 73, 137, 143, 228, 67, 84, 81, 163,
+			73, 81, 26, 177, 57, 106, 54, 73, 113, 52, 115, 26, 141, 230,
+			166, 69, 54, 165, 214, 82, 36, 167, 187, 41, 105, 198, 115, 43,
+			21, 187, 179, 187, 107, 166, 186, 170, 175, 170, 154, 20, 117, 115,
+			88, 47, 110, 113, 54, 176, 183, 24, 31, 188, 88, 239, 249, 108,
+			220, 218, 103, 44, 110, 15, 56, 3, 247, 225, 199, 143, 1, 223,
+			191, 141, 251, 187, 191, 251, 177, 13, 248, 211, 6, 12, 3, 6,
+			12, 24, 70, 68, 101, 86, 119, 243, 161, 199, 120, 126, 124, 39,
+			97, 165, 233, 168, 202, 140, 136, 140, 136, 138, 204, 140, 140, 200,
+			165, 127, 52, 76, 119, 154, 118, 216, 234, 238, 229, 107, 94, 123,
+			201, 233, 214, 108, 252, 103, 177, 233, 45, 57, 94, 179, 238, 53,
+			151, 172, 142, 189, 36, 220, 122, 199, 179, 221, 48, 88, 170, 121,
+			158, 95, 183, 93, 43, 244, 252, 165, 64, 248, 251, 118, 77, 4,
+			75, 251, 43, 75, 65, 104, 133, 34, 223, 241, 189, 208, 99, 169,
+			168, 231, 220, 159, 104, 116, 100, 211, 107, 86, 66, 95, 88, 237,
+			10, 52, 96, 151, 233, 89, 108, 243, 100, 95, 248, 129, 237, 185,
+			51, 26, 215, 178, 67, 229, 51, 248, 240, 97, 244, 140, 77, 211,
+			84, 32, 106, 190, 8, 103, 8, 215, 178, 103, 202, 18, 98, 239,
+			208, 145, 80, 248, 109, 219, 181, 156, 39, 182, 91, 23, 207, 102,
+			116, 174, 101, 245, 242, 89, 245, 180, 4, 15, 153, 73, 51, 150,
+			95, 107, 217, 251, 162, 62, 99, 112, 45, 155, 41, 199, 48, 160,
+			238, 116, 253, 166, 168, 207, 36, 241, 141, 132, 238, 127, 51, 68,
+			83, 204, 48, 18, 111, 105, 244, 95, 105, 84, 59, 195, 116, 35,
+			193, 174, 255, 169, 198, 215, 188, 206, 161, 111, 55, 91, 33, 191,
+			190, 188, 242, 62, 175, 182, 4, 223, 220, 93, 43, 241, 66, 55,
+			108, 121, 126, 144, 231, 5, 199, 225, 216, 32, 224, 190, 0, 137,
+			136, 122, 158, 242, 221, 64, 112, 175, 193, 195, 150, 29, 240, 192,
+			235, 250, 53, 193, 107, 94, 93, 112, 59, 224, 77, 111, 95, 248,
+			174, 168, 243, 174, 91, 23, 62, 15, 91, 130, 23, 58, 86, 13,
+			16, 219, 53, 225, 6, 34, 199, 165, 32, 248, 245, 252, 50, 229,
+			97, 203, 10, 121, 205, 114, 249, 158, 224, 13, 175, 235, 214, 185,
+			237, 98, 175, 205, 210, 90, 113, 171, 82, 228, 13, 219, 17, 121,
+			74, 51, 84, 35, 76, 79, 101, 70, 104, 149, 18, 35, 193, 140,
+			161, 196, 91, 154, 121, 143, 15, 106, 0, 56, 128, 206, 142, 215,
+			228, 1, 62, 230, 168, 58, 190, 111, 57, 93, 96, 178, 221, 238,
+			186, 118, 205, 10, 69, 157, 135, 30, 87, 42, 206, 83, 74, 169,
+			110, 36, 52, 166, 15, 101, 166, 233, 58, 53, 140, 4, 73, 48,
+			125, 152, 204, 154, 31, 240, 157, 62, 237, 41, 2, 168, 209, 189,
+			110, 131, 75, 77, 243, 134, 231, 75, 121, 32, 217, 60, 165, 103,
+			105, 18, 176, 24, 204, 24, 38, 67, 51, 244, 76, 4, 38, 1,
+			107, 70, 65, 26, 211, 135, 135, 166, 21, 164, 51, 125, 248, 188,
+			73, 255, 41, 65, 250, 26, 211, 39, 200, 132, 249, 13, 225, 213,
+			129, 17, 205, 7, 60, 178, 152, 60, 165, 124, 203, 11, 69, 36,
+			67, 224, 42, 122, 14, 60, 250, 34, 236, 130, 26, 46, 241, 42,
+			40, 201, 14, 184, 247, 149, 117, 152, 227, 129, 237, 214, 160, 131,
+			29, 112, 101, 245, 208, 220, 115, 157, 67, 202, 173, 90, 77, 4,
+			129, 189, 231, 8, 16, 78, 232, 119, 3, 144, 83, 44, 36, 94,
+			237, 145, 144, 26, 171, 129, 102, 235, 124, 239, 48, 110, 197, 67,
+			143, 130, 180, 237, 58, 136, 93, 234, 192, 118, 27, 158, 223, 182,
+			66, 16, 212, 129, 29, 182, 188, 110, 200, 93, 33, 234, 182, 219,
+			4, 74, 29, 252, 111, 75, 240, 181, 222, 231, 199, 109, 151, 242,
+			61, 17, 30, 8, 225, 114, 97, 213, 90, 188, 219, 1, 148, 177,
+			96, 53, 131, 25, 19, 100, 120, 86, 10, 79, 75, 130, 184, 210,
+			10, 2, 225, 101, 70, 20, 164, 51, 125, 98, 156, 209, 125, 148,
+			43, 97, 250, 57, 50, 107, 218, 209, 112, 20, 131, 117, 241, 44,
+			50, 233, 35, 162, 86, 31, 31, 111, 139, 32, 176, 154, 34, 207,
+			75, 81, 171, 200, 162, 236, 128, 47, 174, 228, 104, 220, 15, 13,
+			192, 118, 28, 137, 192, 118, 155, 49, 195, 196, 96, 198, 57, 50,
+			49, 33, 153, 34, 73, 224, 67, 49, 76, 52, 166, 159, 203, 40,
+			75, 32, 58, 211, 207, 157, 55, 233, 42, 50, 172, 51, 221, 36,
+			147, 230, 85, 94, 58, 202, 31, 111, 89, 1, 223, 3, 9, 41,
+			23, 16, 83, 211, 13, 102, 152, 228, 156, 18, 143, 158, 4, 36,
+			41, 5, 105, 76, 55, 211, 163, 10, 2, 2, 108, 130, 174, 32,
+			53, 131, 233, 23, 8, 51, 175, 188, 136, 90, 228, 86, 98, 90,
+			134, 193, 140, 11, 196, 156, 148, 248, 140, 36, 160, 80, 180, 12,
+			141, 233, 23, 210, 103, 21, 164, 51, 253, 194, 216, 248, 94, 10,
+			63, 160, 85, 250, 63, 46, 209, 139, 77, 207, 107, 58, 98, 73,
+			125, 82, 75, 245, 174, 143, 166, 34, 253, 237, 104, 244, 62, 175,
+			222, 207, 125, 72, 51, 235, 178, 9, 155, 161, 233, 64, 212, 60,
+			183, 30, 160, 175, 213, 203, 10, 100, 147, 52, 233, 90, 174, 23,
+			160, 151, 77, 150, 35, 224, 206, 14, 157, 168, 121, 237, 252, 17,
+			148, 119, 206, 42, 132, 248, 173, 239, 104, 191, 208, 180, 127, 78,
+			244, 187, 59, 119, 254, 148, 92, 188, 27, 181, 221, 145, 109, 243,
+			143, 132, 227, 124, 207, 245, 14, 220, 234, 97, 71, 4, 247, 127,
+			197, 193, 183, 94, 76, 60, 209, 232, 127, 56, 131, 190, 245, 98,
+			130, 93, 255, 139, 51, 145, 219, 168, 121, 14, 191, 211, 109, 52,
+			132, 31, 240, 69, 30, 161, 154, 15, 120, 221, 10, 45, 110, 187,
+			161, 240, 107, 45, 203, 109, 130, 235, 131, 239, 131, 14, 56, 228,
+			229, 95, 147, 29, 120, 201, 173, 229, 249, 41, 190, 184, 21, 134,
+			157, 224, 195, 165, 165, 186, 216, 23, 142, 215, 17, 126, 160, 70,
+			7, 83, 94, 71, 50, 177, 184, 23, 49, 177, 68, 41, 47, 139,
+			186, 29, 132, 190, 189, 215, 133, 17, 115, 203, 173, 243, 110, 32,
+			192, 239, 74, 95, 14, 79, 246, 108, 215, 242, 15, 145, 175, 32,
+			135, 31, 45, 247, 124, 245, 241, 82, 222, 246, 234, 118, 195, 174,
+			161, 18, 114, 220, 242, 5, 239, 192, 135, 18, 130, 195, 232, 248,
+			222, 190, 93, 23, 245, 158, 95, 106, 120, 142, 227, 29, 192, 87,
+			14, 170, 178, 161, 83, 0, 157, 40, 111, 139, 240, 67, 74, 57,
+			252, 121, 247, 8, 99, 1, 124, 143, 253, 179, 75, 187, 27, 132,
+			220, 23, 161, 37, 103, 8, 107, 207, 219, 7, 159, 46, 37, 70,
+			185, 235, 133, 118, 77, 228, 34, 247, 230, 216, 65, 8, 24, 250,
+			41, 186, 245, 35, 236, 212, 237, 160, 230, 88, 118, 91, 248, 249,
+			211, 152, 176, 221, 126, 89, 40, 38, 58, 190, 87, 239, 214, 68,
+			143, 15, 218, 99, 228, 255, 137, 15, 170, 230, 191, 186, 87, 235,
+			182, 133, 27, 90, 74, 73, 75, 158, 207, 189, 176, 37, 124, 222,
+			182, 66, 225, 219, 150, 19, 244, 68, 13, 138, 1, 156, 148, 247,
+			115, 31, 15, 106, 75, 216, 216, 19, 16, 187, 86, 27, 103, 239,
+			126, 219, 114, 189, 222, 59, 148, 187, 29, 6, 48, 34, 55, 66,
+			229, 249, 1, 111, 91, 135, 48, 71, 119, 3, 80, 172, 7, 179,
+			135, 231, 195, 50, 192, 7, 38, 218, 48, 13, 69, 50, 9, 3,
+			94, 23, 62, 248, 34, 222, 240, 189, 54, 85, 171, 132, 70, 120,
+			0, 102, 162, 220, 127, 208, 17, 53, 176, 32, 222, 241, 109, 48,
+			44, 223, 14, 67, 225, 70, 86, 20, 192, 58, 9, 102, 183, 234,
+			189, 82, 133, 87, 182, 55, 170, 143, 10, 229, 34, 47, 85, 248,
+			78, 121, 251, 97, 105, 189, 184, 206, 239, 124, 198, 171, 247, 138,
+			124, 109, 123, 231, 179, 114, 233, 238, 189, 42, 191, 183, 189, 185,
+			94, 44, 87, 120, 97, 107, 157, 175, 109, 111, 85, 203, 165, 59,
+			187, 213, 237, 114, 133, 242, 185, 66, 133, 151, 42, 115, 248, 166,
+			176, 245, 25, 47, 62, 222, 41, 23, 43, 21, 190, 93, 230, 165,
+			7, 59, 155, 165, 226, 58, 127, 84, 40, 151, 11, 91, 213, 82,
+			177, 146, 227, 165, 173, 181, 205, 221, 245, 210, 214, 221, 28, 191,
+			179, 91, 229, 91, 219, 85, 202, 55, 75, 15, 74, 213, 226, 58,
+			175, 110, 231, 144, 236, 241, 126, 124, 123, 131, 63, 40, 150, 215,
+			238, 21, 182, 170, 133, 59, 165, 205, 82, 245, 51, 36, 184, 81,
+			170, 110, 1, 177, 141, 237, 50, 229, 5, 190, 83, 40, 87, 75,
+			107, 187, 155, 133, 50, 223, 217, 45, 239, 108, 87, 138, 28, 70,
+			182, 94, 170, 172, 109, 22, 74, 15, 138, 235, 121, 94, 218, 226,
+			91, 219, 188, 248, 176, 184, 85, 229, 149, 123, 133, 205, 205, 193,
+			129, 82, 190, 253, 104, 171, 88, 6, 238, 251, 135, 201, 239, 20,
+			249, 102, 169, 112, 103, 179, 200, 55, 182, 203, 56, 206, 245, 82,
+			185, 184, 86, 133, 1, 245, 126, 173, 149, 214, 139, 91, 213, 194,
+			102, 142, 242, 202, 78, 113, 173, 84, 216, 204, 241, 226, 227, 226,
+			131, 157, 205, 66, 249, 179, 156, 68, 90, 41, 126, 186, 91, 220,
+			170, 150, 10, 155, 124, 189, 240, 160, 112, 183, 88, 225, 217, 151,
+			73, 101, 167, 188, 189, 182, 91, 46, 62, 0, 174, 183, 55, 120,
+			101, 247, 78, 165, 90, 170, 238, 86, 139, 252, 238, 246, 246, 58,
+			10, 187, 82, 44, 63, 44, 173, 21, 43, 183, 248, 230, 54, 136,
+			127, 131, 239, 86, 138, 57, 202, 215, 11, 213, 2, 146, 222, 41,
+			111, 111, 148, 170, 149, 91, 240, 251, 206, 110, 165, 132, 130, 43,
+			109, 85, 139, 229, 242, 238, 78, 181, 180, 189, 181, 192, 239, 109,
+			63, 42, 62, 44, 150, 249, 90, 97, 183, 82, 92, 71, 9, 111,
+			111, 193, 104, 193, 86, 138, 219, 229, 207, 0, 45, 200, 1, 53,
+			144, 227, 143, 238, 21, 171, 247, 138, 101, 16, 42, 74, 171, 0,
+			98, 168, 84, 203, 165, 181, 106, 127, 179, 237, 50, 175, 110, 151,
+			171, 180, 111, 156, 124, 171, 120, 119, 179, 116, 183, 184, 181, 86,
+			4, 126, 182, 1, 205, 163, 82, 165, 184, 192, 11, 229, 82, 5,
+			26, 148, 144, 48, 127, 84, 248, 140, 111, 239, 226, 168, 65, 81,
+			187, 149, 34, 141, 126, 247, 153, 110, 14, 245, 201, 75, 27, 188,
+			176, 254, 176, 4, 156, 203, 214, 59, 219, 149, 74, 73, 154, 11,
+			138, 109, 237, 158, 148, 121, 188, 228, 229, 153, 115, 240, 43, 195,
+			244, 185, 196, 45, 58, 76, 141, 204, 127, 77, 39, 34, 224, 12,
+			77, 2, 64, 152, 62, 151, 62, 71, 207, 210, 20, 66, 240, 50,
+			125, 142, 142, 208, 116, 4, 106, 17, 44, 27, 167, 153, 62, 103,
+			126, 40, 49, 94, 78, 228, 36, 70, 45, 2, 162, 70, 176, 210,
+			190, 156, 158, 144, 24, 53, 88, 9, 3, 24, 97, 212, 16, 35,
+			192, 178, 113, 154, 233, 151, 167, 175, 73, 140, 87, 18, 121, 137,
+			145, 68, 64, 212, 136, 0, 148, 158, 149, 24, 9, 96, 188, 146,
+			158, 149, 24, 9, 98, 4, 88, 54, 78, 51, 253, 202, 197, 69,
+			137, 241, 157, 196, 156, 196, 168, 71, 64, 212, 72, 39, 76, 127,
+			39, 109, 74, 140, 58, 96, 4, 48, 194, 168, 35, 70, 128, 101,
+			99, 232, 250, 214, 37, 137, 241, 106, 60, 106, 131, 233, 87, 227,
+			81, 27, 132, 233, 87, 211, 87, 36, 70, 3, 48, 2, 24, 97,
+			52, 16, 35, 192, 178, 177, 206, 244, 171, 243, 106, 212, 243, 137,
+			75, 18, 99, 50, 2, 162, 70, 73, 194, 244, 249, 244, 140, 196,
+			152, 4, 140, 0, 70, 24, 147, 136, 17, 96, 217, 56, 205, 244,
+			249, 89, 78, 255, 51, 141, 246, 55, 229, 196, 19, 205, 252, 43,
+			112, 26, 106, 109, 130, 211, 142, 8, 132, 27, 6, 220, 226, 129,
+			221, 116, 69, 61, 199, 27, 246, 51, 81, 95, 116, 132, 219, 12,
+			91, 60, 232, 88, 46, 184, 239, 208, 110, 139, 94, 115, 81, 167,
+			220, 130, 62, 53, 175, 235, 226, 164, 40, 23, 73, 56, 19, 53,
+			124, 171, 214, 155, 111, 213, 139, 144, 227, 130, 9, 65, 10, 123,
+			63, 207, 137, 166, 20, 94, 194, 29, 3, 172, 152, 59, 194, 173,
+			139, 8, 161, 229, 30, 242, 154, 229, 8, 183, 110, 249, 136, 181,
+			230, 185, 53, 209, 9, 97, 254, 251, 74, 240, 185, 186, 117, 56,
+			71, 97, 178, 152, 107, 123, 110, 216, 154, 83, 104, 124, 225, 168,
+			205, 88, 213, 110, 139, 32, 180, 218, 157, 104, 6, 148, 75, 135,
+			186, 13, 235, 22, 1, 187, 22, 185, 27, 160, 60, 60, 232, 111,
+			141, 11, 113, 220, 226, 88, 61, 81, 1, 11, 118, 188, 77, 177,
+			234, 48, 71, 122, 62, 15, 186, 123, 33, 12, 23, 36, 2, 179,
+			19, 183, 122, 136, 242, 188, 140, 75, 49, 64, 212, 233, 248, 222,
+			51, 27, 230, 89, 231, 144, 95, 91, 92, 89, 206, 45, 47, 47,
+			243, 67, 97, 249, 1, 204, 75, 197, 103, 86, 187, 227, 8, 190,
+			242, 33, 95, 243, 218, 157, 110, 40, 122, 148, 17, 237, 0, 135,
+			32, 44, 222, 9, 68, 183, 238, 225, 66, 38, 47, 23, 60, 113,
+			3, 216, 163, 250, 33, 191, 205, 243, 249, 252, 173, 163, 239, 132,
+			91, 31, 120, 19, 19, 82, 203, 100, 245, 54, 234, 24, 47, 158,
+			149, 38, 111, 195, 84, 29, 67, 139, 17, 45, 5, 223, 58, 210,
+			9, 117, 46, 187, 68, 191, 85, 7, 132, 20, 17, 187, 193, 179,
+			199, 8, 125, 196, 151, 249, 213, 171, 71, 113, 125, 204, 151, 23,
+			248, 111, 69, 221, 78, 224, 238, 218, 109, 190, 114, 235, 216, 91,
+			73, 250, 54, 95, 89, 86, 127, 100, 163, 223, 230, 194, 9, 196,
+			0, 3, 65, 140, 236, 227, 19, 57, 248, 232, 197, 28, 44, 190,
+			128, 131, 107, 39, 113, 208, 167, 255, 235, 61, 253, 247, 20, 134,
+			6, 208, 3, 175, 245, 76, 227, 245, 205, 224, 84, 101, 159, 110,
+			36, 81, 199, 126, 157, 223, 30, 212, 57, 191, 214, 27, 166, 124,
+			36, 241, 245, 180, 174, 186, 72, 49, 244, 58, 28, 51, 131, 94,
+			159, 65, 57, 15, 24, 93, 191, 136, 123, 29, 174, 189, 88, 191,
+			189, 134, 31, 247, 55, 60, 133, 198, 181, 147, 105, 44, 158, 68,
+			131, 246, 194, 61, 229, 204, 24, 237, 168, 112, 207, 67, 50, 105,
+			214, 120, 5, 29, 107, 236, 9, 101, 80, 160, 223, 179, 230, 249,
+			3, 216, 5, 64, 196, 10, 116, 189, 184, 186, 114, 35, 119, 227,
+			131, 247, 193, 71, 192, 95, 10, 222, 236, 218, 145, 135, 220, 118,
+			107, 78, 55, 176, 247, 123, 17, 140, 132, 193, 140, 135, 164, 204,
+			228, 214, 56, 145, 4, 14, 84, 64, 0, 38, 135, 135, 25, 181,
+			69, 79, 232, 76, 127, 200, 38, 232, 239, 232, 42, 52, 244, 125,
+			194, 204, 255, 73, 20, 179, 3, 62, 220, 146, 188, 15, 58, 241,
+			62, 31, 174, 2, 29, 48, 38, 218, 27, 148, 50, 182, 128, 59,
+			34, 128, 48, 23, 140, 216, 21, 49, 54, 127, 96, 74, 193, 189,
+			32, 183, 248, 50, 229, 79, 165, 172, 158, 242, 134, 45, 156, 58,
+			250, 127, 139, 119, 188, 192, 14, 237, 125, 220, 34, 184, 162, 105,
+			225, 239, 167, 200, 144, 108, 152, 231, 27, 158, 31, 219, 86, 128,
+			172, 244, 17, 244, 124, 222, 246, 124, 145, 227, 22, 119, 61, 119,
+			241, 185, 240, 61, 25, 117, 105, 200, 237, 202, 0, 182, 104, 107,
+			182, 39, 104, 60, 60, 216, 232, 192, 52, 9, 147, 31, 54, 31,
+			228, 243, 168, 26, 111, 222, 188, 153, 147, 127, 35, 21, 246, 61,
+			56, 65, 125, 16, 128, 250, 62, 121, 168, 162, 30, 16, 128, 250,
+			126, 172, 62, 77, 99, 250, 247, 51, 42, 234, 161, 233, 76, 255,
+			126, 95, 212, 227, 223, 77, 209, 217, 163, 81, 15, 209, 238, 132,
+			135, 167, 133, 60, 210, 52, 89, 132, 247, 167, 197, 47, 40, 190,
+			237, 5, 47, 254, 215, 107, 196, 47, 38, 163, 248, 197, 234, 216,
+			155, 248, 197, 155, 248, 197, 155, 248, 197, 155, 248, 197, 155, 248,
+			197, 155, 248, 197, 223, 222, 248, 197, 53, 137, 17, 34, 22, 137,
+			107, 223, 62, 126, 241, 238, 223, 210, 248, 69, 52, 234, 108, 226,
+			109, 137, 49, 21, 1, 17, 198, 20, 97, 122, 54, 214, 117, 10,
+			228, 152, 141, 117, 157, 66, 140, 217, 88, 215, 41, 157, 233, 89,
+			243, 34, 253, 223, 4, 35, 34, 250, 106, 98, 204, 252, 111, 132,
+			23, 120, 83, 184, 194, 183, 107, 28, 151, 76, 234, 96, 46, 154,
+			173, 15, 189, 46, 110, 250, 125, 177, 8, 103, 26, 161, 199, 173,
+			125, 207, 174, 243, 186, 104, 216, 46, 76, 224, 245, 110, 199, 137,
+			14, 128, 233, 96, 127, 156, 41, 15, 189, 174, 207, 11, 59, 37,
+			56, 249, 230, 225, 97, 199, 174, 89, 14, 23, 114, 183, 7, 71,
+			191, 30, 76, 32, 220, 14, 213, 146, 210, 23, 191, 217, 21, 65,
+			136, 161, 141, 8, 14, 58, 158, 11, 148, 15, 59, 56, 75, 89,
+			46, 224, 131, 115, 142, 150, 39, 23, 188, 182, 27, 132, 150, 91,
+			19, 106, 225, 32, 143, 78, 249, 134, 231, 245, 246, 54, 126, 167,
+			198, 239, 88, 126, 246, 200, 74, 47, 143, 11, 189, 5, 121, 190,
+			27, 240, 83, 222, 247, 118, 58, 120, 202, 121, 191, 178, 189, 213,
+			91, 187, 203, 56, 133, 231, 243, 167, 136, 237, 41, 196, 78, 34,
+			89, 96, 67, 111, 239, 75, 81, 11, 249, 211, 223, 250, 237, 167,
+			125, 7, 227, 171, 153, 179, 241, 250, 245, 191, 127, 143, 150, 191,
+			155, 188, 138, 232, 247, 96, 102, 133, 249, 157, 231, 108, 152, 47,
+			57, 99, 52, 95, 180, 26, 159, 251, 247, 26, 29, 191, 43, 194,
+			53, 207, 109, 216, 205, 178, 84, 48, 203, 81, 86, 195, 39, 79,
+			36, 209, 39, 93, 223, 145, 9, 31, 99, 209, 155, 74, 244, 98,
+			215, 119, 216, 91, 148, 198, 173, 163, 196, 143, 161, 242, 144, 106,
+			21, 178, 60, 157, 80, 88, 100, 179, 142, 21, 182, 48, 1, 100,
+			168, 60, 46, 95, 69, 12, 236, 88, 97, 11, 218, 199, 232, 240,
+			221, 147, 150, 23, 132, 152, 15, 50, 84, 30, 31, 160, 126, 207,
+			11, 194, 185, 95, 105, 116, 170, 44, 154, 118, 16, 10, 63, 74,
+			88, 41, 71, 134, 11, 7, 168, 29, 223, 3, 133, 75, 222, 21,
+			120, 106, 158, 202, 177, 36, 23, 253, 132, 36, 23, 70, 141, 186,
+			8, 106, 200, 209, 153, 50, 254, 62, 33, 193, 37, 121, 66, 130,
+			203, 220, 67, 58, 125, 148, 85, 41, 242, 17, 74, 236, 186, 100,
+			147, 216, 117, 150, 163, 73, 84, 50, 50, 56, 124, 125, 58, 31,
+			217, 79, 126, 48, 39, 164, 28, 53, 154, 251, 148, 142, 111, 122,
+			86, 253, 85, 135, 31, 17, 35, 49, 49, 53, 34, 24, 109, 38,
+			26, 209, 220, 47, 53, 202, 250, 113, 198, 166, 33, 249, 210, 94,
+			129, 175, 24, 49, 233, 19, 213, 53, 170, 91, 77, 129, 250, 31,
+			190, 126, 254, 232, 118, 47, 175, 118, 234, 101, 104, 197, 46, 209,
+			51, 209, 241, 191, 229, 60, 249, 74, 28, 74, 153, 15, 171, 103,
+			223, 19, 135, 115, 63, 212, 232, 116, 53, 146, 114, 40, 190, 173,
+			4, 122, 6, 161, 191, 36, 113, 201, 56, 33, 113, 105, 238, 15,
+			116, 58, 89, 64, 158, 190, 53, 7, 87, 233, 168, 227, 53, 159,
+			8, 55, 244, 15, 159, 96, 48, 91, 229, 72, 57, 94, 179, 8,
+			79, 215, 224, 225, 43, 114, 4, 41, 2, 194, 247, 61, 31, 179,
+			165, 134, 202, 17, 0, 159, 106, 148, 239, 128, 31, 52, 197, 87,
+			67, 209, 19, 248, 146, 223, 166, 195, 242, 117, 96, 63, 23, 51,
+			195, 136, 88, 246, 168, 216, 207, 5, 155, 165, 67, 40, 5, 236,
+			62, 137, 221, 51, 248, 64, 250, 1, 252, 29, 117, 158, 194, 206,
+			81, 115, 236, 123, 158, 102, 32, 53, 0, 187, 94, 196, 174, 105,
+			128, 161, 231, 44, 29, 130, 159, 81, 199, 183, 177, 35, 182, 197,
+			126, 192, 179, 0, 133, 99, 207, 172, 228, 25, 159, 40, 158, 17,
+			136, 122, 47, 72, 158, 241, 17, 244, 159, 251, 143, 26, 157, 65,
+			153, 68, 154, 217, 128, 240, 72, 240, 221, 89, 200, 199, 52, 133,
+			33, 154, 96, 198, 224, 122, 118, 248, 250, 85, 245, 69, 156, 70,
+			51, 143, 28, 148, 101, 47, 115, 137, 38, 241, 1, 27, 163, 58,
+			88, 120, 100, 38, 240, 19, 116, 136, 49, 33, 201, 69, 4, 92,
+			255, 11, 157, 102, 164, 255, 11, 216, 199, 116, 40, 118, 223, 108,
+			250, 216, 199, 132, 115, 160, 121, 94, 177, 116, 220, 211, 111, 211,
+			145, 65, 135, 196, 222, 82, 141, 7, 159, 75, 230, 205, 139, 167,
+			189, 150, 8, 215, 40, 237, 121, 13, 22, 83, 238, 247, 36, 17,
+			34, 243, 164, 87, 18, 73, 137, 142, 30, 249, 162, 89, 76, 247,
+			228, 79, 221, 60, 101, 236, 236, 1, 29, 63, 166, 8, 198, 95,
+			166, 163, 83, 209, 21, 233, 217, 129, 239, 156, 93, 80, 168, 78,
+			250, 252, 79, 67, 115, 255, 119, 110, 209, 52, 75, 26, 137, 127,
+			163, 253, 77, 200, 90, 28, 162, 68, 79, 48, 61, 147, 254, 12,
+			127, 66, 166, 97, 122, 30, 127, 18, 166, 211, 244, 21, 250, 27,
+			184, 200, 53, 70, 18, 179, 154, 249, 41, 63, 102, 133, 42, 241,
+			48, 94, 96, 6, 161, 223, 173, 133, 93, 191, 23, 13, 237, 6,
+			194, 167, 124, 46, 238, 58, 199, 213, 34, 169, 111, 25, 55, 146,
+			57, 79, 93, 21, 240, 30, 39, 220, 180, 80, 126, 176, 70, 221,
+			45, 111, 170, 208, 233, 158, 21, 8, 62, 7, 107, 175, 197, 154,
+			196, 37, 23, 26, 152, 255, 134, 139, 69, 200, 179, 17, 176, 176,
+			182, 186, 78, 72, 227, 85, 44, 96, 57, 128, 12, 56, 25, 108,
+			25, 8, 119, 143, 147, 17, 149, 145, 6, 225, 238, 241, 129, 76,
+			200, 241, 161, 248, 157, 206, 244, 241, 139, 111, 211, 143, 85, 180,
+			123, 146, 204, 152, 43, 188, 218, 23, 253, 1, 218, 17, 107, 114,
+			46, 228, 129, 8, 97, 125, 238, 120, 22, 196, 195, 189, 246, 64,
+			160, 118, 146, 140, 115, 137, 28, 2, 181, 147, 49, 97, 8, 212,
+			78, 14, 169, 164, 60, 8, 212, 78, 78, 159, 163, 91, 42, 83,
+			112, 154, 92, 50, 11, 72, 24, 22, 100, 138, 112, 40, 158, 133,
+			139, 1, 198, 176, 236, 231, 189, 20, 201, 35, 12, 197, 246, 172,
+			24, 33, 6, 51, 166, 201, 164, 202, 5, 37, 73, 192, 175, 24,
+			129, 97, 78, 15, 93, 80, 144, 206, 244, 233, 183, 57, 109, 244,
+			50, 0, 47, 153, 159, 33, 35, 176, 210, 123, 161, 24, 164, 158,
+			84, 226, 103, 199, 23, 13, 225, 251, 16, 207, 244, 40, 159, 59,
+			178, 110, 236, 250, 206, 92, 44, 169, 40, 105, 112, 250, 210, 64,
+			210, 160, 98, 16, 147, 6, 99, 6, 49, 105, 240, 109, 78, 191,
+			166, 196, 208, 152, 241, 118, 34, 175, 153, 29, 126, 162, 63, 84,
+			166, 11, 10, 130, 132, 44, 203, 113, 132, 191, 24, 116, 59, 29,
+			199, 22, 117, 14, 147, 152, 178, 97, 122, 4, 195, 64, 70, 168,
+			100, 249, 168, 85, 131, 6, 223, 206, 188, 69, 179, 212, 48, 48,
+			87, 225, 18, 153, 50, 103, 143, 39, 205, 202, 73, 75, 14, 86,
+			67, 123, 188, 68, 222, 198, 173, 41, 116, 76, 50, 253, 146, 28,
+			108, 20, 35, 184, 52, 52, 166, 32, 157, 233, 151, 38, 38, 233,
+			60, 146, 128, 88, 3, 153, 48, 205, 23, 228, 229, 130, 190, 53,
+			52, 188, 203, 228, 210, 148, 196, 2, 134, 119, 89, 158, 16, 104,
+			152, 162, 122, 89, 166, 168, 106, 152, 162, 122, 121, 156, 209, 91,
+			72, 1, 50, 17, 200, 172, 153, 71, 10, 199, 82, 141, 33, 62,
+			234, 54, 79, 201, 56, 214, 208, 202, 174, 146, 203, 19, 18, 51,
+			88, 217, 213, 120, 92, 96, 101, 87, 101, 198, 177, 134, 86, 118,
+			245, 188, 73, 191, 135, 84, 97, 187, 77, 198, 205, 143, 101, 158,
+			111, 108, 221, 241, 178, 117, 93, 4, 53, 223, 238, 132, 158, 223,
+			203, 127, 62, 141, 11, 48, 165, 44, 185, 58, 43, 41, 129, 41,
+			101, 227, 177, 131, 41, 101, 51, 103, 20, 4, 132, 71, 199, 232,
+			159, 104, 200, 134, 193, 244, 69, 50, 107, 254, 99, 173, 47, 65,
+			183, 63, 17, 23, 87, 75, 232, 134, 62, 190, 205, 151, 115, 242,
+			131, 236, 127, 25, 251, 159, 64, 132, 113, 60, 218, 71, 211, 148,
+			31, 137, 220, 182, 231, 162, 232, 128, 74, 63, 134, 148, 100, 20,
+			171, 229, 114, 171, 30, 69, 187, 45, 135, 42, 236, 0, 242, 242,
+			206, 90, 60, 70, 200, 123, 93, 36, 217, 113, 57, 14, 200, 123,
+			93, 140, 199, 8, 121, 175, 139, 50, 163, 87, 195, 188, 215, 197,
+			243, 38, 253, 152, 18, 131, 48, 99, 37, 241, 190, 102, 94, 231,
+			213, 126, 135, 174, 226, 24, 202, 157, 31, 249, 18, 34, 194, 96,
+			240, 160, 195, 149, 204, 69, 250, 30, 53, 12, 76, 165, 89, 37,
+			99, 230, 60, 175, 30, 73, 160, 46, 173, 43, 7, 209, 179, 81,
+			201, 58, 65, 227, 95, 37, 43, 145, 79, 36, 104, 252, 171, 210,
+			72, 162, 112, 214, 234, 208, 176, 130, 116, 166, 175, 142, 140, 210,
+			27, 72, 78, 99, 250, 13, 50, 107, 102, 165, 114, 172, 48, 118,
+			67, 82, 166, 162, 126, 18, 61, 248, 20, 110, 144, 213, 49, 137,
+			83, 75, 1, 154, 24, 2, 164, 227, 211, 10, 210, 153, 126, 227,
+			188, 73, 239, 81, 144, 154, 113, 51, 81, 208, 204, 143, 248, 177,
+			197, 17, 58, 250, 200, 177, 212, 186, 190, 15, 121, 40, 49, 59,
+			214, 32, 11, 32, 52, 48, 185, 155, 153, 243, 232, 37, 48, 218,
+			118, 235, 85, 188, 132, 142, 130, 186, 69, 110, 70, 118, 172, 163,
+			160, 110, 73, 65, 69, 89, 70, 183, 164, 151, 208, 81, 80, 183,
+			38, 38, 81, 47, 58, 40, 233, 118, 172, 151, 65, 18, 48, 147,
+			12, 106, 42, 38, 7, 114, 186, 77, 110, 77, 73, 148, 224, 50,
+			110, 199, 228, 192, 101, 220, 150, 122, 209, 209, 101, 220, 30, 25,
+			165, 31, 34, 57, 194, 244, 79, 200, 152, 185, 8, 223, 69, 232,
+			119, 69, 46, 58, 177, 172, 139, 163, 73, 220, 245, 248, 35, 142,
+			137, 130, 199, 248, 132, 220, 86, 227, 0, 143, 241, 137, 204, 223,
+			214, 9, 12, 228, 147, 180, 34, 10, 30, 227, 19, 36, 138, 214,
+			191, 158, 40, 107, 102, 254, 197, 118, 220, 211, 156, 252, 120, 64,
+			29, 240, 117, 172, 103, 76, 52, 42, 12, 85, 110, 188, 182, 81,
+			25, 168, 155, 13, 178, 30, 77, 73, 6, 73, 164, 152, 190, 33,
+			141, 42, 202, 215, 218, 144, 70, 101, 160, 110, 54, 160, 182, 2,
+			124, 140, 1, 99, 186, 79, 198, 205, 111, 52, 16, 87, 76, 35,
+			199, 195, 65, 207, 119, 178, 216, 78, 244, 202, 242, 212, 217, 14,
+			250, 90, 70, 126, 168, 109, 133, 181, 22, 98, 158, 27, 136, 155,
+			204, 201, 99, 107, 219, 229, 115, 104, 185, 106, 30, 54, 112, 226,
+			184, 79, 54, 102, 37, 243, 96, 5, 247, 165, 99, 49, 112, 226,
+			184, 47, 157, 167, 129, 86, 112, 127, 116, 140, 46, 224, 184, 8,
+			211, 31, 144, 203, 230, 5, 100, 209, 106, 198, 98, 60, 65, 120,
+			160, 244, 7, 228, 254, 184, 196, 67, 82, 208, 213, 84, 144, 198,
+			244, 7, 179, 23, 21, 164, 51, 253, 193, 165, 57, 250, 207, 34,
+			225, 233, 76, 255, 148, 156, 55, 255, 97, 228, 160, 85, 152, 129,
+			127, 37, 14, 79, 32, 7, 2, 198, 249, 1, 94, 215, 61, 17,
+			184, 243, 97, 159, 72, 224, 41, 84, 122, 132, 253, 152, 164, 66,
+			114, 253, 22, 0, 139, 136, 160, 219, 17, 126, 195, 233, 122, 221,
+			232, 12, 50, 104, 121, 93, 167, 14, 107, 240, 186, 112, 68, 24,
+			175, 53, 13, 156, 125, 62, 37, 15, 46, 203, 1, 192, 236, 243,
+			105, 44, 64, 112, 5, 159, 102, 38, 21, 4, 195, 57, 55, 67,
+			125, 74, 140, 36, 51, 30, 38, 158, 106, 102, 131, 159, 188, 131,
+			122, 173, 149, 204, 17, 20, 167, 45, 95, 146, 152, 232, 113, 17,
+			29, 83, 18, 190, 132, 199, 175, 226, 152, 146, 104, 252, 143, 201,
+			195, 200, 131, 39, 209, 49, 61, 150, 158, 34, 74, 45, 124, 44,
+			29, 83, 18, 141, 255, 177, 116, 76, 73, 176, 253, 207, 95, 215,
+			49, 37, 209, 36, 63, 39, 143, 167, 36, 74, 48, 201, 207, 99,
+			114, 96, 146, 159, 75, 199, 148, 68, 147, 252, 124, 100, 20, 87,
+			75, 73, 88, 68, 127, 241, 10, 171, 165, 36, 174, 91, 190, 32,
+			159, 43, 166, 193, 11, 125, 33, 117, 150, 68, 47, 244, 133, 92,
+			45, 37, 209, 32, 191, 24, 103, 116, 25, 41, 232, 76, 127, 66,
+			102, 205, 203, 188, 122, 124, 37, 32, 13, 114, 192, 246, 147, 104,
+			30, 79, 200, 23, 19, 18, 29, 152, 199, 147, 152, 20, 152, 199,
+			19, 57, 113, 39, 113, 113, 242, 228, 188, 73, 61, 74, 140, 20,
+			75, 214, 19, 127, 79, 211, 204, 61, 126, 210, 78, 246, 53, 172,
+			99, 176, 63, 61, 205, 54, 82, 26, 211, 235, 153, 11, 104, 27,
+			120, 88, 210, 120, 21, 219, 72, 161, 109, 52, 72, 61, 250, 126,
+			83, 104, 27, 13, 169, 172, 232, 144, 165, 33, 109, 35, 133, 182,
+			209, 152, 152, 164, 27, 72, 66, 99, 122, 139, 140, 153, 55, 95,
+			105, 49, 17, 157, 184, 28, 88, 193, 209, 234, 163, 20, 90, 75,
+			139, 52, 166, 36, 17, 176, 150, 86, 204, 0, 88, 75, 75, 90,
+			75, 10, 173, 165, 53, 50, 74, 63, 66, 6, 8, 211, 191, 36,
+			23, 204, 37, 100, 192, 237, 182, 247, 132, 15, 78, 5, 28, 10,
+			68, 253, 108, 17, 72, 170, 194, 23, 199, 201, 130, 9, 125, 73,
+			90, 106, 108, 96, 66, 95, 74, 189, 166, 208, 132, 190, 204, 156,
+			83, 144, 206, 244, 47, 205, 89, 220, 98, 166, 192, 158, 28, 50,
+			43, 183, 152, 45, 187, 217, 2, 143, 211, 55, 210, 200, 152, 78,
+			31, 47, 24, 148, 67, 190, 188, 32, 145, 131, 65, 57, 49, 97,
+			48, 40, 71, 26, 84, 10, 13, 202, 57, 111, 210, 191, 6, 103,
+			154, 34, 6, 211, 125, 50, 97, 254, 37, 206, 68, 174, 23, 246,
+			237, 173, 33, 219, 0, 136, 169, 234, 46, 56, 138, 130, 8, 37,
+			102, 22, 128, 75, 141, 230, 15, 176, 29, 16, 139, 7, 139, 95,
+			60, 218, 138, 68, 100, 185, 81, 115, 238, 213, 106, 93, 216, 254,
+			101, 247, 4, 172, 116, 33, 151, 10, 137, 44, 128, 19, 197, 76,
+			39, 180, 209, 67, 160, 132, 225, 51, 57, 80, 152, 188, 130, 150,
+			119, 192, 187, 50, 37, 119, 208, 36, 142, 152, 176, 50, 93, 48,
+			144, 32, 22, 12, 44, 18, 124, 226, 204, 202, 193, 195, 18, 217,
+			143, 13, 1, 22, 1, 254, 208, 136, 130, 116, 166, 251, 227, 140,
+			254, 58, 202, 37, 201, 244, 46, 57, 47, 215, 200, 82, 222, 253,
+			17, 138, 158, 114, 230, 213, 222, 3, 191, 176, 152, 114, 210, 96,
+			70, 151, 248, 19, 146, 114, 18, 17, 42, 202, 224, 116, 187, 114,
+			215, 159, 34, 73, 157, 233, 221, 233, 25, 250, 107, 72, 57, 197,
+			244, 3, 114, 222, 188, 134, 148, 33, 94, 250, 202, 36, 83, 6,
+			51, 14, 72, 247, 188, 68, 155, 74, 2, 38, 101, 5, 240, 45,
+			31, 100, 20, 201, 148, 206, 244, 131, 233, 25, 105, 126, 105, 166,
+			63, 143, 35, 28, 47, 27, 108, 100, 139, 3, 132, 211, 6, 51,
+			158, 147, 3, 69, 24, 78, 113, 159, 199, 82, 78, 107, 76, 127,
+			62, 196, 164, 148, 225, 20, 250, 249, 212, 57, 250, 1, 18, 206,
+			48, 253, 107, 50, 99, 190, 251, 226, 177, 158, 64, 49, 99, 48,
+			227, 107, 242, 124, 70, 82, 204, 36, 1, 145, 26, 106, 70, 99,
+			250, 215, 25, 69, 49, 163, 51, 253, 235, 169, 115, 82, 175, 67,
+			76, 255, 1, 57, 247, 138, 122, 149, 137, 75, 3, 148, 135, 12,
+			102, 252, 128, 124, 173, 40, 15, 37, 1, 161, 26, 235, 144, 198,
+			244, 31, 12, 141, 203, 177, 14, 233, 76, 255, 193, 228, 52, 189,
+			137, 148, 41, 51, 126, 168, 145, 115, 47, 83, 236, 32, 205, 17,
+			164, 73, 13, 150, 252, 161, 70, 126, 112, 78, 242, 64, 147, 136,
+			43, 173, 64, 13, 192, 204, 184, 2, 117, 0, 39, 167, 105, 1,
+			233, 14, 51, 227, 71, 26, 57, 111, 174, 190, 210, 144, 163, 160,
+			188, 220, 228, 82, 58, 138, 24, 135, 13, 150, 250, 145, 70, 126,
+			168, 41, 6, 134, 147, 136, 52, 163, 64, 13, 192, 161, 9, 5,
+			234, 0, 78, 207, 224, 214, 32, 69, 206, 48, 227, 119, 129, 129,
+			220, 139, 7, 126, 34, 229, 51, 6, 75, 253, 174, 70, 126, 164,
+			157, 151, 184, 207, 36, 17, 155, 26, 250, 25, 13, 192, 140, 162,
+			124, 70, 7, 112, 122, 134, 62, 163, 196, 72, 179, 212, 143, 181,
+			196, 63, 209, 52, 243, 75, 126, 90, 16, 249, 181, 86, 84, 199,
+			144, 156, 52, 111, 14, 83, 221, 72, 107, 204, 248, 177, 150, 225,
+			184, 42, 78, 147, 4, 51, 126, 162, 189, 108, 230, 4, 73, 167,
+			97, 230, 76, 253, 68, 35, 63, 214, 230, 112, 68, 105, 152, 59,
+			161, 115, 70, 129, 26, 224, 26, 26, 83, 160, 14, 224, 196, 36,
+			238, 99, 210, 68, 99, 198, 55, 218, 235, 172, 173, 34, 162, 154,
+			193, 82, 223, 104, 228, 39, 218, 148, 68, 171, 37, 17, 145, 34,
+			170, 33, 222, 161, 97, 5, 234, 0, 142, 140, 226, 178, 32, 77,
+			8, 51, 126, 95, 123, 201, 10, 43, 162, 67, 12, 150, 250, 125,
+			141, 124, 163, 41, 246, 73, 18, 251, 166, 21, 168, 1, 152, 25,
+			81, 160, 14, 224, 56, 67, 191, 152, 214, 19, 44, 245, 83, 141,
+			252, 129, 166, 155, 89, 142, 167, 49, 160, 59, 11, 42, 202, 33,
+			13, 15, 13, 22, 194, 39, 248, 6, 167, 17, 249, 201, 166, 245,
+			132, 198, 140, 159, 106, 116, 148, 190, 75, 83, 70, 90, 135, 210,
+			122, 227, 103, 154, 161, 88, 30, 232, 58, 31, 192, 70, 34, 79,
+			233, 56, 77, 71, 109, 13, 150, 250, 153, 102, 252, 84, 27, 167,
+			163, 234, 81, 18, 251, 211, 222, 3, 13, 30, 12, 143, 244, 30,
+			232, 240, 96, 156, 209, 15, 36, 69, 141, 25, 63, 215, 140, 41,
+			169, 153, 94, 4, 173, 173, 62, 5, 69, 92, 113, 174, 200, 131,
+			110, 126, 174, 25, 63, 211, 38, 98, 228, 160, 157, 159, 247, 147,
+			7, 253, 252, 92, 27, 30, 235, 61, 208, 225, 193, 196, 36, 198,
+			145, 211, 68, 103, 198, 31, 106, 228, 130, 249, 201, 241, 225, 170,
+			234, 149, 222, 162, 198, 219, 3, 163, 22, 241, 137, 66, 79, 161,
+			74, 158, 184, 124, 253, 67, 141, 196, 96, 10, 240, 15, 43, 165,
+			234, 26, 128, 227, 231, 20, 136, 212, 205, 89, 26, 80, 146, 74,
+			176, 212, 47, 52, 56, 81, 49, 5, 87, 231, 99, 241, 71, 132,
+			234, 220, 244, 154, 235, 94, 115, 192, 82, 227, 153, 29, 153, 196,
+			172, 223, 134, 5, 165, 254, 144, 180, 73, 85, 143, 142, 239, 225,
+			237, 1, 110, 83, 33, 132, 85, 192, 48, 213, 83, 160, 254, 95,
+			104, 153, 49, 42, 168, 145, 66, 229, 255, 177, 70, 214, 204, 71,
+			189, 67, 14, 110, 65, 126, 45, 144, 87, 188, 132, 30, 164, 184,
+			248, 182, 216, 23, 131, 33, 158, 147, 34, 237, 148, 119, 44, 223,
+			106, 139, 16, 138, 159, 81, 42, 64, 70, 99, 198, 31, 107, 169,
+			81, 5, 18, 0, 199, 222, 81, 160, 14, 224, 114, 129, 238, 32,
+			79, 26, 51, 126, 169, 145, 7, 230, 157, 163, 81, 55, 144, 137,
+			203, 237, 186, 104, 119, 188, 16, 25, 232, 191, 85, 194, 151, 141,
+			57, 212, 93, 99, 100, 49, 38, 15, 54, 241, 75, 45, 53, 169,
+			64, 2, 224, 212, 187, 10, 212, 1, 188, 241, 61, 186, 134, 228,
+			9, 51, 126, 165, 145, 130, 121, 163, 63, 88, 242, 234, 241, 173,
+			8, 39, 120, 159, 95, 105, 169, 49, 5, 34, 210, 241, 57, 5,
+			234, 0, 46, 254, 58, 221, 67, 138, 58, 51, 254, 76, 35, 91,
+			102, 245, 232, 78, 247, 248, 136, 227, 177, 193, 74, 49, 186, 132,
+			129, 135, 39, 69, 102, 105, 60, 119, 68, 36, 193, 16, 255, 76,
+			75, 77, 73, 14, 116, 2, 224, 116, 78, 129, 200, 194, 7, 155,
+			244, 31, 104, 200, 145, 193, 140, 63, 215, 72, 217, 252, 161, 118,
+			124, 178, 120, 9, 83, 190, 168, 121, 126, 84, 218, 61, 240, 113,
+			209, 23, 127, 93, 22, 175, 84, 203, 187, 107, 213, 221, 114, 113,
+			253, 36, 129, 194, 185, 198, 159, 107, 169, 115, 10, 36, 0, 206,
+			44, 43, 80, 7, 240, 214, 14, 253, 151, 17, 255, 73, 102, 252,
+			107, 141, 220, 55, 255, 72, 27, 92, 25, 191, 26, 239, 253, 26,
+			157, 87, 27, 12, 203, 25, 176, 108, 89, 50, 135, 21, 216, 123,
+			135, 125, 219, 72, 168, 116, 87, 31, 78, 183, 3, 39, 4, 93,
+			188, 201, 163, 209, 85, 247, 80, 208, 24, 99, 60, 186, 164, 6,
+			12, 167, 38, 20, 72, 0, 156, 204, 42, 80, 7, 112, 245, 94,
+			156, 242, 245, 247, 231, 233, 219, 71, 147, 164, 66, 85, 127, 116,
+			90, 217, 194, 45, 58, 20, 215, 40, 189, 246, 85, 13, 213, 147,
+			75, 29, 70, 98, 140, 223, 170, 220, 225, 223, 190, 19, 149, 59,
+			180, 223, 92, 215, 240, 230, 186, 134, 55, 215, 53, 188, 185, 174,
+			225, 205, 117, 13, 111, 174, 107, 248, 255, 230, 186, 6, 149, 84,
+			15, 185, 3, 113, 82, 61, 164, 24, 93, 78, 159, 27, 44, 119,
+			80, 24, 85, 185, 131, 194, 8, 225, 214, 203, 230, 69, 137, 241,
+			74, 92, 74, 0, 5, 14, 113, 41, 1, 4, 237, 175, 164, 39,
+			6, 203, 29, 38, 142, 148, 59, 76, 244, 151, 59, 196, 5, 20,
+			239, 36, 150, 36, 70, 168, 89, 72, 44, 201, 70, 81, 185, 195,
+			236, 96, 185, 195, 236, 145, 114, 7, 85, 64, 161, 167, 153, 254,
+			206, 197, 188, 196, 120, 53, 46, 160, 192, 114, 7, 85, 64, 17,
+			149, 59, 152, 131, 229, 14, 230, 145, 114, 7, 85, 64, 1, 199,
+			239, 87, 227, 2, 138, 249, 120, 212, 120, 93, 131, 26, 117, 84,
+			238, 112, 101, 176, 220, 225, 202, 145, 114, 7, 85, 64, 1, 49,
+			195, 249, 184, 128, 34, 27, 23, 80, 96, 185, 131, 42, 160, 136,
+			202, 29, 102, 6, 203, 29, 102, 142, 148, 59, 168, 2, 138, 84,
+			154, 233, 217, 89, 78, 127, 50, 22, 101, 130, 89, 137, 182, 102,
+			254, 221, 49, 94, 232, 171, 243, 142, 115, 248, 97, 175, 36, 111,
+			111, 115, 57, 172, 195, 78, 186, 144, 1, 159, 63, 247, 92, 40,
+			137, 245, 227, 235, 25, 114, 3, 85, 188, 86, 32, 203, 109, 95,
+			120, 25, 4, 61, 165, 144, 216, 118, 249, 110, 117, 141, 23, 59,
+			30, 156, 231, 97, 21, 113, 180, 72, 21, 46, 172, 1, 96, 165,
+			34, 147, 43, 40, 212, 147, 58, 162, 19, 218, 53, 126, 215, 23,
+			77, 207, 183, 45, 151, 175, 169, 43, 35, 14, 90, 118, 173, 197,
+			197, 179, 80, 184, 114, 211, 211, 107, 164, 24, 167, 124, 207, 170,
+			125, 117, 96, 249, 208, 194, 195, 219, 24, 160, 62, 249, 40, 73,
+			43, 8, 186, 109, 160, 106, 57, 14, 111, 219, 110, 55, 20, 184,
+			122, 225, 239, 47, 211, 120, 72, 142, 231, 54, 115, 220, 206, 139,
+			60, 119, 132, 213, 233, 13, 213, 23, 124, 46, 104, 11, 203, 23,
+			245, 57, 30, 120, 209, 214, 193, 245, 250, 91, 81, 30, 90, 112,
+			71, 158, 29, 224, 37, 118, 50, 103, 4, 247, 197, 29, 88, 239,
+			128, 100, 250, 46, 147, 136, 166, 213, 229, 229, 229, 149, 69, 252,
+			95, 117, 121, 249, 67, 252, 223, 231, 48, 138, 155, 55, 111, 222,
+			92, 92, 185, 190, 184, 186, 82, 189, 190, 250, 225, 141, 155, 31,
+			222, 184, 153, 191, 169, 254, 124, 158, 167, 252, 206, 33, 28, 173,
+			135, 190, 93, 11, 229, 117, 121, 200, 146, 15, 235, 208, 28, 63,
+			16, 92, 184, 1, 100, 1, 202, 61, 14, 102, 37, 214, 60, 119,
+			95, 248, 144, 14, 71, 165, 86, 189, 54, 231, 229, 141, 53, 190,
+			186, 186, 122, 147, 171, 171, 249, 108, 183, 25, 228, 41, 175, 8,
+			193, 255, 142, 90, 151, 30, 28, 28, 228, 109, 17, 54, 242, 158,
+			223, 92, 242, 27, 53, 248, 11, 157, 242, 225, 179, 240, 55, 178,
+			175, 210, 106, 225, 148, 27, 50, 122, 86, 12, 18, 129, 210, 177,
+			210, 99, 254, 20, 108, 52, 187, 240, 244, 248, 101, 8, 241, 230,
+			66, 214, 178, 244, 54, 27, 129, 8, 159, 72, 125, 101, 225, 105,
+			118, 107, 119, 115, 115, 97, 225, 196, 118, 104, 182, 217, 229, 133,
+			91, 125, 60, 93, 127, 25, 79, 77, 17, 2, 94, 175, 81, 183,
+			14, 251, 120, 139, 50, 46, 145, 192, 190, 229, 240, 112, 95, 82,
+			28, 104, 126, 53, 220, 207, 113, 100, 232, 214, 183, 29, 210, 126,
+			62, 220, 135, 1, 190, 104, 68, 81, 163, 110, 32, 106, 252, 93,
+			188, 225, 96, 96, 132, 171, 167, 142, 240, 145, 237, 174, 94, 231,
+			79, 239, 138, 176, 114, 24, 132, 162, 13, 175, 11, 193, 134, 237,
+			136, 234, 160, 34, 54, 74, 155, 197, 106, 233, 65, 145, 55, 66,
+			201, 198, 105, 125, 174, 54, 66, 197, 233, 110, 105, 171, 250, 254,
+			123, 60, 180, 107, 95, 193, 29, 18, 217, 108, 54, 122, 178, 208,
+			8, 243, 245, 131, 123, 118, 179, 181, 110, 133, 216, 107, 129, 127,
+			244, 17, 95, 189, 190, 192, 191, 230, 248, 110, 211, 59, 80, 175,
+			148, 220, 150, 150, 120, 129, 63, 178, 221, 58, 68, 134, 0, 37,
+			124, 228, 43, 203, 203, 125, 174, 40, 200, 199, 13, 4, 186, 160,
+			149, 247, 143, 127, 101, 49, 54, 232, 190, 242, 254, 123, 239, 189,
+			247, 193, 234, 251, 203, 203, 241, 39, 191, 39, 26, 158, 47, 248,
+			174, 107, 63, 83, 88, 110, 126, 176, 124, 20, 75, 254, 219, 41,
+			51, 27, 141, 159, 103, 179, 48, 130, 128, 47, 197, 215, 81, 44,
+			240, 197, 126, 118, 94, 98, 193, 128, 103, 245, 122, 15, 207, 59,
+			125, 120, 208, 0, 22, 6, 12, 224, 189, 83, 13, 224, 190, 181,
+			111, 241, 167, 145, 242, 243, 50, 176, 4, 77, 30, 216, 142, 99,
+			7, 125, 6, 0, 30, 146, 183, 241, 41, 191, 205, 79, 239, 240,
+			2, 51, 231, 183, 123, 79, 243, 174, 56, 184, 211, 181, 157, 186,
+			240, 179, 11, 48, 176, 138, 148, 144, 36, 17, 9, 102, 65, 149,
+			191, 113, 14, 109, 182, 208, 214, 179, 182, 27, 194, 200, 101, 203,
+			104, 232, 114, 216, 32, 130, 133, 133, 252, 30, 96, 70, 94, 122,
+			50, 184, 113, 170, 12, 228, 40, 212, 188, 201, 119, 14, 195, 86,
+			84, 176, 13, 132, 93, 239, 128, 223, 198, 119, 121, 248, 39, 43,
+			121, 82, 230, 114, 27, 2, 1, 89, 215, 59, 144, 207, 213, 133,
+			41, 240, 20, 30, 243, 69, 101, 89, 17, 139, 239, 190, 123, 115,
+			225, 136, 94, 251, 229, 146, 149, 141, 111, 203, 255, 230, 34, 243,
+			190, 141, 255, 46, 208, 190, 171, 74, 172, 204, 120, 148, 129, 131,
+			107, 198, 6, 153, 132, 12, 156, 178, 154, 202, 123, 211, 184, 215,
+			192, 57, 25, 120, 151, 215, 189, 246, 76, 155, 158, 108, 219, 131,
+			23, 97, 160, 140, 78, 153, 175, 232, 73, 19, 214, 231, 167, 92,
+			110, 210, 32, 150, 74, 172, 142, 82, 16, 250, 47, 55, 105, 12,
+			92, 110, 210, 96, 19, 244, 191, 104, 234, 114, 19, 135, 48, 243,
+			175, 52, 190, 229, 185, 139, 241, 205, 33, 175, 119, 197, 73, 158,
+			111, 201, 142, 106, 206, 87, 177, 64, 216, 209, 246, 45, 115, 48,
+			64, 16, 132, 112, 214, 221, 178, 246, 5, 119, 251, 105, 162, 18,
+			100, 71, 149, 244, 143, 87, 104, 53, 60, 31, 214, 33, 106, 241,
+			117, 68, 128, 203, 114, 98, 239, 221, 38, 114, 242, 237, 33, 14,
+			105, 244, 223, 30, 162, 50, 6, 240, 64, 65, 119, 6, 110, 15,
+			113, 250, 110, 15, 249, 71, 147, 223, 209, 173, 214, 161, 21, 124,
+			21, 28, 169, 189, 124, 89, 165, 228, 203, 130, 128, 115, 191, 71,
+			232, 176, 12, 128, 86, 173, 224, 171, 215, 168, 30, 146, 197, 61,
+			81, 233, 16, 252, 100, 31, 209, 51, 129, 8, 67, 71, 60, 169,
+			11, 199, 138, 42, 219, 94, 88, 15, 55, 28, 53, 95, 135, 214,
+			236, 14, 29, 173, 121, 48, 31, 134, 226, 73, 71, 248, 182, 23,
+			93, 139, 253, 66, 4, 35, 170, 199, 14, 118, 96, 191, 78, 207,
+			214, 237, 160, 3, 249, 106, 162, 254, 196, 10, 103, 82, 88, 146,
+			103, 30, 195, 16, 127, 208, 229, 51, 189, 14, 133, 240, 254, 127,
+			26, 131, 120, 163, 145, 88, 249, 27, 85, 196, 50, 223, 43, 98,
+			201, 210, 122, 180, 95, 57, 155, 88, 209, 204, 199, 42, 248, 13,
+			186, 7, 166, 44, 14, 86, 198, 127, 179, 43, 186, 34, 250, 169,
+			242, 35, 123, 183, 102, 171, 83, 113, 203, 145, 223, 182, 237, 54,
+			29, 65, 7, 99, 242, 202, 13, 158, 205, 76, 244, 46, 232, 30,
+			37, 83, 230, 7, 199, 234, 66, 164, 129, 169, 128, 102, 156, 156,
+			14, 252, 236, 225, 29, 227, 161, 55, 224, 168, 70, 201, 217, 169,
+			62, 71, 53, 42, 243, 9, 34, 71, 53, 42, 115, 165, 162, 178,
+			148, 209, 137, 73, 60, 15, 69, 63, 53, 78, 198, 100, 58, 65,
+			203, 10, 90, 167, 36, 73, 121, 114, 116, 131, 223, 254, 56, 25,
+			85, 36, 181, 129, 74, 24, 13, 43, 97, 134, 21, 4, 149, 48,
+			35, 163, 202, 241, 19, 166, 79, 145, 177, 239, 62, 245, 178, 143,
+			95, 60, 100, 250, 214, 169, 151, 81, 145, 203, 20, 25, 87, 50,
+			131, 28, 172, 169, 216, 177, 129, 208, 166, 50, 106, 112, 144, 118,
+			53, 53, 50, 74, 127, 143, 168, 42, 151, 11, 36, 103, 254, 31,
+			141, 175, 123, 192, 234, 129, 21, 192, 229, 230, 48, 143, 69, 35,
+			85, 121, 250, 125, 220, 118, 221, 208, 118, 184, 141, 60, 90, 33,
+			236, 210, 2, 169, 114, 207, 169, 199, 137, 82, 80, 8, 227, 242,
+			142, 99, 65, 8, 216, 227, 29, 95, 236, 195, 252, 15, 87, 213,
+			59, 135, 139, 86, 179, 233, 195, 201, 229, 126, 79, 162, 65, 52,
+			19, 2, 7, 72, 212, 110, 11, 202, 67, 255, 208, 134, 93, 99,
+			216, 18, 46, 111, 88, 182, 131, 208, 158, 168, 117, 161, 90, 234,
+			8, 99, 209, 145, 129, 186, 13, 60, 202, 188, 170, 121, 142, 35,
+			162, 203, 7, 109, 23, 243, 181, 224, 232, 76, 212, 237, 104, 63,
+			230, 249, 86, 179, 103, 36, 112, 200, 123, 129, 76, 41, 57, 234,
+			41, 184, 84, 219, 148, 151, 106, 235, 112, 169, 246, 236, 188, 130,
+			64, 114, 239, 94, 163, 127, 77, 212, 13, 222, 87, 200, 146, 249,
+			151, 209, 197, 241, 86, 27, 103, 44, 121, 203, 23, 183, 26, 161,
+			244, 13, 248, 49, 66, 246, 94, 205, 23, 112, 31, 66, 244, 185,
+			244, 13, 65, 185, 66, 87, 4, 1, 29, 168, 233, 130, 219, 15,
+			44, 117, 202, 196, 107, 190, 141, 1, 238, 248, 106, 116, 192, 12,
+			135, 88, 209, 101, 138, 158, 19, 253, 31, 1, 88, 112, 47, 144,
+			29, 200, 67, 185, 220, 0, 250, 8, 59, 164, 191, 237, 193, 134,
+			182, 225, 249, 53, 33, 213, 55, 128, 78, 122, 171, 154, 229, 212,
+			186, 209, 197, 145, 123, 135, 241, 165, 142, 32, 98, 27, 110, 156,
+			20, 160, 15, 241, 172, 99, 171, 99, 54, 84, 95, 22, 140, 67,
+			196, 126, 122, 33, 82, 49, 116, 16, 46, 186, 167, 122, 223, 10,
+			45, 43, 159, 245, 154, 199, 138, 49, 12, 102, 92, 33, 23, 114,
+			82, 49, 70, 138, 233, 87, 98, 197, 64, 74, 219, 149, 217, 119,
+			21, 164, 51, 253, 202, 98, 158, 254, 11, 3, 21, 147, 100, 250,
+			50, 201, 155, 63, 51, 162, 97, 129, 58, 14, 192, 150, 64, 44,
+			210, 244, 250, 212, 210, 155, 70, 98, 59, 6, 254, 80, 164, 232,
+			61, 45, 39, 135, 223, 162, 210, 204, 158, 5, 170, 241, 142, 101,
+			234, 205, 7, 188, 230, 120, 181, 175, 242, 50, 197, 144, 242, 231,
+			194, 247, 114, 125, 247, 99, 158, 124, 164, 24, 95, 158, 49, 240,
+			241, 203, 47, 132, 246, 31, 248, 239, 29, 114, 43, 8, 132, 31,
+			69, 40, 192, 140, 74, 27, 168, 108, 185, 196, 70, 6, 224, 27,
+			241, 6, 73, 204, 67, 32, 166, 6, 199, 156, 200, 32, 176, 96,
+			245, 142, 26, 194, 150, 47, 130, 150, 231, 212, 145, 85, 28, 101,
+			7, 76, 121, 96, 194, 0, 113, 129, 124, 238, 136, 154, 213, 13,
+			250, 94, 216, 253, 18, 4, 204, 213, 110, 123, 207, 193, 91, 173,
+			4, 183, 106, 97, 23, 210, 41, 33, 74, 5, 60, 123, 141, 184,
+			99, 255, 145, 43, 202, 186, 1, 231, 254, 96, 6, 224, 234, 172,
+			67, 40, 247, 235, 214, 90, 189, 211, 178, 62, 47, 217, 71, 16,
+			66, 47, 59, 221, 189, 165, 74, 119, 79, 237, 48, 161, 49, 184,
+			5, 248, 210, 69, 239, 50, 253, 232, 72, 189, 231, 64, 147, 6,
+			51, 150, 201, 149, 37, 233, 64, 147, 41, 176, 26, 85, 25, 9,
+			137, 139, 203, 23, 22, 20, 164, 51, 125, 57, 183, 184, 151, 234,
+			248, 94, 232, 173, 254, 223, 1, 0, 108, 33, 227, 179, 240, 100,
+			0, 0},
 	)
 }
 
@@ -18,6 +18,7 @@ It has these top-level messages:
 	LoadStreamResponse
 	TerminateStreamRequest
 	ArchiveStreamRequest
+	IndexStreamFieldsRequest
 	LogStreamState
 	ArchiveTask
 */
@@ -337,6 +338,10 @@ type ArchiveStreamRequest struct {
 	DataUrl string `protobuf:"bytes,30,opt,name=data_url,json=dataUrl" json:"data_url,omitempty"`
 	// The size of the log stream's binary data.
 	DataSize int64 `protobuf:"varint,31,opt,name=data_size,json=dataSize" json:"data_size,omitempty"`
+	// The archive URL of the log stream's search index.
+	SearchUrl string `protobuf:"bytes,40,opt,name=search_url,json=searchUrl" json:"search_url,omitempty"`
+	// The size of the log stream's search index.
+	SearchSize int64 `protobuf:"varint,41,opt,name=search_size,json=searchSize" json:"search_size,omitempty"`
 }
 
 func (m *ArchiveStreamRequest) Reset()                    { *m = ArchiveStreamRequest{} }
@@ -421,6 +426,95 @@ func (m *ArchiveStreamRequest) GetDataSize() int64 {
 	return 0
 }
 
+func (m *ArchiveStreamRequest) GetSearchUrl() string {
+	if m != nil {
+		return m.SearchUrl
+	}
+	return ""
+}
+
+func (m *ArchiveStreamRequest) GetSearchSize() int64 {
+	if m != nil {
+		return m.SearchSize
+	}
+	return 0
+}
+
+// IndexStreamFieldsRequest is the set of caller-supplied data for the
+// IndexStreamFields service endpoint.
+type IndexStreamFieldsRequest struct {
+	// The log stream's project.
+	Project string `protobuf:"bytes,1,opt,name=project" json:"project,omitempty"`
+	// The log stream's path Coordinator ID.
+	Id string `protobuf:"bytes,2,opt,name=id" json:"id,omitempty"`
+	// The log stream's secret.
+	Secret []byte `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The indexed field values that were observed in the log stream.
+	Fields []*IndexStreamFieldsRequest_Field `protobuf:"bytes,4,rep,name=fields" json:"fields,omitempty"`
+}
+
+func (m *IndexStreamFieldsRequest) Reset()                    { *m = IndexStreamFieldsRequest{} }
+func (m *IndexStreamFieldsRequest) String() string            { return proto.CompactTextString(m) }
+func (*IndexStreamFieldsRequest) ProtoMessage()               {}
+func (*IndexStreamFieldsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *IndexStreamFieldsRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *IndexStreamFieldsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *IndexStreamFieldsRequest) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *IndexStreamFieldsRequest) GetFields() []*IndexStreamFieldsRequest_Field {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+// Field is a single indexed field value.
+type IndexStreamFieldsRequest_Field struct {
+	// The indexed field's key.
+	Key string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	// The string form of the field's value.
+	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (m *IndexStreamFieldsRequest_Field) Reset()         { *m = IndexStreamFieldsRequest_Field{} }
+func (m *IndexStreamFieldsRequest_Field) String() string { return proto.CompactTextString(m) }
+func (*IndexStreamFieldsRequest_Field) ProtoMessage()    {}
+func (*IndexStreamFieldsRequest_Field) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{7, 0}
+}
+
+func (m *IndexStreamFieldsRequest_Field) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *IndexStreamFieldsRequest_Field) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GetConfigResponse)(nil), "logdog.GetConfigResponse")
 	proto.RegisterType((*RegisterStreamRequest)(nil), "logdog.RegisterStreamRequest")
@@ -429,6 +523,8 @@ func init() {
 	proto.RegisterType((*LoadStreamResponse)(nil), "logdog.LoadStreamResponse")
 	proto.RegisterType((*TerminateStreamRequest)(nil), "logdog.TerminateStreamRequest")
 	proto.RegisterType((*ArchiveStreamRequest)(nil), "logdog.ArchiveStreamRequest")
+	proto.RegisterType((*IndexStreamFieldsRequest)(nil), "logdog.IndexStreamFieldsRequest")
+	proto.RegisterType((*IndexStreamFieldsRequest_Field)(nil), "logdog.IndexStreamFieldsRequest.Field")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -452,6 +548,9 @@ type ServicesClient interface {
 	// TerminateStream is an idempotent operation to update the stream's terminal
 	// index.
 	TerminateStream(ctx context.Context, in *TerminateStreamRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// IndexStreamFields is an idempotent operation to record the indexed field
+	// values that were observed in a STRUCTURED log stream.
+	IndexStreamFields(ctx context.Context, in *IndexStreamFieldsRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// ArchiveStream is an idempotent operation to record a log stream's archival
 	// parameters. It is used by the Archivist service upon successful stream
 	// archival.
@@ -501,6 +600,15 @@ func (c *servicesPRPCClient) TerminateStream(ctx context.Context, in *TerminateS
 	return out, nil
 }
 
+func (c *servicesPRPCClient) IndexStreamFields(ctx context.Context, in *IndexStreamFieldsRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := c.client.Call(ctx, "logdog.Services", "IndexStreamFields", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *servicesPRPCClient) ArchiveStream(ctx context.Context, in *ArchiveStreamRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
 	out := new(google_protobuf1.Empty)
 	err := c.client.Call(ctx, "logdog.Services", "ArchiveStream", in, out, opts...)
@@ -554,6 +662,15 @@ func (c *servicesClient) TerminateStream(ctx context.Context, in *TerminateStrea
 	return out, nil
 }
 
+func (c *servicesClient) IndexStreamFields(ctx context.Context, in *IndexStreamFieldsRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/logdog.Services/IndexStreamFields", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *servicesClient) ArchiveStream(ctx context.Context, in *ArchiveStreamRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
 	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/logdog.Services/ArchiveStream", in, out, c.cc, opts...)
@@ -576,6 +693,9 @@ type ServicesServer interface {
 	// TerminateStream is an idempotent operation to update the stream's terminal
 	// index.
 	TerminateStream(context.Context, *TerminateStreamRequest) (*google_protobuf1.Empty, error)
+	// IndexStreamFields is an idempotent operation to record the indexed field
+	// values that were observed in a STRUCTURED log stream.
+	IndexStreamFields(context.Context, *IndexStreamFieldsRequest) (*google_protobuf1.Empty, error)
 	// ArchiveStream is an idempotent operation to record a log stream's archival
 	// parameters. It is used by the Archivist service upon successful stream
 	// archival.
@@ -658,6 +778,24 @@ func _Services_TerminateStream_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Services_IndexStreamFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexStreamFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServicesServer).IndexStreamFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logdog.Services/IndexStreamFields",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServicesServer).IndexStreamFields(ctx, req.(*IndexStreamFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Services_ArchiveStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArchiveStreamRequest)
 	if err := dec(in); err != nil {
@@ -696,6 +834,10 @@ var _Services_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TerminateStream",
 			Handler:    _Services_TerminateStream_Handler,
 		},
+		{
+			MethodName: "IndexStreamFields",
+			Handler:    _Services_IndexStreamFields_Handler,
+		},
 		{
 			MethodName: "ArchiveStream",
 			Handler:    _Services_ArchiveStream_Handler,
@@ -710,51 +852,56 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 722 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x4e, 0x13, 0x41,
-	0x14, 0xce, 0xb6, 0x80, 0xed, 0x69, 0x0b, 0x32, 0x42, 0xd3, 0x16, 0xf9, 0xb1, 0x46, 0x43, 0x22,
-	0xee, 0x46, 0xbc, 0x37, 0x31, 0x48, 0x94, 0x48, 0x22, 0x6e, 0x95, 0xdb, 0x66, 0xd8, 0x1d, 0xb6,
-	0xa3, 0xdb, 0x9d, 0x75, 0x66, 0xb6, 0xb1, 0xdc, 0xf9, 0x32, 0x26, 0xbe, 0x85, 0xaf, 0xe1, 0x6b,
-	0xf8, 0x04, 0x66, 0xcf, 0xcc, 0x16, 0x5a, 0x4a, 0x42, 0x88, 0x37, 0x4d, 0xe7, 0x9c, 0xef, 0x3b,
-	0xe7, 0x9b, 0xb3, 0xdf, 0x19, 0xf0, 0x23, 0xae, 0x07, 0xd9, 0x99, 0x1b, 0x88, 0xa1, 0x17, 0x67,
-	0x01, 0xc7, 0x9f, 0xe7, 0x91, 0xf0, 0x62, 0x11, 0x85, 0x22, 0xf2, 0x68, 0xca, 0x3d, 0x96, 0x84,
-	0xa9, 0xe0, 0x89, 0x56, 0x5e, 0x20, 0x84, 0x0c, 0x79, 0x42, 0xb5, 0x90, 0x9e, 0x62, 0x72, 0xc4,
-	0x03, 0xa6, 0xbc, 0xd1, 0x8b, 0xe2, 0xbf, 0x9b, 0x4a, 0xa1, 0x05, 0x59, 0x32, 0xdc, 0xce, 0xc9,
-	0xff, 0xa9, 0xad, 0xa9, 0xb6, 0x95, 0x3b, 0x5b, 0x91, 0x10, 0x51, 0xcc, 0x3c, 0x3c, 0x9d, 0x65,
-	0xe7, 0x5e, 0x98, 0x49, 0xaa, 0xb9, 0x48, 0x6c, 0x7e, 0x63, 0x36, 0xcf, 0x86, 0xa9, 0x1e, 0x9b,
-	0x64, 0xf7, 0xb7, 0x03, 0xab, 0x6f, 0x99, 0x3e, 0x10, 0xc9, 0x39, 0x8f, 0x7c, 0xa6, 0x52, 0x91,
-	0x28, 0x46, 0xf6, 0x80, 0x04, 0x18, 0xe9, 0xdb, 0xa6, 0xfd, 0x4c, 0xc6, 0x2d, 0x67, 0xc7, 0xd9,
-	0xad, 0xfa, 0xf7, 0x4d, 0xa6, 0x67, 0x12, 0x9f, 0x65, 0x4c, 0x36, 0x01, 0x26, 0x68, 0xdd, 0x2a,
-	0x21, 0xaa, 0x5a, 0xa0, 0x34, 0x71, 0xe1, 0x41, 0x51, 0xc5, 0xc2, 0x52, 0xaa, 0x07, 0xad, 0x32,
-	0xe2, 0x56, 0x6d, 0xca, 0x08, 0x38, 0xa1, 0x7a, 0x90, 0xe3, 0x67, 0x9a, 0x0f, 0x84, 0xd2, 0xad,
-	0x05, 0x83, 0x9f, 0xea, 0xfe, 0x4e, 0x28, 0xdd, 0xfd, 0xe5, 0xc0, 0xba, 0xcf, 0x22, 0xae, 0x34,
-	0x93, 0x3d, 0x2d, 0x19, 0x1d, 0xfa, 0xec, 0x5b, 0xc6, 0x94, 0x26, 0x2d, 0xb8, 0x97, 0x4a, 0xf1,
-	0x85, 0x05, 0xda, 0x6a, 0x2f, 0x8e, 0xa4, 0x09, 0x4b, 0x8a, 0x05, 0xd2, 0xca, 0xad, 0xfb, 0xf6,
-	0x44, 0x1e, 0x43, 0x03, 0xe7, 0xd2, 0x1f, 0x31, 0xa9, 0xb8, 0x48, 0xac, 0xca, 0x3a, 0x06, 0x4f,
-	0x4d, 0x8c, 0x10, 0x58, 0x08, 0x99, 0x0a, 0x50, 0x51, 0xdd, 0xc7, 0xff, 0xe4, 0x09, 0x2c, 0x6b,
-	0x26, 0x87, 0x3c, 0xa1, 0x71, 0x9f, 0x27, 0x21, 0xfb, 0xde, 0x5a, 0xdc, 0x71, 0x76, 0xcb, 0x7e,
-	0xa3, 0x88, 0x1e, 0xe5, 0xc1, 0xee, 0x29, 0x34, 0x67, 0xa5, 0xda, 0x91, 0x2f, 0x43, 0x89, 0x87,
-	0x56, 0x66, 0x89, 0x87, 0x64, 0x0f, 0x16, 0xf1, 0x23, 0xa3, 0xc0, 0xda, 0x7e, 0xd3, 0x35, 0xfe,
-	0x70, 0x8f, 0x45, 0x64, 0x98, 0xbd, 0x3c, 0xeb, 0x1b, 0x50, 0xf7, 0x23, 0xac, 0x1e, 0x0b, 0x1a,
-	0xde, 0xf6, 0xfa, 0xa6, 0x59, 0x69, 0xd2, 0xac, 0xb8, 0x51, 0x7e, 0xdb, 0x8a, 0xb9, 0x51, 0xf7,
-	0xa7, 0x03, 0xe4, 0x6a, 0xcd, 0x89, 0x35, 0xac, 0x2e, 0xe7, 0x16, 0xba, 0x26, 0x85, 0x4b, 0x57,
-	0x46, 0xf5, 0x0c, 0xca, 0x34, 0x62, 0xd8, 0xab, 0xb6, 0xdf, 0x76, 0x8d, 0x3b, 0xdd, 0xc2, 0x9d,
-	0xee, 0x1b, 0xeb, 0x5e, 0x3f, 0x47, 0x91, 0x47, 0x50, 0xa7, 0x32, 0x18, 0xf0, 0x11, 0x8d, 0xfb,
-	0x5f, 0xd9, 0xd8, 0xce, 0xbc, 0x56, 0xc4, 0xde, 0xb3, 0x71, 0xf7, 0x87, 0x03, 0xcd, 0x4f, 0x66,
-	0xca, 0x9a, 0xdd, 0x75, 0x02, 0x97, 0x86, 0x28, 0x4f, 0x19, 0xe2, 0xfa, 0x77, 0x5d, 0x98, 0xf7,
-	0x5d, 0xff, 0x94, 0x60, 0xed, 0x35, 0x6a, 0xba, 0xb3, 0x82, 0xa7, 0xb0, 0x12, 0x8b, 0xa8, 0xcf,
-	0x12, 0x2d, 0xc7, 0xfd, 0x40, 0x64, 0x89, 0x91, 0x52, 0xf6, 0x1b, 0xb1, 0x88, 0x0e, 0xf3, 0xe8,
-	0x41, 0x1e, 0xbc, 0xa5, 0x22, 0xb2, 0x06, 0x8b, 0x4c, 0x4a, 0x21, 0xd1, 0x87, 0x55, 0xdf, 0x1c,
-	0xf2, 0x55, 0x55, 0xa8, 0x0f, 0x17, 0x1a, 0xcc, 0xaa, 0x9a, 0x48, 0xbe, 0xc9, 0xdb, 0x50, 0xb3,
-	0x69, 0xc5, 0x2f, 0x58, 0xab, 0x86, 0x85, 0x2d, 0xa3, 0xc7, 0x2f, 0x18, 0xd9, 0x80, 0x2a, 0xf6,
-	0x44, 0xfa, 0x1a, 0xd2, 0x2b, 0x18, 0xb0, 0xef, 0x80, 0x49, 0x22, 0x79, 0x1d, 0xc9, 0x06, 0x8e,
-	0xdc, 0x36, 0x54, 0x42, 0xaa, 0x29, 0x52, 0xb7, 0xcc, 0x2c, 0xf2, 0x73, 0xce, 0xdc, 0x80, 0x2a,
-	0xa6, 0x90, 0xb8, 0x8d, 0x44, 0xc4, 0xe6, 0xbc, 0xfd, 0xbf, 0x25, 0xa8, 0xd8, 0x7d, 0x57, 0xe4,
-	0x15, 0x54, 0x27, 0xcf, 0x15, 0x69, 0x5e, 0x33, 0xcf, 0x61, 0xfe, 0xb4, 0x75, 0xda, 0x85, 0x29,
-	0xaf, 0xbf, 0x6c, 0x1f, 0x60, 0x79, 0x7a, 0x01, 0xc9, 0x66, 0x01, 0x9e, 0xfb, 0x86, 0x74, 0xb6,
-	0x6e, 0x4a, 0xdb, 0x82, 0x07, 0x00, 0x97, 0x5b, 0x42, 0xda, 0x97, 0xeb, 0x30, 0xb3, 0x8d, 0x9d,
-	0xce, 0xbc, 0x94, 0x2d, 0x72, 0x04, 0x2b, 0x33, 0x0e, 0x26, 0x93, 0xbe, 0xf3, 0xad, 0xdd, 0xb9,
-	0xe1, 0xee, 0xe4, 0x10, 0x1a, 0x53, 0x46, 0x24, 0x0f, 0x8b, 0x42, 0xf3, 0xfc, 0x79, 0x53, 0x99,
-	0xb3, 0x25, 0x3c, 0xbf, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x56, 0xa5, 0xea, 0x47, 0x0b, 0x07,
-	0x00, 0x00,
+	// 814 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0x96, 0xe3, 0x4d, 0x48, 0x4e, 0x92, 0x6d, 0x33, 0x6c, 0x23, 0xc7, 0x4b, 0xb7, 0x21, 0x88,
+	0x2a, 0x88, 0x62, 0x8b, 0x70, 0x5f, 0x09, 0x2d, 0x0b, 0x54, 0x14, 0x51, 0x1c, 0xe8, 0x6d, 0xe4,
+	0xb5, 0x67, 0x9d, 0x01, 0xc7, 0x13, 0x66, 0xc6, 0x11, 0xe9, 0x1d, 0x8f, 0xc1, 0x0b, 0x20, 0xf1,
+	0x16, 0xf0, 0x00, 0xbc, 0x13, 0x9a, 0x33, 0xe3, 0x6c, 0xf3, 0xa7, 0xae, 0xaa, 0xde, 0x58, 0x3e,
+	0x3f, 0xdf, 0x39, 0xdf, 0x9c, 0x3f, 0x88, 0x32, 0xa6, 0xe6, 0xe5, 0x75, 0x90, 0xf0, 0x45, 0x98,
+	0x97, 0x09, 0xc3, 0xcf, 0x67, 0x19, 0x0f, 0x73, 0x9e, 0xa5, 0x3c, 0x0b, 0xe3, 0x25, 0x0b, 0x69,
+	0x91, 0x2e, 0x39, 0x2b, 0x94, 0x0c, 0x13, 0xce, 0x45, 0xca, 0x8a, 0x58, 0x71, 0x11, 0x4a, 0x2a,
+	0x56, 0x2c, 0xa1, 0x32, 0x5c, 0x7d, 0x5e, 0xfd, 0x07, 0x4b, 0xc1, 0x15, 0x27, 0x0d, 0x83, 0xf5,
+	0x5f, 0xbc, 0x9b, 0xd8, 0x2a, 0x56, 0x36, 0xb2, 0x7f, 0x91, 0x71, 0x9e, 0xe5, 0x34, 0x44, 0xe9,
+	0xba, 0xbc, 0x09, 0xd3, 0x52, 0xc4, 0x8a, 0xf1, 0xc2, 0xda, 0xcf, 0x77, 0xed, 0x74, 0xb1, 0x54,
+	0x6b, 0x63, 0x1c, 0xfd, 0xe3, 0x40, 0xef, 0x1b, 0xaa, 0x2e, 0x79, 0x71, 0xc3, 0xb2, 0x88, 0xca,
+	0x25, 0x2f, 0x24, 0x25, 0x4f, 0x80, 0x24, 0xa8, 0x99, 0xd9, 0xa4, 0xb3, 0x52, 0xe4, 0x9e, 0x33,
+	0x74, 0xc6, 0xad, 0xe8, 0xbe, 0xb1, 0x4c, 0x8d, 0xe1, 0x67, 0x91, 0x93, 0x87, 0x00, 0x1b, 0x6f,
+	0xe5, 0xd5, 0xd0, 0xab, 0x55, 0x79, 0x29, 0x12, 0xc0, 0xfb, 0x55, 0x14, 0xeb, 0xb6, 0x8c, 0xd5,
+	0xdc, 0x73, 0xd1, 0xaf, 0x67, 0x4d, 0x86, 0xc0, 0x8b, 0x58, 0xcd, 0xb5, 0xff, 0x4e, 0xf2, 0x39,
+	0x97, 0xca, 0x3b, 0x31, 0xfe, 0x5b, 0xd9, 0xbf, 0xe5, 0x52, 0x8d, 0xfe, 0x76, 0xe0, 0x41, 0x44,
+	0x33, 0x26, 0x15, 0x15, 0x53, 0x25, 0x68, 0xbc, 0x88, 0xe8, 0x6f, 0x25, 0x95, 0x8a, 0x78, 0xf0,
+	0xde, 0x52, 0xf0, 0x5f, 0x68, 0xa2, 0x2c, 0xf7, 0x4a, 0x24, 0x7d, 0x68, 0x48, 0x9a, 0x08, 0x4b,
+	0xb7, 0x13, 0x59, 0x89, 0x7c, 0x04, 0x5d, 0xac, 0xcb, 0x6c, 0x45, 0x85, 0x64, 0xbc, 0xb0, 0x2c,
+	0x3b, 0xa8, 0x7c, 0x69, 0x74, 0x84, 0xc0, 0x49, 0x4a, 0x65, 0x82, 0x8c, 0x3a, 0x11, 0xfe, 0x93,
+	0x8f, 0xe1, 0x54, 0x51, 0xb1, 0x60, 0x45, 0x9c, 0xcf, 0x58, 0x91, 0xd2, 0xdf, 0xbd, 0xfa, 0xd0,
+	0x19, 0xbb, 0x51, 0xb7, 0xd2, 0x3e, 0xd3, 0xca, 0xd1, 0x4b, 0xe8, 0xef, 0x52, 0xb5, 0x25, 0x3f,
+	0x85, 0x1a, 0x4b, 0x2d, 0xcd, 0x1a, 0x4b, 0xc9, 0x13, 0xa8, 0x63, 0x93, 0x91, 0x60, 0x7b, 0xd2,
+	0x0f, 0xcc, 0x7c, 0x04, 0xcf, 0x79, 0x66, 0x90, 0x53, 0x6d, 0x8d, 0x8c, 0xd3, 0xe8, 0x47, 0xe8,
+	0x3d, 0xe7, 0x71, 0x7a, 0xd7, 0xe7, 0x9b, 0x64, 0xb5, 0x4d, 0xb2, 0xea, 0x45, 0xfa, 0xb5, 0x4d,
+	0xf3, 0xa2, 0xd1, 0x5f, 0x0e, 0x90, 0xd7, 0x63, 0x6e, 0x46, 0xc3, 0xf2, 0x72, 0xee, 0xc0, 0x6b,
+	0x13, 0xb8, 0xf6, 0x5a, 0xa9, 0x3e, 0x05, 0x37, 0xce, 0x28, 0xe6, 0x6a, 0x4f, 0x06, 0x81, 0x99,
+	0xce, 0xa0, 0x9a, 0xce, 0xe0, 0x2b, 0x3b, 0xbd, 0x91, 0xf6, 0x22, 0x1f, 0x42, 0x27, 0x16, 0xc9,
+	0x9c, 0xad, 0xe2, 0x7c, 0xf6, 0x2b, 0x5d, 0xdb, 0x9a, 0xb7, 0x2b, 0xdd, 0x77, 0x74, 0x3d, 0xfa,
+	0xc3, 0x81, 0xfe, 0x4f, 0xa6, 0xca, 0x8a, 0xbe, 0x6d, 0x05, 0x6e, 0x07, 0xc2, 0xdd, 0x1a, 0x88,
+	0xfd, 0xbe, 0x9e, 0x1c, 0xea, 0xeb, 0x9f, 0x2e, 0x9c, 0x7d, 0x89, 0x9c, 0xde, 0x9a, 0xc1, 0x63,
+	0xb8, 0x97, 0xf3, 0x6c, 0x46, 0x0b, 0x25, 0xd6, 0xb3, 0x84, 0x97, 0x85, 0xa1, 0xe2, 0x46, 0xdd,
+	0x9c, 0x67, 0x57, 0x5a, 0x7b, 0xa9, 0x95, 0x77, 0x64, 0x44, 0xce, 0xa0, 0x4e, 0x85, 0xe0, 0x02,
+	0xe7, 0xb0, 0x15, 0x19, 0x41, 0xaf, 0xaa, 0x44, 0x7e, 0xb8, 0xd0, 0x60, 0x56, 0xd5, 0x68, 0xf4,
+	0x26, 0x3f, 0x82, 0xb6, 0x35, 0x4b, 0xf6, 0x8a, 0x7a, 0x6d, 0x0c, 0x6c, 0x11, 0x53, 0xf6, 0x8a,
+	0x92, 0x73, 0x68, 0x61, 0x4e, 0x84, 0x9f, 0x21, 0xbc, 0x89, 0x0a, 0x7b, 0x07, 0x8c, 0x11, 0xc1,
+	0x0f, 0x10, 0x6c, 0xdc, 0x11, 0x3b, 0x80, 0x66, 0x1a, 0xab, 0x18, 0xa1, 0x17, 0xa6, 0x16, 0x5a,
+	0xd6, 0xc8, 0x73, 0x68, 0xa1, 0x09, 0x81, 0x8f, 0x10, 0x88, 0xbe, 0x88, 0xd3, 0x9c, 0xa9, 0x6e,
+	0x38, 0x22, 0xc7, 0x96, 0x33, 0x6a, 0x2a, 0xce, 0xc6, 0x8c, 0xe8, 0x4f, 0x2c, 0x67, 0x54, 0x69,
+	0xfc, 0xe8, 0x3f, 0x07, 0x3c, 0xac, 0x89, 0xe9, 0xcc, 0xd7, 0x8c, 0xe6, 0xa9, 0x7c, 0x77, 0x13,
+	0xf2, 0x14, 0x1a, 0x37, 0x18, 0xd2, 0x3b, 0x19, 0xba, 0xe3, 0xf6, 0xe4, 0x71, 0xb5, 0x11, 0xc7,
+	0x72, 0x06, 0x28, 0x45, 0x16, 0xe5, 0x87, 0x50, 0x47, 0x05, 0xb9, 0x0f, 0xae, 0x9e, 0x70, 0x43,
+	0x43, 0xff, 0xea, 0x1e, 0xae, 0xe2, 0xbc, 0xa4, 0x96, 0x85, 0x11, 0x26, 0xff, 0xba, 0xd0, 0xb4,
+	0xf7, 0x4f, 0x92, 0xa7, 0xd0, 0xda, 0x9c, 0x6f, 0xd2, 0xdf, 0x5b, 0xa6, 0x2b, 0x7d, 0xea, 0xfd,
+	0x41, 0x45, 0x69, 0xff, 0xd2, 0xff, 0x00, 0xa7, 0xdb, 0x07, 0x89, 0x3c, 0xac, 0x9c, 0x0f, 0xde,
+	0x54, 0xff, 0xe2, 0x98, 0xd9, 0x06, 0xbc, 0x04, 0xb8, 0xbd, 0x1a, 0x64, 0x70, 0x7b, 0x1e, 0x76,
+	0xae, 0x93, 0xef, 0x1f, 0x32, 0xd9, 0x20, 0xcf, 0xe0, 0xde, 0xce, 0x46, 0x93, 0x4d, 0xde, 0xc3,
+	0xab, 0xee, 0x1f, 0x79, 0x3b, 0xf9, 0x1e, 0x7a, 0x7b, 0x8d, 0x20, 0xc3, 0x37, 0xf5, 0xe8, 0x68,
+	0xb8, 0x2b, 0xe8, 0x6e, 0xed, 0x39, 0xf9, 0xa0, 0x0a, 0x75, 0x68, 0xfd, 0x8f, 0x85, 0xb9, 0x6e,
+	0xa0, 0xfc, 0xc5, 0xff, 0x03, 0x00, 0x72, 0x82, 0x62, 0x54, 0x6a, 0x08, 0x00, 0x00,
 }
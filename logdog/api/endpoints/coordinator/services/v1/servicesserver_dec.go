@@ -84,6 +84,21 @@ func (s *DecoratedServices) TerminateStream(c context.Context, req *TerminateStr
 	return
 }
 
+func (s *DecoratedServices) IndexStreamFields(c context.Context, req *IndexStreamFieldsRequest) (rsp *google_protobuf1.Empty, err error) {
+	var newCtx context.Context
+	if s.Prelude != nil {
+		newCtx, err = s.Prelude(c, "IndexStreamFields", req)
+	}
+	if err == nil {
+		c = newCtx
+		rsp, err = s.Service.IndexStreamFields(c, req)
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(c, "IndexStreamFields", rsp, err)
+	}
+	return
+}
+
 func (s *DecoratedServices) ArchiveStream(c context.Context, req *ArchiveStreamRequest) (rsp *google_protobuf1.Empty, err error) {
 	var newCtx context.Context
 	if s.Prelude != nil {
@@ -13,6 +13,9 @@ func (ar *LoadStreamRequest) GetMessageProject() string { return ar.Project }
 // GetMessageProject implements ProjectBoundMessage.
 func (ar *TerminateStreamRequest) GetMessageProject() string { return ar.Project }
 
+// GetMessageProject implements ProjectBoundMessage.
+func (ar *IndexStreamFieldsRequest) GetMessageProject() string { return ar.Project }
+
 // GetMessageProject implements ProjectBoundMessage.
 func (ar *ArchiveStreamRequest) GetMessageProject() string { return ar.Project }
 
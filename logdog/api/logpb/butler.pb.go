@@ -16,8 +16,10 @@ It has these top-level messages:
 	Text
 	Binary
 	Datagram
+	Structured
 	LogEntry
 	LogIndex
+	LogSearchIndex
 */
 package logpb
 
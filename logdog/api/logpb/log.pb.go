@@ -19,20 +19,23 @@ var _ = math.Inf
 type StreamType int32
 
 const (
-	StreamType_TEXT     StreamType = 0
-	StreamType_BINARY   StreamType = 1
-	StreamType_DATAGRAM StreamType = 2
+	StreamType_TEXT       StreamType = 0
+	StreamType_BINARY     StreamType = 1
+	StreamType_DATAGRAM   StreamType = 2
+	StreamType_STRUCTURED StreamType = 3
 )
 
 var StreamType_name = map[int32]string{
 	0: "TEXT",
 	1: "BINARY",
 	2: "DATAGRAM",
+	3: "STRUCTURED",
 }
 var StreamType_value = map[string]int32{
-	"TEXT":     0,
-	"BINARY":   1,
-	"DATAGRAM": 2,
+	"TEXT":       0,
+	"BINARY":     1,
+	"DATAGRAM":   2,
+	"STRUCTURED": 3,
 }
 
 func (x StreamType) String() string {
@@ -85,6 +88,13 @@ type LogStreamDescriptor struct {
 	// If set, the stream will be joined together during archival to recreate the
 	// original stream and made available at <prefix>/+/<name>.ext.
 	BinaryFileExt string `protobuf:"bytes,7,opt,name=binary_file_ext,json=binaryFileExt" json:"binary_file_ext,omitempty"`
+	//
+	// The keys of the fields of a STRUCTURED stream that are indexed.
+	//
+	// LogDog clients can query for log streams based on the values that these
+	// fields take in the stream's log entries. Only STRUCTURED streams may
+	// declare indexed fields.
+	IndexedFields []string `protobuf:"bytes,8,rep,name=indexed_fields,json=indexedFields" json:"indexed_fields,omitempty"`
 }
 
 func (m *LogStreamDescriptor) Reset()                    { *m = LogStreamDescriptor{} }
@@ -141,6 +151,13 @@ func (m *LogStreamDescriptor) GetBinaryFileExt() string {
 	return ""
 }
 
+func (m *LogStreamDescriptor) GetIndexedFields() []string {
+	if m != nil {
+		return m.IndexedFields
+	}
+	return nil
+}
+
 // Text stream content.
 type Text struct {
 	Lines []*Text_Line `protobuf:"bytes,1,rep,name=lines" json:"lines,omitempty"`
@@ -282,6 +299,251 @@ func (m *Datagram_Partial) GetLast() bool {
 	return false
 }
 
+// Structured stream content.
+type Structured struct {
+	Records []*Structured_Record `protobuf:"bytes,1,rep,name=records" json:"records,omitempty"`
+}
+
+func (m *Structured) Reset()                    { *m = Structured{} }
+func (m *Structured) String() string            { return proto.CompactTextString(m) }
+func (*Structured) ProtoMessage()               {}
+func (*Structured) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
+
+func (m *Structured) GetRecords() []*Structured_Record {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+// A single typed key/value field.
+type Structured_Field struct {
+	//
+	// The field's key.
+	//
+	// The keys of nested object fields are joined to their parents' keys with
+	// ".".
+	Key string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	// The field's value.
+	//
+	// Types that are valid to be assigned to Value:
+	//	*Structured_Field_StringValue
+	//	*Structured_Field_IntValue
+	//	*Structured_Field_DoubleValue
+	//	*Structured_Field_BoolValue
+	//	*Structured_Field_JsonValue
+	Value isStructured_Field_Value `protobuf_oneof:"value"`
+}
+
+func (m *Structured_Field) Reset()                    { *m = Structured_Field{} }
+func (m *Structured_Field) String() string            { return proto.CompactTextString(m) }
+func (*Structured_Field) ProtoMessage()               {}
+func (*Structured_Field) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4, 0} }
+
+type isStructured_Field_Value interface {
+	isStructured_Field_Value()
+}
+
+type Structured_Field_StringValue struct {
+	StringValue string `protobuf:"bytes,2,opt,name=string_value,json=stringValue,oneof"`
+}
+type Structured_Field_IntValue struct {
+	IntValue int64 `protobuf:"varint,3,opt,name=int_value,json=intValue,oneof"`
+}
+type Structured_Field_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,4,opt,name=double_value,json=doubleValue,oneof"`
+}
+type Structured_Field_BoolValue struct {
+	BoolValue bool `protobuf:"varint,5,opt,name=bool_value,json=boolValue,oneof"`
+}
+type Structured_Field_JsonValue struct {
+	JsonValue string `protobuf:"bytes,6,opt,name=json_value,json=jsonValue,oneof"`
+}
+
+func (*Structured_Field_StringValue) isStructured_Field_Value() {}
+func (*Structured_Field_IntValue) isStructured_Field_Value()    {}
+func (*Structured_Field_DoubleValue) isStructured_Field_Value() {}
+func (*Structured_Field_BoolValue) isStructured_Field_Value()   {}
+func (*Structured_Field_JsonValue) isStructured_Field_Value()   {}
+
+func (m *Structured_Field) GetValue() isStructured_Field_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Structured_Field) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Structured_Field) GetStringValue() string {
+	if x, ok := m.GetValue().(*Structured_Field_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (m *Structured_Field) GetIntValue() int64 {
+	if x, ok := m.GetValue().(*Structured_Field_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (m *Structured_Field) GetDoubleValue() float64 {
+	if x, ok := m.GetValue().(*Structured_Field_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (m *Structured_Field) GetBoolValue() bool {
+	if x, ok := m.GetValue().(*Structured_Field_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (m *Structured_Field) GetJsonValue() string {
+	if x, ok := m.GetValue().(*Structured_Field_JsonValue); ok {
+		return x.JsonValue
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*Structured_Field) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _Structured_Field_OneofMarshaler, _Structured_Field_OneofUnmarshaler, _Structured_Field_OneofSizer, []interface{}{
+		(*Structured_Field_StringValue)(nil),
+		(*Structured_Field_IntValue)(nil),
+		(*Structured_Field_DoubleValue)(nil),
+		(*Structured_Field_BoolValue)(nil),
+		(*Structured_Field_JsonValue)(nil),
+	}
+}
+
+func _Structured_Field_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*Structured_Field)
+	// value
+	switch x := m.Value.(type) {
+	case *Structured_Field_StringValue:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.StringValue)
+	case *Structured_Field_IntValue:
+		b.EncodeVarint(3<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.IntValue))
+	case *Structured_Field_DoubleValue:
+		b.EncodeVarint(4<<3 | proto.WireFixed64)
+		b.EncodeFixed64(math.Float64bits(x.DoubleValue))
+	case *Structured_Field_BoolValue:
+		t := uint64(0)
+		if x.BoolValue {
+			t = 1
+		}
+		b.EncodeVarint(5<<3 | proto.WireVarint)
+		b.EncodeVarint(t)
+	case *Structured_Field_JsonValue:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.JsonValue)
+	case nil:
+	default:
+		return fmt.Errorf("Structured_Field.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _Structured_Field_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*Structured_Field)
+	switch tag {
+	case 2: // value.string_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &Structured_Field_StringValue{x}
+		return true, err
+	case 3: // value.int_value
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &Structured_Field_IntValue{int64(x)}
+		return true, err
+	case 4: // value.double_value
+		if wire != proto.WireFixed64 {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeFixed64()
+		m.Value = &Structured_Field_DoubleValue{math.Float64frombits(x)}
+		return true, err
+	case 5: // value.bool_value
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &Structured_Field_BoolValue{x != 0}
+		return true, err
+	case 6: // value.json_value
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &Structured_Field_JsonValue{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Structured_Field_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Structured_Field)
+	// value
+	switch x := m.Value.(type) {
+	case *Structured_Field_StringValue:
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.StringValue)))
+		n += len(x.StringValue)
+	case *Structured_Field_IntValue:
+		n += proto.SizeVarint(3<<3 | proto.WireVarint)
+		n += proto.SizeVarint(uint64(x.IntValue))
+	case *Structured_Field_DoubleValue:
+		n += proto.SizeVarint(4<<3 | proto.WireFixed64)
+		n += 8
+	case *Structured_Field_BoolValue:
+		n += proto.SizeVarint(5<<3 | proto.WireVarint)
+		n += 1
+	case *Structured_Field_JsonValue:
+		n += proto.SizeVarint(6<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.JsonValue)))
+		n += len(x.JsonValue)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// A single structured record, composed of ordered fields.
+type Structured_Record struct {
+	Fields []*Structured_Field `protobuf:"bytes,1,rep,name=fields" json:"fields,omitempty"`
+}
+
+func (m *Structured_Record) Reset()                    { *m = Structured_Record{} }
+func (m *Structured_Record) String() string            { return proto.CompactTextString(m) }
+func (*Structured_Record) ProtoMessage()               {}
+func (*Structured_Record) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4, 1} }
+
+func (m *Structured_Record) GetFields() []*Structured_Field {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
 // *
 // An individual log entry.
 //
@@ -315,6 +577,8 @@ type LogEntry struct {
 	// Binary: This is the byte offset of the first byte in the included data.
 	// Datagram: This is the index of the datagram. The first datagram has index
 	//     zero.
+	// Structured: This is the index of the first included record. Record indices
+	//     begin at zero.
 	Sequence uint64 `protobuf:"varint,4,opt,name=sequence" json:"sequence,omitempty"`
 	//
 	// The content of the message. The field that is populated here must
@@ -324,13 +588,14 @@ type LogEntry struct {
 	//	*LogEntry_Text
 	//	*LogEntry_Binary
 	//	*LogEntry_Datagram
+	//	*LogEntry_Structured
 	Content isLogEntry_Content `protobuf_oneof:"content"`
 }
 
 func (m *LogEntry) Reset()                    { *m = LogEntry{} }
 func (m *LogEntry) String() string            { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()               {}
-func (*LogEntry) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
+func (*LogEntry) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
 
 type isLogEntry_Content interface {
 	isLogEntry_Content()
@@ -345,10 +610,14 @@ type LogEntry_Binary struct {
 type LogEntry_Datagram struct {
 	Datagram *Datagram `protobuf:"bytes,12,opt,name=datagram,oneof"`
 }
+type LogEntry_Structured struct {
+	Structured *Structured `protobuf:"bytes,13,opt,name=structured,oneof"`
+}
 
-func (*LogEntry_Text) isLogEntry_Content()     {}
-func (*LogEntry_Binary) isLogEntry_Content()   {}
-func (*LogEntry_Datagram) isLogEntry_Content() {}
+func (*LogEntry_Text) isLogEntry_Content()       {}
+func (*LogEntry_Binary) isLogEntry_Content()     {}
+func (*LogEntry_Datagram) isLogEntry_Content()   {}
+func (*LogEntry_Structured) isLogEntry_Content() {}
 
 func (m *LogEntry) GetContent() isLogEntry_Content {
 	if m != nil {
@@ -406,12 +675,20 @@ func (m *LogEntry) GetDatagram() *Datagram {
 	return nil
 }
 
+func (m *LogEntry) GetStructured() *Structured {
+	if x, ok := m.GetContent().(*LogEntry_Structured); ok {
+		return x.Structured
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*LogEntry) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _LogEntry_OneofMarshaler, _LogEntry_OneofUnmarshaler, _LogEntry_OneofSizer, []interface{}{
 		(*LogEntry_Text)(nil),
 		(*LogEntry_Binary)(nil),
 		(*LogEntry_Datagram)(nil),
+		(*LogEntry_Structured)(nil),
 	}
 }
 
@@ -434,6 +711,11 @@ func _LogEntry_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Datagram); err != nil {
 			return err
 		}
+	case *LogEntry_Structured:
+		b.EncodeVarint(13<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Structured); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("LogEntry.Content has unexpected type %T", x)
@@ -468,6 +750,14 @@ func _LogEntry_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Content = &LogEntry_Datagram{msg}
 		return true, err
+	case 13: // content.structured
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Structured)
+		err := b.DecodeMessage(msg)
+		m.Content = &LogEntry_Structured{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -492,6 +782,11 @@ func _LogEntry_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(12<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *LogEntry_Structured:
+		s := proto.Size(x.Structured)
+		n += proto.SizeVarint(13<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -547,7 +842,7 @@ type LogIndex struct {
 func (m *LogIndex) Reset()                    { *m = LogIndex{} }
 func (m *LogIndex) String() string            { return proto.CompactTextString(m) }
 func (*LogIndex) ProtoMessage()               {}
-func (*LogIndex) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
+func (*LogIndex) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
 
 func (m *LogIndex) GetDesc() *LogStreamDescriptor {
 	if m != nil {
@@ -627,7 +922,7 @@ type LogIndex_Entry struct {
 func (m *LogIndex_Entry) Reset()                    { *m = LogIndex_Entry{} }
 func (m *LogIndex_Entry) String() string            { return proto.CompactTextString(m) }
 func (*LogIndex_Entry) ProtoMessage()               {}
-func (*LogIndex_Entry) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5, 0} }
+func (*LogIndex_Entry) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6, 0} }
 
 func (m *LogIndex_Entry) GetOffset() uint64 {
 	if m != nil {
@@ -664,6 +959,93 @@ func (m *LogIndex_Entry) GetTimeOffset() *google_protobuf1.Duration {
 	return nil
 }
 
+// *
+// LogSearchIndex is an inverted index of the terms in a TEXT log stream.
+//
+// The search index is generated by the Archivist during archival alongside the
+// log stream and LogIndex. Each line in the stream is assigned an ordinal,
+// beginning at zero, in stream order. The index maps each term to the ordinals
+// of the lines that contain it.
+type LogSearchIndex struct {
+	//
+	// The indexed terms, sorted by their text.
+	Terms []*LogSearchIndex_Term `protobuf:"bytes,1,rep,name=terms" json:"terms,omitempty"`
+	//
+	// The stream index of each LogEntry in the stream, in ascending order.
+	StreamIndex []uint64 `protobuf:"varint,2,rep,packed,name=stream_index,json=streamIndex" json:"stream_index,omitempty"`
+	//
+	// The number of lines in each LogEntry, parallel to "stream_index".
+	//
+	// Line ordinals are assigned to each LogEntry's lines in order, so this can
+	// be used to map a line ordinal to its LogEntry and line index.
+	LineCount []uint32 `protobuf:"varint,3,rep,packed,name=line_count,json=lineCount" json:"line_count,omitempty"`
+}
+
+func (m *LogSearchIndex) Reset()                    { *m = LogSearchIndex{} }
+func (m *LogSearchIndex) String() string            { return proto.CompactTextString(m) }
+func (*LogSearchIndex) ProtoMessage()               {}
+func (*LogSearchIndex) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+
+func (m *LogSearchIndex) GetTerms() []*LogSearchIndex_Term {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+func (m *LogSearchIndex) GetStreamIndex() []uint64 {
+	if m != nil {
+		return m.StreamIndex
+	}
+	return nil
+}
+
+func (m *LogSearchIndex) GetLineCount() []uint32 {
+	if m != nil {
+		return m.LineCount
+	}
+	return nil
+}
+
+//
+// Term is a single indexed term.
+type LogSearchIndex_Term struct {
+	//
+	// The term's text. Terms are lower-cased runs of letters, digits, and
+	// underscores.
+	//
+	// If the stream has too many distinct terms to index, the lines containing
+	// the terms that were left out are listed under an empty term, and are
+	// candidates for every search.
+	Text string `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"`
+	//
+	// The ordinals of the lines that contain this term.
+	//
+	// The ordinals are ascending and delta-encoded: the first value is the
+	// ordinal of the first line, and each successive value is the difference
+	// between its line's ordinal and the previous line's ordinal.
+	Lines []uint32 `protobuf:"varint,2,rep,packed,name=lines" json:"lines,omitempty"`
+}
+
+func (m *LogSearchIndex_Term) Reset()                    { *m = LogSearchIndex_Term{} }
+func (m *LogSearchIndex_Term) String() string            { return proto.CompactTextString(m) }
+func (*LogSearchIndex_Term) ProtoMessage()               {}
+func (*LogSearchIndex_Term) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7, 0} }
+
+func (m *LogSearchIndex_Term) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *LogSearchIndex_Term) GetLines() []uint32 {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LogStreamDescriptor)(nil), "logpb.LogStreamDescriptor")
 	proto.RegisterType((*Text)(nil), "logpb.Text")
@@ -671,63 +1053,85 @@ func init() {
 	proto.RegisterType((*Binary)(nil), "logpb.Binary")
 	proto.RegisterType((*Datagram)(nil), "logpb.Datagram")
 	proto.RegisterType((*Datagram_Partial)(nil), "logpb.Datagram.Partial")
+	proto.RegisterType((*Structured)(nil), "logpb.Structured")
+	proto.RegisterType((*Structured_Field)(nil), "logpb.Structured.Field")
+	proto.RegisterType((*Structured_Record)(nil), "logpb.Structured.Record")
 	proto.RegisterType((*LogEntry)(nil), "logpb.LogEntry")
 	proto.RegisterType((*LogIndex)(nil), "logpb.LogIndex")
 	proto.RegisterType((*LogIndex_Entry)(nil), "logpb.LogIndex.Entry")
+	proto.RegisterType((*LogSearchIndex)(nil), "logpb.LogSearchIndex")
+	proto.RegisterType((*LogSearchIndex_Term)(nil), "logpb.LogSearchIndex.Term")
 	proto.RegisterEnum("logpb.StreamType", StreamType_name, StreamType_value)
 }
 
 func init() { proto.RegisterFile("github.com/luci/luci-go/logdog/api/logpb/log.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 782 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x54, 0xdb, 0x6e, 0xdb, 0x46,
-	0x10, 0x15, 0x25, 0xea, 0x36, 0x94, 0x2a, 0x79, 0x7b, 0x63, 0x89, 0xa2, 0x95, 0x84, 0xc2, 0x15,
-	0x0c, 0x98, 0x6a, 0xd5, 0x02, 0x31, 0xfc, 0x26, 0x47, 0x8e, 0x6d, 0xc0, 0x49, 0x8c, 0xb5, 0x1e,
-	0x92, 0x27, 0x62, 0x25, 0xad, 0x98, 0x4d, 0x28, 0x2e, 0x43, 0xae, 0x02, 0x29, 0x9f, 0x92, 0x5f,
-	0x08, 0x90, 0x3f, 0xc8, 0x27, 0xe5, 0x1f, 0x82, 0xbd, 0xe8, 0xe2, 0x5b, 0x80, 0xbc, 0x10, 0xb3,
-	0x33, 0x67, 0x66, 0x67, 0xce, 0x9c, 0x25, 0xf4, 0x43, 0x26, 0x5e, 0x2d, 0xc6, 0xfe, 0x84, 0xcf,
-	0x7b, 0xd1, 0x62, 0xc2, 0xd4, 0xe7, 0x30, 0xe4, 0xbd, 0x88, 0x87, 0x53, 0x1e, 0xf6, 0x48, 0xc2,
-	0xa4, 0x99, 0x8c, 0xe5, 0xd7, 0x4f, 0x52, 0x2e, 0x38, 0x2a, 0x2a, 0x87, 0xf7, 0x67, 0xc8, 0x79,
-	0x18, 0xd1, 0x9e, 0x72, 0x8e, 0x17, 0xb3, 0x9e, 0x60, 0x73, 0x9a, 0x09, 0x32, 0x4f, 0x34, 0xce,
-	0xfb, 0xe3, 0x36, 0x60, 0xba, 0x48, 0x89, 0x60, 0x3c, 0xd6, 0xf1, 0xce, 0x97, 0x3c, 0xfc, 0x78,
-	0xc9, 0xc3, 0x6b, 0x91, 0x52, 0x32, 0x1f, 0xd2, 0x6c, 0x92, 0xb2, 0x44, 0xf0, 0x14, 0xfd, 0x02,
-	0xa5, 0x24, 0xa5, 0x33, 0xb6, 0x74, 0xad, 0x96, 0xd5, 0xad, 0x62, 0x73, 0x42, 0x08, 0xec, 0x98,
-	0xcc, 0xa9, 0x9b, 0x57, 0x5e, 0x65, 0xa3, 0x3e, 0x38, 0x99, 0xca, 0x0f, 0xc4, 0x2a, 0xa1, 0x6e,
-	0xa1, 0x65, 0x75, 0x7f, 0xe8, 0xef, 0xf9, 0xaa, 0x43, 0x5f, 0x57, 0x1e, 0xad, 0x12, 0x8a, 0x21,
-	0xdb, 0xd8, 0xa8, 0x0d, 0xb5, 0x09, 0x8f, 0x05, 0x8d, 0x85, 0x4e, 0xb2, 0x55, 0x3d, 0xc7, 0xf8,
-	0x14, 0xe4, 0x08, 0xaa, 0x9b, 0x69, 0xdc, 0x62, 0xcb, 0xea, 0x3a, 0x7d, 0xcf, 0xd7, 0xe3, 0xf8,
-	0xeb, 0x71, 0xfc, 0xd1, 0x1a, 0x81, 0xb7, 0x60, 0x74, 0x04, 0xb6, 0x20, 0x61, 0xe6, 0x96, 0x5a,
-	0x85, 0xae, 0xd3, 0xff, 0xcb, 0x74, 0x72, 0xcf, 0x98, 0xfe, 0x88, 0x84, 0xd9, 0x69, 0x2c, 0xd2,
-	0x15, 0x56, 0x19, 0x68, 0x1f, 0x1a, 0x63, 0x16, 0x93, 0x74, 0x15, 0xcc, 0x58, 0x44, 0x03, 0xba,
-	0x14, 0x6e, 0x59, 0x75, 0x56, 0xd7, 0xee, 0x27, 0x2c, 0xa2, 0xa7, 0x4b, 0xe1, 0x3d, 0x82, 0xea,
-	0x26, 0x15, 0x35, 0xa1, 0xf0, 0x86, 0xae, 0x0c, 0x51, 0xd2, 0x44, 0x3f, 0x41, 0xf1, 0x1d, 0x89,
-	0x16, 0x6b, 0x9a, 0xf4, 0xe1, 0x38, 0x7f, 0x64, 0x75, 0x5e, 0x83, 0x3d, 0xa2, 0x4b, 0x81, 0xf6,
-	0xa1, 0x18, 0xb1, 0x98, 0x66, 0xae, 0xa5, 0x7a, 0x6c, 0x9a, 0x1e, 0x65, 0xcc, 0xbf, 0x64, 0x31,
-	0xc5, 0x3a, 0xec, 0x1d, 0x83, 0x2d, 0x8f, 0xdb, 0x8a, 0xd6, 0x4e, 0x45, 0xf4, 0x3b, 0x54, 0xa7,
-	0x34, 0x62, 0x73, 0x26, 0x68, 0x6a, 0xee, 0xda, 0x3a, 0x3a, 0xff, 0x43, 0xe9, 0x44, 0x75, 0x2d,
-	0xb7, 0xc9, 0x67, 0xb3, 0x8c, 0x0a, 0x95, 0x6e, 0x63, 0x73, 0x92, 0xdb, 0x9c, 0x12, 0x41, 0x54,
-	0x6a, 0x0d, 0x2b, 0xbb, 0xf3, 0xc1, 0x82, 0xca, 0x90, 0x08, 0x12, 0xa6, 0x64, 0xbe, 0x01, 0x58,
-	0x5b, 0x00, 0xfa, 0x17, 0xca, 0x09, 0x49, 0x05, 0x23, 0x91, 0xca, 0x73, 0xfa, 0xbf, 0x9a, 0xe6,
-	0xd7, 0x59, 0xfe, 0x95, 0x0e, 0xe3, 0x35, 0xce, 0x3b, 0x83, 0xb2, 0xf1, 0xc9, 0x41, 0x58, 0x3c,
-	0xa5, 0x5a, 0x57, 0x75, 0xac, 0x0f, 0xf2, 0x9e, 0x8c, 0xbd, 0xd7, 0x7c, 0xd9, 0x58, 0xd9, 0xd2,
-	0x17, 0x91, 0x4c, 0x28, 0x3d, 0x55, 0xb0, 0xb2, 0x3b, 0x9f, 0xf2, 0x50, 0xb9, 0xe4, 0xa1, 0xe6,
-	0xfd, 0x18, 0x1c, 0xb9, 0xf3, 0x60, 0x67, 0x34, 0xa7, 0xff, 0xdb, 0x1d, 0x89, 0x0c, 0x8d, 0xe2,
-	0x31, 0x48, 0xf4, 0x73, 0x3d, 0x79, 0x1b, 0x6a, 0x5a, 0xd1, 0x81, 0xee, 0x46, 0x5f, 0xec, 0x68,
-	0xdf, 0x85, 0xea, 0xa9, 0x0d, 0x35, 0x23, 0x6b, 0x0d, 0x29, 0x68, 0x88, 0xf6, 0x69, 0x88, 0x07,
-	0x95, 0x8c, 0xbe, 0x5d, 0xd0, 0x78, 0xa2, 0x15, 0x6c, 0xe3, 0xcd, 0x19, 0xb5, 0xc1, 0x16, 0x52,
-	0x3f, 0xa0, 0xda, 0x72, 0x76, 0x16, 0x7c, 0x9e, 0xc3, 0x2a, 0x84, 0xfe, 0x86, 0x92, 0x96, 0x95,
-	0xeb, 0x28, 0x50, 0xdd, 0x80, 0xf4, 0xd6, 0xce, 0x73, 0xd8, 0x84, 0xd1, 0x21, 0x54, 0xa6, 0x86,
-	0x5c, 0xb7, 0xa6, 0xa0, 0x8d, 0x5b, 0x9c, 0x9f, 0xe7, 0xf0, 0x06, 0x72, 0x52, 0x85, 0xb2, 0x79,
-	0x48, 0x9d, 0x8f, 0x05, 0x45, 0x98, 0x6e, 0xd7, 0x07, 0x7b, 0x4a, 0xb3, 0x89, 0x61, 0xca, 0x7b,
-	0xf8, 0x5d, 0x60, 0x85, 0x43, 0x3d, 0x28, 0xd3, 0x58, 0xa4, 0x8c, 0x66, 0x6e, 0x5e, 0xc9, 0xf4,
-	0xe7, 0x6d, 0x8a, 0xaa, 0xe8, 0xeb, 0xb7, 0xb3, 0x46, 0xa1, 0x03, 0xd8, 0x93, 0x6b, 0x0a, 0x6e,
-	0x50, 0xab, 0x79, 0x6b, 0xc8, 0xc0, 0xd5, 0x0e, 0xbd, 0x6b, 0xec, 0x0d, 0x8e, 0xed, 0x2d, 0xf6,
-	0x7a, 0x87, 0xe7, 0x7d, 0x68, 0x44, 0x3c, 0x0c, 0xe4, 0x35, 0xab, 0x60, 0xc2, 0x17, 0xb1, 0x50,
-	0x3f, 0x04, 0x1b, 0xd7, 0x23, 0x23, 0x86, 0xc7, 0xd2, 0xe9, 0x7d, 0xb6, 0xa0, 0xa8, 0xb5, 0xf1,
-	0x90, 0xe2, 0x77, 0x37, 0x96, 0xbf, 0xb3, 0xb1, 0xda, 0x3d, 0x8d, 0x7f, 0x53, 0x13, 0xf6, 0x5d,
-	0x4d, 0xdc, 0x52, 0x65, 0xf1, 0x3b, 0x54, 0x79, 0xf0, 0x0f, 0xc0, 0xf6, 0x7f, 0x89, 0x2a, 0x60,
-	0x8f, 0x4e, 0x5f, 0x8c, 0x9a, 0x39, 0x04, 0x50, 0x3a, 0xb9, 0x78, 0x36, 0xc0, 0x2f, 0x9b, 0x16,
-	0xaa, 0x41, 0x65, 0x38, 0x18, 0x0d, 0xce, 0xf0, 0xe0, 0x69, 0x33, 0x3f, 0x2e, 0xa9, 0x82, 0xff,
-	0x7d, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x12, 0x93, 0xb0, 0x44, 0x06, 0x00, 0x00,
+	// 1055 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0x8e, 0x63, 0xe7, 0xef, 0x38, 0xd9, 0xcd, 0x0e, 0x3f, 0x35, 0x16, 0xa5, 0xd9, 0x14, 0x96,
+	0x55, 0xa5, 0x3a, 0x25, 0x45, 0x62, 0xd9, 0x2b, 0x76, 0xbb, 0xdb, 0xa6, 0xd2, 0x02, 0xd5, 0x6c,
+	0x8a, 0xe0, 0x2a, 0x72, 0xe2, 0x89, 0x3b, 0xc5, 0xb1, 0xc3, 0x78, 0x82, 0x12, 0x9e, 0x83, 0x2b,
+	0x5e, 0x81, 0x7b, 0xee, 0x78, 0x02, 0xc4, 0x53, 0xf0, 0x22, 0x68, 0xce, 0x8c, 0x93, 0x74, 0xb3,
+	0x45, 0xe2, 0xc6, 0x9a, 0xf9, 0xe6, 0x3b, 0x33, 0xe7, 0x9c, 0xef, 0x9c, 0x63, 0xe8, 0xc7, 0x5c,
+	0xbe, 0x5a, 0x8c, 0x83, 0x49, 0x36, 0xeb, 0x25, 0x8b, 0x09, 0xc7, 0xcf, 0xc3, 0x38, 0xeb, 0x25,
+	0x59, 0x1c, 0x65, 0x71, 0x2f, 0x9c, 0x73, 0xb5, 0x9c, 0x8f, 0xd5, 0x37, 0x98, 0x8b, 0x4c, 0x66,
+	0xa4, 0x82, 0x80, 0x7f, 0x2f, 0xce, 0xb2, 0x38, 0x61, 0x3d, 0x04, 0xc7, 0x8b, 0x69, 0x4f, 0xf2,
+	0x19, 0xcb, 0x65, 0x38, 0x9b, 0x6b, 0x9e, 0xff, 0xd1, 0x4d, 0x42, 0xb4, 0x10, 0xa1, 0xe4, 0x59,
+	0xaa, 0xcf, 0xbb, 0xbf, 0xda, 0xf0, 0xce, 0x55, 0x16, 0x5f, 0x4b, 0xc1, 0xc2, 0xd9, 0x05, 0xcb,
+	0x27, 0x82, 0xcf, 0x65, 0x26, 0xc8, 0xfb, 0x50, 0x9d, 0x0b, 0x36, 0xe5, 0x4b, 0xcf, 0xea, 0x58,
+	0xc7, 0x0d, 0x6a, 0x76, 0x84, 0x80, 0x93, 0x86, 0x33, 0xe6, 0x95, 0x11, 0xc5, 0x35, 0xe9, 0x83,
+	0x9b, 0xa3, 0xfd, 0x48, 0xae, 0xe6, 0xcc, 0xb3, 0x3b, 0xd6, 0xf1, 0x5e, 0xff, 0x20, 0x40, 0x0f,
+	0x03, 0x7d, 0xf3, 0x70, 0x35, 0x67, 0x14, 0xf2, 0xf5, 0x9a, 0x1c, 0x42, 0x73, 0x92, 0xa5, 0x92,
+	0xa5, 0x52, 0x1b, 0x39, 0x78, 0x9f, 0x6b, 0x30, 0xa4, 0x9c, 0x40, 0x63, 0x1d, 0x8d, 0x57, 0xe9,
+	0x58, 0xc7, 0x6e, 0xdf, 0x0f, 0x74, 0x38, 0x41, 0x11, 0x4e, 0x30, 0x2c, 0x18, 0x74, 0x43, 0x26,
+	0x27, 0xe0, 0xc8, 0x30, 0xce, 0xbd, 0x6a, 0xc7, 0x3e, 0x76, 0xfb, 0x1f, 0x1b, 0x4f, 0x6e, 0x09,
+	0x33, 0x18, 0x86, 0x71, 0x7e, 0x99, 0x4a, 0xb1, 0xa2, 0x68, 0x41, 0x8e, 0x60, 0x7f, 0xcc, 0xd3,
+	0x50, 0xac, 0x46, 0x53, 0x9e, 0xb0, 0x11, 0x5b, 0x4a, 0xaf, 0x86, 0x9e, 0xb5, 0x34, 0xfc, 0x94,
+	0x27, 0xec, 0x72, 0x29, 0xc9, 0x27, 0xb0, 0xc7, 0xd3, 0x88, 0x2d, 0x59, 0x34, 0x9a, 0x72, 0x96,
+	0x44, 0xb9, 0x57, 0xef, 0xd8, 0x8a, 0x66, 0xd0, 0xa7, 0x08, 0xfa, 0x5f, 0x40, 0x63, 0xfd, 0x02,
+	0x69, 0x83, 0xfd, 0x23, 0x5b, 0x99, 0x7c, 0xaa, 0x25, 0x79, 0x17, 0x2a, 0x3f, 0x87, 0xc9, 0xa2,
+	0xc8, 0xa6, 0xde, 0x9c, 0x96, 0x4f, 0xac, 0xee, 0x6b, 0x70, 0x86, 0x6c, 0x29, 0xc9, 0x11, 0x54,
+	0x12, 0x9e, 0xb2, 0xdc, 0xb3, 0x30, 0x94, 0xb6, 0x09, 0x45, 0x9d, 0x05, 0x57, 0x3c, 0x65, 0x54,
+	0x1f, 0xfb, 0xa7, 0xe0, 0xa8, 0xed, 0xe6, 0x46, 0x6b, 0xeb, 0x46, 0xf2, 0x21, 0x34, 0x22, 0x96,
+	0xf0, 0x19, 0x97, 0x4c, 0x98, 0xb7, 0x36, 0x40, 0xf7, 0x73, 0xa8, 0x9e, 0x63, 0x70, 0x4a, 0xf4,
+	0x6c, 0x3a, 0xcd, 0x99, 0x44, 0x73, 0x87, 0x9a, 0x9d, 0x12, 0x3d, 0x0a, 0x65, 0x88, 0xa6, 0x4d,
+	0x8a, 0xeb, 0xee, 0x6f, 0x16, 0xd4, 0x2f, 0x42, 0x19, 0xc6, 0x22, 0x9c, 0xad, 0x09, 0xd6, 0x86,
+	0x40, 0x3e, 0x83, 0xda, 0x3c, 0x14, 0x92, 0x87, 0x09, 0xda, 0xb9, 0xfd, 0x3b, 0xc6, 0xf9, 0xc2,
+	0x2a, 0x78, 0xa1, 0x8f, 0x69, 0xc1, 0xf3, 0x9f, 0x41, 0xcd, 0x60, 0x2a, 0x10, 0x4c, 0x25, 0x5e,
+	0xd9, 0xa2, 0x7a, 0xa3, 0xde, 0xc9, 0xf9, 0x2f, 0x3a, 0x5f, 0x0e, 0xc5, 0xb5, 0xc2, 0x92, 0x30,
+	0x97, 0x58, 0x76, 0x75, 0x8a, 0xeb, 0xee, 0x5f, 0x65, 0x80, 0x6b, 0x29, 0x16, 0x13, 0xb9, 0x10,
+	0x2c, 0x22, 0x7d, 0xa8, 0x09, 0x36, 0xc9, 0x44, 0x54, 0xe4, 0xd1, 0xdb, 0x14, 0xa7, 0xe1, 0x04,
+	0x14, 0x09, 0xb4, 0x20, 0xfa, 0x7f, 0x5b, 0x50, 0x41, 0x15, 0x6f, 0xd1, 0xed, 0x3e, 0x34, 0x73,
+	0x29, 0x78, 0x1a, 0x8f, 0xb6, 0xe4, 0x1b, 0x94, 0xa8, 0xab, 0xd1, 0xef, 0x30, 0xe9, 0x77, 0xa1,
+	0xc1, 0x53, 0x69, 0x18, 0xca, 0x39, 0x7b, 0x50, 0xa2, 0x75, 0x9e, 0x4a, 0x7d, 0x7c, 0x1f, 0x9a,
+	0x51, 0xb6, 0x18, 0x27, 0xcc, 0x30, 0x54, 0x03, 0x58, 0xea, 0x0e, 0x8d, 0x6a, 0xd2, 0x3d, 0x80,
+	0x71, 0x96, 0x25, 0x86, 0xa2, 0x7a, 0xa0, 0x3e, 0x28, 0xd1, 0x86, 0xc2, 0xd6, 0x84, 0xd7, 0x79,
+	0x96, 0x1a, 0x42, 0xd5, 0xf8, 0xd1, 0x50, 0x18, 0x12, 0xce, 0x6b, 0xa6, 0x20, 0xfc, 0x2f, 0xa1,
+	0xaa, 0x43, 0x24, 0x3d, 0xa8, 0x9a, 0x9a, 0xd5, 0xc9, 0xb8, 0xb3, 0x9b, 0x0c, 0x0c, 0x9c, 0x1a,
+	0x5a, 0xf7, 0x9f, 0x32, 0xd4, 0xaf, 0xb2, 0x58, 0x57, 0xf1, 0x29, 0xb8, 0xaa, 0xd1, 0x46, 0x5b,
+	0x85, 0xe2, 0xf6, 0x3f, 0xd8, 0xe9, 0xcb, 0x0b, 0x33, 0x66, 0x28, 0x28, 0xf6, 0xb7, 0xba, 0x8e,
+	0x0e, 0xa1, 0xa9, 0xc7, 0xc8, 0x48, 0x6b, 0xab, 0x65, 0x74, 0x35, 0xf6, 0x1c, 0x15, 0x3e, 0x84,
+	0xa6, 0x99, 0x25, 0x9a, 0x62, 0x6b, 0x8a, 0xc6, 0x34, 0xc5, 0x87, 0x7a, 0xce, 0x7e, 0x5a, 0xb0,
+	0x74, 0xa2, 0xb3, 0xe6, 0xd0, 0xf5, 0x9e, 0x1c, 0x82, 0x23, 0x55, 0xd3, 0x02, 0xba, 0xe5, 0x6e,
+	0xb5, 0xcb, 0xa0, 0x44, 0xf1, 0x88, 0x7c, 0x0a, 0x55, 0xdd, 0xcb, 0x9e, 0x8b, 0xa4, 0x96, 0x21,
+	0xe9, 0x1e, 0x18, 0x94, 0xa8, 0x39, 0x26, 0x0f, 0xa1, 0x1e, 0x99, 0x52, 0xf5, 0x9a, 0x48, 0xdd,
+	0xbf, 0x51, 0xc1, 0x4a, 0xd0, 0x82, 0x42, 0x1e, 0x03, 0xe4, 0xeb, 0x0c, 0x7a, 0x2d, 0x34, 0x38,
+	0xd8, 0x49, 0xed, 0xa0, 0x44, 0xb7, 0x68, 0xe7, 0x0d, 0xa8, 0x99, 0x91, 0xd7, 0xfd, 0xdd, 0xc6,
+	0x2c, 0xeb, 0x18, 0x03, 0x70, 0x22, 0x96, 0x4f, 0x4c, 0x7a, 0xfd, 0xb7, 0x4f, 0x30, 0x8a, 0x3c,
+	0xd2, 0x83, 0x1a, 0x4b, 0xa5, 0xe0, 0x2c, 0xf7, 0xca, 0x28, 0xea, 0x7b, 0x1b, 0x13, 0xbc, 0x31,
+	0xd0, 0x53, 0xae, 0x60, 0x91, 0x07, 0x70, 0xa0, 0x3a, 0x65, 0xf4, 0x86, 0x1e, 0x3a, 0xd9, 0xfb,
+	0xea, 0xe0, 0xc5, 0x96, 0x26, 0x05, 0xf7, 0x0d, 0x61, 0x9c, 0x0d, 0xf7, 0x7a, 0x4b, 0x9c, 0x23,
+	0xd8, 0x4f, 0xb2, 0x78, 0xa4, 0x9e, 0x59, 0x8d, 0x26, 0xd9, 0x22, 0x95, 0x58, 0xb6, 0x0e, 0x6d,
+	0x25, 0xa6, 0x82, 0x9e, 0x28, 0xd0, 0xff, 0xd3, 0x82, 0x0a, 0x6e, 0xdf, 0x3a, 0x74, 0xb6, 0x65,
+	0x2e, 0xef, 0xc8, 0xdc, 0xbc, 0xc5, 0xf1, 0xff, 0x2c, 0x24, 0x67, 0xb7, 0x90, 0x6e, 0x94, 0x72,
+	0xe5, 0x7f, 0x94, 0x72, 0xf7, 0x0f, 0x0b, 0xf6, 0x94, 0x1c, 0x2c, 0x14, 0x93, 0x57, 0xfa, 0xba,
+	0x47, 0x50, 0x91, 0x4c, 0xcc, 0x8a, 0xb6, 0xda, 0x16, 0x6d, 0xc3, 0x0a, 0x86, 0x4c, 0xcc, 0xa8,
+	0x26, 0xee, 0xf8, 0xa8, 0xa4, 0xbb, 0xe1, 0xe3, 0x5d, 0x00, 0x35, 0xe1, 0x4d, 0x2a, 0xed, 0x8e,
+	0x7d, 0xdc, 0xa2, 0x0d, 0x85, 0xe8, 0x34, 0x3e, 0x52, 0xff, 0x09, 0x81, 0x03, 0x18, 0xeb, 0x5e,
+	0x0f, 0x29, 0x5c, 0xab, 0x11, 0xaa, 0xff, 0x1d, 0x65, 0xb4, 0xd2, 0x9b, 0x07, 0x5f, 0xe1, 0x64,
+	0x2c, 0x7e, 0xc3, 0x75, 0x70, 0x86, 0x97, 0xdf, 0x0f, 0xdb, 0x25, 0x02, 0x50, 0x3d, 0x7f, 0xfe,
+	0xcd, 0x19, 0xfd, 0xa1, 0x6d, 0x91, 0x26, 0xd4, 0x2f, 0xce, 0x86, 0x67, 0xcf, 0xe8, 0xd9, 0xd7,
+	0xed, 0x32, 0xd9, 0x03, 0xb8, 0x1e, 0xd2, 0x97, 0x4f, 0x86, 0x2f, 0xe9, 0xe5, 0x45, 0xdb, 0x1e,
+	0x57, 0x31, 0x33, 0x8f, 0xff, 0x1d, 0x00, 0x55, 0x6c, 0x34, 0x87, 0xb7, 0x08, 0x00, 0x00,
 }
@@ -38,7 +38,7 @@ func (d *LogStreamDescriptor) Validate(prefix bool) error {
 	}
 
 	switch d.StreamType {
-	case StreamType_TEXT, StreamType_BINARY, StreamType_DATAGRAM:
+	case StreamType_TEXT, StreamType_BINARY, StreamType_DATAGRAM, StreamType_STRUCTURED:
 		break
 
 	default:
@@ -57,6 +57,25 @@ func (d *LogStreamDescriptor) Validate(prefix bool) error {
 			return fmt.Errorf("invalid tag %q: %v", k, err)
 		}
 	}
+
+	if len(d.IndexedFields) > 0 {
+		if d.StreamType != StreamType_STRUCTURED {
+			return errors.New("only STRUCTURED streams may declare indexed fields")
+		}
+		if len(d.IndexedFields) > types.MaxIndexedFields {
+			return fmt.Errorf("too many indexed fields (%d > %d)", len(d.IndexedFields), types.MaxIndexedFields)
+		}
+		seen := make(map[string]struct{}, len(d.IndexedFields))
+		for _, k := range d.IndexedFields {
+			if err := types.ValidateFieldKey(k); err != nil {
+				return fmt.Errorf("invalid indexed field %q: %v", k, err)
+			}
+			if _, ok := seen[k]; ok {
+				return fmt.Errorf("duplicate indexed field %q", k)
+			}
+			seen[k] = struct{}{}
+		}
+	}
 	return nil
 }
 
@@ -97,6 +116,11 @@ func (e *LogEntry) Validate(d *LogStreamDescriptor) error {
 		if d := e.GetDatagram(); d == nil {
 			return ErrNoContent
 		}
+
+	case StreamType_STRUCTURED:
+		if s := e.GetStructured(); s == nil || len(s.Records) == 0 {
+			return ErrNoContent
+		}
 	}
 	return nil
 }
@@ -19,6 +19,7 @@ import (
 	configPB "github.com/luci/luci-go/common/proto/config"
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/logdog/api/config/svcconfig"
+	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/appengine/coordinator"
 	"github.com/luci/luci-go/logdog/appengine/coordinator/config"
 	"github.com/luci/luci-go/logdog/common/storage/archive"
@@ -310,6 +311,12 @@ func Install() (context.Context, *Environment) {
 				Opts:    opts,
 			}, nil
 		},
+		SI: func(lst *coordinator.LogStreamState) (*logpb.LogSearchIndex, error) {
+			if !lst.ArchivalState().Archived() || lst.ArchiveSearchURL == "" {
+				return nil, nil
+			}
+			return coordinator.LoadSearchIndex(&e.GSClient, gs.Path(lst.ArchiveSearchURL))
+		},
 		AP: func() (coordinator.ArchivalPublisher, error) {
 			return &e.ArchivalPublisher, nil
 		},
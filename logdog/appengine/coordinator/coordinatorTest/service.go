@@ -6,6 +6,7 @@ package coordinatorTest
 
 import (
 	"github.com/luci/luci-go/logdog/api/config/svcconfig"
+	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/appengine/coordinator"
 	"github.com/luci/luci-go/logdog/appengine/coordinator/config"
 	"github.com/luci/luci-go/luci_config/common/cfgtypes"
@@ -34,6 +35,12 @@ type Services struct {
 	// if the stream is archived.
 	ST func(*coordinator.LogStreamState) (coordinator.Storage, error)
 
+	// SI returns the archived search index for the supplied log stream.
+	//
+	// By default, this will load the search index from this Environment's
+	// GSClient instance if the stream is archived with a search index.
+	SI func(*coordinator.LogStreamState) (*logpb.LogSearchIndex, error)
+
 	// ArchivalPublisher returns an ArchivalPublisher instance.
 	AP func() (coordinator.ArchivalPublisher, error)
 }
@@ -64,6 +71,14 @@ func (s *Services) StorageForStream(c context.Context, lst *coordinator.LogStrea
 	panic("not implemented")
 }
 
+// SearchIndexForStream implements coordinator.Services.
+func (s *Services) SearchIndexForStream(c context.Context, lst *coordinator.LogStreamState) (*logpb.LogSearchIndex, error) {
+	if s.SI != nil {
+		return s.SI(lst)
+	}
+	panic("not implemented")
+}
+
 // ArchivalPublisher implements coordinator.Services.
 func (s *Services) ArchivalPublisher(context.Context) (coordinator.ArchivalPublisher, error) {
 	if s.AP != nil {
@@ -97,8 +97,66 @@ type queryRunner struct {
 }
 
 func (r *queryRunner) runQuery(resp *logdog.QueryResponse) error {
+	logStreams, cursor, err := r.queryLogStreams()
+	if err != nil {
+		return err
+	}
+
+	if len(logStreams) > 0 {
+		// Don't fetch our states unless requested.
+		var logStreamStates []coordinator.LogStreamState
+		if !r.State {
+			if err := ds.Get(r, logStreams); err != nil {
+				log.WithError(err).Errorf(r, "Failed to load entry content.")
+				return grpcutil.Internal
+			}
+		} else {
+			entities := make([]interface{}, 0, 2*len(logStreams))
+			logStreamStates = make([]coordinator.LogStreamState, len(logStreams))
+			for i, ls := range logStreams {
+				ls.PopulateState(r, &logStreamStates[i])
+				entities = append(entities, ls, &logStreamStates[i])
+
+			}
+
+			if err := ds.Get(r, entities); err != nil {
+				log.WithError(err).Errorf(r, "Failed to load entry and state content.")
+				return grpcutil.Internal
+			}
+		}
+
+		resp.Streams = make([]*logdog.QueryResponse_Stream, len(logStreams))
+		for i, ls := range logStreams {
+			stream := logdog.QueryResponse_Stream{
+				Path: string(ls.Path()),
+			}
+			if logStreamStates != nil {
+				stream.State = buildLogStreamState(ls, &logStreamStates[i])
+
+				var err error
+				stream.Desc, err = ls.DescriptorValue()
+				if err != nil {
+					return grpcutil.Internal
+				}
+			}
+
+			resp.Streams[i] = &stream
+		}
+	}
+
+	if cursor != nil {
+		resp.Next = cursor.String()
+	}
+
+	return nil
+}
+
+// queryLogStreams issues the query and returns the matching LogStream entities,
+// populated with only their keys, along with the cursor for the next query, if
+// there are more results.
+func (r *queryRunner) queryLogStreams() ([]*coordinator.LogStream, ds.Cursor, error) {
 	if r.limit == 0 {
-		return grpcutil.Errf(codes.InvalidArgument, "query limit is zero")
+		return nil, nil, grpcutil.Errf(codes.InvalidArgument, "query limit is zero")
 	}
 
 	if int(r.MaxResults) > 0 && r.limit > int(r.MaxResults) {
@@ -115,7 +173,7 @@ func (r *queryRunner) runQuery(resp *logdog.QueryResponse) error {
 				log.ErrorKey: err,
 				"cursor":     r.Next,
 			}.Errorf(r, "Failed to decode cursor.")
-			return grpcutil.Errf(codes.InvalidArgument, "invalid `next` value")
+			return nil, nil, grpcutil.Errf(codes.InvalidArgument, "invalid `next` value")
 		}
 		q = q.Start(cursor)
 	}
@@ -129,7 +187,7 @@ func (r *queryRunner) runQuery(resp *logdog.QueryResponse) error {
 				log.ErrorKey: err,
 				"path":       r.Path,
 			}.Errorf(r, "Invalid query path.")
-			return grpcutil.Errf(codes.InvalidArgument, "invalid query `path`")
+			return nil, nil, grpcutil.Errf(codes.InvalidArgument, "invalid query `path`")
 		}
 	}
 
@@ -139,11 +197,11 @@ func (r *queryRunner) runQuery(resp *logdog.QueryResponse) error {
 
 	if st := r.StreamType; st != nil {
 		switch v := st.Value; v {
-		case logpb.StreamType_TEXT, logpb.StreamType_BINARY, logpb.StreamType_DATAGRAM:
+		case logpb.StreamType_TEXT, logpb.StreamType_BINARY, logpb.StreamType_DATAGRAM, logpb.StreamType_STRUCTURED:
 			q = q.Eq("StreamType", v)
 
 		default:
-			return grpcutil.Errf(codes.InvalidArgument, "invalid query `streamType`: %s", v.String())
+			return nil, nil, grpcutil.Errf(codes.InvalidArgument, "invalid query `streamType`: %s", v.String())
 		}
 	}
 
@@ -173,11 +231,24 @@ func (r *queryRunner) runQuery(resp *logdog.QueryResponse) error {
 				"key":        k,
 				"value":      v,
 			}.Errorf(r, "Invalid tag constraint.")
-			return grpcutil.Errf(codes.InvalidArgument, "invalid tag constraint: %q", k)
+			return nil, nil, grpcutil.Errf(codes.InvalidArgument, "invalid tag constraint: %q", k)
 		}
 		q = coordinator.AddLogStreamTagFilter(q, k, v)
 	}
 
+	// Add indexed field constraints.
+	for k, v := range r.Fields {
+		if err := types.ValidateFieldKey(k); err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"key":        k,
+				"value":      v,
+			}.Errorf(r, "Invalid field constraint.")
+			return nil, nil, grpcutil.Errf(codes.InvalidArgument, "invalid field constraint: %q", k)
+		}
+		q = coordinator.AddLogStreamFieldFilter(q, k, v)
+	}
+
 	q = q.Limit(int32(r.limit))
 	q = q.KeysOnly(true)
 
@@ -216,54 +287,7 @@ func (r *queryRunner) runQuery(resp *logdog.QueryResponse) error {
 		log.Fields{
 			log.ErrorKey: err,
 		}.Errorf(r, "Failed to execute query.")
-		return grpcutil.Internal
+		return nil, nil, grpcutil.Internal
 	}
-
-	if len(logStreams) > 0 {
-		// Don't fetch our states unless requested.
-		var logStreamStates []coordinator.LogStreamState
-		if !r.State {
-			if err := ds.Get(r, logStreams); err != nil {
-				log.WithError(err).Errorf(r, "Failed to load entry content.")
-				return grpcutil.Internal
-			}
-		} else {
-			entities := make([]interface{}, 0, 2*len(logStreams))
-			logStreamStates = make([]coordinator.LogStreamState, len(logStreams))
-			for i, ls := range logStreams {
-				ls.PopulateState(r, &logStreamStates[i])
-				entities = append(entities, ls, &logStreamStates[i])
-
-			}
-
-			if err := ds.Get(r, entities); err != nil {
-				log.WithError(err).Errorf(r, "Failed to load entry and state content.")
-				return grpcutil.Internal
-			}
-		}
-
-		resp.Streams = make([]*logdog.QueryResponse_Stream, len(logStreams))
-		for i, ls := range logStreams {
-			stream := logdog.QueryResponse_Stream{
-				Path: string(ls.Path()),
-			}
-			if logStreamStates != nil {
-				stream.State = buildLogStreamState(ls, &logStreamStates[i])
-
-				var err error
-				stream.Desc, err = ls.DescriptorValue()
-				if err != nil {
-					return grpcutil.Internal
-				}
-			}
-
-			resp.Streams[i] = &stream
-		}
-	}
-
-	if cursor != nil {
-		resp.Next = cursor.String()
-	}
-
-	return nil
+	return logStreams, cursor, nil
 }
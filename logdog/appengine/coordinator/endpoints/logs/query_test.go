@@ -475,5 +475,57 @@ func TestQuery(t *testing.T) {
 				So(err, ShouldBeRPCInvalidArgument, "invalid tag constraint")
 			})
 		})
+
+		Convey(`When querying for indexed fields`, func() {
+			req.Fields = map[string]string{}
+
+			tls := ct.MakeStream(c, project, "structured/+/foo")
+			tls.Desc.StreamType = logpb.StreamType_STRUCTURED
+			tls.Desc.ContentType = string(types.ContentTypeJSONLines)
+			tls.Desc.IndexedFields = []string{"status", "user"}
+			tls.Reload(c)
+			tls.Stream.Fields.Add("status", "404")
+			So(tls.Put(c), ShouldBeNil)
+			ds.GetTestable(c).CatchupIndexes()
+
+			Convey(`Field "status", returns [structured/+/foo]`, func() {
+				req.Fields["status"] = ""
+
+				resp, err := svr.Query(c, &req)
+				So(err, ShouldBeRPCOK)
+				So(resp, shouldHaveLogPaths, "structured/+/foo")
+			})
+
+			Convey(`Field "status=404", returns [structured/+/foo]`, func() {
+				req.Fields["status"] = "404"
+
+				resp, err := svr.Query(c, &req)
+				So(err, ShouldBeRPCOK)
+				So(resp, shouldHaveLogPaths, "structured/+/foo")
+			})
+
+			Convey(`Fields "status=200", returns nothing`, func() {
+				req.Fields["status"] = "200"
+
+				resp, err := svr.Query(c, &req)
+				So(err, ShouldBeRPCOK)
+				So(resp, shouldHaveLogPaths)
+			})
+
+			Convey(`When querying for structured streams, returns [structured/+/foo]`, func() {
+				req.StreamType = &logdog.QueryRequest_StreamTypeFilter{Value: logpb.StreamType_STRUCTURED}
+
+				resp, err := svr.Query(c, &req)
+				So(err, ShouldBeRPCOK)
+				So(resp, shouldHaveLogPaths, "structured/+/foo")
+			})
+
+			Convey(`When an invalid field is specified, returns BadRequest error`, func() {
+				req.Fields["+++not a valid field+++"] = ""
+
+				_, err := svr.Query(c, &req)
+				So(err, ShouldBeRPCInvalidArgument, "invalid field constraint")
+			})
+		})
 	})
 }
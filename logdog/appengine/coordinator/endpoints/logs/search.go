@@ -0,0 +1,349 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package logs
+
+import (
+	"time"
+
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/retry"
+	"github.com/luci/luci-go/grpc/grpcutil"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	"github.com/luci/luci-go/logdog/common/search"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+
+	ds "github.com/luci/gae/service/datastore"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// searchStreamLimit is the maximum number of log streams that will be
+	// searched in a single Search request. If the user requests more, it will be
+	// automatically capped at this value.
+	searchStreamLimit = 50
+
+	// searchMatchLimit is the maximum number of matching lines that will be
+	// returned for a single log stream.
+	searchMatchLimit = 100
+
+	// searchMaxContextLines is the maximum number of context lines that may be
+	// requested before and after each matching line.
+	searchMaxContextLines = 10
+
+	// searchCandidateLimit is the maximum number of candidate lines that will be
+	// checked in a single Search request, across all of its log streams.
+	searchCandidateLimit = 10000
+
+	// searchIndexBytesLimit is the maximum total size, in bytes, of the search
+	// indexes that will be loaded in a single Search request.
+	searchIndexBytesLimit = 64 * 1024 * 1024
+
+	// searchFetchCount is the minimum number of log entries that will be fetched
+	// from storage at a time while verifying candidate lines. Candidates tend to
+	// cluster, so fetching ahead saves storage round trips.
+	searchFetchCount = 64
+)
+
+// Search returns the lines in archived log streams that contain the requested
+// text.
+func (s *server) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	log.Fields{
+		"project":      req.Project,
+		"path":         req.Path,
+		"text":         req.Text,
+		"contextLines": req.ContextLines,
+	}.Debugf(c, "Received search request.")
+
+	if len(search.Tokenize(req.Text)) == 0 {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "search `text` must contain a letter or digit")
+	}
+	if req.ContextLines < 0 || req.ContextLines > searchMaxContextLines {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "`context_lines` must be between 0 and %d",
+			searchMaxContextLines)
+	}
+
+	// Identify the TEXT log streams to search. Purged log streams are never
+	// searched.
+	r := queryRunner{
+		Context: log.SetField(c, "path", req.Path),
+		QueryRequest: &logdog.QueryRequest{
+			Project: req.Project,
+			Path:    req.Path,
+			StreamType: &logdog.QueryRequest_StreamTypeFilter{
+				Value: logpb.StreamType_TEXT,
+			},
+			MaxResults: req.MaxResults,
+			Next:       req.Next,
+		},
+		limit: s.limit(0, searchStreamLimit),
+	}
+	logStreams, cursor, err := r.queryLogStreams()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := logdog.SearchResponse{Project: req.Project}
+	if len(logStreams) > 0 {
+		entities := make([]interface{}, 0, 2*len(logStreams))
+		logStreamStates := make([]coordinator.LogStreamState, len(logStreams))
+		for i, ls := range logStreams {
+			ls.PopulateState(c, &logStreamStates[i])
+			entities = append(entities, ls, &logStreamStates[i])
+		}
+
+		if err := ds.Get(c, entities); err != nil {
+			log.WithError(err).Errorf(c, "Failed to load entry and state content.")
+			return nil, grpcutil.Internal
+		}
+
+		b := searchBudget{
+			indexBytes: searchIndexBytesLimit,
+			candidates: searchCandidateLimit,
+		}
+		for i, ls := range logStreams {
+			stream, err := searchLogStream(c, req, ls, &logStreamStates[i], &b)
+			if err != nil {
+				log.Fields{
+					log.ErrorKey: err,
+					"path":       ls.Path(),
+				}.Errorf(c, "Failed to search log stream.")
+				return nil, grpcutil.Internal
+			}
+			if stream != nil {
+				resp.Streams = append(resp.Streams, stream)
+			}
+		}
+	}
+
+	if cursor != nil {
+		resp.Next = cursor.String()
+	}
+
+	log.Fields{
+		"streams": len(logStreams),
+		"matched": len(resp.Streams),
+	}.Debugf(c, "Search request completed successfully.")
+	return &resp, nil
+}
+
+// searchBudget is the work that a Search request may still do.
+type searchBudget struct {
+	// indexBytes is the total size of the search indexes that may be loaded.
+	indexBytes int64
+	// candidates is the number of candidate lines that may be checked.
+	candidates int
+}
+
+// searchLogStream searches a single log stream for lines matching the request,
+// within budget b.
+//
+// If the log stream has no search index or no matching lines, nil will be
+// returned. If it can't be searched entirely, because its search index is too
+// large or b runs out, it is returned truncated, possibly without matches.
+func searchLogStream(c context.Context, req *logdog.SearchRequest, ls *coordinator.LogStream,
+	lst *coordinator.LogStreamState, b *searchBudget) (*logdog.SearchResponse_Stream, error) {
+
+	stream := logdog.SearchResponse_Stream{
+		Path: string(ls.Path()),
+	}
+	if size := lst.ArchiveSearchSize; size > coordinator.MaxSearchIndexSize || size > b.indexBytes {
+		log.Fields{
+			"path": ls.Path(),
+			"size": size,
+		}.Warningf(c, "Log stream's search index is too large to load; skipping.")
+		stream.Truncated = true
+		return &stream, nil
+	}
+	b.indexBytes -= lst.ArchiveSearchSize
+
+	svc := coordinator.GetServices(c)
+	sidx, err := svc.SearchIndexForStream(c, lst)
+	if err != nil {
+		return nil, errors.Annotate(err).InternalReason("failed to load search index").Err()
+	}
+	if sidx == nil {
+		log.Fields{
+			"path": ls.Path(),
+		}.Debugf(c, "Log stream has no search index; skipping.")
+		return nil, nil
+	}
+
+	idx, err := search.NewIndex(sidx)
+	if err != nil {
+		return nil, errors.Annotate(err).InternalReason("invalid search index").Err()
+	}
+	cands := idx.Candidates(req.Text)
+	if len(cands) == 0 {
+		return nil, nil
+	}
+
+	st, err := svc.StorageForStream(c, lst)
+	if err != nil {
+		return nil, errors.Annotate(err).InternalReason("failed to create storage instance").Err()
+	}
+	defer st.Close()
+
+	f := entryFetcher{
+		Context: c,
+		st:      st,
+		project: coordinator.Project(c),
+		path:    ls.Path(),
+	}
+
+	ctxLines := int(req.ContextLines)
+	for _, cand := range cands {
+		if b.candidates <= 0 {
+			stream.Truncated = true
+			break
+		}
+		b.candidates--
+
+		pre, post := idx.Surrounding(cand, ctxLines, ctxLines)
+
+		first, last := cand.StreamIndex, cand.StreamIndex
+		if len(pre) > 0 {
+			first = pre[0].StreamIndex
+		}
+		if len(post) > 0 {
+			last = post[len(post)-1].StreamIndex
+		}
+		if err := f.fetch(first, last); err != nil {
+			return nil, err
+		}
+
+		line, ok := f.line(cand)
+		if !ok || !search.Match(line, req.Text) {
+			continue
+		}
+
+		if len(stream.Matches) >= searchMatchLimit {
+			stream.Truncated = true
+			break
+		}
+
+		m := logdog.SearchResponse_Match{
+			StreamIndex: int64(cand.StreamIndex),
+			LineIndex:   int32(cand.Line),
+			Line:        line,
+		}
+		for _, loc := range pre {
+			if v, ok := f.line(loc); ok {
+				m.Before = append(m.Before, v)
+			}
+		}
+		for _, loc := range post {
+			if v, ok := f.line(loc); ok {
+				m.After = append(m.After, v)
+			}
+		}
+		stream.Matches = append(stream.Matches, &m)
+	}
+
+	if len(stream.Matches) == 0 && !stream.Truncated {
+		return nil, nil
+	}
+	return &stream, nil
+}
+
+// entryFetcher fetches a log stream's entries from storage as a search moves
+// forward through the stream.
+type entryFetcher struct {
+	context.Context
+
+	st      coordinator.Storage
+	project cfgtypes.ProjectName
+	path    types.StreamPath
+
+	// entries are the fetched log entries, keyed by stream index.
+	entries map[uint64]*logpb.LogEntry
+	// next is the stream index of the next log entry to fetch.
+	next uint64
+}
+
+// fetch ensures that the log entries between first and last, inclusive, have
+// been fetched. Log entries before first are discarded.
+func (f *entryFetcher) fetch(first, last uint64) error {
+	for sidx := range f.entries {
+		if sidx < first {
+			delete(f.entries, sidx)
+		}
+	}
+	if last < f.next {
+		return nil
+	}
+
+	if f.next < first {
+		f.next = first
+	}
+	count := int(last - f.next + 1)
+	if count < searchFetchCount {
+		count = searchFetchCount
+	}
+	if f.entries == nil {
+		f.entries = make(map[uint64]*logpb.LogEntry, count)
+	}
+
+	sreq := storage.GetRequest{
+		Project: f.project,
+		Path:    f.path,
+		Index:   types.MessageIndex(f.next),
+		Limit:   count,
+	}
+
+	var ierr error
+	err := retry.Retry(f, retry.TransientOnly(retry.Default), func() error {
+		if sreq.Limit <= 0 {
+			// A previous attempt fetched everything before failing.
+			return nil
+		}
+
+		// Issue the Get request. This may return a transient error, in which case
+		// we will retry from the last log entry that we received.
+		return f.st.Get(sreq, func(e *storage.Entry) bool {
+			var le *logpb.LogEntry
+			if le, ierr = e.GetLogEntry(); ierr != nil {
+				return false
+			}
+
+			f.entries[le.StreamIndex] = le
+			sreq.Index = types.MessageIndex(le.StreamIndex + 1)
+			sreq.Limit--
+			return sreq.Limit > 0
+		})
+	}, func(err error, delay time.Duration) {
+		log.Fields{
+			log.ErrorKey: err,
+			"delay":      delay,
+			"nextIndex":  sreq.Index,
+		}.Warningf(f, "Transient error while fetching logs for search; retrying.")
+	})
+	switch {
+	case err == storage.ErrDoesNotExist:
+		// The stream has no more log entries.
+	case err != nil:
+		return errors.Annotate(err).InternalReason("failed to fetch log entries").Err()
+	case ierr != nil:
+		return errors.Annotate(ierr).InternalReason("bad log entry data").Err()
+	}
+
+	f.next += uint64(count)
+	return nil
+}
+
+// line returns the value of the line at loc, if it has been fetched.
+func (f *entryFetcher) line(loc search.Location) (string, bool) {
+	lines := f.entries[loc.StreamIndex].GetText().GetLines()
+	if loc.Line >= len(lines) {
+		return "", false
+	}
+	return lines[loc.Line].Value, true
+}
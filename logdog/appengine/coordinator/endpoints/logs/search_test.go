@@ -0,0 +1,325 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package logs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/gcloud/gs"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	ct "github.com/luci/luci-go/logdog/appengine/coordinator/coordinatorTest"
+	"github.com/luci/luci-go/logdog/common/archive"
+	"github.com/luci/luci-go/logdog/common/renderer"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+
+	ds "github.com/luci/gae/service/datastore"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func shouldHaveSearchPaths(actual interface{}, expected ...interface{}) string {
+	resp := actual.(*logdog.SearchResponse)
+	var paths []string
+	for _, s := range resp.Streams {
+		paths = append(paths, s.Path)
+	}
+
+	var exp []string
+	for _, e := range expected {
+		exp = append(exp, e.(string))
+	}
+	return ShouldResemble(paths, exp)
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration, a Search request`, t, func() {
+		c, env := ct.Install()
+
+		ds.GetTestable(c).Consistent(true)
+
+		var svrBase server
+		svr := newService(&svrBase)
+
+		const project = cfgtypes.ProjectName("proj-foo")
+
+		// textEntry builds a TEXT LogEntry containing the supplied lines.
+		textEntry := func(tls *ct.TestStream, idx int, lines ...string) *logpb.LogEntry {
+			le := tls.LogEntry(c, idx)
+			le.GetText().Lines = nil
+			for _, l := range lines {
+				le.GetText().Lines = append(le.GetText().Lines, &logpb.Text_Line{Value: l, Delimiter: "\n"})
+			}
+			return le
+		}
+
+		// addStream adds a log stream with the supplied entries. If archived is
+		// true, the stream is archived along with a search index.
+		addStream := func(path types.StreamPath, archived bool, entries func(*ct.TestStream) []*logpb.LogEntry) *ct.TestStream {
+			tls := ct.MakeStream(c, project, path)
+
+			if archived {
+				src := renderer.StaticSource(entries(tls))
+				var lbuf, ibuf, sbuf bytes.Buffer
+				m := archive.Manifest{
+					Desc:              tls.Desc,
+					Source:            &src,
+					LogWriter:         &lbuf,
+					IndexWriter:       &ibuf,
+					SearchIndexWriter: &sbuf,
+				}
+				if err := archive.Archive(m); err != nil {
+					panic(err)
+				}
+
+				base := fmt.Sprintf("gs://testbucket/%s", path)
+				env.GSClient.Put(gs.Path(base+"/stream"), lbuf.Bytes())
+				env.GSClient.Put(gs.Path(base+"/index"), ibuf.Bytes())
+				env.GSClient.Put(gs.Path(base+"/search"), sbuf.Bytes())
+
+				now := env.Clock.Now().UTC()
+				tls.State.TerminatedTime = now
+				tls.State.ArchivedTime = now
+				tls.State.ArchiveStreamURL = base + "/stream"
+				tls.State.ArchiveIndexURL = base + "/index"
+				tls.State.ArchiveSearchURL = base + "/search"
+				tls.State.ArchiveSearchSize = int64(sbuf.Len())
+				So(tls.State.ArchivalState().Archived(), ShouldBeTrue)
+			}
+
+			if err := tls.Put(c); err != nil {
+				panic(err)
+			}
+			env.Clock.Add(time.Second)
+			return tls
+		}
+
+		addStream("testing/+/foo", true, func(tls *ct.TestStream) []*logpb.LogEntry {
+			return []*logpb.LogEntry{
+				textEntry(tls, 0, "Starting test run", "Running FooTest.Bar"),
+				textEntry(tls, 1, "ERROR: connection reset by peer"),
+				textEntry(tls, 2, "retrying...", "Running FooTest.Baz"),
+				textEntry(tls, 3, "error: Connection refused"),
+			}
+		})
+		addStream("testing/+/bar", true, func(tls *ct.TestStream) []*logpb.LogEntry {
+			return []*logpb.LogEntry{
+				textEntry(tls, 0, "Starting test run", "All tests passed."),
+			}
+		})
+		addStream("testing/+/baz", true, func(tls *ct.TestStream) []*logpb.LogEntry {
+			return []*logpb.LogEntry{
+				textEntry(tls, 0, "Connection established."),
+			}
+		})
+		addStream("testing/+/streaming", false, nil)
+		purged := addStream("testing/+/purged", true, func(tls *ct.TestStream) []*logpb.LogEntry {
+			return []*logpb.LogEntry{
+				textEntry(tls, 0, "Connection refused"),
+			}
+		})
+		purged.Stream.Purged = true
+		if err := purged.Put(c); err != nil {
+			panic(err)
+		}
+		ds.GetTestable(c).CatchupIndexes()
+
+		req := logdog.SearchRequest{
+			Project: string(project),
+			Path:    "testing/+/**",
+			Text:    "connection",
+		}
+
+		Convey(`Will return matching lines from archived log streams.`, func() {
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp.Project, ShouldEqual, string(project))
+			So(resp, shouldHaveSearchPaths, "testing/+/baz", "testing/+/foo")
+
+			So(resp.Streams[1].Matches, ShouldResemble, []*logdog.SearchResponse_Match{
+				{StreamIndex: 1, LineIndex: 0, Line: "ERROR: connection reset by peer"},
+				{StreamIndex: 3, LineIndex: 0, Line: "error: Connection refused"},
+			})
+			So(resp.Streams[1].Truncated, ShouldBeFalse)
+			So(resp.Next, ShouldEqual, "")
+		})
+
+		Convey(`Will match partial terms.`, func() {
+			req.Text = "ooTest.Ba"
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "testing/+/foo")
+			So(resp.Streams[0].Matches, ShouldResemble, []*logdog.SearchResponse_Match{
+				{StreamIndex: 0, LineIndex: 1, Line: "Running FooTest.Bar"},
+				{StreamIndex: 2, LineIndex: 1, Line: "Running FooTest.Baz"},
+			})
+		})
+
+		Convey(`Will return context lines across log entries.`, func() {
+			req.Text = "reset by"
+			req.ContextLines = 2
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "testing/+/foo")
+			So(resp.Streams[0].Matches, ShouldResemble, []*logdog.SearchResponse_Match{
+				{
+					StreamIndex: 1,
+					LineIndex:   0,
+					Line:        "ERROR: connection reset by peer",
+					Before:      []string{"Starting test run", "Running FooTest.Bar"},
+					After:       []string{"retrying...", "Running FooTest.Baz"},
+				},
+			})
+		})
+
+		Convey(`Will return no streams if nothing matches.`, func() {
+			req.Text = "segmentation fault"
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp.Streams, ShouldHaveLength, 0)
+		})
+
+		Convey(`Will truncate log streams with too many matching lines.`, func() {
+			addStream("testing/+/noisy", true, func(tls *ct.TestStream) []*logpb.LogEntry {
+				entries := make([]*logpb.LogEntry, searchMatchLimit+1)
+				for i := range entries {
+					entries[i] = textEntry(tls, i, fmt.Sprintf("connection attempt #%d", i))
+				}
+				return entries
+			})
+			ds.GetTestable(c).CatchupIndexes()
+			req.Path = "testing/+/noisy"
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "testing/+/noisy")
+			So(resp.Streams[0].Matches, ShouldHaveLength, searchMatchLimit)
+			So(resp.Streams[0].Truncated, ShouldBeTrue)
+		})
+
+		Convey(`Will truncate log streams once too many candidate lines were checked.`, func() {
+			addStream("testing/+/candidates", true, func(tls *ct.TestStream) []*logpb.LogEntry {
+				// Every line is a candidate for "connection x", but none matches.
+				lines := make([]string, searchCandidateLimit+1)
+				for i := range lines {
+					lines[i] = "x connection"
+				}
+				return []*logpb.LogEntry{textEntry(tls, 0, lines...)}
+			})
+			ds.GetTestable(c).CatchupIndexes()
+			req.Path = "testing/+/candidates"
+			req.Text = "connection x"
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "testing/+/candidates")
+			So(resp.Streams[0].Matches, ShouldHaveLength, 0)
+			So(resp.Streams[0].Truncated, ShouldBeTrue)
+		})
+
+		Convey(`Will skip log streams whose search index is too large.`, func() {
+			big := addStream("testing/+/big", true, func(tls *ct.TestStream) []*logpb.LogEntry {
+				return []*logpb.LogEntry{
+					textEntry(tls, 0, "Connection refused"),
+				}
+			})
+			big.State.ArchiveSearchSize = coordinator.MaxSearchIndexSize + 1
+			if err := big.Put(c); err != nil {
+				panic(err)
+			}
+			ds.GetTestable(c).CatchupIndexes()
+			req.Path = "testing/+/big"
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "testing/+/big")
+			So(resp.Streams[0].Matches, ShouldHaveLength, 0)
+			So(resp.Streams[0].Truncated, ShouldBeTrue)
+		})
+
+		Convey(`Will page through log streams.`, func() {
+			req.MaxResults = 2
+
+			resp, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "testing/+/baz")
+			So(resp.Next, ShouldNotEqual, "")
+
+			req.Next = resp.Next
+			resp, err = svr.Search(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp, shouldHaveSearchPaths, "testing/+/foo")
+		})
+
+		Convey(`If the user is logged in`, func() {
+			env.LogIn()
+
+			Convey(`When accessing a restricted project`, func() {
+				req.Project = "proj-exclusive"
+
+				Convey(`Will succeed if the user can access the project.`, func() {
+					env.JoinGroup("auth")
+
+					_, err := svr.Search(c, &req)
+					So(err, ShouldBeRPCOK)
+				})
+
+				Convey(`Will fail with PermissionDenied if the user can't access the project.`, func() {
+					_, err := svr.Search(c, &req)
+					So(err, ShouldBeRPCPermissionDenied)
+				})
+			})
+
+			Convey(`Will fail with PermissionDenied if the project does not exist.`, func() {
+				req.Project = "does-not-exist"
+
+				_, err := svr.Search(c, &req)
+				So(err, ShouldBeRPCPermissionDenied)
+			})
+		})
+
+		Convey(`Will fail with InvalidArgument if the text has no terms.`, func() {
+			req.Text = "..."
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "must contain a letter or digit")
+		})
+
+		Convey(`Will fail with InvalidArgument if too many context lines are requested.`, func() {
+			req.ContextLines = searchMaxContextLines + 1
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "context_lines")
+		})
+
+		Convey(`Will fail with InvalidArgument if the path is invalid.`, func() {
+			req.Path = "***"
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInvalidArgument, "invalid query `path`")
+		})
+
+		Convey(`Will fail with Internal if the search index can't be loaded.`, func() {
+			env.Services.SI = func(*coordinator.LogStreamState) (*logpb.LogSearchIndex, error) {
+				return nil, errors.New("test error")
+			}
+
+			_, err := svr.Search(c, &req)
+			So(err, ShouldBeRPCInternal)
+		})
+	})
+}
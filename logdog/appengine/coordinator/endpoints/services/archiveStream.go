@@ -100,6 +100,8 @@ func (b *server) ArchiveStream(c context.Context, req *logdog.ArchiveStreamReque
 		lst.ArchiveIndexSize = req.IndexSize
 		lst.ArchiveDataURL = req.DataUrl
 		lst.ArchiveDataSize = req.DataSize
+		lst.ArchiveSearchURL = req.SearchUrl
+		lst.ArchiveSearchSize = req.SearchSize
 
 		// Update the log stream.
 		if err := ds.Put(c, lst); err != nil {
@@ -50,6 +50,8 @@ func TestArchiveStream(t *testing.T) {
 			IndexSize:     20,
 			DataUrl:       "gs://fake.data",
 			DataSize:      30,
+			SearchUrl:     "gs://fake.search",
+			SearchSize:    40,
 		}
 
 		Convey(`Returns Forbidden error if not a service.`, func() {
@@ -80,6 +82,8 @@ func TestArchiveStream(t *testing.T) {
 				So(tls.State.ArchiveIndexSize, ShouldEqual, 20)
 				So(tls.State.ArchiveDataURL, ShouldEqual, "gs://fake.data")
 				So(tls.State.ArchiveDataSize, ShouldEqual, 30)
+				So(tls.State.ArchiveSearchURL, ShouldEqual, "gs://fake.search")
+				So(tls.State.ArchiveSearchSize, ShouldEqual, 40)
 			})
 
 			Convey(`Will mark the stream as partially archived if not complete.`, func() {
@@ -104,6 +108,8 @@ func TestArchiveStream(t *testing.T) {
 				So(tls.State.ArchiveIndexSize, ShouldEqual, 20)
 				So(tls.State.ArchiveDataURL, ShouldEqual, "gs://fake.data")
 				So(tls.State.ArchiveDataSize, ShouldEqual, 30)
+				So(tls.State.ArchiveSearchURL, ShouldEqual, "gs://fake.search")
+				So(tls.State.ArchiveSearchSize, ShouldEqual, 40)
 			})
 
 			Convey(`Will refuse to process an invalid stream hash.`, func() {
@@ -0,0 +1,90 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"crypto/subtle"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	ds "github.com/luci/gae/service/datastore"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/grpc/grpcutil"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/services/v1"
+	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// IndexStreamFields is an idempotent operation that records the indexed field
+// values observed in a STRUCTURED log stream.
+//
+// Values that are already indexed, or that can't be indexed because they are
+// too large or because their field has reached its maximum number of values,
+// are ignored.
+func (s *server) IndexStreamFields(c context.Context, req *logdog.IndexStreamFieldsRequest) (*empty.Empty, error) {
+	log.Fields{
+		"project": req.Project,
+		"id":      req.Id,
+		"fields":  len(req.Fields),
+	}.Infof(c, "Request to index log stream fields.")
+
+	id := coordinator.HashID(req.Id)
+	if err := id.Normalize(); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "Invalid ID (%s): %s", id, err)
+	}
+
+	ls := &coordinator.LogStream{ID: id}
+	lst := ls.State(c)
+
+	// Transactionally add the field values to the log stream.
+	err := ds.RunInTransaction(c, func(c context.Context) error {
+		if err := ds.Get(c, ls, lst); err != nil {
+			if anyNoSuchEntity(err) {
+				log.Debugf(c, "Log stream not found.")
+				return grpcutil.Errf(codes.NotFound, "Log stream %q is not registered", id)
+			}
+
+			log.WithError(err).Errorf(c, "Failed to load LogStream.")
+			return grpcutil.Internal
+		}
+
+		if subtle.ConstantTimeCompare(lst.Secret, req.Secret) != 1 {
+			log.Errorf(c, "Secrets do not match.")
+			return grpcutil.Errf(codes.InvalidArgument, "Request secret doesn't match the stream secret.")
+		}
+
+		added := 0
+		for _, f := range req.Fields {
+			if _, ok := ls.Fields[f.Key]; !ok {
+				return grpcutil.Errf(codes.InvalidArgument, "Field %q is not indexed by this log stream.", f.Key)
+			}
+			if ls.Fields.Add(f.Key, f.Value) {
+				added++
+			}
+		}
+		if added == 0 {
+			log.Debugf(c, "No new field values to index.")
+			return nil
+		}
+
+		if err := ds.Put(c, ls); err != nil {
+			log.WithError(err).Errorf(c, "Failed to Put() LogStream.")
+			return grpcutil.Internal
+		}
+
+		log.Fields{
+			"added": added,
+		}.Debugf(c, "Indexed new field values.")
+		return nil
+	}, nil)
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+		}.Errorf(c, "Failed to update LogStream.")
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/luci/gae/filter/featureBreaker"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/services/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/appengine/coordinator"
+	ct "github.com/luci/luci-go/logdog/appengine/coordinator/coordinatorTest"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestIndexStreamFields(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a testing configuration`, t, func() {
+		c, env := ct.Install()
+
+		svr := New()
+
+		tls := ct.MakeStream(c, "proj-foo", "testing/+/foo/bar")
+		tls.Desc.StreamType = logpb.StreamType_STRUCTURED
+		tls.Desc.ContentType = string(types.ContentTypeJSONLines)
+		tls.Desc.IndexedFields = []string{"status"}
+		tls.Reload(c)
+
+		req := logdog.IndexStreamFieldsRequest{
+			Project: string(tls.Project),
+			Id:      string(tls.Stream.ID),
+			Secret:  tls.Prefix.Secret,
+			Fields: []*logdog.IndexStreamFieldsRequest_Field{
+				{Key: "status", Value: "404"},
+				{Key: "status", Value: "200"},
+			},
+		}
+
+		Convey(`Returns Forbidden error if not a service.`, func() {
+			_, err := svr.IndexStreamFields(c, &req)
+			So(err, ShouldBeRPCPermissionDenied)
+		})
+
+		Convey(`When logged in as a service`, func() {
+			env.JoinGroup("services")
+
+			Convey(`A registered stream, "testing/+/foo/bar"`, func() {
+				So(tls.Put(c), ShouldBeNil)
+
+				Convey(`Can index field values.`, func() {
+					_, err := svr.IndexStreamFields(c, &req)
+					So(err, ShouldBeRPCOK)
+
+					So(tls.Get(c), ShouldBeNil)
+					So(tls.Stream.Fields, ShouldResemble, coordinator.FieldMap{"status": {"200", "404"}})
+
+					Convey(`Will ignore values that are already indexed (idempotent).`, func() {
+						_, err := svr.IndexStreamFields(c, &req)
+						So(err, ShouldBeRPCOK)
+
+						So(tls.Get(c), ShouldBeNil)
+						So(tls.Stream.Fields, ShouldResemble, coordinator.FieldMap{"status": {"200", "404"}})
+					})
+				})
+
+				Convey(`Will reject a field that is not indexed.`, func() {
+					req.Fields[0].Key = "user"
+					_, err := svr.IndexStreamFields(c, &req)
+					So(err, ShouldBeRPCInvalidArgument, "is not indexed")
+				})
+
+				Convey(`Will return an internal server error if Put() fails.`, func() {
+					c, fb := featureBreaker.FilterRDS(c, nil)
+					fb.BreakFeatures(errors.New("test error"), "PutMulti")
+					_, err := svr.IndexStreamFields(c, &req)
+					So(err, ShouldBeRPCInternal)
+				})
+
+				Convey(`Will return a bad request error if the secret doesn't match.`, func() {
+					req.Secret[0] ^= 0xFF
+					_, err := svr.IndexStreamFields(c, &req)
+					So(err, ShouldBeRPCInvalidArgument, "Request secret doesn't match the stream secret.")
+				})
+			})
+
+			Convey(`Will not index a stream with an invalid ID.`, func() {
+				req.Id = "!!!invalid path!!!"
+				_, err := svr.IndexStreamFields(c, &req)
+				So(err, ShouldBeRPCInvalidArgument, "Invalid ID")
+			})
+
+			Convey(`Will fail if the stream is not registered.`, func() {
+				_, err := svr.IndexStreamFields(c, &req)
+				So(err, ShouldBeRPCNotFound, "is not registered")
+			})
+		})
+	})
+}
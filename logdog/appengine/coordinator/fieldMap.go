@@ -0,0 +1,155 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package coordinator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	ds "github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/logdog/common/types"
+)
+
+// FieldMap is a field map that stores the indexed field values of a STRUCTURED
+// log stream into the datastore.
+//
+// Each indexed field is stored as a presence entry (Key), and each of its
+// observed values is stored as an equality entry (Key=Value). Both entry
+// contents are encoded via encodeKey.
+type FieldMap map[string][]string
+
+// fieldMapFromProperties converts a set of field property objects into a
+// FieldMap.
+//
+// If an error occurs decoding a specific property, an errors.MultiError will be
+// returned alongside the successfully-decoded fields.
+func fieldMapFromProperties(props ds.PropertySlice) (FieldMap, error) {
+	fm := FieldMap{}
+	lme := errors.NewLazyMultiError(len(props))
+	for idx, prop := range props {
+		v, ok := prop.Value().(string)
+		if !ok {
+			lme.Assign(idx, fmt.Errorf("property is not a string (%T)", prop.Value()))
+			continue
+		}
+		e, err := decodeKey(v)
+		if err != nil {
+			lme.Assign(idx, fmt.Errorf("failed to decode property (%q): %s", v, err))
+			continue
+		}
+
+		parts := strings.SplitN(e, "=", 2)
+		if err := types.ValidateFieldKey(parts[0]); err != nil {
+			lme.Assign(idx, fmt.Errorf("invalid field %q: %s", parts[0], err))
+			continue
+		}
+
+		k := parts[0]
+		if len(parts) == 1 {
+			// This is a presence entry.
+			if _, ok := fm[k]; !ok {
+				fm[k] = nil
+			}
+			continue
+		}
+		fm[k] = append(fm[k], parts[1])
+	}
+
+	if len(fm) == 0 {
+		fm = nil
+	}
+	return fm, lme.Get()
+}
+
+// Add adds value to the set of values indexed for key.
+//
+// It returns true if the value was added, and false if it was already indexed,
+// or if it can't be indexed because it is too large or because key has
+// already reached its maximum number of indexed values.
+func (m FieldMap) Add(key, value string) bool {
+	if len(value) > types.MaxIndexedFieldValueSize {
+		return false
+	}
+
+	values := m[key]
+	if len(values) >= types.MaxIndexedFieldValues {
+		return false
+	}
+	for _, v := range values {
+		if v == value {
+			return false
+		}
+	}
+	m[key] = append(values, value)
+	return true
+}
+
+// Validate returns an error if m contains an invalid field key or value.
+func (m FieldMap) Validate() error {
+	for k, values := range m {
+		if err := types.ValidateFieldKey(k); err != nil {
+			return err
+		}
+		if len(values) > types.MaxIndexedFieldValues {
+			return fmt.Errorf("field %q has too many values (%d > %d)", k, len(values), types.MaxIndexedFieldValues)
+		}
+		for _, v := range values {
+			if len(v) > types.MaxIndexedFieldValueSize {
+				return fmt.Errorf("field %q value exceeds maximum size (%d > %d)",
+					k, len(v), types.MaxIndexedFieldValueSize)
+			}
+		}
+	}
+	return nil
+}
+
+// toProperties converts a FieldMap to a set of Property objects for storage.
+func (m FieldMap) toProperties() (ds.PropertySlice, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Deterministic conversion.
+	keys := make([]string, 0, len(m))
+	count := 0
+	for k, values := range m {
+		keys = append(keys, k)
+		count += len(values) + 1
+	}
+	sort.Strings(keys)
+
+	parts := make(ds.PropertySlice, 0, count)
+	for _, k := range keys {
+		// Presence entry.
+		parts = append(parts, ds.MkProperty(encodeKey(k)))
+
+		// Value entries.
+		values := append([]string(nil), m[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, ds.MkProperty(encodeKey(fmt.Sprintf("%s=%s", k, v))))
+		}
+	}
+	return parts, nil
+}
+
+// AddLogStreamFieldFilter adds an indexed field filter to a Query object.
+//
+// This method will only add equality filters to the query. If value is empty,
+// a presence filter will be added, matching log streams that index the field;
+// otherwise, an equality filter will be added.
+//
+// This incorporates the encoding expressed by FieldMap.
+func AddLogStreamFieldFilter(q *ds.Query, key string, value string) *ds.Query {
+	if value == "" {
+		return q.Eq("_Fields", encodeKey(key))
+	}
+	return q.Eq("_Fields", encodeKey(fmt.Sprintf("%s=%s", key, value)))
+}
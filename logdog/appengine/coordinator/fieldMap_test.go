@@ -0,0 +1,115 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package coordinator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	ds "github.com/luci/gae/service/datastore"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFieldMap(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Will return nil when encoding an empty FieldMap.`, t, func() {
+		fm := FieldMap{}
+		props, err := fm.toProperties()
+		So(err, ShouldBeNil)
+		So(props, ShouldBeNil)
+	})
+
+	Convey(`Will encode fields as both value and presence entries.`, t, func() {
+		fm := FieldMap{
+			"status": {"404", "200"},
+			"user":   nil,
+		}
+
+		props, err := fm.toProperties()
+		So(err, ShouldBeNil)
+		So(props, ShouldResemble, sps(
+			encodeKey("status"),
+			encodeKey("status=200"),
+			encodeKey("status=404"),
+			encodeKey("user"),
+		))
+
+		Convey(`Can decode fields.`, func() {
+			dfm, err := fieldMapFromProperties(props)
+			So(err, ShouldBeNil)
+			So(dfm, ShouldResemble, FieldMap{
+				"status": {"200", "404"},
+				"user":   nil,
+			})
+		})
+
+		Convey(`Will decode mixed invalid and valid fields, returning errors for the invalid.`, func() {
+			props = append(props, []ds.Property{
+				ds.MkProperty(123), // Not a string.
+				ds.MkProperty(encodeKey("!!!invalid field!!!=12")),
+			}...)
+
+			_, err := fieldMapFromProperties(props)
+			So(err, ShouldHaveSameTypeAs, errors.MultiError{})
+			me := err.(errors.MultiError)
+			So(me[4], ShouldErrLike, "property is not a string")
+			So(me[5], ShouldErrLike, "invalid field")
+		})
+	})
+
+	Convey(`Adding values to a FieldMap`, t, func() {
+		fm := FieldMap{"status": nil}
+
+		Convey(`Will add distinct values only once.`, func() {
+			So(fm.Add("status", "200"), ShouldBeTrue)
+			So(fm.Add("status", "200"), ShouldBeFalse)
+			So(fm.Add("status", "404"), ShouldBeTrue)
+			So(fm["status"], ShouldResemble, []string{"200", "404"})
+		})
+
+		Convey(`Will not add values that are too large.`, func() {
+			So(fm.Add("status", strings.Repeat("x", types.MaxIndexedFieldValueSize+1)), ShouldBeFalse)
+			So(fm["status"], ShouldHaveLength, 0)
+		})
+
+		Convey(`Will stop adding values at the maximum.`, func() {
+			for i := 0; i < types.MaxIndexedFieldValues; i++ {
+				So(fm.Add("status", fmt.Sprintf("%d", i)), ShouldBeTrue)
+			}
+			So(fm.Add("status", "overflow"), ShouldBeFalse)
+			So(fm.Validate(), ShouldBeNil)
+		})
+	})
+
+	Convey(`A Datastore query`, t, func() {
+		q := ds.NewQuery("test")
+
+		Convey(`Will request an encoded presence key if no value is specified.`, func() {
+			q = AddLogStreamFieldFilter(q, "status", "")
+
+			fq, err := q.Finalize()
+			So(err, ShouldBeNil)
+			So(fq.EqFilters(), ShouldResemble, map[string]ds.PropertySlice{
+				"_Fields": sps(encodeKey("status")),
+			})
+		})
+
+		Convey(`Will request an encoded key/value.`, func() {
+			q = AddLogStreamFieldFilter(q, "status", "404")
+
+			fq, err := q.Finalize()
+			So(err, ShouldBeNil)
+			So(fq.EqFilters(), ShouldResemble, map[string]ds.PropertySlice{
+				"_Fields": sps(encodeKey("status=404")),
+			})
+		})
+	})
+}
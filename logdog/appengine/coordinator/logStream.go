@@ -49,6 +49,10 @@ const CurrentSchemaVersion = "1"
 //	  - _Tags is a string slice containing:
 //	    - KEY=[VALUE] key/value tags.
 //	    - KEY key presence tags.
+//
+//	  - _Fields is a string slice containing a STRUCTURED stream's:
+//	    - KEY=VALUE indexed field values.
+//	    - KEY indexed field presence entries.
 type LogStream struct {
 	// ID is the LogStream ID. It is generated from the stream's Prefix/Name
 	// fields.
@@ -101,6 +105,15 @@ type LogStream struct {
 	// key/value queries.
 	Tags TagMap `gae:"-"`
 
+	// Fields is the set of indexed field values that have been observed in a
+	// STRUCTURED log stream. Each of the stream's indexed fields has an entry,
+	// even if no values have been observed for it. Fields can be queried
+	// against.
+	//
+	// Like Tags, the serialization/deserialization is handled manually in order
+	// to enable key/value queries.
+	Fields FieldMap `gae:"-"`
+
 	// extra causes datastore to ignore unrecognized fields and strip them in
 	// future writes.
 	extra ds.PropertyMap `gae:"-,extra"`
@@ -158,6 +171,11 @@ func (s *LogStream) Load(pmap ds.PropertyMap) error {
 			// Load the tag map. Ignore errors.
 			tm, _ := tagMapFromProperties(pmap.Slice(k))
 			s.Tags = tm
+
+		case "_Fields":
+			// Load the field map. Ignore errors.
+			fm, _ := fieldMapFromProperties(pmap.Slice(k))
+			s.Fields = fm
 		}
 	}
 
@@ -196,6 +214,12 @@ func (s *LogStream) Save(withMeta bool) (ds.PropertyMap, error) {
 		return nil, fmt.Errorf("failed to encode tags: %v", err)
 	}
 
+	// Encode _Fields.
+	pmap["_Fields"], err = s.Fields.toProperties()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode fields: %v", err)
+	}
+
 	// Generate our path components, "_C".
 	pmap["_C"] = generatePathComponents(s.Prefix, s.Name)
 
@@ -230,7 +254,7 @@ func (s *LogStream) validateImpl(enforceHashID bool) error {
 	}
 
 	switch s.StreamType {
-	case logpb.StreamType_TEXT, logpb.StreamType_BINARY, logpb.StreamType_DATAGRAM:
+	case logpb.StreamType_TEXT, logpb.StreamType_BINARY, logpb.StreamType_DATAGRAM, logpb.StreamType_STRUCTURED:
 		break
 
 	default:
@@ -242,6 +266,9 @@ func (s *LogStream) validateImpl(enforceHashID bool) error {
 			return fmt.Errorf("invalid tag [%s]: %s", k, err)
 		}
 	}
+	if err := s.Fields.Validate(); err != nil {
+		return fmt.Errorf("invalid fields: %s", err)
+	}
 
 	// Ensure that our Descriptor can be unmarshalled.
 	if _, err := s.DescriptorValue(); err != nil {
@@ -268,6 +295,7 @@ func (s *LogStream) DescriptorValue() (*logpb.LogStreamDescriptor, error) {
 //   - Descriptor
 //   - Timestamp
 //   - Tags
+//   - Fields (indexed field keys)
 func (s *LogStream) LoadDescriptor(desc *logpb.LogStreamDescriptor) error {
 	if err := desc.Validate(true); err != nil {
 		return fmt.Errorf("invalid descriptor: %v", err)
@@ -291,6 +319,16 @@ func (s *LogStream) LoadDescriptor(desc *logpb.LogStreamDescriptor) error {
 
 	// Note: tag content was validated via ValidateDescriptor.
 	s.Tags = TagMap(desc.Tags)
+
+	// Note: indexed field keys were validated via ValidateDescriptor. Values are
+	// added as they are observed.
+	s.Fields = nil
+	if len(desc.IndexedFields) > 0 {
+		s.Fields = make(FieldMap, len(desc.IndexedFields))
+		for _, k := range desc.IndexedFields {
+			s.Fields[k] = nil
+		}
+	}
 	return nil
 }
 
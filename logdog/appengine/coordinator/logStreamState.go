@@ -120,6 +120,12 @@ type LogStreamState struct {
 	// ArchiveDataSize is the size, in bytes, of the archived data. It will be
 	// zero if the file is not archived.
 	ArchiveDataSize int64 `gae:",noindex"`
+	// ArchiveSearchURL is the Google Storage URL where the log stream's search
+	// index is archived. It will be empty if the stream has no search index.
+	ArchiveSearchURL string `gae:",noindex"`
+	// ArchiveSearchSize is the size, in bytes, of the archived search index. It
+	// will be zero if the file is not archived.
+	ArchiveSearchSize int64 `gae:",noindex"`
 
 	// extra causes datastore to ignore unrecognized fields and strip them in
 	// future writes.
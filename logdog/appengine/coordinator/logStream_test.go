@@ -170,6 +170,29 @@ func TestLogStream(t *testing.T) {
 			So(ls.LoadDescriptor(&desc), ShouldErrLike, "invalid descriptor")
 		})
 
+		Convey(`Can populate a STRUCTURED LogStream's indexed fields.`, func() {
+			desc.StreamType = logpb.StreamType_STRUCTURED
+			desc.ContentType = string(types.ContentTypeJSONLines)
+			desc.IndexedFields = []string{"status", "user"}
+
+			So(ls.LoadDescriptor(&desc), ShouldBeNil)
+			So(ls.Fields, ShouldResemble, FieldMap{"status": nil, "user": nil})
+
+			Convey(`Can write and read back indexed field values.`, func() {
+				So(ls.Fields.Add("status", "404"), ShouldBeTrue)
+				So(ds.Put(c, &ls), ShouldBeNil)
+
+				ls2 := LogStream{ID: ls.ID}
+				So(ds.Get(c, &ls2), ShouldBeNil)
+				So(ls2.Fields, ShouldResemble, FieldMap{"status": {"404"}, "user": nil})
+
+				var streams []*LogStream
+				q := AddLogStreamFieldFilter(ds.NewQuery("LogStream"), "status", "404")
+				So(ds.GetAll(c, q, &streams), ShouldBeNil)
+				So(streams, ShouldHaveLength, 1)
+			})
+		})
+
 		Convey(`Writing multiple LogStream entries`, func() {
 			times := map[string]time.Time{}
 			streamNames := []string{
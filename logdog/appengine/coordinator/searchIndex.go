@@ -0,0 +1,47 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package coordinator
+
+import (
+	"io"
+	"io/ioutil"
+
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/gcloud/gs"
+	"github.com/luci/luci-go/logdog/api/logpb"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// MaxSearchIndexSize is the maximum size, in bytes, of an archived
+// LogSearchIndex that will be loaded. Log streams with larger search indexes
+// are not searched.
+const MaxSearchIndexSize = 16 * 1024 * 1024
+
+// LoadSearchIndex loads the archived LogSearchIndex at path p.
+//
+// It fails if the search index is larger than MaxSearchIndexSize.
+func LoadSearchIndex(client gs.Client, p gs.Path) (*logpb.LogSearchIndex, error) {
+	r, err := client.NewReader(p, 0, -1)
+	if err != nil {
+		return nil, errors.Annotate(err).Reason("failed to open search index %(path)q").D("path", p).Err()
+	}
+	defer r.Close()
+
+	d, err := ioutil.ReadAll(io.LimitReader(r, MaxSearchIndexSize+1))
+	if err != nil {
+		return nil, errors.Annotate(err).Reason("failed to read search index %(path)q").D("path", p).Err()
+	}
+	if len(d) > MaxSearchIndexSize {
+		return nil, errors.Reason("search index %(path)q exceeds %(max)d bytes").
+			D("path", p).D("max", MaxSearchIndexSize).Err()
+	}
+
+	var sidx logpb.LogSearchIndex
+	if err := proto.Unmarshal(d, &sidx); err != nil {
+		return nil, errors.Annotate(err).Reason("failed to unmarshal search index %(path)q").D("path", p).Err()
+	}
+	return &sidx, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/luci/luci-go/common/gcloud/pubsub"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/logdog/api/config/svcconfig"
+	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/appengine/coordinator/config"
 	"github.com/luci/luci-go/logdog/common/storage"
 	"github.com/luci/luci-go/logdog/common/storage/archive"
@@ -81,6 +82,12 @@ type Services interface {
 	// The caller must close the returned instance if successful.
 	StorageForStream(context.Context, *LogStreamState) (Storage, error)
 
+	// SearchIndexForStream returns the archived search index for the supplied
+	// log stream.
+	//
+	// If the log stream has no archived search index, nil will be returned.
+	SearchIndexForStream(context.Context, *LogStreamState) (*logpb.LogSearchIndex, error)
+
 	// ArchivalPublisher returns an ArchivalPublisher instance.
 	ArchivalPublisher(context.Context) (ArchivalPublisher, error)
 }
@@ -214,6 +221,25 @@ func (s *prodServicesInst) StorageForStream(c context.Context, lst *LogStreamSta
 	return s.newGoogleStorage(c, gs.Path(lst.ArchiveIndexURL), gs.Path(lst.ArchiveStreamURL))
 }
 
+func (s *prodServicesInst) SearchIndexForStream(c context.Context, lst *LogStreamState) (*logpb.LogSearchIndex, error) {
+	if !lst.ArchivalState().Archived() || lst.ArchiveSearchURL == "" {
+		return nil, nil
+	}
+
+	client, err := s.newGSClient(c)
+	if err != nil {
+		log.WithError(err).Errorf(c, "Failed to create Google Storage client.")
+		return nil, err
+	}
+	defer func() {
+		if err := client.Close(); err != nil {
+			log.WithError(err).Warningf(c, "Failed to close Google Storage client.")
+		}
+	}()
+
+	return LoadSearchIndex(client, gs.Path(lst.ArchiveSearchURL))
+}
+
 func (s *prodServicesInst) newBigTableStorage(c context.Context) (Storage, error) {
 	cfg, err := s.Config(c)
 	if err != nil {
@@ -46,6 +46,17 @@ type Config struct {
 	// bundled data. Other factors can cause the bundle to be sent before this,
 	// but it is an upper bound.
 	MaxBufferDelay time.Duration
+
+	// Redactor, if not nil, redacts secrets from the lines of TEXT streams
+	// before they are bundled. Streams whose Properties set NoRedact are not
+	// redacted.
+	Redactor *Redactor
+}
+
+// Stats is a snapshot of a Bundler's statistics.
+type Stats struct {
+	// Redactions is the number of secrets that were redacted from stream data.
+	Redactions int64
 }
 
 type bundlerStream interface {
@@ -77,6 +88,8 @@ type Bundler struct {
 
 	// prefixCounter is a global counter for Prefix-wide streams.
 	prefixCounter counter
+	// redactionCounter counts the secrets redacted from all streams.
+	redactionCounter counter
 }
 
 // New instantiates a new Bundler instance.
@@ -123,7 +136,11 @@ func (b *Bundler) Register(p *streamproto.Properties) (Stream, error) {
 	}
 
 	err := error(nil)
-	c.parser, err = newParser(p, &b.prefixCounter)
+	var r *Redactor
+	if !p.NoRedact {
+		r = b.c.Redactor
+	}
+	c.parser, err = newParser(p, &b.prefixCounter, r, &b.redactionCounter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create stream parser: %s", err)
 	}
@@ -163,6 +180,13 @@ func (b *Bundler) GetStreamDescs() map[string]*logpb.LogStreamDescriptor {
 	return streams
 }
 
+// Stats returns a snapshot of the Bundler's statistics.
+func (b *Bundler) Stats() Stats {
+	return Stats{
+		Redactions: b.redactionCounter.value(),
+	}
+}
+
 // CloseAndFlush closes the Bundler, alerting it that no more streams will be
 // added and that existing data may be aggressively output.
 //
@@ -22,3 +22,11 @@ func (c *counter) next() int64 {
 	// (current counter value).
 	return atomic.AddInt64(&c.current, 1) - 1
 }
+
+func (c *counter) add(delta int64) {
+	atomic.AddInt64(&c.current, delta)
+}
+
+func (c *counter) value() int64 {
+	return atomic.LoadInt64(&c.current)
+}
@@ -33,6 +33,10 @@ type constraints struct {
 	// expectation that no further data will be buffered. It is only relevant
 	// if allowSplit is also true.
 	closed bool
+
+	// empty means that the bundle has no content yet, so limit is as large as
+	// it can ever be for a LogEntry.
+	empty bool
 }
 
 // parser is a stateful presence bound to a single log stream. A parser yields
@@ -55,7 +59,11 @@ type parser interface {
 	firstChunkTime() (time.Time, bool)
 }
 
-func newParser(p *streamproto.Properties, c *counter) (parser, error) {
+// newParser creates a parser for the stream described by p.
+//
+// If r is not nil, TEXT stream lines are redacted with it, and the redactions
+// are counted in redactions.
+func newParser(p *streamproto.Properties, c *counter, r *Redactor, redactions *counter) (parser, error) {
 	base := baseParser{
 		counter:  c,
 		timeBase: google.TimeFromProto(p.Timestamp),
@@ -65,6 +73,8 @@ func newParser(p *streamproto.Properties, c *counter) (parser, error) {
 	case logpb.StreamType_TEXT:
 		return &textParser{
 			baseParser: base,
+			redactor:   r,
+			redactions: redactions,
 		}, nil
 
 	case logpb.StreamType_BINARY:
@@ -78,6 +88,12 @@ func newParser(p *streamproto.Properties, c *counter) (parser, error) {
 			maxSize:    int64(types.MaxDatagramSize),
 		}, nil
 
+	case logpb.StreamType_STRUCTURED:
+		return &structuredParser{
+			baseParser: base,
+			maxSize:    int64(types.MaxStructuredRecordSize),
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("unknown stream type: %v", p.StreamType)
 	}
@@ -80,6 +80,9 @@ func (s *parserTestStream) next(d interface{}) *logpb.LogEntry {
 	case logpb.Datagram:
 		le.Content = &logpb.LogEntry_Datagram{Datagram: &t}
 
+	case logpb.Structured:
+		le.Content = &logpb.LogEntry_Structured{Structured: &t}
+
 	default:
 		panic(fmt.Errorf("unknown content type: %T", t))
 	}
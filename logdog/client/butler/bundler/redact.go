@@ -0,0 +1,132 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bundler
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// RedactedText is the text that replaces each redacted secret.
+const RedactedText = "[REDACTED]"
+
+// Redactor replaces secrets in the lines of TEXT streams with RedactedText
+// before they are bundled.
+//
+// Secrets are matched within individual lines. Lines that are too long to fit
+// in a bundle are split outside of their secrets, but a secret that is split
+// across two log entries, because its line was emitted before all of it was
+// written, may not be redacted.
+//
+// A Redactor is immutable, and may be shared between streams.
+type Redactor struct {
+	re *regexp.Regexp
+}
+
+// NewRedactor creates a Redactor that redacts the supplied literal secrets and
+// the text that matches the supplied regular expressions.
+//
+// Empty literals are ignored. Regular expressions must not match the empty
+// string. If there is nothing to redact, NewRedactor returns nil.
+func NewRedactor(literals, patterns []string) (*Redactor, error) {
+	exprs := make([]string, 0, len(literals)+len(patterns))
+	for _, l := range literals {
+		if l != "" {
+			exprs = append(exprs, regexp.QuoteMeta(l))
+		}
+	}
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %s", p, err)
+		}
+		if re.MatchString("") {
+			return nil, fmt.Errorf("redaction pattern %q matches the empty string", p)
+		}
+		exprs = append(exprs, p)
+	}
+	if len(exprs) == 0 {
+		return nil, nil
+	}
+
+	for i, e := range exprs {
+		exprs[i] = "(?:" + e + ")"
+	}
+	re, err := regexp.Compile(strings.Join(exprs, "|"))
+	if err != nil {
+		return nil, err
+	}
+	return &Redactor{re}, nil
+}
+
+// ReadRedactionPatterns reads redaction regular expressions from r, one per
+// line. Empty lines and lines starting with "#" are ignored.
+func ReadRedactionPatterns(r io.Reader) ([]string, error) {
+	var patterns []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return patterns, nil
+}
+
+// Redact returns line with its secrets replaced, and the number of secrets that
+// were replaced.
+func (r *Redactor) Redact(line string) (string, int) {
+	count := 0
+	redacted := r.re.ReplaceAllStringFunc(line, func(string) string {
+		count++
+		return RedactedText
+	})
+	return redacted, count
+}
+
+// redactPrefix returns the length of the longest prefix of line that fits in
+// limit bytes once redacted, along with the redacted prefix and the number of
+// secrets that were replaced in it.
+//
+// Secrets are matched in the whole line, and the prefix never ends inside one
+// of them or inside a UTF-8 character. It is empty if nothing fits.
+func (r *Redactor) redactPrefix(line string, limit int) (int, string, int) {
+	var buf bytes.Buffer
+	cut, count := 0, 0
+	for _, span := range append(r.re.FindAllStringIndex(line, -1), []int{len(line), len(line)}) {
+		// Copy the text up to the secret, as much of it as fits.
+		if avail := limit - buf.Len(); span[0]-cut > avail {
+			end := cut + avail
+			for end > cut && !utf8.RuneStart(line[end]) {
+				end--
+			}
+			buf.WriteString(line[cut:end])
+			return end, buf.String(), count
+		}
+		buf.WriteString(line[cut:span[0]])
+		cut = span[0]
+
+		// Replace the secret, if its replacement fits.
+		if span[1] == span[0] {
+			continue
+		}
+		if buf.Len()+len(RedactedText) > limit {
+			break
+		}
+		buf.WriteString(RedactedText)
+		cut = span[1]
+		count++
+	}
+	return cut, buf.String(), count
+}
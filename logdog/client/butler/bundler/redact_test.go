@@ -0,0 +1,88 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bundler
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRedactor(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A Redactor`, t, func() {
+		r, err := NewRedactor([]string{"hunter2", "", "a.b"}, []string{`token=[0-9a-f]+`})
+		So(err, ShouldBeNil)
+
+		Convey(`Redacts literals and patterns`, func() {
+			line, count := r.Redact("password hunter2, token=deadbeef and hunter2")
+			So(line, ShouldEqual, "password [REDACTED], [REDACTED] and [REDACTED]")
+			So(count, ShouldEqual, 3)
+		})
+
+		Convey(`Quotes literals`, func() {
+			line, count := r.Redact("axb a.b")
+			So(line, ShouldEqual, "axb [REDACTED]")
+			So(count, ShouldEqual, 1)
+		})
+
+		Convey(`Leaves other lines alone`, func() {
+			line, count := r.Redact("nothing to see here")
+			So(line, ShouldEqual, "nothing to see here")
+			So(count, ShouldEqual, 0)
+		})
+
+		Convey(`Cuts lines outside of secrets`, func() {
+			const line = "pw hunter2 été"
+			for _, tc := range []struct {
+				limit    int
+				cut      int
+				redacted string
+				count    int
+			}{
+				{0, 0, "", 0},
+				{3, 3, "pw ", 0},
+				{12, 3, "pw ", 0},
+				{13, 10, "pw [REDACTED]", 1},
+				{14, 11, "pw [REDACTED] ", 1},
+				{15, 11, "pw [REDACTED] ", 1},
+				{16, 13, "pw [REDACTED] é", 1},
+				{100, len(line), "pw [REDACTED] été", 1},
+			} {
+				cut, redacted, count := r.redactPrefix(line, tc.limit)
+				So(cut, ShouldEqual, tc.cut)
+				So(redacted, ShouldEqual, tc.redacted)
+				So(count, ShouldEqual, tc.count)
+			}
+		})
+	})
+
+	Convey(`NewRedactor`, t, func() {
+		Convey(`Returns nil if there is nothing to redact`, func() {
+			r, err := NewRedactor([]string{""}, nil)
+			So(err, ShouldBeNil)
+			So(r, ShouldBeNil)
+		})
+
+		Convey(`Rejects invalid patterns`, func() {
+			_, err := NewRedactor(nil, []string{`(`})
+			So(err, ShouldErrLike, "invalid redaction pattern")
+		})
+
+		Convey(`Rejects patterns matching the empty string`, func() {
+			_, err := NewRedactor(nil, []string{`x*`})
+			So(err, ShouldErrLike, "matches the empty string")
+		})
+	})
+
+	Convey(`ReadRedactionPatterns skips comments and empty lines`, t, func() {
+		patterns, err := ReadRedactionPatterns(strings.NewReader("# Tokens.\n\ntoken=\\S+\n  key-[0-9]+  \n"))
+		So(err, ShouldBeNil)
+		So(patterns, ShouldResemble, []string{`token=\S+`, `key-[0-9]+`})
+	})
+}
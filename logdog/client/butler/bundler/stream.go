@@ -291,6 +291,7 @@ func (s *streamImpl) nextBundleEntryLocked(bb *builder, aggressive bool) (bool,
 	modified := false
 
 	for c.limit = bb.remaining(); c.limit > 0; c.limit = bb.remaining() {
+		c.empty = !bb.hasContent()
 		emittedLog := false
 		err := s.withParserLock(func() error {
 			le, err := s.c.parser.nextEntry(&c)
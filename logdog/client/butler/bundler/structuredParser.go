@@ -0,0 +1,104 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bundler
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/structured"
+)
+
+// structuredParser is a parser implementation for the LogDog STRUCTURED stream
+// type.
+//
+// STRUCTURED stream data is a series of newline-delimited JSON objects. Each
+// object is parsed into a single structured record. Records are never split
+// across LogEntry boundaries.
+type structuredParser struct {
+	baseParser
+
+	// maxSize is the maximum size of a single record. A record that exceeds this
+	// size is a processing error.
+	maxSize int64
+
+	sequence int64
+	buf      bytes.Buffer
+}
+
+var _ parser = (*structuredParser)(nil)
+
+func (p *structuredParser) nextEntry(c *constraints) (*logpb.LogEntry, error) {
+	limit := int64(c.limit)
+	ts := time.Time{}
+	st := logpb.Structured{}
+	for p.Len() > 0 {
+		// Use the timestamp of the first data chunk.
+		if len(st.Records) == 0 {
+			ts, _ = p.firstChunkTime()
+		} else if ct, _ := p.firstChunkTime(); !ct.Equal(ts) {
+			// New timestamp, so need new LogEntry.
+			break
+		}
+
+		// Find the end of the next record.
+		br := p.ViewLimit(p.maxSize + 1)
+		idx := br.Index(posixNewlineBytes)
+		delimSize := int64(len(posixNewline))
+		if idx < 0 {
+			if br.Remaining() > p.maxSize {
+				return nil, fmt.Errorf("structured record exceeds maximum size (%d > %d)", br.Remaining(), p.maxSize)
+			}
+			if !c.closed {
+				// Wait for the rest of the record.
+				break
+			}
+
+			// This is the last record in the stream, and it is not delimited.
+			idx, delimSize = br.Remaining(), 0
+		}
+
+		p.buf.Reset()
+		p.buf.ReadFrom(br.CloneLimit(idx))
+		line := bytes.TrimSpace(p.buf.Bytes())
+		if len(line) == 0 {
+			// Skip blank lines.
+			p.Consume(idx + delimSize)
+			continue
+		}
+
+		rec, err := structured.Parse(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid structured record #%d: %s", p.sequence+int64(len(st.Records)), err)
+		}
+
+		// Will this record fit? If not, leave it for the next LogEntry, unless it
+		// won't fit in any.
+		size := int64(protoSize(rec))
+		size += int64(varintLength(uint64(size))) + 1
+		if size > limit {
+			if c.allowSplit && c.empty && len(st.Records) == 0 {
+				return nil, fmt.Errorf("structured record #%d doesn't fit in a bundle (%d > %d)", p.sequence, size, limit)
+			}
+			break
+		}
+
+		st.Records = append(st.Records, rec)
+		p.Consume(idx + delimSize)
+		limit -= size
+	}
+
+	if len(st.Records) == 0 {
+		return nil, nil
+	}
+	le := p.baseLogEntry(ts)
+	le.Sequence = uint64(p.sequence)
+	le.Content = &logpb.LogEntry_Structured{Structured: &st}
+
+	p.sequence += int64(len(st.Records))
+	return le, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package bundler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/structured"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func structuredRecords(recs ...string) logpb.Structured {
+	st := logpb.Structured{}
+	for _, r := range recs {
+		rec, err := structured.Parse([]byte(r))
+		if err != nil {
+			panic(err)
+		}
+		st.Records = append(st.Records, rec)
+	}
+	return st
+}
+
+func TestStructuredParser(t *testing.T) {
+	Convey(`A structuredParser`, t, func() {
+		s := &parserTestStream{
+			now:         time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+			prefixIndex: 1337,
+		}
+		p := &structuredParser{
+			baseParser: s.base(),
+			maxSize:    64,
+		}
+		c := &constraints{
+			limit: 1024,
+		}
+
+		Convey(`Will parse complete records, skipping blank lines.`, func() {
+			p.Append(dstr(s.now, "{\"a\": 1}\n\r\n{\"b\": \"x\"}\r\n{\"c\": tr"))
+
+			le, err := p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.le(0, structuredRecords(`{"a": 1}`, `{"b": "x"}`)))
+
+			le, err = p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, ShouldBeNil)
+
+			Convey(`Will parse the remainder of a record when it arrives.`, func() {
+				p.Append(dstr(s.now, "ue}\n"))
+
+				le, err := p.nextEntry(c)
+				So(err, ShouldBeNil)
+				So(le, shouldMatchLogEntry, s.le(2, structuredRecords(`{"c": true}`)))
+			})
+
+			Convey(`Will parse an undelimited final record when closed.`, func() {
+				p.Append(dstr(s.now, "ue}"))
+				c.closed = true
+
+				le, err := p.nextEntry(c)
+				So(err, ShouldBeNil)
+				So(le, shouldMatchLogEntry, s.le(2, structuredRecords(`{"c": true}`)))
+			})
+		})
+
+		Convey(`Will start a new LogEntry when the timestamp changes.`, func() {
+			p.Append(dstr(s.now, "{\"a\": 1}\n"))
+			p.Append(dstr(s.now.Add(time.Second), "{\"b\": 2}\n"))
+
+			le, err := p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.le(0, structuredRecords(`{"a": 1}`)))
+
+			le, err = p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.add(time.Second).le(1, structuredRecords(`{"b": 2}`)))
+		})
+
+		Convey(`Will not split a record that exceeds the remaining limit.`, func() {
+			p.Append(dstr(s.now, "{\"a\": 1}\n{\"b\": 2}\n"))
+			c.limit = 15
+
+			le, err := p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.le(0, structuredRecords(`{"a": 1}`)))
+
+			le, err = p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.le(1, structuredRecords(`{"b": 2}`)))
+		})
+
+		Convey(`Will error on a record that doesn't fit in an empty bundle.`, func() {
+			p.Append(dstr(s.now, "{\"a\": \"0123456789\"}\n"))
+			c.limit = 15
+
+			le, err := p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, ShouldBeNil)
+
+			c.allowSplit = true
+			le, err = p.nextEntry(c)
+			So(err, ShouldBeNil)
+			So(le, ShouldBeNil)
+
+			c.empty = true
+			_, err = p.nextEntry(c)
+			So(err, ShouldErrLike, "structured record #0 doesn't fit in a bundle")
+		})
+
+		Convey(`Will error on an invalid record.`, func() {
+			p.Append(dstr(s.now, "{\"a\": 1}\nnot JSON\n"))
+
+			_, err := p.nextEntry(c)
+			So(err, ShouldErrLike, "invalid structured record #1")
+		})
+
+		Convey(`Will error on a record that exceeds the maximum size.`, func() {
+			p.Append(dstr(s.now, "{\"a\": \""))
+			for i := 0; i < 8; i++ {
+				p.Append(dstr(s.now, "0123456789"))
+			}
+
+			_, err := p.nextEntry(c)
+			So(err, ShouldErrLike, "exceeds maximum size")
+		})
+	})
+}
@@ -27,6 +27,11 @@ type textParser struct {
 
 	sequence int64
 	buf      bytes.Buffer
+
+	// redactor, if not nil, redacts the secrets of each line. The number of
+	// secrets that it redacts is added to redactions.
+	redactor   *Redactor
+	redactions *counter
 }
 
 var _ parser = (*textParser)(nil)
@@ -36,8 +41,14 @@ func (p *textParser) nextEntry(c *constraints) (*logpb.LogEntry, error) {
 	ts := time.Time{}
 	txt := logpb.Text{}
 	lineCount := 0
+
 	for limit > 0 {
 		br := p.ViewLimit(limit)
+		if p.redactor != nil {
+			// Secrets are matched in whole lines, so read past our limit. Lines that
+			// don't fit are cut afterwards, outside of their secrets.
+			br = p.View()
+		}
 		if br.Remaining() == 0 {
 			// Exceeded either limit or available buffer data.
 			break
@@ -93,9 +104,6 @@ func (p *textParser) nextEntry(c *constraints) (*logpb.LogEntry, error) {
 		}
 
 		partial := (idx < 0)
-		if !partial {
-			lineCount++
-		}
 
 		// If we didn't have a delimiter, make sure we don't terminate in the middle
 		// of a UTF8 character.
@@ -117,12 +125,38 @@ func (p *textParser) nextEntry(c *constraints) (*logpb.LogEntry, error) {
 			p.buf.Truncate(lidx)
 		}
 
+		value, redacted, consumed := p.buf.String(), 0, p.buf.Len()
+		if p.redactor != nil {
+			value, redacted = p.redactor.Redact(value)
+
+			// If the redacted line doesn't fit, emit as much of it as we can as a
+			// partial line, without cutting it inside a secret.
+			if int64(len(value)+len(newline)) > limit {
+				if !c.allowSplit {
+					break
+				}
+				consumed, value, redacted = p.redactor.redactPrefix(p.buf.String(), int(limit))
+				if consumed == 0 {
+					break
+				}
+				newline = ""
+				partial = true
+			}
+		}
+
+		if redacted > 0 {
+			p.redactions.add(int64(redacted))
+		}
+		if !partial {
+			lineCount++
+		}
+
 		txt.Lines = append(txt.Lines, &logpb.Text_Line{
-			Value:     p.buf.String(),
+			Value:     value,
 			Delimiter: newline,
 		})
-		p.Consume(int64(p.buf.Len() + len(newline)))
-		limit -= int64(p.buf.Len() + len(newline))
+		p.Consume(int64(consumed + len(newline)))
+		limit -= int64(len(value) + len(newline))
 	}
 
 	if len(txt.Lines) == 0 {
@@ -215,3 +215,128 @@ func TestTextParser(t *testing.T) {
 		}
 	})
 }
+
+func TestTextParserRedaction(t *testing.T) {
+	Convey(`Using a parser test stream with a Redactor`, t, func() {
+		s := &parserTestStream{
+			now:         time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+			prefixIndex: 1337,
+		}
+
+		r, err := NewRedactor([]string{"hunter2"}, nil)
+		So(err, ShouldBeNil)
+
+		var redactions counter
+		p := &textParser{
+			baseParser: s.base(),
+			redactor:   r,
+			redactions: &redactions,
+		}
+		p.Append(dstr(s.now, "my password is hunter2\nhunter2hunter2\nok\n"))
+
+		le, err := p.nextEntry(&constraints{limit: 1024})
+		So(err, ShouldBeNil)
+		So(le, shouldMatchLogEntry, s.le(0, logpb.Text{
+			Lines: []*logpb.Text_Line{
+				{Value: "my password is [REDACTED]", Delimiter: "\n"},
+				{Value: "[REDACTED][REDACTED]", Delimiter: "\n"},
+				{Value: "ok", Delimiter: "\n"},
+			},
+		}))
+		So(redactions.value(), ShouldEqual, 3)
+		So(p.Len(), ShouldEqual, 0)
+	})
+
+	Convey(`Using a parser test stream with a Redactor and a limit`, t, func() {
+		s := &parserTestStream{
+			now:         time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+			prefixIndex: 1337,
+		}
+
+		r, err := NewRedactor([]string{"hunter2"}, nil)
+		So(err, ShouldBeNil)
+
+		var redactions counter
+		p := &textParser{
+			baseParser: s.base(),
+			redactor:   r,
+			redactions: &redactions,
+		}
+
+		Convey(`Ends the entry before a line that doesn't fit once redacted`, func() {
+			p.Append(dstr(s.now, "ok\nhunter2\n"))
+
+			le, err := p.nextEntry(&constraints{limit: 12})
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.le(0, logpb.Text{
+				Lines: []*logpb.Text_Line{
+					{Value: "ok", Delimiter: "\n"},
+				},
+			}))
+			So(redactions.value(), ShouldEqual, 0)
+
+			le, err = p.nextEntry(&constraints{limit: 12})
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.le(1, logpb.Text{
+				Lines: []*logpb.Text_Line{
+					{Value: "[REDACTED]", Delimiter: "\n"},
+				},
+			}))
+			So(redactions.value(), ShouldEqual, 1)
+			So(p.Len(), ShouldEqual, 0)
+		})
+
+		Convey(`Splits a single line that doesn't fit once redacted`, func() {
+			p.Append(dstr(s.now, "a hunter2\n"))
+
+			le, err := p.nextEntry(&constraints{limit: 12, allowSplit: true})
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.le(0, logpb.Text{
+				Lines: []*logpb.Text_Line{
+					{Value: "a [REDACTED]"},
+				},
+			}))
+			So(p.Len(), ShouldEqual, 1)
+		})
+
+		Convey(`Doesn't split a line inside a secret`, func() {
+			p.Append(dstr(s.now, "a hunter2\n"))
+
+			le, err := p.nextEntry(&constraints{limit: 5, allowSplit: true})
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.le(0, logpb.Text{
+				Lines: []*logpb.Text_Line{
+					{Value: "a "},
+				},
+			}))
+			So(redactions.value(), ShouldEqual, 0)
+			So(p.Len(), ShouldEqual, 8)
+
+			// The secret alone doesn't fit either.
+			le, err = p.nextEntry(&constraints{limit: 5, allowSplit: true})
+			So(err, ShouldBeNil)
+			So(le, ShouldBeNil)
+			So(p.Len(), ShouldEqual, 8)
+
+			le, err = p.nextEntry(&constraints{limit: 12, allowSplit: true})
+			So(err, ShouldBeNil)
+			So(le, shouldMatchLogEntry, s.le(0, logpb.Text{
+				Lines: []*logpb.Text_Line{
+					{Value: "[REDACTED]", Delimiter: "\n"},
+				},
+			}))
+			So(redactions.value(), ShouldEqual, 1)
+			So(p.Len(), ShouldEqual, 0)
+		})
+
+		Convey(`Doesn't emit a single line that doesn't fit once redacted without splitting`, func() {
+			p.Append(dstr(s.now, "a hunter2\n"))
+
+			le, err := p.nextEntry(&constraints{limit: 12})
+			So(err, ShouldBeNil)
+			So(le, ShouldBeNil)
+			So(redactions.value(), ShouldEqual, 0)
+			So(p.Len(), ShouldEqual, 10)
+		})
+	})
+}
@@ -91,6 +91,10 @@ type Config struct {
 	// IOKeepAliveWriter is an io.Writer to send keep-alive updates through. This
 	// must be set for I/O keep-alive to be active.
 	IOKeepAliveWriter io.Writer
+
+	// Redactor, if not nil, redacts secrets from TEXT streams before they are
+	// sent to Output. Streams can opt out through their Properties' NoRedact.
+	Redactor *bundler.Redactor
 }
 
 // Validate validates that the configuration is sufficient to instantiate a
@@ -181,6 +185,7 @@ func New(ctx context.Context, config Config) (*Butler, error) {
 		Prefix:           config.Prefix,
 		MaxBufferedBytes: streamBufferSize,
 		MaxBundleSize:    config.Output.MaxSize(),
+		Redactor:         config.Redactor,
 	}
 	if config.BufferLogs {
 		bc.MaxBufferDelay = config.MaxBufferAge
@@ -310,7 +315,8 @@ func (b *Butler) Wait() error {
 	log.Debugf(b.ctx, "Output queue has shut down.")
 
 	log.Fields{
-		"stats": b.c.Output.Stats(),
+		"stats":      b.c.Output.Stats(),
+		"redactions": b.bundler.Stats().Redactions,
 	}.Infof(b.ctx, "Message output has closed")
 	return b.getRunErr()
 }
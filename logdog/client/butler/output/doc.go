@@ -11,5 +11,6 @@
 //
 // The package current provides the following implementations:
 //   - pubsub: Write logs to Google Cloud Pub/Sub.
+//   - s3: Spool logs locally and upload them to an S3-compatible object store.
 //   - log: (Debug/testing) data is dumped to the installed Logger instance.
 package output
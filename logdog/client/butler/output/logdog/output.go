@@ -18,6 +18,7 @@ import (
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/common/retry"
 	"github.com/luci/luci-go/grpc/prpc"
+	bundles "github.com/luci/luci-go/logdog/api/endpoints/coordinator/bundles/v1"
 	api "github.com/luci/luci-go/logdog/api/endpoints/coordinator/registration/v1"
 	"github.com/luci/luci-go/logdog/client/butler/output"
 	out "github.com/luci/luci-go/logdog/client/butler/output/pubsub"
@@ -148,8 +149,50 @@ func (cfg *Config) Register(c context.Context) (output.Output, error) {
 		"bundleTopic": resp.LogBundleTopic,
 	}.Debugf(c, "Successfully registered log stream prefix.")
 
+	// We will use the non-cancelling context for all publishing calls, as we
+	// want the transport to drain without interruption if the application is
+	// otherwise canceled.
+	pctx := cfg.PublishContext
+	if pctx == nil {
+		pctx = c
+	}
+
+	// If the Coordinator doesn't use a Pub/Sub transport, publish our bundles
+	// through its Bundles service.
+	var topic out.Topic
+	if resp.LogBundleTopic == "" {
+		log.Fields{
+			"prefix": cfg.Prefix,
+			"host":   cfg.Host,
+		}.Infof(c, "Coordinator has no bundle topic; publishing bundles through its Bundles service.")
+		topic = &bundlesTopic{
+			host:   cfg.Host,
+			client: bundles.NewBundlesPRPCClient(&client),
+		}
+	} else {
+		if topic, err = cfg.pubSubTopic(c, pctx, resp.LogBundleTopic); err != nil {
+			return nil, err
+		}
+	}
+
+	// We own the prefix and all verifiable parameters have been validated.
+	// Successfully return our Output instance.
+	//
+	// Note that we use our publishing context here.
+	return out.New(pctx, out.Config{
+		Topic:      topic,
+		Secret:     resp.Secret,
+		Compress:   true,
+		Track:      cfg.Track,
+		RPCTimeout: cfg.RPCTimeout,
+	}), nil
+}
+
+// pubSubTopic returns the Pub/Sub topic, bundleTopic, that the Coordinator
+// returned at registration, asserting that it exists.
+func (cfg *Config) pubSubTopic(c, pctx context.Context, bundleTopic string) (out.Topic, error) {
 	// Validate the response topic.
-	fullTopic := ps.Topic(resp.LogBundleTopic)
+	fullTopic := ps.Topic(bundleTopic)
 	if err := fullTopic.Validate(); err != nil {
 		log.Fields{
 			log.ErrorKey: err,
@@ -162,16 +205,6 @@ func (cfg *Config) Register(c context.Context) (output.Output, error) {
 	// just finished validating the topic.
 	proj, topic := fullTopic.Split()
 
-	// Instantiate our Pub/Sub instance.
-	//
-	// We will use the non-cancelling context, for all Pub/Sub calls, as we want
-	// the Pub/Sub system to drain without interruption if the application is
-	// otherwise canceled.
-	pctx := cfg.PublishContext
-	if pctx == nil {
-		pctx = c
-	}
-
 	tokenSource, err := cfg.Auth.TokenSource()
 	if err != nil {
 		log.WithError(err).Errorf(c, "Failed to get TokenSource for Pub/Sub client.")
@@ -206,17 +239,7 @@ func (cfg *Config) Register(c context.Context) (output.Output, error) {
 		return nil, errors.New("PubSub topic does not exist")
 	}
 
-	// We own the prefix and all verifiable parameters have been validated.
-	// Successfully return our Output instance.
-	//
-	// Note that we use our publishing context here.
-	return out.New(pctx, out.Config{
-		Topic:      pubSubTopicWrapper{psTopic},
-		Secret:     resp.Secret,
-		Compress:   true,
-		Track:      cfg.Track,
-		RPCTimeout: cfg.RPCTimeout,
-	}), nil
+	return pubSubTopicWrapper{psTopic}, nil
 }
 
 func retryTopicExists(ctx context.Context, t *pubsub.Topic, rpcTimeout time.Duration) (bool, error) {
@@ -253,3 +276,21 @@ func (w pubSubTopicWrapper) String() string {
 func (w pubSubTopicWrapper) Publish(ctx context.Context, msg *pubsub.Message) (string, error) {
 	return w.t.Publish(ctx, msg).Get(ctx)
 }
+
+// bundlesTopic is a Butler pubsub.Topic that publishes messages through a
+// Coordinator's Bundles service.
+type bundlesTopic struct {
+	host   string
+	client bundles.BundlesClient
+}
+
+func (t *bundlesTopic) String() string {
+	return fmt.Sprintf("bundles(%s)", t.host)
+}
+
+func (t *bundlesTopic) Publish(ctx context.Context, msg *pubsub.Message) (string, error) {
+	if _, err := t.client.Publish(ctx, &bundles.PublishRequest{Data: msg.Data}); err != nil {
+		return "", err
+	}
+	return "", nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package s3
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+)
+
+const (
+	// DefaultRegion is the region that is used to sign requests if none is
+	// configured.
+	DefaultRegion = "us-east-1"
+
+	// amzDateFormat is the time format of the "x-amz-date" header.
+	amzDateFormat = "20060102T150405Z"
+	// maxErrorBodySize is the maximum number of bytes of an error response body
+	// that will be included in the returned error.
+	maxErrorBodySize = 1024
+)
+
+// Uploader uploads completed spool segments to an object store.
+//
+// Client implements Uploader.
+type Uploader interface {
+	// String returns a description of the upload destination.
+	String() string
+
+	// Upload uploads data as the object named key.
+	//
+	// If the upload may succeed when retried, the returned error should be
+	// transient (see errors.WrapTransient).
+	Upload(c context.Context, key string, data []byte) error
+}
+
+// Client is a minimal S3-compatible object store client.
+//
+// Requests are addressed using path-style URLs (<Endpoint>/<Bucket>/<key>) and
+// signed using AWS Signature Version 4.
+type Client struct {
+	// Endpoint is the base URL of the object store (e.g.,
+	// "https://s3.amazonaws.com").
+	Endpoint string
+	// Region is the region to sign requests for. If empty, DefaultRegion will be
+	// used.
+	Region string
+	// Bucket is the name of the bucket to upload objects into.
+	Bucket string
+
+	// AccessKeyID is the access key ID to sign requests with.
+	AccessKeyID string
+	// SecretAccessKey is the secret access key to sign requests with.
+	SecretAccessKey string
+
+	// HTTPClient, if not nil, is the HTTP client to use. Otherwise,
+	// http.DefaultClient will be used.
+	HTTPClient *http.Client
+}
+
+var _ Uploader = (*Client)(nil)
+
+func (c *Client) String() string {
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(c.Endpoint, "/"), c.Bucket)
+}
+
+// Upload implements Uploader.
+func (c *Client) Upload(ctx context.Context, key string, data []byte) error {
+	u, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return errors.Annotate(err).Reason("invalid endpoint %(endpoint)q").
+			D("endpoint", c.Endpoint).Err()
+	}
+	u.Path = fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(u.Path, "/"), c.Bucket, key)
+	u.RawPath = uriEncode(u.Path, false)
+
+	req, err := http.NewRequest("PUT", u.String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	c.sign(req, hashHex(data), clock.Now(ctx))
+
+	resp, err := ctxhttp.Do(ctx, c.httpClient(), req)
+	if err != nil {
+		return errors.WrapTransient(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	err = fmt.Errorf("s3: failed to upload %q: HTTP %d: %s", key, resp.StatusCode, bytes.TrimSpace(body))
+	if isTransientStatus(resp.StatusCode) {
+		err = errors.WrapTransient(err)
+	}
+	return err
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (c *Client) region() string {
+	if c.Region != "" {
+		return c.Region
+	}
+	return DefaultRegion
+}
+
+// sign adds AWS Signature Version 4 authentication headers to req.
+//
+// All of req's headers, as well as its host, are signed. payloadHash is the
+// hex-encoded SHA256 hash of the request body.
+func (c *Client) sign(req *http.Request, payloadHash string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(amzDateFormat)
+	scope := strings.Join([]string{now.Format("20060102"), c.region(), "s3", "aws4_request"}, "/")
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	// Build our canonical headers.
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{
+		"host": host,
+	}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var canonicalHeaders bytes.Buffer
+	for _, k := range keys {
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", k, headers[k])
+	}
+	signedHeaders := strings.Join(keys, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	// Derive the signing key from the scope components.
+	key := []byte("AWS4" + c.SecretAccessKey)
+	for _, part := range strings.Split(scope, "/") {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery returns the canonical form of a query string.
+func canonicalQuery(q url.Values) string {
+	parts := make([]string, 0, len(q))
+	for k, values := range q {
+		for _, v := range values {
+			parts = append(parts, fmt.Sprintf("%s=%s", uriEncode(k, true), uriEncode(v, true)))
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, "&")
+}
+
+// uriEncode encodes s as described by the AWS Signature Version 4
+// specification. All characters except for unreserved characters are
+// percent-encoded. If encodeSlash is false, "/" is not encoded.
+func uriEncode(s string, encodeSlash bool) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case 'A' <= ch && ch <= 'Z', 'a' <= ch && ch <= 'z', '0' <= ch && ch <= '9',
+			ch == '-', ch == '_', ch == '.', ch == '~':
+			buf.WriteByte(ch)
+		case ch == '/' && !encodeSlash:
+			buf.WriteByte(ch)
+		default:
+			fmt.Fprintf(&buf, "%%%02X", ch)
+		}
+	}
+	return buf.String()
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// isTransientStatus returns true if an upload that failed with the supplied
+// HTTP status code may succeed if retried.
+func isTransientStatus(code int) bool {
+	return code >= 500 || code == http.StatusTooManyRequests || code == http.StatusRequestTimeout
+}
@@ -0,0 +1,19 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package s3 implements the "s3" Output.
+//
+// The "s3" Output writes ButlerLogBundle protobufs to segment files in a local
+// spool directory. When a segment reaches its maximum size or age, it is
+// rotated and uploaded to an S3-compatible object store, then removed from the
+// spool.
+//
+// Each segment is a series of RecordIO frames, each of which contains a single
+// ButlerLogBundle encoded using the protocol defined in:
+//   github.com/luci/luci-go/logdog/client/butlerproto
+//
+// Because bundles are spooled before they are uploaded, segments that were
+// not uploaded when a Butler instance terminated (e.g., crashed) are recovered
+// and uploaded by the next instance that uses the same spool directory.
+package s3
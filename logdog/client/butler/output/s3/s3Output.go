@@ -0,0 +1,428 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package s3
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/retry"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butler/output"
+	"github.com/luci/luci-go/logdog/client/butlerproto"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// DefaultSegmentSize is the default size, in bytes, at which a spool segment
+	// is rotated and uploaded.
+	DefaultSegmentSize = 16 * 1024 * 1024
+
+	// maxBundleSize is the maximum bundle size reported by the Output.
+	maxBundleSize = 1024 * 1024
+)
+
+// Config is a configuration structure for S3 output.
+type Config struct {
+	// Uploader uploads completed spool segments.
+	Uploader Uploader
+
+	// SpoolDir is the directory that bundles are spooled into before they are
+	// uploaded. It will be created if it doesn't exist.
+	//
+	// Any segments that were left in SpoolDir by a previous instance will be
+	// uploaded.
+	SpoolDir string
+
+	// KeyPrefix, if not empty, is prepended to the object key of each uploaded
+	// segment.
+	KeyPrefix string
+
+	// Secret, if not nil, is the prefix secret to attach to each outgoing bundle.
+	Secret types.PrefixSecret
+
+	// Compress, if true, enables zlib compression.
+	Compress bool
+
+	// SegmentSize is the size, in bytes, at which a spool segment is rotated and
+	// uploaded. If <= 0, DefaultSegmentSize will be used.
+	SegmentSize int64
+
+	// SegmentAge, if > 0, is the maximum amount of time that a spool segment
+	// will accept bundles before it is rotated and uploaded.
+	SegmentAge time.Duration
+
+	// Track, if true, tracks all log entries that have been successfully
+	// spooled.
+	Track bool
+
+	// RPCTimeout is the timeout to apply to an individual upload.
+	RPCTimeout time.Duration
+}
+
+// s3Output is a Butler Output that spools bundles into local segment files and
+// uploads completed segments to an S3-compatible object store.
+type s3Output struct {
+	*Config
+	context.Context
+
+	// mu protects all of the following members.
+	mu sync.Mutex
+	// pendingCond is signalled when a segment is added to pending, or when the
+	// Output is closed.
+	pendingCond *sync.Cond
+
+	buf         bytes.Buffer
+	protoWriter butlerproto.Writer
+
+	// cur is the open segment that bundles are written to. It is nil if no
+	// segment is open.
+	cur *segment
+	// nextIndex is the index of the next segment that is created.
+	nextIndex int64
+	// pending is the set of completed segments waiting to be uploaded, in
+	// upload order.
+	pending []*segment
+	// closed is true if the Output has been closed.
+	closed bool
+
+	stats output.StatsBase
+	et    *output.EntryTracker
+
+	// closeC is closed when the Output is closed.
+	closeC chan struct{}
+	// doneC is closed when all of the Output's goroutines have finished.
+	doneC chan struct{}
+}
+
+// New instantiates a new S3 output.
+//
+// Any segments that were left in the spool directory by a previous instance
+// are recovered and queued for upload.
+func New(ctx context.Context, c Config) (output.Output, error) {
+	if c.Uploader == nil {
+		return nil, errors.New("s3: an Uploader is required")
+	}
+	if c.SpoolDir == "" {
+		return nil, errors.New("s3: a spool directory is required")
+	}
+
+	o := s3Output{
+		Config: &c,
+		protoWriter: butlerproto.Writer{
+			Compress:          c.Compress,
+			CompressThreshold: butlerproto.DefaultCompressThreshold,
+		},
+		closeC: make(chan struct{}),
+		doneC:  make(chan struct{}),
+	}
+	o.pendingCond = sync.NewCond(&o.mu)
+	if c.Track {
+		o.et = &output.EntryTracker{}
+	}
+	o.Context = log.SetField(ctx, "s3", &o)
+
+	segs, err := loadSpool(o.Context, c.SpoolDir)
+	if err != nil {
+		return nil, err
+	}
+	o.pending = segs
+
+	// Don't reuse the indexes of spooled segments. Otherwise, a segment created
+	// at the same time as a spooled segment could replace it.
+	for _, seg := range segs {
+		if idx, ok := segmentIndex(seg.name); ok && idx >= o.nextIndex {
+			o.nextIndex = idx + 1
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		o.uploadLoop()
+	}()
+	if c.SegmentAge > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			o.rotateLoop()
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(o.doneC)
+	}()
+
+	return &o, nil
+}
+
+func (o *s3Output) String() string {
+	return fmt.Sprintf("s3(%s)", o.Uploader.String())
+}
+
+func (o *s3Output) SendBundle(bundle *logpb.ButlerLogBundle) error {
+	bundle.Secret = []byte(o.Secret)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.closed {
+		return errors.New("s3: output is closed")
+	}
+
+	o.buf.Reset()
+	if err := o.protoWriter.Write(&o.buf, bundle); err != nil {
+		log.WithError(err).Errorf(o, "Failed to encode bundle.")
+		o.stats.F.DiscardedMessages++
+		o.stats.F.Errors++
+		return err
+	}
+
+	if err := o.spoolLocked(o.buf.Bytes()); err != nil {
+		log.WithError(err).Errorf(o, "Failed to spool bundle.")
+		o.stats.F.DiscardedMessages++
+		o.stats.F.Errors++
+		return err
+	}
+
+	if o.et != nil {
+		o.et.Track(bundle)
+	}
+	return nil
+}
+
+func (*s3Output) MaxSize() int {
+	return maxBundleSize
+}
+
+func (o *s3Output) Stats() output.Stats {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	statsCopy := o.stats
+	return &statsCopy
+}
+
+func (o *s3Output) Record() *output.EntryRecord {
+	if o.et == nil {
+		return nil
+	}
+	return o.et.Record()
+}
+
+// Close rotates the open segment, if any, and blocks until all completed
+// segments have been uploaded.
+//
+// Segments that fail to upload remain in the spool directory, and will be
+// uploaded by the next instance that uses it.
+func (o *s3Output) Close() {
+	o.mu.Lock()
+	if o.closed {
+		o.mu.Unlock()
+		return
+	}
+	if o.cur != nil {
+		o.rotateLocked()
+	}
+	o.closed = true
+	o.pendingCond.Broadcast()
+	close(o.closeC)
+	o.mu.Unlock()
+
+	<-o.doneC
+}
+
+// spoolLocked writes a frame containing data to the open segment, creating it
+// if necessary. If the segment has reached its maximum size or age, it will be
+// rotated.
+//
+// o.mu must be held.
+func (o *s3Output) spoolLocked(data []byte) error {
+	now := clock.Now(o)
+	if o.cur != nil && o.segmentExpired(o.cur, now) {
+		o.rotateLocked()
+	}
+
+	if o.cur == nil {
+		seg, err := createSegment(o.SpoolDir, now, o.nextIndex)
+		if err != nil {
+			return err
+		}
+		o.cur = seg
+		o.nextIndex++
+	}
+
+	if err := o.cur.write(data); err != nil {
+		return err
+	}
+	if o.cur.size >= o.segmentSize() {
+		o.rotateLocked()
+	}
+	return nil
+}
+
+// rotateLocked completes the open segment and queues it for upload.
+//
+// If the segment could not be completed, it is left in the spool directory as
+// an open segment, and will be recovered by the next instance that uses it.
+//
+// o.mu must be held.
+func (o *s3Output) rotateLocked() {
+	seg := o.cur
+	o.cur = nil
+
+	if err := seg.finish(); err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"path":       seg.path,
+		}.Errorf(o, "Failed to complete spool segment.")
+		o.stats.F.Errors++
+		return
+	}
+
+	o.pending = append(o.pending, seg)
+	o.pendingCond.Signal()
+}
+
+func (o *s3Output) segmentSize() int64 {
+	if o.SegmentSize > 0 {
+		return o.SegmentSize
+	}
+	return DefaultSegmentSize
+}
+
+func (o *s3Output) segmentExpired(seg *segment, now time.Time) bool {
+	return o.SegmentAge > 0 && now.Sub(seg.created) >= o.SegmentAge
+}
+
+// rotateLoop periodically rotates the open segment once it reaches its maximum
+// age, so that segments are uploaded even if no more bundles are sent.
+func (o *s3Output) rotateLoop() {
+	for {
+		select {
+		case <-o.closeC:
+			return
+		case <-clock.After(o, o.SegmentAge/2):
+		}
+
+		o.mu.Lock()
+		if o.cur != nil && o.segmentExpired(o.cur, clock.Now(o)) {
+			o.rotateLocked()
+		}
+		o.mu.Unlock()
+	}
+}
+
+// uploadLoop uploads pending segments until the Output is closed and no
+// segments remain.
+func (o *s3Output) uploadLoop() {
+	for {
+		o.mu.Lock()
+		for len(o.pending) == 0 && !o.closed {
+			o.pendingCond.Wait()
+		}
+		if len(o.pending) == 0 {
+			o.mu.Unlock()
+			return
+		}
+		seg := o.pending[0]
+		o.pending = o.pending[1:]
+		o.mu.Unlock()
+
+		o.uploadSegment(seg)
+	}
+}
+
+// uploadSegment uploads a completed segment, removing it from the spool
+// directory if the upload succeeded.
+func (o *s3Output) uploadSegment(seg *segment) {
+	st := output.StatsBase{}
+	defer o.mergeStats(&st)
+
+	ctx := log.SetFields(o, log.Fields{
+		"path":    seg.path,
+		"bundles": seg.bundles,
+	})
+
+	data, err := ioutil.ReadFile(seg.path)
+	if err != nil {
+		log.WithError(err).Errorf(ctx, "Failed to read spool segment.")
+		st.F.Errors++
+		return
+	}
+
+	key := path.Join(o.KeyPrefix, seg.name+segmentExt)
+	transientErrors := 0
+	err = retry.Retry(ctx, retry.TransientOnly(uploadRetry), func() error {
+		ctx := ctx
+		if o.RPCTimeout > 0 {
+			var cancelFunc context.CancelFunc
+			ctx, cancelFunc = clock.WithTimeout(ctx, o.RPCTimeout)
+			defer cancelFunc()
+		}
+
+		err := o.Uploader.Upload(ctx, key, data)
+		if err == context.DeadlineExceeded {
+			// If we hit our upload deadline, retry.
+			err = errors.WrapTransient(err)
+		}
+		return err
+	}, func(err error, d time.Duration) {
+		log.Fields{
+			log.ErrorKey: err,
+			"delay":      d,
+		}.Warningf(ctx, "TRANSIENT error uploading segment; retrying...")
+		transientErrors++
+	})
+	if err != nil {
+		log.WithError(err).Errorf(ctx, "Failed to upload segment. It will remain in the spool directory.")
+		st.F.Errors++
+		return
+	}
+
+	log.Fields{
+		"key":             key,
+		"size":            len(data),
+		"transientErrors": transientErrors,
+	}.Debugf(ctx, "Uploaded segment.")
+	st.F.SentBytes += int64(len(data))
+	st.F.SentMessages += seg.bundles
+
+	if err := os.Remove(seg.path); err != nil {
+		// The segment will be uploaded again by the next instance. This is
+		// harmless, since it will be uploaded to the same key.
+		log.WithError(err).Warningf(ctx, "Failed to remove uploaded segment.")
+		st.F.Errors++
+	}
+}
+
+func (o *s3Output) mergeStats(s output.Stats) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.stats.Merge(s)
+}
+
+// uploadRetry is the retry.Iterator used for segment uploads.
+func uploadRetry() retry.Iterator {
+	return &retry.ExponentialBackoff{
+		Limited: retry.Limited{
+			Retries: 10,
+			Delay:   500 * time.Millisecond,
+		},
+		MaxDelay: 30 * time.Second,
+	}
+}
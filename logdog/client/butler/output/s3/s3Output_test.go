@@ -0,0 +1,256 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package s3
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/data/recordio"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butlerproto"
+
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// objectBundles decodes the bundles in an uploaded segment object.
+func objectBundles(data []byte) ([]*logpb.ButlerLogBundle, error) {
+	frames, err := recordio.Split(data)
+	if err != nil {
+		return nil, err
+	}
+
+	bundles := make([]*logpb.ButlerLogBundle, len(frames))
+	for i, frame := range frames {
+		var r butlerproto.Reader
+		if err := r.Read(bytes.NewReader(frame)); err != nil {
+			return nil, fmt.Errorf("failed to read bundle #%d: %s", i, err)
+		}
+		bundles[i] = r.Bundle
+	}
+	return bundles, nil
+}
+
+// spoolFiles returns the sorted names of the files in a spool directory.
+func spoolFiles(dir string) []string {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		panic(err)
+	}
+
+	names := make([]string, len(infos))
+	for i, fi := range infos {
+		names[i] = fi.Name()
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestOutput(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a test object store and spool directory`, t, func() {
+		c, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+
+		ts := newTestObjectStore()
+		defer ts.Close()
+
+		spoolDir, err := ioutil.TempDir("", "logdog_s3_output_test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(spoolDir)
+
+		conf := Config{
+			Uploader:  ts.client(),
+			SpoolDir:  spoolDir,
+			KeyPrefix: "logs",
+			Compress:  true,
+		}
+
+		bundle := func(name string) *logpb.ButlerLogBundle {
+			return &logpb.ButlerLogBundle{
+				Timestamp: google.NewTimestamp(clock.Now(c)),
+				Project:   "proj",
+				Prefix:    "testing",
+				Entries: []*logpb.ButlerLogBundle_Entry{
+					{Desc: &logpb.LogStreamDescriptor{Prefix: "testing", Name: name}},
+				},
+			}
+		}
+
+		// objectNames returns the sorted names of the uploaded objects.
+		objectNames := func() []string {
+			ts.Lock()
+			defer ts.Unlock()
+
+			names := make([]string, 0, len(ts.objects))
+			for k := range ts.objects {
+				names = append(names, k)
+			}
+			sort.Strings(names)
+			return names
+		}
+
+		segPath := func(index int, ext string) string {
+			return filepath.Join(spoolDir, segmentName(tc.Now(), int64(index))+ext)
+		}
+		objPath := func(index int) string {
+			return fmt.Sprintf("/test-bucket/logs/%s%s", segmentName(tc.Now(), int64(index)), segmentExt)
+		}
+
+		Convey(`Will fail to create an Output without a spool directory.`, func() {
+			conf.SpoolDir = ""
+			_, err := New(c, conf)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey(`Can spool and upload bundles.`, func() {
+			o, err := New(c, conf)
+			So(err, ShouldBeNil)
+
+			So(o.SendBundle(bundle("foo")), ShouldBeNil)
+			So(o.SendBundle(bundle("bar")), ShouldBeNil)
+			So(spoolFiles(spoolDir), ShouldResemble, []string{filepath.Base(segPath(0, openSegmentExt))})
+
+			o.Close()
+			So(spoolFiles(spoolDir), ShouldHaveLength, 0)
+			So(objectNames(), ShouldResemble, []string{objPath(0)})
+
+			data, _ := ts.object(objPath(0))
+			bundles, err := objectBundles(data)
+			So(err, ShouldBeNil)
+			So(bundles, ShouldHaveLength, 2)
+			So(bundles[0].Entries[0].Desc.Name, ShouldEqual, "foo")
+			So(bundles[1].Entries[0].Desc.Name, ShouldEqual, "bar")
+
+			st := o.Stats()
+			So(st.Errors(), ShouldEqual, 0)
+			So(st.SentBytes(), ShouldEqual, len(data))
+			So(st.SentMessages(), ShouldEqual, 2)
+			So(st.DiscardedMessages(), ShouldEqual, 0)
+		})
+
+		Convey(`Will rotate segments when they reach their maximum size.`, func() {
+			conf.SegmentSize = 1
+			o, err := New(c, conf)
+			So(err, ShouldBeNil)
+
+			So(o.SendBundle(bundle("foo")), ShouldBeNil)
+			So(o.SendBundle(bundle("bar")), ShouldBeNil)
+			So(o.SendBundle(bundle("baz")), ShouldBeNil)
+			o.Close()
+
+			So(objectNames(), ShouldResemble, []string{objPath(0), objPath(1), objPath(2)})
+			So(o.Stats().SentMessages(), ShouldEqual, 3)
+		})
+
+		Convey(`Will rotate segments when they reach their maximum age.`, func() {
+			conf.SegmentAge = time.Minute
+			o, err := New(c, conf)
+			So(err, ShouldBeNil)
+
+			So(o.SendBundle(bundle("foo")), ShouldBeNil)
+			first := objPath(0)
+			tc.Add(time.Minute)
+			So(o.SendBundle(bundle("bar")), ShouldBeNil)
+			o.Close()
+
+			So(objectNames(), ShouldResemble, []string{first, objPath(1)})
+		})
+
+		Convey(`Will retry transient upload errors.`, func() {
+			tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+				tc.Add(d)
+			})
+
+			failures := 3
+			ts.status = func() int {
+				if failures > 0 {
+					failures--
+					return http.StatusServiceUnavailable
+				}
+				return 0
+			}
+
+			o, err := New(c, conf)
+			So(err, ShouldBeNil)
+			So(o.SendBundle(bundle("foo")), ShouldBeNil)
+			o.Close()
+
+			So(failures, ShouldEqual, 0)
+			So(objectNames(), ShouldHaveLength, 1)
+			So(o.Stats().Errors(), ShouldEqual, 0)
+		})
+
+		Convey(`Will keep segments that fail to upload, and upload them from the next instance.`, func() {
+			ts.status = func() int { return http.StatusForbidden }
+
+			o, err := New(c, conf)
+			So(err, ShouldBeNil)
+			So(o.SendBundle(bundle("foo")), ShouldBeNil)
+			o.Close()
+
+			So(o.Stats().Errors(), ShouldEqual, 1)
+			So(objectNames(), ShouldHaveLength, 0)
+			So(spoolFiles(spoolDir), ShouldResemble, []string{filepath.Base(segPath(0, segmentExt))})
+
+			ts.status = nil
+			o, err = New(c, conf)
+			So(err, ShouldBeNil)
+			So(o.SendBundle(bundle("bar")), ShouldBeNil)
+			o.Close()
+
+			So(spoolFiles(spoolDir), ShouldHaveLength, 0)
+			So(objectNames(), ShouldResemble, []string{objPath(0), objPath(1)})
+			So(o.Stats().SentMessages(), ShouldEqual, 2)
+		})
+
+		Convey(`Will recover segments left by a crashed instance.`, func() {
+			// Write an open segment containing two complete bundle frames, followed
+			// by a partial frame.
+			var buf bytes.Buffer
+			for _, name := range []string{"foo", "bar"} {
+				var frame bytes.Buffer
+				So((&butlerproto.Writer{}).Write(&frame, bundle(name)), ShouldBeNil)
+				_, err := recordio.WriteFrame(&buf, frame.Bytes())
+				So(err, ShouldBeNil)
+			}
+			complete := buf.Len()
+			_, err := recordio.WriteFrame(&buf, bytes.Repeat([]byte{0x55}, 1024))
+			So(err, ShouldBeNil)
+			So(ioutil.WriteFile(segPath(0, openSegmentExt), buf.Bytes()[:complete+10], 0644), ShouldBeNil)
+
+			// Write an empty open segment, which should be discarded.
+			So(ioutil.WriteFile(segPath(1, openSegmentExt), nil, 0644), ShouldBeNil)
+
+			o, err := New(c, conf)
+			So(err, ShouldBeNil)
+			o.Close()
+
+			So(spoolFiles(spoolDir), ShouldHaveLength, 0)
+			So(objectNames(), ShouldResemble, []string{objPath(0)})
+
+			data, _ := ts.object(objPath(0))
+			So(data, ShouldHaveLength, complete)
+			bundles, err := objectBundles(data)
+			So(err, ShouldBeNil)
+			So(bundles, ShouldHaveLength, 2)
+			So(bundles[0].Entries[0].Desc.Name, ShouldEqual, "foo")
+			So(bundles[1].Entries[0].Desc.Name, ShouldEqual, "bar")
+			So(o.Stats().SentMessages(), ShouldEqual, 2)
+		})
+	})
+}
@@ -0,0 +1,287 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package s3
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/luci/luci-go/common/data/recordio"
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	"golang.org/x/net/context"
+)
+
+const (
+	// segmentExt is the file extension of a completed spool segment.
+	segmentExt = ".seg"
+	// openSegmentExt is the file extension of a spool segment that is still
+	// being written.
+	openSegmentExt = segmentExt + ".tmp"
+
+	// segmentTimeFormat is the time format used in segment names. It sorts
+	// lexically in time order.
+	segmentTimeFormat = "20060102T150405.000000000Z"
+
+	// maxFrameSize is the maximum size of a single segment frame. Frames larger
+	// than this are treated as corrupt.
+	maxFrameSize = 2 * types.MaxButlerLogBundleSize
+)
+
+// segmentName returns the name of a segment created at now with the supplied
+// index.
+func segmentName(now time.Time, index int64) string {
+	return fmt.Sprintf("%s-%08d", now.UTC().Format(segmentTimeFormat), index)
+}
+
+// segmentIndex returns the index component of a segment name. It returns false
+// if the name is not a valid segment name.
+func segmentIndex(name string) (int64, bool) {
+	idx := strings.LastIndex(name, "-")
+	if idx < 0 {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(name[idx+1:], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// segment is a single spool file.
+//
+// A segment is created as an open segment, to which bundle frames are
+// appended. Once it is finished, it is renamed to a completed segment, which
+// is ready to be uploaded.
+type segment struct {
+	// name is the segment's name. It is unique within the spool directory, and is
+	// used to derive the segment's file and object names.
+	name string
+	// path is the path of the segment's file.
+	path string
+	// created is the time when the segment was created.
+	created time.Time
+
+	// size is the number of bytes in the segment.
+	size int64
+	// bundles is the number of bundle frames in the segment.
+	bundles int64
+
+	// fd is the segment's file. It is nil if the segment is not open.
+	fd *os.File
+}
+
+// createSegment creates a new open segment in dir.
+//
+// index disambiguates segments that are created at the same time.
+func createSegment(dir string, now time.Time, index int64) (*segment, error) {
+	name := segmentName(now, index)
+	seg := segment{
+		name:    name,
+		path:    filepath.Join(dir, name+openSegmentExt),
+		created: now,
+	}
+
+	fd, err := os.OpenFile(seg.path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, err
+	}
+	seg.fd = fd
+	return &seg, nil
+}
+
+// write appends a frame containing data to an open segment.
+//
+// If the frame could not be completely written, the segment is truncated to
+// its previous size.
+func (s *segment) write(data []byte) error {
+	n, err := recordio.WriteFrame(s.fd, data)
+	if err != nil {
+		if terr := s.fd.Truncate(s.size); terr != nil {
+			return errors.MultiError{err, terr}
+		}
+		if _, serr := s.fd.Seek(s.size, 0); serr != nil {
+			return errors.MultiError{err, serr}
+		}
+		return err
+	}
+
+	s.size += int64(n)
+	s.bundles++
+	return nil
+}
+
+// finish completes an open segment, syncing its contents and renaming it to
+// its completed segment path.
+func (s *segment) finish() error {
+	if err := s.fd.Sync(); err != nil {
+		s.fd.Close()
+		return err
+	}
+	if err := s.fd.Close(); err != nil {
+		return err
+	}
+	s.fd = nil
+
+	path := strings.TrimSuffix(s.path, openSegmentExt) + segmentExt
+	if err := os.Rename(s.path, path); err != nil {
+		return err
+	}
+	s.path = path
+	return nil
+}
+
+// loadSpool loads the segments that were left in a spool directory by a
+// previous Output instance, creating the directory if it doesn't exist.
+//
+// Open segments are recovered: any partially-written trailing frame is
+// discarded, and the remainder of the segment is completed. Open segments that
+// contain no complete frames are deleted.
+//
+// The completed segments are returned in the order in which they were
+// created.
+func loadSpool(c context.Context, dir string) ([]*segment, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Annotate(err).Reason("failed to create spool directory").Err()
+	}
+
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Annotate(err).Reason("failed to read spool directory").Err()
+	}
+
+	var segs []*segment
+	for _, fi := range infos {
+		if fi.IsDir() {
+			continue
+		}
+
+		var seg *segment
+		switch name := fi.Name(); {
+		case strings.HasSuffix(name, openSegmentExt):
+			seg = &segment{
+				name: strings.TrimSuffix(name, openSegmentExt),
+				path: filepath.Join(dir, name),
+			}
+			if err := seg.recover(); err != nil {
+				return nil, errors.Annotate(err).Reason("failed to recover segment %(path)q").
+					D("path", seg.path).Err()
+			}
+			if seg.bundles == 0 {
+				log.Fields{
+					"path": seg.path,
+				}.Infof(c, "Removing empty spool segment.")
+				if err := os.Remove(seg.path); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if err := seg.finish(); err != nil {
+				return nil, errors.Annotate(err).Reason("failed to complete segment %(path)q").
+					D("path", seg.path).Err()
+			}
+
+		case strings.HasSuffix(name, segmentExt):
+			seg = &segment{
+				name: strings.TrimSuffix(name, segmentExt),
+				path: filepath.Join(dir, name),
+			}
+			if err := seg.load(); err != nil {
+				return nil, errors.Annotate(err).Reason("failed to load segment %(path)q").
+					D("path", seg.path).Err()
+			}
+
+		default:
+			// Not a segment file.
+			continue
+		}
+
+		log.Fields{
+			"path":    seg.path,
+			"size":    seg.size,
+			"bundles": seg.bundles,
+		}.Infof(c, "Loaded spooled segment.")
+		segs = append(segs, seg)
+	}
+
+	sort.Sort(segmentsByName(segs))
+	return segs, nil
+}
+
+// load counts the frames in a completed segment.
+func (s *segment) load() error {
+	fd, err := os.Open(s.path)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	s.scan(fd)
+	return nil
+}
+
+// recover scans an open segment, truncating any trailing partial frame and
+// opening the segment for completion.
+func (s *segment) recover() error {
+	fd, err := os.OpenFile(s.path, os.O_RDWR, 0644)
+	if err != nil {
+		return err
+	}
+
+	fi, err := fd.Stat()
+	if err != nil {
+		fd.Close()
+		return err
+	}
+
+	s.scan(fd)
+	if s.size != fi.Size() {
+		if err := fd.Truncate(s.size); err != nil {
+			fd.Close()
+			return err
+		}
+	}
+	s.fd = fd
+	return nil
+}
+
+// scan reads the complete frames from r, setting the segment's size and bundle
+// count to the size and number of complete frames.
+//
+// Reading stops at the end of r, or at the first incomplete or invalid frame.
+func (s *segment) scan(r io.Reader) {
+	fr := recordio.NewReader(bufio.NewReader(r), maxFrameSize)
+
+	s.size, s.bundles = 0, 0
+	for {
+		size, r, err := fr.ReadFrame()
+		if err != nil {
+			return
+		}
+		if n, err := io.Copy(ioutil.Discard, r); err != nil || n != size {
+			return
+		}
+
+		s.size += int64(recordio.FrameHeaderSize(size)) + size
+		s.bundles++
+	}
+}
+
+// segmentsByName sorts a segment slice by name.
+type segmentsByName []*segment
+
+func (s segmentsByName) Len() int           { return len(s) }
+func (s segmentsByName) Less(i, j int) bool { return s[i].name < s[j].name }
+func (s segmentsByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
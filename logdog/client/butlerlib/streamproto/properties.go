@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/luci/luci-go/common/clock/clockflag"
+	"github.com/luci/luci-go/common/flag/stringlistflag"
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/common/types"
@@ -37,6 +38,9 @@ type Properties struct {
 	// Note that this value is best-effort, as it is subject to the constraints
 	// of the underlying transport medium.
 	Deadline time.Duration
+
+	// NoRedact, if true, opts this stream out of the Butler's secret redaction.
+	NoRedact bool
 }
 
 // Validate validates that the configured Properties are valid and sufficient to
@@ -61,17 +65,19 @@ func (p *Properties) Clone() *Properties {
 // Flags is a flag- and JSON-compatible collapse of Properties. It is used
 // for stream negotiation protocol and command-line interfaces.
 type Flags struct {
-	Name                StreamNameFlag `json:"name,omitempty"`
-	ContentType         string         `json:"contentType,omitempty"`
-	Type                StreamType     `json:"type,omitempty"`
-	Timestamp           clockflag.Time `json:"timestamp,omitempty"`
-	Tags                TagMap         `json:"tags,omitempty"`
-	BinaryFileExtension string         `json:"binaryFileExtension,omitempty"`
-	IOKeepAlive         bool           `json:"ioKeepAlive,omitempty"`
+	Name                StreamNameFlag      `json:"name,omitempty"`
+	ContentType         string              `json:"contentType,omitempty"`
+	Type                StreamType          `json:"type,omitempty"`
+	Timestamp           clockflag.Time      `json:"timestamp,omitempty"`
+	Tags                TagMap              `json:"tags,omitempty"`
+	BinaryFileExtension string              `json:"binaryFileExtension,omitempty"`
+	IndexedFields       stringlistflag.Flag `json:"indexedFields,omitempty"`
+	IOKeepAlive         bool                `json:"ioKeepAlive,omitempty"`
 
 	Tee      TeeType            `json:"tee,omitempty"`
 	Timeout  clockflag.Duration `json:"timeout,omitempty"`
 	Deadline clockflag.Duration `json:"deadline,omitempty"`
+	NoRedact bool               `json:"noRedact,omitempty"`
 }
 
 // Properties converts the Flags to a standard Properties structure.
@@ -91,10 +97,12 @@ func (f *Flags) Properties() *Properties {
 			Timestamp:     google.NewTimestamp(time.Time(f.Timestamp)),
 			BinaryFileExt: f.BinaryFileExtension,
 			Tags:          f.Tags,
+			IndexedFields: []string(f.IndexedFields),
 		},
 		Tee:      f.Tee,
 		Timeout:  time.Duration(f.Timeout),
 		Deadline: time.Duration(f.Deadline),
+		NoRedact: f.NoRedact,
 	}
 	return p
 }
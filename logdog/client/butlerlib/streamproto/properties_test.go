@@ -123,5 +123,20 @@ func TestFlagsJSON(t *testing.T) {
 				Tee: TeeNone,
 			})
 		})
+
+		Convey(`Will decode a STRUCTURED stream with indexed fields.`, func() {
+			t := `{"name": "my/stream", "type": "structured", "indexedFields": ["level", "http.status"]}`
+			So(json.Unmarshal([]byte(t), &f), ShouldBeNil)
+
+			So(f.Properties(), ShouldResemble, &Properties{
+				LogStreamDescriptor: &logpb.LogStreamDescriptor{
+					Name:          "my/stream",
+					StreamType:    logpb.StreamType_STRUCTURED,
+					ContentType:   string(types.ContentTypeJSONLines),
+					IndexedFields: []string{"level", "http.status"},
+				},
+				Tee: TeeNone,
+			})
+		})
 	})
 }
@@ -26,6 +26,9 @@ func (t StreamType) DefaultContentType() types.ContentType {
 	case logpb.StreamType_DATAGRAM:
 		return types.ContentTypeLogdogDatagram
 
+	case logpb.StreamType_STRUCTURED:
+		return types.ContentTypeJSONLines
+
 	case logpb.StreamType_BINARY:
 		fallthrough
 	default:
@@ -42,9 +45,10 @@ var _ interface {
 var (
 	// StreamTypeFlagEnum maps configuration strings to their underlying StreamTypes.
 	StreamTypeFlagEnum = flagenum.Enum{
-		"text":     StreamType(logpb.StreamType_TEXT),
-		"binary":   StreamType(logpb.StreamType_BINARY),
-		"datagram": StreamType(logpb.StreamType_DATAGRAM),
+		"text":       StreamType(logpb.StreamType_TEXT),
+		"binary":     StreamType(logpb.StreamType_BINARY),
+		"datagram":   StreamType(logpb.StreamType_DATAGRAM),
+		"structured": StreamType(logpb.StreamType_STRUCTURED),
 	}
 )
 
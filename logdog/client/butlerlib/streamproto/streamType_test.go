@@ -52,6 +52,7 @@ func TestStreamType(t *testing.T) {
 			logpb.StreamType_TEXT,
 			logpb.StreamType_BINARY,
 			logpb.StreamType_DATAGRAM,
+			logpb.StreamType_STRUCTURED,
 		} {
 			Convey(fmt.Sprintf(`Stream type [%s] has a default content type.`, t), func() {
 				st := StreamType(t)
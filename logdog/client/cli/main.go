@@ -160,6 +160,7 @@ func Main(ctx context.Context, params Parameters) int {
 				subcommands.CmdHelp,
 				newCatCommand(),
 				newQueryCommand(),
+				newGrepCommand(),
 				newListCommand(),
 				newLatestCommand(),
 				authcli.SubcommandLogin(authOptions, "auth-login", false),
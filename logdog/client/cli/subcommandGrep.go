@@ -0,0 +1,140 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/logdog/client/coordinator"
+
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+)
+
+type grepCommandRun struct {
+	subcommands.CommandRunBase
+
+	path         string
+	contextLines int
+	results      int
+	pathsOnly    bool
+}
+
+func newGrepCommand() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "grep [-path <path-query>] <text>",
+		ShortDesc: "Search archived text log streams for lines containing text.",
+		LongDesc: "Search archived text log streams for lines containing text, ignoring case. " +
+			"Log streams that have not been archived are not searched.",
+		CommandRun: func() subcommands.CommandRun {
+			cmd := &grepCommandRun{}
+
+			fs := cmd.GetFlags()
+			fs.StringVar(&cmd.path, "path", "", "Search logs matching this path (may include globbing).")
+			fs.IntVar(&cmd.contextLines, "C", 0, "Print this many lines of context before and after each matching line.")
+			fs.IntVar(&cmd.results, "results", defaultQueryResults,
+				"The maximum number of matching log streams to return. If 0, no limit will be applied.")
+			fs.BoolVar(&cmd.pathsOnly, "l", false, "Only print the paths of log streams with matching lines.")
+
+			return cmd
+		},
+	}
+}
+
+func (cmd *grepCommandRun) Run(scApp subcommands.Application, args []string, _ subcommands.Env) int {
+	a := scApp.(*application)
+
+	if len(args) == 0 {
+		log.Errorf(a, "Must specify the text to search for.")
+		return 1
+	}
+	text := strings.Join(args, " ")
+
+	project, path, unified, err := a.splitPath(cmd.path)
+	if err != nil {
+		log.WithError(err).Errorf(a, "Invalid path specifier.")
+		return 1
+	}
+
+	coord, err := a.coordinatorClient("")
+	if err != nil {
+		errors.Log(a, errors.Annotate(err).Reason("could not create Coordinator client").Err())
+		return 1
+	}
+
+	bw := bufio.NewWriter(os.Stdout)
+	defer bw.Flush()
+
+	so := coordinator.SearchOptions{
+		ContextLines: cmd.contextLines,
+	}
+	count, matches := 0, 0
+	log.Debugf(a, "Issuing search...")
+
+	tctx, _ := a.timeoutCtx(a)
+	ierr := error(nil)
+	err = coord.Search(tctx, project, path, text, so, func(sr *coordinator.SearchResult) bool {
+		p := string(sr.Path)
+		if unified {
+			p = makeUnifiedPath(sr.Project, sr.Path)
+		}
+
+		if cmd.pathsOnly {
+			fmt.Fprintf(bw, "%s\n", p)
+			matches += len(sr.Matches)
+		} else {
+			for _, m := range sr.Matches {
+				if cmd.contextLines > 0 && matches > 0 {
+					fmt.Fprintf(bw, "--\n")
+				}
+				for _, l := range m.Before {
+					fmt.Fprintf(bw, "%s-%s\n", p, l)
+				}
+				fmt.Fprintf(bw, "%s:%d:%d:%s\n", p, m.StreamIndex, m.LineIndex, m.Line)
+				for _, l := range m.After {
+					fmt.Fprintf(bw, "%s-%s\n", p, l)
+				}
+				matches++
+			}
+		}
+		if sr.Truncated {
+			log.Fields{
+				"path": p,
+			}.Warningf(a, "Log stream has more matching lines than were returned.")
+		}
+		if ierr = bw.Flush(); ierr != nil {
+			return false
+		}
+
+		count++
+		return !(cmd.results > 0 && count >= cmd.results)
+	})
+	if err == nil {
+		// Propagate internal error.
+		err = ierr
+	}
+	if err != nil {
+		log.Fields{
+			log.ErrorKey: err,
+			"count":      count,
+		}.Errorf(a, "Search failed.")
+
+		if err == context.DeadlineExceeded {
+			return 2
+		}
+		return 1
+	}
+	log.Fields{
+		"count":   count,
+		"matches": matches,
+	}.Infof(a, "Search completed.")
+
+	return 0
+}
@@ -32,6 +32,7 @@ type queryCommandRun struct {
 	path        string
 	contentType string
 	tags        streamproto.TagMap
+	fields      streamproto.TagMap
 	results     int
 	before      clockflag.Time
 	after       clockflag.Time
@@ -52,6 +53,7 @@ func newQueryCommand() *subcommands.Command {
 			fs.StringVar(&cmd.path, "path", "", "Filter logs matching this path (may include globbing).")
 			fs.StringVar(&cmd.contentType, "contentType", "", "Limit results to a content type.")
 			fs.Var(&cmd.tags, "tag", "Filter logs containing this tag (key[=value]).")
+			fs.Var(&cmd.fields, "field", "Filter structured logs indexing this field (key[=value]).")
 			fs.Var(&cmd.purged, "purged", "Include purged streams in the result. This requires administrative privileges.")
 			fs.Var(&cmd.before, "before", "Limit the query to streams registered at or before this RFC3339 time.")
 			fs.Var(&cmd.after, "after", "Limit the query to streams registered at or after this RFC3339 time.")
@@ -115,6 +117,7 @@ func (cmd *queryCommandRun) Run(scApp subcommands.Application, args []string, _
 	}
 
 	qo := coordinator.QueryOptions{
+		Fields:      cmd.fields,
 		ContentType: cmd.contentType,
 		State:       cmd.json,
 		Before:      cmd.before.Time(),
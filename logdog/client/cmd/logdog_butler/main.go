@@ -25,6 +25,7 @@ import (
 	"github.com/luci/luci-go/common/data/rand/mathrand"
 	"github.com/luci/luci-go/common/errors"
 	"github.com/luci/luci-go/common/flag/multiflag"
+	"github.com/luci/luci-go/common/flag/stringlistflag"
 	log "github.com/luci/luci-go/common/logging"
 	"github.com/luci/luci-go/common/logging/gologger"
 	"github.com/luci/luci-go/common/runtime/paniccatcher"
@@ -73,6 +74,9 @@ type application struct {
 	maxBufferAge clockflag.Duration
 	noBufferLogs bool
 
+	redactPatternsPath string
+	redactEnv          stringlistflag.Flag
+
 	prof profiling.Profiler
 
 	client *http.Client
@@ -116,6 +120,12 @@ func (a *application) addFlags(fs *flag.FlagSet) {
 			"of wire-format efficiency.")
 	fs.Var(&a.ioKeepAliveInterval, "io-keepalive-stderr",
 		"If supplied, periodically write messages to STDERR if data is received on any Butler stream.")
+	fs.StringVar(&a.redactPatternsPath, "redact-patterns", "",
+		"Path to a file of regular expressions, one per line, whose matches are redacted from text "+
+			"streams. Empty lines and lines starting with '#' are ignored.")
+	fs.Var(&a.redactEnv, "redact-env",
+		"The name of an environment variable holding a secret, whose value is redacted from text streams. "+
+			"Can be specified multiple times.")
 }
 
 func (a *application) authenticator(ctx context.Context) (*auth.Authenticator, error) {
@@ -146,6 +156,11 @@ func (a *application) runWithButler(out output.Output, runFunc func(*butler.Butl
 	}
 	defer a.prof.Stop()
 
+	redactor, err := a.redactor()
+	if err != nil {
+		return err
+	}
+
 	// Instantiate our Butler.
 	butlerOpts := butler.Config{
 		Project:             a.project,
@@ -159,6 +174,7 @@ func (a *application) runWithButler(out output.Output, runFunc func(*butler.Butl
 		TeeStderr:           os.Stderr,
 		IOKeepAliveInterval: time.Duration(a.ioKeepAliveInterval),
 		IOKeepAliveWriter:   os.Stderr,
+		Redactor:            redactor,
 	}
 	b, err := butler.New(a, butlerOpts)
 	if err != nil {
@@ -0,0 +1,100 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/clockflag"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/flag/multiflag"
+	"github.com/luci/luci-go/logdog/client/butler/output"
+	s3Output "github.com/luci/luci-go/logdog/client/butler/output/s3"
+)
+
+func init() {
+	registerOutputFactory(new(s3OutputFactory))
+}
+
+// s3OutputFactory for spooling logs locally and uploading them to an
+// S3-compatible object store.
+type s3OutputFactory struct {
+	endpoint    string
+	region      string
+	bucket      string
+	keyPrefix   string
+	spoolDir    string
+	segmentSize int64
+	segmentAge  clockflag.Duration
+	compress    bool
+
+	track bool
+}
+
+var _ outputFactory = (*s3OutputFactory)(nil)
+
+func (f *s3OutputFactory) option() multiflag.Option {
+	opt := newOutputOption("s3", "Spool logs locally and upload them to an S3-compatible object store. "+
+		"Credentials are read from the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.", f)
+
+	flags := opt.Flags()
+	flags.StringVar(&f.endpoint, "endpoint", "https://s3.amazonaws.com",
+		"The base URL of the object store.")
+	flags.StringVar(&f.region, "region", s3Output.DefaultRegion,
+		"The object store region to sign requests for.")
+	flags.StringVar(&f.bucket, "bucket", "",
+		"The bucket to upload segments into (required).")
+	flags.StringVar(&f.keyPrefix, "key-prefix", "",
+		"Optional prefix to prepend to the object key of each uploaded segment.")
+	flags.StringVar(&f.spoolDir, "spool-dir", "",
+		"The directory to spool segments into before they are uploaded (required). Segments that were "+
+			"left in this directory by a previous Butler instance will be uploaded.")
+	flags.Int64Var(&f.segmentSize, "segment-size", s3Output.DefaultSegmentSize,
+		"The size, in bytes, at which a segment is rotated and uploaded.")
+	f.segmentAge = clockflag.Duration(5 * time.Minute)
+	flags.Var(&f.segmentAge, "segment-age",
+		"The maximum amount of time that a segment will accept logs before it is rotated and uploaded.")
+	flags.BoolVar(&f.compress, "compress", true,
+		"Compress the bundles in each segment.")
+	flags.BoolVar(&f.track, "track", false,
+		"Track each sent message and dump at the end. This adds CPU/memory overhead.")
+
+	return opt
+}
+
+func (f *s3OutputFactory) configOutput(a *application) (output.Output, error) {
+	switch {
+	case f.bucket == "":
+		return nil, errors.New("s3 output requires a bucket")
+	case f.spoolDir == "":
+		return nil, errors.New("s3 output requires a spool directory")
+	}
+
+	client := s3Output.Client{
+		Endpoint:        f.endpoint,
+		Region:          f.region,
+		Bucket:          f.bucket,
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+	}
+	if client.AccessKeyID == "" || client.SecretAccessKey == "" {
+		return nil, errors.New("s3 output requires AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY to be set")
+	}
+
+	cfg := s3Output.Config{
+		Uploader:    &client,
+		SpoolDir:    f.spoolDir,
+		KeyPrefix:   f.keyPrefix,
+		Compress:    f.compress,
+		SegmentSize: f.segmentSize,
+		SegmentAge:  time.Duration(f.segmentAge),
+		Track:       f.track,
+		RPCTimeout:  5 * time.Minute,
+	}
+	return s3Output.New(a.ncCtx, cfg)
+}
+
+func (f *s3OutputFactory) scopes() []string { return nil }
@@ -0,0 +1,40 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/luci/luci-go/logdog/client/butler/bundler"
+)
+
+// redactor builds the Butler's Redactor from the redaction patterns file and
+// from the values of the secret environment variables.
+//
+// If nothing is configured to be redacted, it returns nil.
+func (a *application) redactor() (*bundler.Redactor, error) {
+	var patterns []string
+	if a.redactPatternsPath != "" {
+		fd, err := os.Open(a.redactPatternsPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open redaction patterns file: %v", err)
+		}
+		defer fd.Close()
+
+		if patterns, err = bundler.ReadRedactionPatterns(fd); err != nil {
+			return nil, fmt.Errorf("failed to read redaction patterns file: %v", err)
+		}
+	}
+
+	var secrets []string
+	for _, name := range a.redactEnv {
+		if v := os.Getenv(name); v != "" {
+			secrets = append(secrets, v)
+		}
+	}
+
+	return bundler.NewRedactor(secrets, patterns)
+}
@@ -36,6 +36,10 @@ func (s *streamConfig) addFlags(fs *flag.FlagSet) {
 		fmt.Sprintf("Tee the stream through the Butler's output. Options are: %s",
 			streamproto.TeeTypeFlagEnum.Choices()))
 	fs.Var(&s.Tags, "tag", "Add a key[=value] tag.")
+	fs.Var(&s.IndexedFields, "index-field",
+		"Index this field of a structured stream. Can be specified multiple times.")
+	fs.BoolVar(&s.NoRedact, "no-redact", false,
+		"Don't redact secrets from this stream.")
 }
 
 // Converts command-line parameters into a stream.Config.
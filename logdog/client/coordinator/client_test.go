@@ -42,3 +42,7 @@ func (s *testLogsServiceBase) Query(c context.Context, req *logdog.QueryRequest)
 func (s *testLogsServiceBase) List(c context.Context, req *logdog.ListRequest) (*logdog.ListResponse, error) {
 	panic("not implemented")
 }
+
+func (s *testLogsServiceBase) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	panic("not implemented")
+}
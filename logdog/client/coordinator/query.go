@@ -51,6 +51,8 @@ const (
 	Binary
 	// Datagram selects only datagram streams.
 	Datagram
+	// Structured selects only structured streams.
+	Structured
 )
 
 // queryValue returns the StreamType for a specified QueryStreamType parameter.
@@ -63,6 +65,8 @@ func (t QueryStreamType) queryValue() logpb.StreamType {
 		return logpb.StreamType_BINARY
 	case Datagram:
 		return logpb.StreamType_DATAGRAM
+	case Structured:
+		return logpb.StreamType_STRUCTURED
 	default:
 		return -1
 	}
@@ -73,6 +77,9 @@ type QueryOptions struct {
 	// Tags is the list of tags to require. The value may be empty if key presence
 	// is all that is being asserted.
 	Tags map[string]string
+	// Fields is the list of indexed STRUCTURED stream fields to require. The
+	// value may be empty if only the field's indexing is being asserted.
+	Fields map[string]string
 	// ContentType, if not empty, restricts results to streams with the supplied
 	// content type.
 	ContentType string
@@ -148,6 +155,14 @@ func (c *Client) Query(ctx context.Context, project cfgtypes.ProjectName, path s
 		}
 	}
 
+	// Clone fields.
+	if len(o.Fields) > 0 {
+		req.Fields = make(map[string]string, len(o.Fields))
+		for k, v := range o.Fields {
+			req.Fields[k] = v
+		}
+	}
+
 	// Iteratively query until either our query is done (Next is empty) or we are
 	// asked to stop via callback.
 	for {
@@ -205,6 +205,22 @@ func TestClientQuery(t *testing.T) {
 					So(client.Query(c, project, path, q, accumulate), ShouldBeNil)
 					So(svc.LR.StreamType, ShouldResemble, &logdog.QueryRequest_StreamTypeFilter{Value: logpb.StreamType_DATAGRAM})
 				})
+
+				Convey(`Structured`, func() {
+					q.StreamType = Structured
+					So(client.Query(c, project, path, q, accumulate), ShouldBeNil)
+					So(svc.LR.StreamType, ShouldResemble, &logdog.QueryRequest_StreamTypeFilter{Value: logpb.StreamType_STRUCTURED})
+				})
+			})
+
+			Convey(`Can query for indexed fields.`, func() {
+				svc.H = func(*logdog.QueryRequest) (*logdog.QueryResponse, error) {
+					return &logdog.QueryResponse{}, nil
+				}
+
+				q.Fields = map[string]string{"status": "404", "user": ""}
+				So(client.Query(c, project, path, q, accumulate), ShouldBeNil)
+				So(svc.LR.Fields, ShouldResemble, map[string]string{"status": "404", "user": ""})
 			})
 
 			Convey(`Will return ErrNoAccess if unauthenticated.`, func() {
@@ -0,0 +1,105 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package coordinator
+
+import (
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+	"golang.org/x/net/context"
+)
+
+// SearchMatch is a single line that matched a search.
+type SearchMatch struct {
+	// StreamIndex is the stream index of the log entry containing the line.
+	StreamIndex types.MessageIndex
+	// LineIndex is the index of the line within its log entry.
+	LineIndex int
+	// Line is the matching line.
+	Line string
+
+	// Before is the context lines preceding Line, in stream order.
+	Before []string
+	// After is the context lines following Line, in stream order.
+	After []string
+}
+
+// SearchResult is a single log stream that contains lines matching a search.
+type SearchResult struct {
+	// Project is the project that this result is bound to.
+	Project cfgtypes.ProjectName
+	// Path is the log stream's path.
+	Path types.StreamPath
+
+	// Matches is the set of matching lines, in stream order.
+	Matches []*SearchMatch
+	// Truncated is true if the log stream contains more matching lines than
+	// were returned.
+	Truncated bool
+}
+
+// SearchCallback is a callback method type that is used in search requests.
+//
+// If it returns false, additional callbacks and search requests will be
+// aborted.
+type SearchCallback func(*SearchResult) bool
+
+// SearchOptions is a set of search request options.
+type SearchOptions struct {
+	// ContextLines is the number of lines of context to return before and after
+	// each matching line.
+	ContextLines int
+}
+
+// Search searches the archived TEXT log streams matching the path query for
+// lines that contain text, ignoring case, invoking the supplied callback once
+// for each log stream with matching lines.
+//
+// The path query uses the same syntax as Query. Log streams that have not been
+// archived are not searched.
+func (c *Client) Search(ctx context.Context, project cfgtypes.ProjectName, path, text string, o SearchOptions,
+	cb SearchCallback) error {
+
+	req := logdog.SearchRequest{
+		Project:      string(project),
+		Path:         path,
+		Text:         text,
+		ContextLines: int32(o.ContextLines),
+	}
+
+	for {
+		resp, err := c.C.Search(ctx, &req)
+		if err != nil {
+			return normalizeError(err)
+		}
+
+		for _, s := range resp.Streams {
+			sr := SearchResult{
+				Project:   cfgtypes.ProjectName(resp.Project),
+				Path:      types.StreamPath(s.Path),
+				Matches:   make([]*SearchMatch, len(s.Matches)),
+				Truncated: s.Truncated,
+			}
+			for i, m := range s.Matches {
+				sr.Matches[i] = &SearchMatch{
+					StreamIndex: types.MessageIndex(m.StreamIndex),
+					LineIndex:   int(m.LineIndex),
+					Line:        m.Line,
+					Before:      m.Before,
+					After:       m.After,
+				}
+			}
+
+			if !cb(&sr) {
+				return nil
+			}
+		}
+
+		if resp.Next == "" {
+			return nil
+		}
+		req.Next = resp.Next
+	}
+}
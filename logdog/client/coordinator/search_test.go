@@ -0,0 +1,120 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package coordinator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/luci/luci-go/common/testing/prpctest"
+	"github.com/luci/luci-go/grpc/grpcutil"
+	"github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+
+	"golang.org/x/net/context"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testSearchLogsService struct {
+	testLogsServiceBase
+
+	LR logdog.SearchRequest
+	H  func(*logdog.SearchRequest) (*logdog.SearchResponse, error)
+}
+
+func (s *testSearchLogsService) Search(c context.Context, req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+	s.LR = *req
+	if h := s.H; h != nil {
+		return s.H(req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func TestClientSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A testing Client`, t, func() {
+		c := context.Background()
+
+		ts := prpctest.Server{}
+		svc := testSearchLogsService{}
+		logdog.RegisterLogsServer(&ts, &svc)
+
+		// Create a testing server and client.
+		ts.Start(c)
+		defer ts.Close()
+
+		prpcClient, err := ts.NewClient()
+		if err != nil {
+			panic(err)
+		}
+		client := Client{
+			C: logdog.NewLogsPRPCClient(prpcClient),
+		}
+
+		Convey(`When making a search request`, func() {
+			const project = cfgtypes.ProjectName("myproj")
+
+			var results []*SearchResult
+			accumulate := func(sr *SearchResult) bool {
+				results = append(results, sr)
+				return true
+			}
+
+			Convey(`Can accumulate results across requests.`, func() {
+				svc.H = func(req *logdog.SearchRequest) (*logdog.SearchResponse, error) {
+					r := logdog.SearchResponse{
+						Project: string(project),
+					}
+
+					switch req.Next {
+					case "":
+						r.Streams = append(r.Streams, &logdog.SearchResponse_Stream{
+							Path: "test/+/a",
+							Matches: []*logdog.SearchResponse_Match{
+								{StreamIndex: 1, LineIndex: 2, Line: "error", Before: []string{"ok"}},
+							},
+							Truncated: true,
+						})
+						r.Next = "final"
+					case "final":
+						r.Streams = append(r.Streams, &logdog.SearchResponse_Stream{Path: "test/+/b"})
+					default:
+						return nil, errors.New("invalid cursor")
+					}
+					return &r, nil
+				}
+
+				So(client.Search(c, project, "test/**", "error", SearchOptions{ContextLines: 1}, accumulate), ShouldBeNil)
+				So(svc.LR.Text, ShouldEqual, "error")
+				So(svc.LR.ContextLines, ShouldEqual, 1)
+				So(results, ShouldResemble, []*SearchResult{
+					{
+						Project: project,
+						Path:    "test/+/a",
+						Matches: []*SearchMatch{
+							{StreamIndex: 1, LineIndex: 2, Line: "error", Before: []string{"ok"}},
+						},
+						Truncated: true,
+					},
+					{
+						Project: project,
+						Path:    "test/+/b",
+						Matches: []*SearchMatch{},
+					},
+				})
+			})
+
+			Convey(`Will return an error if the search fails.`, func() {
+				svc.H = func(*logdog.SearchRequest) (*logdog.SearchResponse, error) {
+					return nil, grpcutil.Internal
+				}
+
+				So(client.Search(c, project, "", "error", SearchOptions{}, accumulate), ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/luci/luci-go/common/sync/parallel"
 	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/common/renderer"
+	"github.com/luci/luci-go/logdog/common/search"
 
 	"github.com/golang/protobuf/proto"
 )
@@ -35,6 +36,10 @@ type Manifest struct {
 	// DataWriter, if not nil, is the Writer to which reconstructed LogEntry data
 	// will be written.
 	DataWriter io.Writer
+	// SearchIndexWriter, if not nil, is the Writer to which the log stream's
+	// LogSearchIndex protobuf will be written. A search index is only built for
+	// TEXT streams, and requires a LogWriter.
+	SearchIndexWriter io.Writer
 
 	// StreamIndexRange, if >0, is the maximum number of log entry stream indices
 	// in between successive index entries.
@@ -88,20 +93,32 @@ func Archive(m Manifest) error {
 		}
 	}
 
+	// If we're constructing a search index for a TEXT stream, allocate a search
+	// index builder.
+	var sidx *search.Builder
+	if m.SearchIndexWriter != nil && m.Desc.StreamType == logpb.StreamType_TEXT {
+		sidx = &search.Builder{}
+	}
+
 	err := parallel.FanOutIn(func(taskC chan<- func() error) {
 		var logC chan *logpb.LogEntry
 		if m.LogWriter != nil {
 			logC = make(chan *logpb.LogEntry)
 
 			taskC <- func() error {
-				if err := archiveLogs(m.LogWriter, m.Desc, logC, idx); err != nil {
+				if err := archiveLogs(m.LogWriter, m.Desc, logC, idx, sidx); err != nil {
 					return err
 				}
 
 				// If we're building an index, emit it now that the log stream has
 				// finished.
 				if idx != nil {
-					return idx.emit(m.IndexWriter)
+					if err := idx.emit(m.IndexWriter); err != nil {
+						return err
+					}
+				}
+				if sidx != nil {
+					return emitSearchIndex(m.SearchIndexWriter, sidx)
 				}
 				return nil
 			}
@@ -159,7 +176,8 @@ func Archive(m Manifest) error {
 	return err
 }
 
-func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.LogEntry, idx *indexBuilder) error {
+func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.LogEntry, idx *indexBuilder,
+	sidx *search.Builder) error {
 	offset := int64(0)
 	out := func(pb proto.Message) error {
 		d, err := proto.Marshal(pb)
@@ -184,7 +202,20 @@ func archiveLogs(w io.Writer, d *logpb.LogStreamDescriptor, logC <-chan *logpb.L
 		if idx != nil {
 			idx.addLogEntry(le, offset)
 		}
+		if sidx != nil {
+			sidx.AddLogEntry(le)
+		}
 		err = out(le)
 	}
 	return err
 }
+
+func emitSearchIndex(w io.Writer, sidx *search.Builder) error {
+	d, err := proto.Marshal(sidx.Index())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(d)
+	return err
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/luci/luci-go/common/iotools"
 	"github.com/luci/luci-go/common/proto/google"
 	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/search"
 
 	. "github.com/luci/luci-go/common/testing/assertions"
 	. "github.com/smartystreets/goconvey/convey"
@@ -218,7 +219,7 @@ func (ic *indexChecker) shouldContainIndexFor(actual interface{}, expected ...in
 
 func TestArchive(t *testing.T) {
 	Convey(`A Manifest connected to Buffer Writers`, t, func() {
-		var logB, indexB, dataB bytes.Buffer
+		var logB, indexB, dataB, searchB bytes.Buffer
 		desc := &logpb.LogStreamDescriptor{
 			Prefix: "test",
 			Name:   "foo",
@@ -231,6 +232,8 @@ func TestArchive(t *testing.T) {
 			LogWriter:   &logB,
 			IndexWriter: &indexB,
 			DataWriter:  &dataB,
+
+			SearchIndexWriter: &searchB,
 		}
 
 		Convey(`A sequence of logs will build a complete index.`, func() {
@@ -261,6 +264,31 @@ func TestArchive(t *testing.T) {
 			So(dataB.String(), ShouldEqual, "0\n1\n3\n6\n")
 		})
 
+		Convey(`Will build a search index for a TEXT stream.`, func() {
+			ts.add(0, 1, 3, 11)
+			So(Archive(m), ShouldBeNil)
+
+			var sidx logpb.LogSearchIndex
+			So(proto.Unmarshal(searchB.Bytes(), &sidx), ShouldBeNil)
+			So(sidx.StreamIndex, ShouldResemble, []uint64{0, 1, 3, 11})
+			So(sidx.LineCount, ShouldResemble, []uint32{1, 1, 1, 1})
+
+			idx, err := search.NewIndex(&sidx)
+			So(err, ShouldBeNil)
+			So(idx.Candidates("11"), ShouldResemble, []search.Location{{StreamIndex: 11, Line: 0}})
+			So(idx.Candidates("1"), ShouldResemble, []search.Location{
+				{StreamIndex: 1, Line: 0},
+				{StreamIndex: 11, Line: 0},
+			})
+		})
+
+		Convey(`Will not build a search index for a non-TEXT stream.`, func() {
+			desc.StreamType = logpb.StreamType_BINARY
+			ts.add(0, 1, 2)
+			So(Archive(m), ShouldBeNil)
+			So(searchB.Len(), ShouldEqual, 0)
+		})
+
 		Convey(`Out of order logs are ignored`, func() {
 			Convey(`When StreamIndex is out of order.`, func() {
 				ts.add(0, 2, 1, 3)
@@ -353,6 +381,11 @@ func TestArchive(t *testing.T) {
 				So(errors.SingleError(Archive(m)), ShouldErrLike, "test error")
 			})
 
+			Convey(`For search index writer errors.`, func() {
+				m.SearchIndexWriter = &errWriter{m.SearchIndexWriter, errors.New("test error")}
+				So(errors.SingleError(Archive(m)), ShouldErrLike, "test error")
+			})
+
 			Convey(`For data writer errors.`, func() {
 				m.DataWriter = &errWriter{m.DataWriter, errors.New("test error")}
 				So(errors.SingleError(Archive(m)), ShouldErrLike, "test error")
@@ -9,6 +9,8 @@
 //     order.
 //   - Binary streams are rendered by emitting the sequential binary data
 //     verbatim.
+//   - Structured streams are rendered by emitting each record as a JSON
+//     object, one per line.
 package renderer
 
 import (
@@ -18,6 +20,7 @@ import (
 	"io"
 
 	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/structured"
 )
 
 // DatagramWriter is a callback function that, given datagram bytes, writes them
@@ -40,6 +43,8 @@ type Renderer struct {
 	//   newline delimiters. If this is false, UTF8 and "\n" will be used.
 	// - For binary and datagram streams, this skips any associated
 	//   DatagramWriters and hex translation and dumps data directly to output.
+	// - For structured streams, this renders every field of every record
+	//   compactly, ignoring StructuredFields and StructuredIndent.
 	Raw bool
 
 	// TextPrefix, if not nil, is called prior to rendering a text line. The
@@ -51,6 +56,16 @@ type Renderer struct {
 	// render the datagram.
 	DatagramWriter DatagramWriter
 
+	// StructuredFields, if not empty, is the set of field keys to render for
+	// structured records. A key also selects the fields nested beneath it.
+	//
+	// Records that have none of the selected fields are not rendered.
+	StructuredFields []string
+
+	// StructuredIndent, if not empty, causes structured records to be rendered
+	// with one field per line, indented by this string.
+	StructuredIndent string
+
 	// Currently-buffered data.
 	buf bytes.Buffer
 	// bufPos is the current position in the buffer.
@@ -142,6 +157,24 @@ func (r *Renderer) bufferNext() error {
 
 				r.dgBuf.Reset()
 			}
+
+		case le.GetStructured() != nil:
+			for _, rec := range le.GetStructured().Records {
+				indent := ""
+				if !r.Raw {
+					if len(r.StructuredFields) > 0 {
+						if rec = structured.Project(rec, r.StructuredFields); len(rec.Fields) == 0 {
+							continue
+						}
+					}
+					indent = r.StructuredIndent
+				}
+
+				if err := structured.Write(&r.buf, rec, indent); err != nil {
+					return err
+				}
+				r.buf.WriteRune('\n')
+			}
 		}
 	}
 
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/structured"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -76,6 +77,23 @@ func (ts *testSource) loadDatagram(data []byte, term bool) {
 	})
 }
 
+func (ts *testSource) loadStructured(records ...string) {
+	st := logpb.Structured{}
+	for _, v := range records {
+		rec, err := structured.Parse([]byte(v))
+		if err != nil {
+			panic(err)
+		}
+		st.Records = append(st.Records, rec)
+	}
+
+	ts.loadLogEntry(&logpb.LogEntry{
+		Content: &logpb.LogEntry_Structured{
+			Structured: &st,
+		},
+	})
+}
+
 func TestRenderer(t *testing.T) {
 	t.Parallel()
 
@@ -200,6 +218,40 @@ func TestRenderer(t *testing.T) {
 			})
 		})
 
+		Convey(`With STRUCTURED log entries`, func() {
+			ts.loadStructured(`{"level": "info", "msg": "a"}`, `{"level": "error", "http": {"status": 500}}`)
+			ts.loadStructured(`{"msg": "b"}`)
+
+			Convey(`Renders one JSON object per line.`, func() {
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldEqual, `{"level":"info","msg":"a"}`+"\n"+
+					`{"level":"error","http.status":500}`+"\n"+
+					`{"msg":"b"}`+"\n")
+			})
+
+			Convey(`Renders a projection of the records' fields, with indentation.`, func() {
+				r.StructuredFields = []string{"level", "http"}
+				r.StructuredIndent = " "
+
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldEqual, "{\n \"level\": \"info\"\n}\n"+
+					"{\n \"level\": \"error\",\n \"http.status\": 500\n}\n")
+			})
+
+			Convey(`Renders every field when configured to render raw.`, func() {
+				r.StructuredFields = []string{"level"}
+				r.Raw = true
+
+				_, err := b.ReadFrom(r)
+				So(err, ShouldBeNil)
+				So(b.String(), ShouldEqual, `{"level":"info","msg":"a"}`+"\n"+
+					`{"level":"error","http.status":500}`+"\n"+
+					`{"msg":"b"}`+"\n")
+			})
+		})
+
 		Convey(`With empty log entries, renders nothing.`, func() {
 			ts.loadLogEntry(&logpb.LogEntry{})
 			c, err := b.ReadFrom(r)
@@ -0,0 +1,116 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package search
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/luci/luci-go/logdog/api/logpb"
+)
+
+// DefaultMaxTerms is the default maximum number of distinct terms that a
+// Builder indexes.
+const DefaultMaxTerms = 1 << 18
+
+// overflowTerm is the indexed term of the lines containing terms that were not
+// indexed because a Builder already had its maximum number of terms. It is
+// empty, which no real term is, and every search matches it.
+const overflowTerm = ""
+
+// Builder builds a LogSearchIndex from a stream's log entries.
+//
+// The zero value is an empty Builder, ready for use.
+type Builder struct {
+	// MaxTerms is the maximum number of distinct terms to index. Once it is
+	// reached, lines containing new terms are indexed as candidates for every
+	// search instead. If zero, DefaultMaxTerms is used.
+	MaxTerms int
+
+	// postings maps each term to the ascending ordinals of the lines that
+	// contain it.
+	postings map[string][]uint32
+
+	streamIndex []uint64
+	lineCount   []uint32
+
+	// lines is the number of lines that have been added.
+	lines uint32
+}
+
+// AddLogEntry adds a log entry's lines to the index.
+//
+// Log entries must be added in stream index order. Entries that do not contain
+// text are recorded as having no lines.
+func (b *Builder) AddLogEntry(le *logpb.LogEntry) {
+	lines := le.GetText().GetLines()
+	for _, line := range lines {
+		b.addLine(line.Value)
+	}
+
+	b.streamIndex = append(b.streamIndex, le.StreamIndex)
+	b.lineCount = append(b.lineCount, uint32(len(lines)))
+}
+
+func (b *Builder) addLine(v string) {
+	ord := b.lines
+	b.lines++
+
+	scanTerms(strings.ToLower(v), func(t string, start, end int) {
+		t = truncate(t)
+
+		if b.postings == nil {
+			b.postings = make(map[string][]uint32)
+		}
+		p, ok := b.postings[t]
+		if !ok && len(b.postings) >= b.maxTerms() {
+			t = overflowTerm
+			p = b.postings[t]
+		}
+		if len(p) > 0 && p[len(p)-1] == ord {
+			// The term has already been recorded for this line.
+			return
+		}
+		b.postings[t] = append(p, ord)
+	})
+}
+
+func (b *Builder) maxTerms() int {
+	if b.MaxTerms > 0 {
+		// Leave room for the overflow term.
+		return b.MaxTerms - 1
+	}
+	return DefaultMaxTerms - 1
+}
+
+// Index returns the LogSearchIndex for the entries that have been added.
+func (b *Builder) Index() *logpb.LogSearchIndex {
+	terms := make([]string, 0, len(b.postings))
+	for t := range b.postings {
+		terms = append(terms, t)
+	}
+	sort.Strings(terms)
+
+	idx := logpb.LogSearchIndex{
+		Terms:       make([]*logpb.LogSearchIndex_Term, len(terms)),
+		StreamIndex: b.streamIndex,
+		LineCount:   b.lineCount,
+	}
+	for i, t := range terms {
+		p := b.postings[t]
+		lines := make([]uint32, len(p))
+		last := uint32(0)
+		for j, ord := range p {
+			lines[j] = ord - last
+			last = ord
+		}
+
+		idx.Terms[i] = &logpb.LogSearchIndex_Term{
+			Text:  t,
+			Lines: lines,
+		}
+	}
+	return &idx
+}
@@ -0,0 +1,225 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package search
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/luci/luci-go/logdog/api/logpb"
+)
+
+// Location identifies a single line in a log stream.
+type Location struct {
+	// StreamIndex is the stream index of the line's LogEntry.
+	StreamIndex uint64
+	// Line is the index of the line within its LogEntry.
+	Line int
+}
+
+// Index is a loaded LogSearchIndex.
+type Index struct {
+	terms []*logpb.LogSearchIndex_Term
+
+	streamIndex []uint64
+	lineCount   []uint32
+	// firstLine is the ordinal of the first line of each LogEntry.
+	firstLine []uint32
+	// lines is the total number of indexed lines.
+	lines uint32
+}
+
+// NewIndex loads a LogSearchIndex.
+func NewIndex(pb *logpb.LogSearchIndex) (*Index, error) {
+	if len(pb.StreamIndex) != len(pb.LineCount) {
+		return nil, errors.New("search index has mismatched stream index and line count")
+	}
+
+	i := Index{
+		terms:       pb.Terms,
+		streamIndex: pb.StreamIndex,
+		lineCount:   pb.LineCount,
+		firstLine:   make([]uint32, len(pb.LineCount)),
+	}
+	for j, count := range pb.LineCount {
+		i.firstLine[j] = i.lines
+		i.lines += count
+	}
+	return &i, nil
+}
+
+// Candidates returns the locations of the lines that may contain text, in
+// stream order.
+//
+// Every line that contains text is returned, but some of the returned lines
+// may not; each should be checked with Match. If text contains no terms, no
+// locations will be returned.
+func (i *Index) Candidates(text string) []Location {
+	text = strings.ToLower(text)
+
+	var (
+		cands []uint32
+		first = true
+	)
+	scanTerms(text, func(t string, start, end int) {
+		if !first && len(cands) == 0 {
+			// Nothing left to intersect with.
+			return
+		}
+
+		// A term that is not at the edge of text must match an entire indexed
+		// term. A term at the edge of text may match part of one.
+		lines := i.termLines(t, start > 0, end < len(text))
+		if first {
+			cands, first = lines, false
+			return
+		}
+		cands = intersect(cands, lines)
+	})
+
+	locs := make([]Location, 0, len(cands))
+	for _, ord := range cands {
+		if loc, ok := i.locate(ord); ok {
+			locs = append(locs, loc)
+		}
+	}
+	return locs
+}
+
+// Surrounding returns the locations of up to before lines preceding loc and up
+// to after lines following it, in stream order.
+//
+// If loc is not an indexed line, no locations will be returned.
+func (i *Index) Surrounding(loc Location, before, after int) (pre, post []Location) {
+	j := sort.Search(len(i.streamIndex), func(j int) bool { return i.streamIndex[j] >= loc.StreamIndex })
+	if j >= len(i.streamIndex) || i.streamIndex[j] != loc.StreamIndex ||
+		loc.Line < 0 || loc.Line >= int(i.lineCount[j]) {
+		return nil, nil
+	}
+	ord := int64(i.firstLine[j]) + int64(loc.Line)
+
+	for o := ord - int64(before); o < ord; o++ {
+		if o < 0 {
+			continue
+		}
+		if l, ok := i.locate(uint32(o)); ok {
+			pre = append(pre, l)
+		}
+	}
+	for o := ord + 1; o <= ord+int64(after) && o < int64(i.lines); o++ {
+		if l, ok := i.locate(uint32(o)); ok {
+			post = append(post, l)
+		}
+	}
+	return
+}
+
+// termLines returns the sorted, unique ordinals of the lines containing an
+// indexed term that matches t.
+//
+// If anchorStart is true, the indexed term must begin with t. If anchorEnd is
+// true, the indexed term must end with t.
+func (i *Index) termLines(t string, anchorStart, anchorEnd bool) []uint32 {
+	if utf8.RuneCountInString(t) > MaxTermLength {
+		// The indexed term would have been truncated, so we can only match the
+		// beginning of it.
+		t, anchorEnd = truncate(t), false
+	}
+
+	var lines []uint32
+	add := func(term *logpb.LogSearchIndex_Term) {
+		ord := uint32(0)
+		for _, delta := range term.Lines {
+			ord += delta
+			lines = append(lines, ord)
+		}
+	}
+
+	// The terms of the overflow term's lines are unknown, so they are always
+	// candidates. It sorts first.
+	if len(i.terms) > 0 && i.terms[0].Text == overflowTerm {
+		add(i.terms[0])
+	}
+
+	if anchorStart {
+		// The matching terms share the prefix t, and so are contiguous in the
+		// sorted term list.
+		j := sort.Search(len(i.terms), func(j int) bool { return i.terms[j].Text >= t })
+		for ; j < len(i.terms) && strings.HasPrefix(i.terms[j].Text, t); j++ {
+			if !anchorEnd || i.terms[j].Text == t {
+				add(i.terms[j])
+			}
+		}
+	} else {
+		for _, term := range i.terms {
+			if term.Text == overflowTerm {
+				continue
+			}
+			// If the indexed term was truncated, its end is unknown, so it is
+			// always a candidate.
+			if isTruncated(term.Text) ||
+				(anchorEnd && strings.HasSuffix(term.Text, t)) ||
+				(!anchorEnd && strings.Contains(term.Text, t)) {
+				add(term)
+			}
+		}
+	}
+
+	return uniqueOrdinals(lines)
+}
+
+// locate returns the Location of the line with the supplied ordinal.
+func (i *Index) locate(ord uint32) (Location, bool) {
+	// Find the last LogEntry whose first line is <= ord. Entries without lines
+	// share their first line with the following entry, so they will be skipped.
+	j := sort.Search(len(i.firstLine), func(j int) bool { return i.firstLine[j] > ord }) - 1
+	if j < 0 || ord-i.firstLine[j] >= i.lineCount[j] {
+		return Location{}, false
+	}
+	return Location{
+		StreamIndex: i.streamIndex[j],
+		Line:        int(ord - i.firstLine[j]),
+	}, true
+}
+
+// uniqueOrdinals sorts v and removes duplicate values.
+func uniqueOrdinals(v []uint32) []uint32 {
+	sort.Sort(ordinals(v))
+
+	out := v[:0]
+	for j, ord := range v {
+		if j == 0 || ord != v[j-1] {
+			out = append(out, ord)
+		}
+	}
+	return out
+}
+
+// intersect returns the values present in both of the sorted, unique slices a
+// and b.
+func intersect(a, b []uint32) []uint32 {
+	var out []uint32
+	for len(a) > 0 && len(b) > 0 {
+		switch {
+		case a[0] < b[0]:
+			a = a[1:]
+		case a[0] > b[0]:
+			b = b[1:]
+		default:
+			out = append(out, a[0])
+			a, b = a[1:], b[1:]
+		}
+	}
+	return out
+}
+
+// ordinals sorts a slice of line ordinals.
+type ordinals []uint32
+
+func (s ordinals) Len() int           { return len(s) }
+func (s ordinals) Less(i, j int) bool { return s[i] < s[j] }
+func (s ordinals) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
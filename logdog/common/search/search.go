@@ -0,0 +1,83 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package search builds and queries LogSearchIndex inverted indexes of TEXT
+// log streams.
+//
+// A line is indexed by its terms: lower-cased runs of letters, digits, and
+// underscores. A search is a case-insensitive substring match against each
+// line. The index is used to narrow the set of lines that could match a
+// search to those that contain all of the search text's terms; each candidate
+// line must then be checked with Match.
+package search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxTermLength is the maximum length, in runes, of an indexed term. Longer
+// terms are truncated to this length.
+const MaxTermLength = 64
+
+// Tokenize returns the terms in s, in the order in which they appear.
+func Tokenize(s string) []string {
+	var terms []string
+	scanTerms(strings.ToLower(s), func(t string, start, end int) {
+		terms = append(terms, truncate(t))
+	})
+	return terms
+}
+
+// Match returns true if line contains text, ignoring case.
+func Match(line, text string) bool {
+	return strings.Contains(strings.ToLower(line), strings.ToLower(text))
+}
+
+// isTermRune returns true if r can be part of a term.
+func isTermRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// scanTerms invokes cb for each term in s, along with the byte offsets of its
+// start and end. s must already be lower-cased.
+func scanTerms(s string, cb func(t string, start, end int)) {
+	start := -1
+	for i, r := range s {
+		switch {
+		case isTermRune(r):
+			if start < 0 {
+				start = i
+			}
+		case start >= 0:
+			cb(s[start:i], start, i)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		cb(s[start:], start, len(s))
+	}
+}
+
+// truncate truncates t to MaxTermLength runes.
+func truncate(t string) string {
+	if len(t) <= MaxTermLength {
+		return t
+	}
+
+	count := 0
+	for i := range t {
+		if count == MaxTermLength {
+			return t[:i]
+		}
+		count++
+	}
+	return t
+}
+
+// isTruncated returns true if the indexed term t may have been truncated.
+func isTruncated(t string) bool {
+	return utf8.RuneCountInString(t) >= MaxTermLength
+}
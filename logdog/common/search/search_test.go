@@ -0,0 +1,182 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/luci/luci-go/logdog/api/logpb"
+
+	"github.com/golang/protobuf/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func textEntry(streamIndex uint64, lines ...string) *logpb.LogEntry {
+	le := logpb.LogEntry{
+		StreamIndex: streamIndex,
+		Content: &logpb.LogEntry_Text{
+			Text: &logpb.Text{},
+		},
+	}
+	for _, l := range lines {
+		le.GetText().Lines = append(le.GetText().Lines, &logpb.Text_Line{Value: l, Delimiter: "\n"})
+	}
+	return &le
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Can tokenize text.`, t, func() {
+		So(Tokenize("ERROR: connection_reset (peer 10.0.0.1)"), ShouldResemble,
+			[]string{"error", "connection_reset", "peer", "10", "0", "0", "1"})
+		So(Tokenize("Größe ändern"), ShouldResemble, []string{"größe", "ändern"})
+		So(Tokenize("  --- "), ShouldBeNil)
+
+		long := strings.Repeat("ü", MaxTermLength+10)
+		So(Tokenize(long), ShouldResemble, []string{strings.Repeat("ü", MaxTermLength)})
+	})
+
+	Convey(`Can match lines.`, t, func() {
+		So(Match("ERROR: Connection reset", "connection RESET"), ShouldBeTrue)
+		So(Match("ERROR: Connection reset", "connection refused"), ShouldBeFalse)
+	})
+
+	Convey(`With an index of a log stream`, t, func() {
+		long := strings.Repeat("x", MaxTermLength+10)
+		entries := []*logpb.LogEntry{
+			textEntry(0, "Starting test run", "Running FooTest.Bar"),
+			textEntry(1),
+			textEntry(2, "ERROR: connection reset by peer", "retrying..."),
+			textEntry(3, "Running FooTest.Baz", "error: Connection refused"),
+			textEntry(4, "token="+long+"suffix"),
+			textEntry(5, "Finished test run; 1 failure(s)"),
+		}
+
+		var b Builder
+		for _, le := range entries {
+			b.AddLogEntry(le)
+		}
+		pb := b.Index()
+
+		// The index should survive a round trip through its protobuf.
+		data, err := proto.Marshal(pb)
+		So(err, ShouldBeNil)
+		var loaded logpb.LogSearchIndex
+		So(proto.Unmarshal(data, &loaded), ShouldBeNil)
+
+		idx, err := NewIndex(&loaded)
+		So(err, ShouldBeNil)
+
+		Convey(`The index has sorted, delta-encoded terms.`, func() {
+			So(pb.StreamIndex, ShouldResemble, []uint64{0, 1, 2, 3, 4, 5})
+			So(pb.LineCount, ShouldResemble, []uint32{2, 0, 2, 2, 1, 1})
+
+			for i := 1; i < len(pb.Terms); i++ {
+				So(pb.Terms[i-1].Text, ShouldBeLessThan, pb.Terms[i].Text)
+			}
+			for _, term := range pb.Terms {
+				if term.Text == "running" {
+					So(term.Lines, ShouldResemble, []uint32{1, 3})
+				}
+			}
+		})
+
+		// search returns the candidate locations that actually match text.
+		search := func(text string) []Location {
+			var locs []Location
+			for _, loc := range idx.Candidates(text) {
+				le := entries[loc.StreamIndex]
+				if Match(le.GetText().Lines[loc.Line].Value, text) {
+					locs = append(locs, loc)
+				}
+			}
+			return locs
+		}
+
+		Convey(`Can find lines containing whole terms.`, func() {
+			So(search("connection"), ShouldResemble, []Location{{2, 0}, {3, 1}})
+			So(search("test run"), ShouldResemble, []Location{{0, 0}, {5, 0}})
+			So(search("nonexistent"), ShouldHaveLength, 0)
+		})
+
+		Convey(`Can find lines containing partial terms.`, func() {
+			So(search("ooTest.Ba"), ShouldResemble, []Location{{0, 1}, {3, 0}})
+			So(search("rror: conn"), ShouldResemble, []Location{{2, 0}, {3, 1}})
+			So(search("ure(s"), ShouldResemble, []Location{{5, 0}})
+		})
+
+		Convey(`Can find lines containing truncated terms.`, func() {
+			So(search("xsuffix"), ShouldResemble, []Location{{4, 0}})
+			So(search("="+long), ShouldResemble, []Location{{4, 0}})
+		})
+
+		Convey(`Can find the lines surrounding a line.`, func() {
+			pre, post := idx.Surrounding(Location{2, 1}, 2, 2)
+			So(pre, ShouldResemble, []Location{{0, 1}, {2, 0}})
+			So(post, ShouldResemble, []Location{{3, 0}, {3, 1}})
+
+			pre, post = idx.Surrounding(Location{5, 0}, 1, 1)
+			So(pre, ShouldResemble, []Location{{4, 0}})
+			So(post, ShouldBeNil)
+
+			pre, post = idx.Surrounding(Location{1, 0}, 1, 1)
+			So(pre, ShouldBeNil)
+			So(post, ShouldBeNil)
+		})
+
+		Convey(`Returns no candidates for text without terms.`, func() {
+			So(idx.Candidates("..."), ShouldHaveLength, 0)
+		})
+
+		Convey(`Will find every line that matches.`, func() {
+			for _, text := range []string{"run", "e", "r", "t r", "ing ", ": c", "peer", "(s)", long} {
+				var expected []Location
+				for _, le := range entries {
+					for i, l := range le.GetText().GetLines() {
+						if Match(l.Value, text) {
+							expected = append(expected, Location{le.StreamIndex, i})
+						}
+					}
+				}
+				So(search(text), ShouldResemble, expected)
+			}
+		})
+	})
+
+	Convey(`With an index that has too many terms`, t, func() {
+		entries := []*logpb.LogEntry{
+			textEntry(0, "alpha beta", "gamma"),
+			textEntry(1, "alpha delta", "epsilon zeta"),
+		}
+
+		b := Builder{MaxTerms: 3}
+		for _, le := range entries {
+			b.AddLogEntry(le)
+		}
+		pb := b.Index()
+		So(pb.Terms, ShouldHaveLength, 3)
+		So(pb.Terms[0].Text, ShouldEqual, overflowTerm)
+		So(pb.Terms[0].Lines, ShouldResemble, []uint32{1, 1, 1})
+
+		idx, err := NewIndex(pb)
+		So(err, ShouldBeNil)
+
+		Convey(`Lines with unindexed terms are candidates for every search.`, func() {
+			all := []Location{{0, 0}, {0, 1}, {1, 0}, {1, 1}}
+			So(idx.Candidates("alpha"), ShouldResemble, all)
+			So(idx.Candidates("beta"), ShouldResemble, all)
+			So(idx.Candidates("lph"), ShouldResemble, all)
+			So(idx.Candidates("zeta"), ShouldResemble, []Location{{0, 1}, {1, 0}, {1, 1}})
+		})
+	})
+
+	Convey(`Will reject an invalid index.`, t, func() {
+		_, err := NewIndex(&logpb.LogSearchIndex{StreamIndex: []uint64{0}})
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,246 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package structured converts between JSON objects and the typed records of
+// LogDog STRUCTURED log streams.
+//
+// A record is a flattened JSON object. The fields of nested objects are joined
+// to their parents' keys with ".", so {"http": {"status": 200}} becomes a
+// single "http.status" integer field. Strings, integers, floating point
+// numbers and booleans are stored as their respective typed values; lists and
+// nulls are stored as JSON-encoded values.
+package structured
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/luci/luci-go/logdog/api/logpb"
+)
+
+// KeySep is the separator used to join nested object keys.
+const KeySep = "."
+
+// Parse parses a single JSON object into a structured record.
+//
+// The object's field order is preserved.
+func Parse(data []byte) (*logpb.Structured_Record, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		return nil, errors.New("record is not a JSON object")
+	}
+
+	var rec logpb.Structured_Record
+	if err := parseObject(&rec, "", data); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func parseObject(rec *logpb.Structured_Record, base string, data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	// Consume the opening "{".
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	empty := true
+	for dec.More() {
+		empty = false
+
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := tok.(string) // Object keys are always strings.
+		if base != "" {
+			key = base + KeySep + key
+		}
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+		if err := parseValue(rec, key, raw); err != nil {
+			return err
+		}
+	}
+
+	// Consume the closing "}", and make sure that nothing follows it.
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("unexpected data following JSON object")
+	}
+
+	// Retain empty nested objects, so that their keys aren't lost.
+	if empty && base != "" {
+		rec.Fields = append(rec.Fields, &logpb.Structured_Field{
+			Key:   base,
+			Value: &logpb.Structured_Field_JsonValue{JsonValue: "{}"},
+		})
+	}
+	return nil
+}
+
+func parseValue(rec *logpb.Structured_Record, key string, raw json.RawMessage) error {
+	f := logpb.Structured_Field{Key: key}
+
+	switch raw[0] {
+	case '{':
+		return parseObject(rec, key, raw)
+
+	case '"':
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return err
+		}
+		f.Value = &logpb.Structured_Field_StringValue{StringValue: v}
+
+	case 't', 'f':
+		var v bool
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return err
+		}
+		f.Value = &logpb.Structured_Field_BoolValue{BoolValue: v}
+
+	case '[', 'n':
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, raw); err != nil {
+			return err
+		}
+		f.Value = &logpb.Structured_Field_JsonValue{JsonValue: buf.String()}
+
+	default:
+		// A number. Prefer an integer representation, if it has one.
+		s := string(raw)
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			f.Value = &logpb.Structured_Field_IntValue{IntValue: v}
+		} else if v, err := strconv.ParseFloat(s, 64); err == nil {
+			f.Value = &logpb.Structured_Field_DoubleValue{DoubleValue: v}
+		} else {
+			return fmt.Errorf("invalid number for field %q: %s", key, s)
+		}
+	}
+
+	rec.Fields = append(rec.Fields, &f)
+	return nil
+}
+
+// Value returns the string form of a field's value.
+//
+// This is the value's JSON encoding, except for strings, which are returned
+// verbatim. It is the form that is used to index and query field values.
+func Value(f *logpb.Structured_Field) string {
+	switch v := f.Value.(type) {
+	case *logpb.Structured_Field_StringValue:
+		return v.StringValue
+	case *logpb.Structured_Field_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *logpb.Structured_Field_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'g', -1, 64)
+	case *logpb.Structured_Field_BoolValue:
+		return strconv.FormatBool(v.BoolValue)
+	case *logpb.Structured_Field_JsonValue:
+		return v.JsonValue
+	default:
+		return ""
+	}
+}
+
+// Get returns the first field in rec with the specified key, or nil if there
+// is no such field.
+func Get(rec *logpb.Structured_Record, key string) *logpb.Structured_Field {
+	for _, f := range rec.GetFields() {
+		if f.Key == key {
+			return f
+		}
+	}
+	return nil
+}
+
+// Project returns a record containing the fields of rec that are selected by
+// keys, in their original order.
+//
+// A key selects the field with that key, as well as any fields nested beneath
+// it. For example, "http" selects both "http" and "http.status".
+func Project(rec *logpb.Structured_Record, keys []string) *logpb.Structured_Record {
+	var out logpb.Structured_Record
+	for _, f := range rec.GetFields() {
+		for _, k := range keys {
+			if f.Key == k || strings.HasPrefix(f.Key, k+KeySep) {
+				out.Fields = append(out.Fields, f)
+				break
+			}
+		}
+	}
+	return &out
+}
+
+// Write writes rec to w as a flattened JSON object.
+//
+// If indent is not empty, the object is written with one field per line, each
+// indented with indent. Otherwise, it is written compactly.
+func Write(w io.Writer, rec *logpb.Structured_Record, indent string) error {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range rec.GetFields() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if indent != "" {
+			buf.WriteByte('\n')
+			buf.WriteString(indent)
+		}
+
+		if err := writeJSON(&buf, f.Key); err != nil {
+			return err
+		}
+		buf.WriteByte(':')
+		if indent != "" {
+			buf.WriteByte(' ')
+		}
+
+		switch v := f.Value.(type) {
+		case *logpb.Structured_Field_StringValue:
+			if err := writeJSON(&buf, v.StringValue); err != nil {
+				return err
+			}
+		case *logpb.Structured_Field_JsonValue:
+			buf.WriteString(v.JsonValue)
+		case nil:
+			buf.WriteString("null")
+		default:
+			buf.WriteString(Value(f))
+		}
+	}
+	if indent != "" && len(rec.GetFields()) > 0 {
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('}')
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// writeJSON writes the JSON encoding of a string to buf.
+func writeJSON(buf *bytes.Buffer, v string) error {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+
+	// Encode terminates its value with a newline.
+	buf.Truncate(buf.Len() - 1)
+	return nil
+}
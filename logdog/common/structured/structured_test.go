@@ -0,0 +1,81 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package structured
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/luci/luci-go/logdog/api/logpb"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func write(rec *logpb.Structured_Record, indent string) string {
+	var buf bytes.Buffer
+	if err := Write(&buf, rec, indent); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestStructured(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Parsing a JSON object`, t, func() {
+		rec, err := Parse([]byte(`{"msg": "<hi>", "n": 12, "f": 1.5, "ok": true,
+			"http": {"status": 404, "hdr": {}}, "l": [1, 2], "z": null}`))
+		So(err, ShouldBeNil)
+
+		Convey(`Produces ordered, flattened, typed fields.`, func() {
+			So(rec, ShouldResemble, &logpb.Structured_Record{Fields: []*logpb.Structured_Field{
+				{Key: "msg", Value: &logpb.Structured_Field_StringValue{StringValue: "<hi>"}},
+				{Key: "n", Value: &logpb.Structured_Field_IntValue{IntValue: 12}},
+				{Key: "f", Value: &logpb.Structured_Field_DoubleValue{DoubleValue: 1.5}},
+				{Key: "ok", Value: &logpb.Structured_Field_BoolValue{BoolValue: true}},
+				{Key: "http.status", Value: &logpb.Structured_Field_IntValue{IntValue: 404}},
+				{Key: "http.hdr", Value: &logpb.Structured_Field_JsonValue{JsonValue: "{}"}},
+				{Key: "l", Value: &logpb.Structured_Field_JsonValue{JsonValue: "[1,2]"}},
+				{Key: "z", Value: &logpb.Structured_Field_JsonValue{JsonValue: "null"}},
+			}})
+		})
+
+		Convey(`Can get field values.`, func() {
+			So(Value(Get(rec, "msg")), ShouldEqual, "<hi>")
+			So(Value(Get(rec, "f")), ShouldEqual, "1.5")
+			So(Value(Get(rec, "http.status")), ShouldEqual, "404")
+			So(Value(Get(rec, "ok")), ShouldEqual, "true")
+			So(Get(rec, "http"), ShouldBeNil)
+		})
+
+		Convey(`Can write the record compactly.`, func() {
+			So(write(rec, ""), ShouldEqual,
+				`{"msg":"<hi>","n":12,"f":1.5,"ok":true,"http.status":404,"http.hdr":{},"l":[1,2],"z":null}`)
+		})
+
+		Convey(`Can write a projection of the record with indentation.`, func() {
+			So(write(Project(rec, []string{"n", "http"}), "  "), ShouldEqual,
+				"{\n  \"n\": 12,\n  \"http.status\": 404,\n  \"http.hdr\": {}\n}")
+			So(write(Project(rec, []string{"missing"}), "  "), ShouldEqual, "{}")
+		})
+	})
+
+	Convey(`Will round-trip a large integer.`, t, func() {
+		rec, err := Parse([]byte(`{"id": 9007199254740993}`))
+		So(err, ShouldBeNil)
+		So(write(rec, ""), ShouldEqual, `{"id":9007199254740993}`)
+	})
+
+	Convey(`Will reject invalid records.`, t, func() {
+		for _, v := range []string{``, `[]`, `"foo"`, `{"a": 1`, `{"a": 1} {}`, `{"a": }`} {
+			_, err := Parse([]byte(v))
+			So(err, ShouldNotBeNil)
+		}
+
+		_, err := Parse([]byte(`{"a": 1}, 2`))
+		So(err, ShouldErrLike, "unexpected data following JSON object")
+	})
+}
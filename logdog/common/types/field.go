@@ -0,0 +1,41 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// MaxIndexedFields is the maximum number of fields that a STRUCTURED log
+	// stream may declare as indexed.
+	MaxIndexedFields = 8
+
+	// MaxFieldKeySize is the maximum number of bytes allowed in an indexed
+	// field's key.
+	MaxFieldKeySize = 64
+
+	// MaxIndexedFieldValueSize is the maximum number of bytes in an indexed
+	// field value. Longer values are not indexed.
+	MaxIndexedFieldValueSize = 256
+
+	// MaxIndexedFieldValues is the maximum number of distinct values that are
+	// indexed for each of a log stream's indexed fields. Additional values are
+	// not indexed.
+	MaxIndexedFieldValues = 64
+)
+
+// ValidateFieldKey returns an error if k is not a valid indexed field key.
+//
+// Indexed field keys follow the same rules as tag keys.
+func ValidateFieldKey(k string) error {
+	if err := StreamName(k).Validate(); err != nil {
+		return fmt.Errorf("invalid field key: %s", err)
+	}
+	if len(k) > MaxFieldKeySize {
+		return fmt.Errorf("field key exceeds maximum size (%d > %d)", len(k), MaxFieldKeySize)
+	}
+	return nil
+}
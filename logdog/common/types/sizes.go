@@ -15,4 +15,8 @@ const (
 
 	// MaxDatagramSize is the maximum size, in bytes, of datagram data.
 	MaxDatagramSize int = MaxLogEntryDataSize
+
+	// MaxStructuredRecordSize is the maximum size, in bytes, of a single
+	// JSON-encoded structured record.
+	MaxStructuredRecordSize = 1024 * 1024
 )
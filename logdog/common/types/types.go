@@ -19,6 +19,9 @@ const (
 	// ContentTypeLogdogDatagram is a content type for size-prefixed datagram
 	// frame stream.
 	ContentTypeLogdogDatagram = "application/x-logdog-datagram"
+	// ContentTypeJSONLines is a content type for newline-delimited JSON object
+	// streams.
+	ContentTypeJSONLines = "application/x-ndjson"
 	// ContentTypeLogdogLog is a LogDog log stream.
 	ContentTypeLogdogLog = "application/x-logdog-logs"
 	// ContentTypeLogdogIndex is a LogDog log index.
@@ -36,6 +36,7 @@ const (
 	tsEntriesField = "entries"
 	tsIndexField   = "index"
 	tsDataField    = "data"
+	tsSearchField  = "search"
 
 	// If the archive dispatch is within this range of the current time, we will
 	// avoid archival.
@@ -53,8 +54,8 @@ var (
 	// tsSize tracks the archive binary file size distribution of completed
 	// archives.
 	//
-	// The "archive" field is the specific type of archive (entries, index, data)
-	// that is being tracked.
+	// The "archive" field is the specific type of archive (entries, index, data,
+	// search) that is being tracked.
 	//
 	// The "stream" field is the type of log stream that is being archived.
 	tsSize = metric.NewCumulativeDistribution("logdog/archivist/archive/size",
@@ -67,8 +68,8 @@ var (
 	// tsTotalBytes tracks the cumulative total number of bytes that have
 	// been archived by this instance.
 	//
-	// The "archive" field is the specific type of archive (entries, index, data)
-	// that is being tracked.
+	// The "archive" field is the specific type of archive (entries, index, data,
+	// search) that is being tracked.
 	//
 	// The "stream" field is the type of log stream that is being archived.
 	tsTotalBytes = metric.NewCounter("logdog/archivist/archive/total_bytes",
@@ -385,6 +386,7 @@ func (a *Archivist) archiveTaskImpl(c context.Context, task Task) error {
 		staged.stream.addMetrics(c, tsEntriesField, streamType)
 		staged.index.addMetrics(c, tsIndexField, streamType)
 		staged.data.addMetrics(c, tsDataField, streamType)
+		staged.search.addMetrics(c, tsSearchField, streamType)
 
 		tsLogEntries.Add(c, float64(staged.logEntryCount), streamType)
 		tsTotalLogEntries.Add(c, staged.logEntryCount, streamType)
@@ -394,6 +396,7 @@ func (a *Archivist) archiveTaskImpl(c context.Context, task Task) error {
 		"streamURL":     ar.StreamUrl,
 		"indexURL":      ar.IndexUrl,
 		"dataURL":       ar.DataUrl,
+		"searchURL":     ar.SearchUrl,
 		"terminalIndex": ar.TerminalIndex,
 		"logEntryCount": ar.LogEntryCount,
 		"hadError":      ar.Error,
@@ -473,6 +476,11 @@ func (a *Archivist) makeStagedArchival(c context.Context, project cfgtypes.Proje
 	sa.stream = sa.makeStagingPaths("logstream.entries", uid)
 	sa.index = sa.makeStagingPaths("logstream.index", uid)
 
+	// TEXT streams also get a search index.
+	if sa.desc.StreamType == logpb.StreamType_TEXT {
+		sa.search = sa.makeStagingPaths("logstream.search", uid)
+	}
+
 	// If we're emitting binary files, construct that too.
 	bext := sa.desc.BinaryFileExt
 	if bext != "" || sa.AlwaysRender {
@@ -497,6 +505,7 @@ type stagedArchival struct {
 	stream stagingPaths
 	index  stagingPaths
 	data   stagingPaths
+	search stagingPaths
 
 	finalized     bool
 	terminalIndex types.MessageIndex
@@ -572,6 +581,7 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		"streamURL": sa.stream.staged,
 		"indexURL":  sa.index.staged,
 		"dataURL":   sa.data.staged,
+		"searchURL": sa.search.staged,
 	}.Debugf(c, "Staging log stream...")
 
 	// Group any transient errors that occur during cleanup. If we aren't
@@ -619,7 +629,7 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		return w, nil
 	}
 
-	var streamWriter, indexWriter, dataWriter gs.Writer
+	var streamWriter, indexWriter, dataWriter, searchWriter gs.Writer
 	if streamWriter, err = createWriter(sa.stream.staged); err != nil {
 		return
 	}
@@ -638,6 +648,13 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		defer closeWriter(dataWriter, sa.data.staged)
 	}
 
+	if sa.search.enabled() {
+		if searchWriter, err = createWriter(sa.search.staged); err != nil {
+			return err
+		}
+		defer closeWriter(searchWriter, sa.search.staged)
+	}
+
 	// Read our log entries from intermediate storage.
 	ss := storageSource{
 		Context:       c,
@@ -658,6 +675,8 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 		PrefixIndexRange: sa.IndexPrefixRange,
 		ByteRange:        sa.IndexByteRange,
 
+		SearchIndexWriter: searchWriter,
+
 		Logger: log.Get(c),
 	}
 	if err = archive.Archive(m); err != nil {
@@ -686,6 +705,9 @@ func (sa *stagedArchival) stage(c context.Context) (err error) {
 	if dataWriter != nil {
 		sa.data.bytesWritten = dataWriter.Count()
 	}
+	if searchWriter != nil {
+		sa.search.bytesWritten = searchWriter.Count()
+	}
 	return
 }
 
@@ -744,6 +766,11 @@ func (sa *stagedArchival) finalize(c context.Context, client gs.Client, ar *logd
 		ar.DataUrl = string(sa.data.final)
 		ar.DataSize = sa.data.bytesWritten
 	}
+	if sa.search.enabled() && sa.search.bytesWritten > 0 {
+		// An empty search index is not finalized, so don't report it.
+		ar.SearchUrl = string(sa.search.final)
+		ar.SearchSize = sa.search.bytesWritten
+	}
 	return nil
 }
 
@@ -769,6 +796,7 @@ func (sa *stagedArchival) getStagingPaths() []*stagingPaths {
 		&sa.stream,
 		&sa.index,
 		&sa.data,
+		&sa.search,
 	}
 }
 
@@ -331,17 +331,19 @@ func TestHandleArchive(t *testing.T) {
 		//
 		// After checking, the values are set to zero. This allows us to use
 		// ShouldEqual without hardcoding specific archival sizes into the results.
-		hasStreams := func(log, index, data bool) bool {
+		hasStreams := func(log, index, data, search bool) bool {
 			So(archiveRequest, ShouldNotBeNil)
 			if (log && archiveRequest.StreamSize <= 0) ||
 				(index && archiveRequest.IndexSize <= 0) ||
-				(data && archiveRequest.DataSize <= 0) {
+				(data && archiveRequest.DataSize <= 0) ||
+				(search && archiveRequest.SearchSize <= 0) {
 				return false
 			}
 
 			archiveRequest.StreamSize = 0
 			archiveRequest.IndexSize = 0
 			archiveRequest.DataSize = 0
+			archiveRequest.SearchSize = 0
 			return true
 		}
 
@@ -455,7 +457,7 @@ func TestHandleArchive(t *testing.T) {
 				So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 				So(task.consumed, ShouldBeTrue)
 
-				So(hasStreams(true, true, true), ShouldBeTrue)
+				So(hasStreams(true, true, true, true), ShouldBeTrue)
 				So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 					Project:       project,
 					Id:            archiveTask.Id,
@@ -465,6 +467,7 @@ func TestHandleArchive(t *testing.T) {
 					StreamUrl: gsURL(project, "logstream.entries"),
 					IndexUrl:  gsURL(project, "logstream.index"),
 					DataUrl:   gsURL(project, "data.bin"),
+					SearchUrl: gsURL(project, "logstream.search"),
 				})
 			})
 
@@ -526,7 +529,7 @@ func TestHandleArchive(t *testing.T) {
 					So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 					So(task.consumed, ShouldBeTrue)
 
-					So(hasStreams(true, true, false), ShouldBeTrue)
+					So(hasStreams(true, true, false, false), ShouldBeTrue)
 					So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 						Project:       project,
 						Id:            archiveTask.Id,
@@ -545,7 +548,7 @@ func TestHandleArchive(t *testing.T) {
 					So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 					So(task.consumed, ShouldBeTrue)
 
-					So(hasStreams(true, true, true), ShouldBeTrue)
+					So(hasStreams(true, true, true, true), ShouldBeTrue)
 					So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 						Project:       project,
 						Id:            archiveTask.Id,
@@ -555,6 +558,7 @@ func TestHandleArchive(t *testing.T) {
 						StreamUrl: gsURL(project, "logstream.entries"),
 						IndexUrl:  gsURL(project, "logstream.index"),
 						DataUrl:   gsURL(project, "data.bin"),
+						SearchUrl: gsURL(project, "logstream.search"),
 					})
 				})
 			})
@@ -566,7 +570,7 @@ func TestHandleArchive(t *testing.T) {
 					So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 					So(task.consumed, ShouldBeTrue)
 
-					So(hasStreams(true, true, false), ShouldBeTrue)
+					So(hasStreams(true, true, false, false), ShouldBeTrue)
 					So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 						Project:       project,
 						Id:            archiveTask.Id,
@@ -585,7 +589,7 @@ func TestHandleArchive(t *testing.T) {
 					So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 					So(task.consumed, ShouldBeTrue)
 
-					So(hasStreams(true, true, true), ShouldBeTrue)
+					So(hasStreams(true, true, true, true), ShouldBeTrue)
 					So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 						Project:       project,
 						Id:            archiveTask.Id,
@@ -595,6 +599,7 @@ func TestHandleArchive(t *testing.T) {
 						StreamUrl: gsURL(project, "logstream.entries"),
 						IndexUrl:  gsURL(project, "logstream.index"),
 						DataUrl:   gsURL(project, "data.bin"),
+						SearchUrl: gsURL(project, "logstream.search"),
 					})
 				})
 			})
@@ -610,7 +615,7 @@ func TestHandleArchive(t *testing.T) {
 				So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 				So(task.consumed, ShouldBeTrue)
 
-				So(hasStreams(true, true, false), ShouldBeTrue)
+				So(hasStreams(true, true, false, true), ShouldBeTrue)
 				So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 					Project:       project,
 					Id:            archiveTask.Id,
@@ -619,6 +624,7 @@ func TestHandleArchive(t *testing.T) {
 
 					StreamUrl: gsURL(project, "logstream.entries"),
 					IndexUrl:  gsURL(project, "logstream.index"),
+					SearchUrl: gsURL(project, "logstream.search"),
 				})
 			})
 
@@ -629,7 +635,7 @@ func TestHandleArchive(t *testing.T) {
 				So(ar.archiveTaskImpl(c, task), ShouldBeNil)
 				So(task.consumed, ShouldBeTrue)
 
-				So(hasStreams(true, true, true), ShouldBeTrue)
+				So(hasStreams(true, true, true, true), ShouldBeTrue)
 				So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
 					Project:       project,
 					Id:            archiveTask.Id,
@@ -639,10 +645,36 @@ func TestHandleArchive(t *testing.T) {
 					StreamUrl: gsURL(project, "logstream.entries"),
 					IndexUrl:  gsURL(project, "logstream.index"),
 					DataUrl:   gsURL(project, "data.foobar"),
+					SearchUrl: gsURL(project, "logstream.search"),
 				})
 			})
 		})
 
+		Convey(`Will not emit a search index for a non-TEXT stream.`, func() {
+			desc.StreamType = logpb.StreamType_DATAGRAM
+			reloadDesc()
+			addTestEntry(project, 0, 1, 2, 3, 4)
+			stream.State.TerminalIndex = 4
+
+			So(ar.archiveTaskImpl(c, task), ShouldBeNil)
+			So(task.consumed, ShouldBeTrue)
+
+			So(hasStreams(true, true, false, false), ShouldBeTrue)
+			So(archiveRequest, ShouldResemble, &logdog.ArchiveStreamRequest{
+				Project:       project,
+				Id:            archiveTask.Id,
+				LogEntryCount: 5,
+				TerminalIndex: 4,
+
+				StreamUrl: gsURL(project, "logstream.entries"),
+				IndexUrl:  gsURL(project, "logstream.index"),
+				DataUrl:   gsURL(project, "data.bin"),
+			})
+			for p := range gsc.objs {
+				So(string(p), ShouldNotEndWith, "logstream.search")
+			}
+		})
+
 		Convey(`With an empty project name, will fail and consume the task.`, func() {
 			archiveTask.Project = ""
 
@@ -662,7 +694,7 @@ func TestHandleArchive(t *testing.T) {
 			stream.State.TerminalIndex = 3
 			addTestEntry(project, 0, 1, 2, 3)
 
-			for _, failName := range []string{"/logstream.entries", "/logstream.index", "/data.bin"} {
+			for _, failName := range []string{"/logstream.entries", "/logstream.index", "/data.bin", "/logstream.search"} {
 				for _, testCase := range []struct {
 					name  string
 					setup func()
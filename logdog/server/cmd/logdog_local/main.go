@@ -0,0 +1,64 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Binary logdog_local runs a self-contained LogDog Coordinator for local
+// development.
+//
+// A Butler can stream to it with "-output logdog,host=ADDR", and the "logdog"
+// tool can read from it with "-insecure -host ADDR". All state, including log
+// data, is held in memory and is lost when logdog_local exits.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/logging/gologger"
+	"github.com/luci/luci-go/grpc/prpc"
+	"github.com/luci/luci-go/logdog/common/storage/memory"
+	"github.com/luci/luci-go/logdog/server/local"
+	"github.com/luci/luci-go/server/router"
+
+	"golang.org/x/net/context"
+)
+
+func mainImpl() error {
+	addr := flag.String("addr", "localhost:8080", "Address to listen on")
+	prefixExpiration := flag.Duration("prefix-expiration", local.DefaultPrefixExpiration,
+		"Maximum amount of time after registration that a prefix can register new log streams")
+	logConfig := logging.Config{Level: logging.Info}
+	logConfig.AddFlags(flag.CommandLine)
+	flag.Parse()
+	if flag.NArg() != 0 {
+		return fmt.Errorf("unknown arguments: %s", flag.Args())
+	}
+
+	ctx := logConfig.Set(gologger.StdConfig.Use(context.Background()))
+
+	coord := local.New(&memory.Storage{})
+	defer coord.Close()
+	coord.PrefixExpiration = *prefixExpiration
+
+	srv := prpc.Server{Authenticator: prpc.NoAuthentication}
+	coord.InstallServices(&srv)
+
+	r := router.New()
+	srv.InstallHandlers(r, router.NewMiddlewareChain(func(c *router.Context, next router.Handler) {
+		c.Context = ctx
+		next(c)
+	}))
+
+	logging.Infof(ctx, "Serving the LogDog Coordinator on http://%s", *addr)
+	return http.ListenAndServe(*addr, r)
+}
+
+func main() {
+	if err := mainImpl(); err != nil {
+		fmt.Fprintf(os.Stderr, "logdog_local: %s\n", err)
+		os.Exit(1)
+	}
+}
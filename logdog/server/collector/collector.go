@@ -19,6 +19,7 @@ import (
 	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/client/butlerproto"
 	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/structured"
 	"github.com/luci/luci-go/logdog/common/types"
 	"github.com/luci/luci-go/logdog/server/collector/coordinator"
 	"github.com/luci/luci-go/luci_config/common/cfgtypes"
@@ -422,6 +423,26 @@ func (c *Collector) processLogStream(ctx context.Context, h *bundleEntryHandler)
 			}
 		}
 
+		// If our log entries include indexed field values, report them to the
+		// Coordinator.
+		if fields := indexedFieldValues(h.be.Desc, h.be.Logs); len(fields) > 0 {
+			taskC <- func() error {
+				ireq := coordinator.IndexFieldsRequest{
+					Project: state.Project,
+					Path:    state.Path,
+					ID:      state.ID,
+					Secret:  state.Secret,
+					Fields:  fields,
+				}
+
+				if err := c.Coordinator.IndexStreamFields(ctx, &ireq); err != nil {
+					log.WithError(err).Errorf(ctx, "Failed to index stream fields.")
+					return err
+				}
+				return nil
+			}
+		}
+
 		// If our bundle entry is terminal, we have an additional task of reporting
 		// this to the Coordinator.
 		if h.be.Terminal {
@@ -448,6 +469,40 @@ func (c *Collector) processLogStream(ctx context.Context, h *bundleEntryHandler)
 	})
 }
 
+// indexedFieldValues returns the distinct values of desc's indexed fields in
+// the records of a STRUCTURED stream's log entries, in the order that they
+// first appear.
+//
+// Values that are too large to be indexed are omitted.
+func indexedFieldValues(desc *logpb.LogStreamDescriptor, logs []*logpb.LogEntry) []coordinator.FieldValue {
+	if len(desc.IndexedFields) == 0 {
+		return nil
+	}
+
+	var fields []coordinator.FieldValue
+	seen := make(map[coordinator.FieldValue]struct{})
+	for _, le := range logs {
+		for _, rec := range le.GetStructured().GetRecords() {
+			for _, k := range desc.IndexedFields {
+				f := structured.Get(rec, k)
+				if f == nil {
+					continue
+				}
+
+				fv := coordinator.FieldValue{Key: k, Value: structured.Value(f)}
+				if len(fv.Value) > types.MaxIndexedFieldValueSize {
+					continue
+				}
+				if _, ok := seen[fv]; !ok {
+					seen[fv] = struct{}{}
+					fields = append(fields, fv)
+				}
+			}
+		}
+	}
+	return fields
+}
+
 func streamType(desc *logpb.LogStreamDescriptor) string {
 	if desc == nil {
 		return "UNKNOWN"
@@ -15,6 +15,7 @@ import (
 	"github.com/luci/luci-go/logdog/api/logpb"
 	"github.com/luci/luci-go/logdog/client/butlerproto"
 	"github.com/luci/luci-go/logdog/common/storage/memory"
+	"github.com/luci/luci-go/logdog/common/structured"
 	"github.com/luci/luci-go/logdog/common/types"
 	cc "github.com/luci/luci-go/logdog/server/collector/coordinator"
 	"github.com/luci/luci-go/luci_config/common/cfgtypes"
@@ -60,6 +61,42 @@ func testCollectorImpl(t *testing.T, caching bool) {
 			So(st, shouldHaveStoredStream, "test-project", "foo/+/baz", indexRange{0, 255})
 		})
 
+		Convey(`Will index the field values of a STRUCTURED stream.`, func() {
+			be := bb.genBundleEntry("foo/+/bar", 1)
+			be.Desc.StreamType = logpb.StreamType_STRUCTURED
+			be.Desc.ContentType = string(types.ContentTypeJSONLines)
+			be.Desc.IndexedFields = []string{"status", "req.user"}
+
+			for i, line := range []string{
+				`{"status": 404, "req": {"user": "foo"}}`,
+				`{"status": 200, "req": {"user": "foo"}, "msg": "ok"}`,
+			} {
+				rec, err := structured.Parse([]byte(line))
+				So(err, ShouldBeNil)
+
+				be.Logs = append(be.Logs, &logpb.LogEntry{
+					StreamIndex: uint64(i),
+					Sequence:    uint64(i),
+					Content: &logpb.LogEntry_Structured{
+						Structured: &logpb.Structured{Records: []*logpb.Structured_Record{rec}},
+					},
+				})
+			}
+			be.Terminal = true
+			be.TerminalIndex = 1
+			bb.addBundleEntry(be)
+
+			So(coll.Process(c, bb.bundle()), ShouldBeNil)
+
+			So(tcc, shouldHaveRegisteredStream, "test-project", "foo/+/bar", 1)
+			So(st, shouldHaveStoredStream, "test-project", "foo/+/bar", 0, 1)
+			So(tcc.indexedFields("test-project", "foo/+/bar"), ShouldResemble, []cc.FieldValue{
+				{Key: "status", Value: "404"},
+				{Key: "req.user", Value: "foo"},
+				{Key: "status", Value: "200"},
+			})
+		})
+
 		Convey(`Will return a transient error if a transient error happened while registering.`, func() {
 			tcc.registerCallback = func(cc.LogStreamState) error { return errors.WrapTransient(errors.New("test error")) }
 
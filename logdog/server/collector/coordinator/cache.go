@@ -125,6 +125,17 @@ func (c *cache) TerminateStream(ctx context.Context, r *TerminateRequest) error
 	return entry.terminateStream(ctx, c.Coordinator, *r)
 }
 
+// IndexStreamFields invokes the wrapped Coordinator's IndexStreamFields method
+// with the field values that have not already been indexed through this
+// cache.
+func (c *cache) IndexStreamFields(ctx context.Context, r *IndexFieldsRequest) error {
+	entry, _ := c.getCacheEntry(ctx, cacheEntryKey{
+		project: r.Project,
+		path:    r.Path,
+	})
+	return entry.indexStreamFields(ctx, c.Coordinator, *r)
+}
+
 // cacheEntryKey is the LRU key for a cacheEntry.
 type cacheEntryKey struct {
 	project cfgtypes.ProjectName
@@ -165,6 +176,10 @@ type cacheEntry struct {
 	// terminateP is a Promise that is blocking pending stream termination.
 	// Upon successful resolution, it will contain a nil result with no error.
 	terminateP *promise.Promise
+
+	// indexed is the set of field values that have been successfully indexed by
+	// the Coordinator.
+	indexed map[FieldValue]struct{}
 }
 
 // registerStream performs a RegisterStream Coordinator RPC.
@@ -279,6 +294,41 @@ func (ce *cacheEntry) terminateStream(ctx context.Context, coord Coordinator, tr
 	return nil
 }
 
+// indexStreamFields performs an IndexStreamFields Coordinator RPC for the
+// field values in ir that have not already been indexed.
+//
+// Unlike registration and termination, indexing is not a singleton operation,
+// so concurrent requests may index the same values. This is harmless, since
+// the RPC is idempotent.
+func (ce *cacheEntry) indexStreamFields(ctx context.Context, coord Coordinator, ir IndexFieldsRequest) error {
+	var fields []FieldValue
+	ce.withLock(func() {
+		for _, f := range ir.Fields {
+			if _, ok := ce.indexed[f]; !ok {
+				fields = append(fields, f)
+			}
+		}
+	})
+	if len(fields) == 0 {
+		return nil
+	}
+
+	ir.Fields = fields
+	if err := coord.IndexStreamFields(ctx, &ir); err != nil {
+		return err
+	}
+
+	ce.withLock(func() {
+		if ce.indexed == nil {
+			ce.indexed = make(map[FieldValue]struct{}, len(fields))
+		}
+		for _, f := range fields {
+			ce.indexed[f] = struct{}{}
+		}
+	})
+	return nil
+}
+
 // loadRemoteTerminalIndex updates our cached remote terminal index with tidx,
 // if tidx >= 0 and a remote terminal index is not already cached.
 //
@@ -30,6 +30,9 @@ type testCoordinator struct {
 	callC chan struct{}
 	// errC is a channel that error status will be read from if not nil.
 	errC chan error
+
+	// indexed is the set of field values that have been indexed.
+	indexed []FieldValue
 }
 
 func (c *testCoordinator) RegisterStream(ctx context.Context, s *LogStreamState, desc []byte) (
@@ -51,6 +54,14 @@ func (c *testCoordinator) TerminateStream(ctx context.Context, tr *TerminateRequ
 	return nil
 }
 
+func (c *testCoordinator) IndexStreamFields(ctx context.Context, ir *IndexFieldsRequest) error {
+	if err := c.incCalls(); err != nil {
+		return err
+	}
+	c.indexed = append(c.indexed, ir.Fields...)
+	return nil
+}
+
 // incCalls is an entry point for client goroutines. It offers the opportunity
 // to track call count as well as trap executing goroutines within client calls.
 //
@@ -320,6 +331,42 @@ func TestStreamStateCache(t *testing.T) {
 				})
 			})
 
+			Convey(`IndexStreamFields`, func() {
+				ir := IndexFieldsRequest{
+					Project: st.Project,
+					Path:    st.Path,
+					ID:      st.ID,
+					Fields:  []FieldValue{{"status", "200"}, {"status", "404"}},
+				}
+
+				Convey(`Will only index each field value once.`, func() {
+					So(ssc.IndexStreamFields(c, &ir), ShouldBeNil)
+					So(tcc.calls, ShouldEqual, 1)
+
+					ir.Fields = []FieldValue{{"status", "404"}, {"user", "bob"}}
+					So(ssc.IndexStreamFields(c, &ir), ShouldBeNil)
+					So(tcc.calls, ShouldEqual, 2)
+
+					So(ssc.IndexStreamFields(c, &ir), ShouldBeNil)
+					So(tcc.calls, ShouldEqual, 2)
+
+					So(tcc.indexed, ShouldResemble, []FieldValue{{"status", "200"}, {"status", "404"}, {"user", "bob"}})
+				})
+
+				Convey(`Will retry field values that failed to index.`, func() {
+					tcc.errC = make(chan error, 1)
+					tcc.errC <- errors.WrapTransient(errors.New("test error"))
+
+					err := ssc.IndexStreamFields(c, &ir)
+					So(errors.IsTransient(err), ShouldBeTrue)
+
+					tcc.errC = nil
+					So(ssc.IndexStreamFields(c, &ir), ShouldBeNil)
+					So(tcc.calls, ShouldEqual, 2)
+					So(tcc.indexed, ShouldResemble, []FieldValue{{"status", "200"}, {"status", "404"}})
+				})
+			})
+
 			Convey(`Different projects with the same stream name will not conflict.`, func() {
 				var projects = []cfgtypes.ProjectName{"", "foo", "bar"}
 
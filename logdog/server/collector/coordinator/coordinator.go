@@ -25,6 +25,8 @@ type Coordinator interface {
 	RegisterStream(c context.Context, s *LogStreamState, desc []byte) (*LogStreamState, error)
 	// TerminateStream registers the terminal index of a log stream state.
 	TerminateStream(c context.Context, s *TerminateRequest) error
+	// IndexStreamFields registers indexed field values observed in a log stream.
+	IndexStreamFields(c context.Context, r *IndexFieldsRequest) error
 }
 
 // LogStreamState is a local representation of a remote stream's state. It is a
@@ -72,6 +74,27 @@ type TerminateRequest struct {
 	Secret types.PrefixSecret
 }
 
+// FieldValue is a single indexed field value.
+type FieldValue struct {
+	Key   string // The indexed field's key.
+	Value string // The string form of the field's value.
+}
+
+// IndexFieldsRequest is a local representation of a Coordinator stream field
+// indexing request.
+type IndexFieldsRequest struct {
+	Project cfgtypes.ProjectName // Project name.
+	Path    types.StreamPath     // Stream path. Needed for cache lookup.
+
+	// ID is the stream's Coordinator ID, as indicated by the Coordinator.
+	ID string
+	// Secret is the log stream's prefix secret.
+	Secret types.PrefixSecret
+
+	// Fields is the set of indexed field values to register.
+	Fields []FieldValue
+}
+
 type coordinatorImpl struct {
 	c logdog.ServicesClient
 }
@@ -146,3 +169,34 @@ func (c *coordinatorImpl) TerminateStream(ctx context.Context, r *TerminateReque
 	}
 	return nil
 }
+
+func (c *coordinatorImpl) IndexStreamFields(ctx context.Context, r *IndexFieldsRequest) error {
+	// Client-side validate our parameters.
+	if err := r.Project.Validate(); err != nil {
+		return fmt.Errorf("failed to validate project: %s", err)
+	}
+	if r.ID == "" {
+		return errors.New("missing stream ID")
+	}
+	if len(r.Fields) == 0 {
+		return nil
+	}
+
+	req := logdog.IndexStreamFieldsRequest{
+		Project: string(r.Project),
+		Id:      r.ID,
+		Secret:  []byte(r.Secret),
+		Fields:  make([]*logdog.IndexStreamFieldsRequest_Field, len(r.Fields)),
+	}
+	for i, f := range r.Fields {
+		req.Fields[i] = &logdog.IndexStreamFieldsRequest_Field{
+			Key:   f.Key,
+			Value: f.Value,
+		}
+	}
+
+	if _, err := c.c.IndexStreamFields(ctx, &req); err != nil {
+		return err
+	}
+	return nil
+}
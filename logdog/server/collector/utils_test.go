@@ -49,6 +49,8 @@ type testCoordinator struct {
 
 	// state is the latest tracked stream state.
 	state map[streamKey]*cc.LogStreamState
+	// fields is the set of field values that have been indexed for each stream.
+	fields map[streamKey][]cc.FieldValue
 }
 
 var _ cc.Coordinator = (*testCoordinator)(nil)
@@ -112,6 +114,29 @@ func (c *testCoordinator) TerminateStream(ctx context.Context, tr *cc.TerminateR
 	return nil
 }
 
+func (c *testCoordinator) IndexStreamFields(ctx context.Context, r *cc.IndexFieldsRequest) error {
+	c.Lock()
+	defer c.Unlock()
+
+	key := mkStreamKey(string(r.Project), r.ID)
+	if _, ok := c.state[key]; !ok {
+		return fmt.Errorf("no such stream: %s", r.ID)
+	}
+
+	if c.fields == nil {
+		c.fields = make(map[streamKey][]cc.FieldValue)
+	}
+	c.fields[key] = append(c.fields[key], r.Fields...)
+	return nil
+}
+
+func (c *testCoordinator) indexedFields(project, path string) []cc.FieldValue {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.fields[mkStreamKey(project, idFromPath(path))]
+}
+
 func (c *testCoordinator) stream(project, id string) (int, bool) {
 	c.Lock()
 	defer c.Unlock()
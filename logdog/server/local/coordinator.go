@@ -0,0 +1,150 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package local implements a self-contained LogDog Coordinator for local
+// development.
+//
+// The Coordinator keeps its prefix and log stream state in memory, and loads
+// log entries into an intermediate storage.Storage through an in-process
+// Collector. It exposes the Registration, Services, Logs and Bundles
+// Coordinator services, so a Butler can stream to it, publishing its bundles
+// through the Bundles service, and the "logdog" tool can read from it.
+//
+// Log streams are never archived: they are served from the intermediate
+// storage for as long as the Coordinator runs.
+package local
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/luci/luci-go/grpc/prpc"
+	bundles "github.com/luci/luci-go/logdog/api/endpoints/coordinator/bundles/v1"
+	logs "github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	registration "github.com/luci/luci-go/logdog/api/endpoints/coordinator/registration/v1"
+	services "github.com/luci/luci-go/logdog/api/endpoints/coordinator/services/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/logdog/server/collector"
+	"github.com/luci/luci-go/logdog/server/collector/coordinator"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+)
+
+// DefaultPrefixExpiration is the prefix expiration that is applied when a
+// prefix registration doesn't request one.
+const DefaultPrefixExpiration = 24 * time.Hour
+
+// Coordinator is a local, in-memory LogDog Coordinator.
+//
+// A Coordinator's Close should be called when finished to release any internal
+// resources.
+type Coordinator struct {
+	// PrefixExpiration is the maximum prefix expiration. If zero,
+	// DefaultPrefixExpiration will be used.
+	PrefixExpiration time.Duration
+
+	st        storage.Storage
+	collector *collector.Collector
+
+	mu       sync.Mutex
+	prefixes map[prefixKey]*logPrefix
+	streams  map[streamKey]*logStream
+}
+
+// New creates a new Coordinator that loads log entries into, and serves them
+// from, st.
+func New(st storage.Storage) *Coordinator {
+	c := Coordinator{
+		st:       st,
+		prefixes: make(map[prefixKey]*logPrefix),
+		streams:  make(map[streamKey]*logStream),
+	}
+	c.collector = &collector.Collector{
+		Coordinator: coordinator.NewCoordinator(servicesClient{&c}),
+		Storage:     st,
+	}
+	return &c
+}
+
+// Close releases the Coordinator's internal resources, including its storage.
+func (c *Coordinator) Close() {
+	c.collector.Close()
+	c.st.Close()
+}
+
+// InstallServices registers the Coordinator's services with s.
+func (c *Coordinator) InstallServices(s prpc.Registrar) {
+	registration.RegisterRegistrationServer(s, c)
+	services.RegisterServicesServer(s, c)
+	logs.RegisterLogsServer(s, c)
+	bundles.RegisterBundlesServer(s, c)
+}
+
+func (c *Coordinator) prefixExpiration() time.Duration {
+	if c.PrefixExpiration > 0 {
+		return c.PrefixExpiration
+	}
+	return DefaultPrefixExpiration
+}
+
+type prefixKey struct {
+	project cfgtypes.ProjectName
+	prefix  types.StreamName
+}
+
+// logPrefix is a registered prefix.
+type logPrefix struct {
+	secret     types.PrefixSecret
+	source     []string
+	created    time.Time
+	expiration time.Time
+}
+
+type streamKey struct {
+	project cfgtypes.ProjectName
+	id      string
+}
+
+// logStream is a registered log stream.
+type logStream struct {
+	project      cfgtypes.ProjectName
+	path         types.StreamPath
+	id           string
+	protoVersion string
+	secret       types.PrefixSecret
+
+	desc      *logpb.LogStreamDescriptor
+	descBytes []byte
+
+	// fields is the set of indexed field values that have been observed in a
+	// STRUCTURED log stream, keyed on each of its indexed fields.
+	fields map[string][]string
+
+	created time.Time
+	updated time.Time
+
+	// terminalIndex is the stream's terminal index, or -1 if it is not
+	// terminated.
+	terminalIndex int64
+}
+
+// streamID returns the ID of the log stream at path.
+//
+// Like the production Coordinator, the ID is the hex-encoded SHA256 hash of the
+// stream's path.
+func streamID(path types.StreamPath) string {
+	sum := sha256.Sum256([]byte(path))
+	return hex.EncodeToString(sum[:])
+}
+
+// getStreamLocked returns the log stream at path in project, or nil if it isn't
+// registered.
+//
+// c.mu must be held.
+func (c *Coordinator) getStreamLocked(project cfgtypes.ProjectName, path types.StreamPath) *logStream {
+	return c.streams[streamKey{project, streamID(path)}]
+}
@@ -0,0 +1,343 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/luci/luci-go/common/clock/testclock"
+	"github.com/luci/luci-go/common/proto/google"
+	bundles "github.com/luci/luci-go/logdog/api/endpoints/coordinator/bundles/v1"
+	logs "github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	registration "github.com/luci/luci-go/logdog/api/endpoints/coordinator/registration/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/client/butlerproto"
+	"github.com/luci/luci-go/logdog/common/storage/memory"
+	"github.com/luci/luci-go/logdog/common/structured"
+	"github.com/luci/luci-go/logdog/common/types"
+
+	"golang.org/x/net/context"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCoordinator(t *testing.T) {
+	t.Parallel()
+
+	Convey(`With a local Coordinator`, t, func() {
+		c, tc := testclock.UseTime(context.Background(), testclock.TestTimeUTC)
+
+		coord := New(&memory.Storage{})
+		defer coord.Close()
+
+		wrongSecret := bytes.Repeat([]byte{0x55}, types.PrefixSecretLength)
+
+		// send publishes a bundle containing the supplied entries in the
+		// "testing/+" prefix.
+		send := func(secret []byte, entries ...*logpb.ButlerLogBundle_Entry) error {
+			bundle := logpb.ButlerLogBundle{
+				Timestamp: google.NewTimestamp(tc.Now()),
+				Project:   "proj",
+				Prefix:    "testing",
+				Secret:    secret,
+				Entries:   entries,
+			}
+
+			var buf bytes.Buffer
+			w := butlerproto.Writer{Compress: true}
+			if err := w.Write(&buf, &bundle); err != nil {
+				return err
+			}
+			_, err := coord.Publish(c, &bundles.PublishRequest{Data: buf.Bytes()})
+			return err
+		}
+
+		// publish writes a bundle containing a text stream for each name in the
+		// registered "testing/+" prefix, with the specified log entries.
+		publish := func(secret []byte, terminal bool, names []string, lines ...string) error {
+			var entries []*logpb.ButlerLogBundle_Entry
+			for _, name := range names {
+				be := logpb.ButlerLogBundle_Entry{
+					Desc: &logpb.LogStreamDescriptor{
+						Prefix:      "testing",
+						Name:        name,
+						ContentType: "text/plain",
+						StreamType:  logpb.StreamType_TEXT,
+						Timestamp:   google.NewTimestamp(tc.Now()),
+						Tags:        map[string]string{"name": name},
+					},
+				}
+				for i, line := range lines {
+					be.Logs = append(be.Logs, &logpb.LogEntry{
+						StreamIndex: uint64(i),
+						Sequence:    uint64(i),
+						Content: &logpb.LogEntry_Text{Text: &logpb.Text{
+							Lines: []*logpb.Text_Line{{Value: line, Delimiter: "\n"}},
+						}},
+					})
+				}
+				if terminal && len(lines) > 0 {
+					be.Terminal = true
+					be.TerminalIndex = uint64(len(lines) - 1)
+				}
+				entries = append(entries, &be)
+			}
+			return send(secret, entries...)
+		}
+
+		Convey(`Will reject invalid prefix registrations.`, func() {
+			_, err := coord.RegisterPrefix(c, &registration.RegisterPrefixRequest{Project: "proj", Prefix: "!!!"})
+			So(err, ShouldBeRPCInvalidArgument, "invalid prefix")
+		})
+
+		Convey(`Will register a prefix only once.`, func() {
+			req := registration.RegisterPrefixRequest{Project: "proj", Prefix: "testing"}
+			resp, err := coord.RegisterPrefix(c, &req)
+			So(err, ShouldBeRPCOK)
+			So(resp.Secret, ShouldHaveLength, types.PrefixSecretLength)
+			So(resp.LogBundleTopic, ShouldEqual, "")
+
+			_, err = coord.RegisterPrefix(c, &req)
+			So(err, ShouldBeRPCAlreadyExists)
+		})
+
+		Convey(`Will not ingest streams for an unregistered prefix.`, func() {
+			So(publish(wrongSecret, true, []string{"foo"}, "a"), ShouldBeRPCInvalidArgument, "prefix is not registered")
+
+			_, err := coord.Get(c, &logs.GetRequest{Project: "proj", Path: "testing/+/foo"})
+			So(err, ShouldBeRPCNotFound)
+		})
+
+		Convey(`With a registered prefix`, func() {
+			resp, err := coord.RegisterPrefix(c, &registration.RegisterPrefixRequest{
+				Project:    "proj",
+				Prefix:     "testing",
+				Expiration: google.NewDuration(time.Hour),
+			})
+			So(err, ShouldBeRPCOK)
+			secret := resp.Secret
+
+			Convey(`Will not ingest streams with the wrong secret.`, func() {
+				So(publish(wrongSecret, true, []string{"foo"}, "a"), ShouldBeRPCInvalidArgument, "invalid secret")
+
+				_, err := coord.Get(c, &logs.GetRequest{Project: "proj", Path: "testing/+/foo"})
+				So(err, ShouldBeRPCNotFound)
+			})
+
+			Convey(`Will not register new streams after the prefix expires.`, func() {
+				tc.Add(2 * time.Hour)
+				So(publish(secret, true, []string{"foo"}, "a"), ShouldBeRPCInvalidArgument, "prefix has expired")
+
+				_, err := coord.Get(c, &logs.GetRequest{Project: "proj", Path: "testing/+/foo"})
+				So(err, ShouldBeRPCNotFound)
+			})
+
+			Convey(`Can ingest and serve log streams.`, func() {
+				So(publish(secret, true, []string{"foo", "bar/baz"}, "a", "b", "c"), ShouldBeNil)
+				tc.Add(time.Second)
+				So(publish(secret, false, []string{"bar/qux"}, "d"), ShouldBeNil)
+
+				Convey(`Can Get a log stream.`, func() {
+					resp, err := coord.Get(c, &logs.GetRequest{Project: "proj", Path: "testing/+/foo", State: true})
+					So(err, ShouldBeRPCOK)
+					So(resp.State.TerminalIndex, ShouldEqual, 2)
+					So(resp.Desc.ContentType, ShouldEqual, "text/plain")
+					So(resp.Logs, ShouldHaveLength, 3)
+					So(resp.Logs[2].GetText().Lines[0].Value, ShouldEqual, "c")
+				})
+
+				Convey(`Can Get a log stream from an index, with a count.`, func() {
+					resp, err := coord.Get(c, &logs.GetRequest{Project: "proj", Path: "testing/+/foo", Index: 1, LogCount: 1})
+					So(err, ShouldBeRPCOK)
+					So(resp.State, ShouldBeNil)
+					So(resp.Logs, ShouldHaveLength, 1)
+					So(resp.Logs[0].StreamIndex, ShouldEqual, 1)
+				})
+
+				Convey(`Can Tail a log stream.`, func() {
+					resp, err := coord.Tail(c, &logs.TailRequest{Project: "proj", Path: "testing/+/bar/baz"})
+					So(err, ShouldBeRPCOK)
+					So(resp.Logs, ShouldHaveLength, 1)
+					So(resp.Logs[0].StreamIndex, ShouldEqual, 2)
+				})
+
+				Convey(`Reports an unterminated stream's state.`, func() {
+					resp, err := coord.Get(c, &logs.GetRequest{Project: "proj", Path: "testing/+/bar/qux", State: true})
+					So(err, ShouldBeRPCOK)
+					So(resp.State.TerminalIndex, ShouldEqual, -1)
+				})
+
+				Convey(`Can Query log streams.`, func() {
+					query := func(req *logs.QueryRequest) []string {
+						req.Project = "proj"
+						resp, err := coord.Query(c, req)
+						So(err, ShouldBeRPCOK)
+
+						paths := make([]string, len(resp.Streams))
+						for i, s := range resp.Streams {
+							paths[i] = s.Path
+						}
+						return paths
+					}
+
+					So(query(&logs.QueryRequest{}), ShouldResemble,
+						[]string{"testing/+/bar/qux", "testing/+/bar/baz", "testing/+/foo"})
+					So(query(&logs.QueryRequest{Path: "testing/+/bar/*"}), ShouldResemble,
+						[]string{"testing/+/bar/qux", "testing/+/bar/baz"})
+					So(query(&logs.QueryRequest{Path: "**/+/foo"}), ShouldResemble, []string{"testing/+/foo"})
+					So(query(&logs.QueryRequest{Tags: map[string]string{"name": "bar/baz"}}), ShouldResemble,
+						[]string{"testing/+/bar/baz"})
+					So(query(&logs.QueryRequest{Older: google.NewTimestamp(tc.Now())}), ShouldResemble,
+						[]string{"testing/+/bar/baz", "testing/+/foo"})
+					So(query(&logs.QueryRequest{Purged: logs.QueryRequest_YES}), ShouldHaveLength, 0)
+				})
+
+				Convey(`Can paginate Query results.`, func() {
+					resp, err := coord.Query(c, &logs.QueryRequest{Project: "proj", MaxResults: 2})
+					So(err, ShouldBeRPCOK)
+					So(resp.Streams, ShouldHaveLength, 2)
+					So(resp.Next, ShouldNotEqual, "")
+
+					resp, err = coord.Query(c, &logs.QueryRequest{Project: "proj", MaxResults: 2, Next: resp.Next})
+					So(err, ShouldBeRPCOK)
+					So(resp.Streams, ShouldHaveLength, 1)
+					So(resp.Streams[0].Path, ShouldEqual, "testing/+/foo")
+					So(resp.Next, ShouldEqual, "")
+				})
+
+				Convey(`Can Search log streams.`, func() {
+					resp, err := coord.Search(c, &logs.SearchRequest{Project: "proj", Text: "B", ContextLines: 1})
+					So(err, ShouldBeRPCOK)
+					So(resp.Streams, ShouldHaveLength, 2)
+					So(resp.Streams[0].Path, ShouldEqual, "testing/+/bar/baz")
+					So(resp.Streams[1].Path, ShouldEqual, "testing/+/foo")
+					So(resp.Streams[1].Matches, ShouldResemble, []*logs.SearchResponse_Match{
+						{StreamIndex: 1, Line: "b", Before: []string{"a"}, After: []string{"c"}},
+					})
+
+					resp, err = coord.Search(c, &logs.SearchRequest{Project: "proj", Path: "testing/+/bar/*", Text: "d"})
+					So(err, ShouldBeRPCOK)
+					So(resp.Streams, ShouldHaveLength, 1)
+					So(resp.Streams[0].Path, ShouldEqual, "testing/+/bar/qux")
+
+					_, err = coord.Search(c, &logs.SearchRequest{Project: "proj", Text: "..."})
+					So(err, ShouldBeRPCInvalidArgument, "must contain a letter or digit")
+				})
+
+				Convey(`Can List projects and path components.`, func() {
+					list := func(req *logs.ListRequest) []string {
+						resp, err := coord.List(c, req)
+						So(err, ShouldBeRPCOK)
+
+						comps := make([]string, len(resp.Components))
+						for i, comp := range resp.Components {
+							comps[i] = fmt.Sprintf("%s:%s", comp.Type, comp.Name)
+						}
+						return comps
+					}
+
+					So(list(&logs.ListRequest{}), ShouldResemble, []string{"PROJECT:proj"})
+					So(list(&logs.ListRequest{Project: "proj"}), ShouldResemble, []string{"PATH:testing"})
+					So(list(&logs.ListRequest{Project: "proj", PathBase: "testing/+"}), ShouldResemble,
+						[]string{"PATH:bar", "STREAM:foo"})
+					So(list(&logs.ListRequest{Project: "proj", PathBase: "testing/+/bar", MaxResults: 1}), ShouldResemble,
+						[]string{"STREAM:baz"})
+					So(list(&logs.ListRequest{Project: "proj", PathBase: "testing/+", StreamOnly: true}), ShouldResemble,
+						[]string{"STREAM:foo"})
+				})
+			})
+
+			Convey(`Can index and Query a STRUCTURED stream's fields.`, func() {
+				be := logpb.ButlerLogBundle_Entry{
+					Desc: &logpb.LogStreamDescriptor{
+						Prefix:        "testing",
+						Name:          "structured",
+						ContentType:   string(types.ContentTypeJSONLines),
+						StreamType:    logpb.StreamType_STRUCTURED,
+						Timestamp:     google.NewTimestamp(tc.Now()),
+						IndexedFields: []string{"status"},
+					},
+				}
+				for i, line := range []string{`{"status": 200}`, `{"status": 404, "user": "foo"}`} {
+					rec, err := structured.Parse([]byte(line))
+					So(err, ShouldBeNil)
+					be.Logs = append(be.Logs, &logpb.LogEntry{
+						StreamIndex: uint64(i),
+						Sequence:    uint64(i),
+						Content: &logpb.LogEntry_Structured{Structured: &logpb.Structured{
+							Records: []*logpb.Structured_Record{rec},
+						}},
+					})
+				}
+				So(send(secret, &be), ShouldBeNil)
+				So(publish(secret, true, []string{"foo"}, "a"), ShouldBeNil)
+
+				query := func(fields map[string]string) []string {
+					resp, err := coord.Query(c, &logs.QueryRequest{Project: "proj", Fields: fields})
+					So(err, ShouldBeRPCOK)
+
+					paths := make([]string, len(resp.Streams))
+					for i, s := range resp.Streams {
+						paths[i] = s.Path
+					}
+					return paths
+				}
+
+				So(query(map[string]string{"status": ""}), ShouldResemble, []string{"testing/+/structured"})
+				So(query(map[string]string{"status": "404"}), ShouldResemble, []string{"testing/+/structured"})
+				So(query(map[string]string{"status": "500"}), ShouldHaveLength, 0)
+				So(query(map[string]string{"user": ""}), ShouldHaveLength, 0)
+
+				_, err := coord.Query(c, &logs.QueryRequest{Project: "proj", Fields: map[string]string{"!!!": ""}})
+				So(err, ShouldBeRPCInvalidArgument, "invalid field constraint")
+			})
+		})
+	})
+}
+
+func TestPathQuery(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Testing path queries`, t, func() {
+		for _, tc := range []struct {
+			query string
+			path  string
+			match bool
+		}{
+			{"", "foo/+/bar", true},
+			{"foo/+/bar", "foo/+/bar", true},
+			{"foo/+/bar", "foo/+/bar/baz", false},
+			{"foo", "foo/+/bar", true},
+			{"*/+/bar", "foo/+/bar", true},
+			{"*/+/bar", "foo/bar/+/bar", false},
+			{"foo/+/**", "foo/+/bar/baz", true},
+			{"foo/+/**/baz", "foo/+/baz", true},
+			{"foo/+/**/baz", "foo/+/bar/qux/baz", true},
+			{"foo/+/**/baz", "foo/+/bar/baz/qux", false},
+			{"foo/+/bar/**/*", "foo/+/bar", false},
+			{"foo/+/bar/**/*", "foo/+/bar/baz", true},
+		} {
+			Convey(fmt.Sprintf(`Query %q matching %q is %v.`, tc.query, tc.path, tc.match), func() {
+				pq, err := parsePathQuery(tc.query)
+				So(err, ShouldBeNil)
+				So(pq.match(types.StreamPath(tc.path)), ShouldEqual, tc.match)
+			})
+		}
+
+		Convey(`Will reject multiple greedy globs.`, func() {
+			_, err := parsePathQuery("foo/+/**/bar/**")
+			So(err, ShouldErrLike, "more than one greedy glob")
+		})
+
+		Convey(`Will reject invalid segments.`, func() {
+			_, err := parsePathQuery("foo/+/!!!")
+			So(err, ShouldErrLike, "invalid name component")
+		})
+	})
+}
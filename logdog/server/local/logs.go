@@ -0,0 +1,583 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/grpc/grpcutil"
+	logs "github.com/luci/luci-go/logdog/api/endpoints/coordinator/logs/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/search"
+	"github.com/luci/luci-go/logdog/common/storage"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// getBytesLimit is the maximum amount of log data that a Get request will
+	// return.
+	getBytesLimit = 16 * 1024 * 1024
+
+	// queryResultLimit is the maximum number of log streams that a Query
+	// request will return.
+	queryResultLimit = 500
+
+	// listResultLimit is the maximum number of components that a List request
+	// will return.
+	listResultLimit = 500
+
+	// searchStreamLimit is the maximum number of log streams that a Search
+	// request will search.
+	searchStreamLimit = 50
+
+	// searchMatchLimit is the maximum number of matching lines that a Search
+	// request will return for a single log stream.
+	searchMatchLimit = 100
+
+	// searchMaxContextLines is the maximum number of context lines that a
+	// Search request may request before and after each matching line.
+	searchMaxContextLines = 10
+)
+
+// Get implements logs.LogsServer.
+func (c *Coordinator) Get(ctx context.Context, req *logs.GetRequest) (*logs.GetResponse, error) {
+	return c.getImpl(ctx, req, false)
+}
+
+// Tail implements logs.LogsServer.
+func (c *Coordinator) Tail(ctx context.Context, req *logs.TailRequest) (*logs.GetResponse, error) {
+	r := logs.GetRequest{
+		Project: req.Project,
+		Path:    req.Path,
+		State:   req.State,
+	}
+	return c.getImpl(ctx, &r, true)
+}
+
+func (c *Coordinator) getImpl(ctx context.Context, req *logs.GetRequest, tail bool) (*logs.GetResponse, error) {
+	project := cfgtypes.ProjectName(req.Project)
+	if err := project.Validate(); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid project %q: %s", req.Project, err)
+	}
+	path := types.StreamPath(req.Path)
+	if err := path.Validate(); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid path value")
+	}
+
+	c.mu.Lock()
+	ls := c.getStreamLocked(project, path)
+	var state *logs.LogStreamState
+	if ls != nil {
+		state = ls.logsState()
+	}
+	c.mu.Unlock()
+
+	if ls == nil {
+		return nil, grpcutil.Errf(codes.NotFound, "path not found")
+	}
+
+	resp := logs.GetResponse{
+		Project: string(project),
+	}
+	if req.State {
+		resp.State = state
+		resp.Desc = ls.desc
+	}
+
+	var err error
+	switch {
+	case tail:
+		resp.Logs, err = c.getTail(project, path)
+	case req.LogCount >= 0:
+		resp.Logs, err = c.getHead(req, project, path)
+	}
+	if err != nil {
+		log.WithError(err).Errorf(ctx, "Failed to get logs.")
+		return nil, grpcutil.Internal
+	}
+	return &resp, nil
+}
+
+func (c *Coordinator) getHead(req *logs.GetRequest, project cfgtypes.ProjectName, path types.StreamPath) (
+	[]*logpb.LogEntry, error) {
+
+	logCount := int(req.LogCount)
+	byteLimit := int(req.ByteCount)
+	if byteLimit <= 0 || byteLimit > getBytesLimit {
+		byteLimit = getBytesLimit
+	}
+
+	sreq := storage.GetRequest{
+		Project: project,
+		Path:    path,
+		Index:   types.MessageIndex(req.Index),
+		Limit:   logCount,
+	}
+
+	var entries []*logpb.LogEntry
+	var ierr error
+	err := c.st.Get(sreq, func(e *storage.Entry) bool {
+		var le *logpb.LogEntry
+		if le, ierr = e.GetLogEntry(); ierr != nil {
+			return false
+		}
+
+		if len(entries) > 0 && byteLimit-len(e.D) < 0 {
+			// Not the first log, and we've exceeded our byte limit.
+			return false
+		}
+
+		sidx, _ := e.GetStreamIndex() // GetLogEntry succeeded, so this must.
+		if !(req.NonContiguous || sidx == sreq.Index) {
+			return false
+		}
+
+		entries = append(entries, le)
+		sreq.Index = sidx + 1
+		byteLimit -= len(e.D)
+		return !(logCount > 0 && len(entries) >= logCount)
+	})
+	switch {
+	case err == storage.ErrDoesNotExist:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case ierr != nil:
+		return nil, ierr
+	default:
+		return entries, nil
+	}
+}
+
+func (c *Coordinator) getTail(project cfgtypes.ProjectName, path types.StreamPath) ([]*logpb.LogEntry, error) {
+	e, err := c.st.Tail(project, path)
+	switch err {
+	case nil:
+		le, err := e.GetLogEntry()
+		if err != nil {
+			return nil, err
+		}
+		return []*logpb.LogEntry{le}, nil
+
+	case storage.ErrDoesNotExist:
+		return nil, nil
+
+	default:
+		return nil, err
+	}
+}
+
+// Query implements logs.LogsServer.
+//
+// Results are returned newest first. Since log streams are never purged, a
+// query for purged log streams returns no results.
+func (c *Coordinator) Query(ctx context.Context, req *logs.QueryRequest) (*logs.QueryResponse, error) {
+	project := cfgtypes.ProjectName(req.Project)
+	if err := project.Validate(); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid project %q: %s", req.Project, err)
+	}
+
+	pq, err := parsePathQuery(req.Path)
+	if err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid query `path`: %s", err)
+	}
+	if st := req.StreamType; st != nil {
+		switch v := st.Value; v {
+		case logpb.StreamType_TEXT, logpb.StreamType_BINARY, logpb.StreamType_DATAGRAM, logpb.StreamType_STRUCTURED:
+		default:
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid query `streamType`: %s", v.String())
+		}
+	}
+	for k, v := range req.Tags {
+		if err := types.ValidateTag(k, v); err != nil {
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid tag constraint: %q", k)
+		}
+	}
+	for k := range req.Fields {
+		if err := types.ValidateFieldKey(k); err != nil {
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid field constraint: %q", k)
+		}
+	}
+	offset, err := parseNext(req.Next)
+	if err != nil {
+		return nil, err
+	}
+	limit := resultLimit(int(req.MaxResults), queryResultLimit)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var matches []*logStream
+	if req.Purged != logs.QueryRequest_YES {
+		for _, ls := range c.streams {
+			if ls.project == project && ls.matchesQuery(req, pq) {
+				matches = append(matches, ls)
+			}
+		}
+	}
+	sort.Sort(newestFirst(matches))
+
+	resp := logs.QueryResponse{
+		Project: string(project),
+	}
+	if offset < len(matches) {
+		matches = matches[offset:]
+	} else {
+		matches = nil
+	}
+	if len(matches) > limit {
+		matches = matches[:limit]
+		resp.Next = strconv.Itoa(offset + limit)
+	}
+
+	for _, ls := range matches {
+		stream := logs.QueryResponse_Stream{
+			Path: string(ls.path),
+		}
+		if req.State {
+			stream.State = ls.logsState()
+			if req.Proto {
+				stream.DescProto = ls.descBytes
+			} else {
+				stream.Desc = ls.desc
+			}
+		}
+		resp.Streams = append(resp.Streams, &stream)
+	}
+	return &resp, nil
+}
+
+func (ls *logStream) matchesQuery(req *logs.QueryRequest, pq *pathQuery) bool {
+	switch {
+	case !pq.match(ls.path):
+		return false
+	case req.ContentType != "" && ls.desc.ContentType != req.ContentType:
+		return false
+	case req.StreamType != nil && ls.desc.StreamType != req.StreamType.Value:
+		return false
+	case req.Newer != nil && !ls.created.After(google.TimeFromProto(req.Newer)):
+		return false
+	case req.Older != nil && !ls.created.Before(google.TimeFromProto(req.Older)):
+		return false
+	case req.ProtoVersion != "" && ls.protoVersion != req.ProtoVersion:
+		return false
+	}
+
+	for k, v := range req.Tags {
+		tv, ok := ls.desc.Tags[k]
+		if !ok || (v != "" && tv != v) {
+			return false
+		}
+	}
+	for k, v := range req.Fields {
+		values, ok := ls.fields[k]
+		if !ok || (v != "" && !hasValue(values, v)) {
+			return false
+		}
+	}
+	return true
+}
+
+// List implements logs.LogsServer.
+func (c *Coordinator) List(ctx context.Context, req *logs.ListRequest) (*logs.ListResponse, error) {
+	offset, err := parseNext(req.Next)
+	if err != nil {
+		return nil, err
+	}
+	offset += int(req.Offset)
+	limit := resultLimit(int(req.MaxResults), listResultLimit)
+
+	resp := logs.ListResponse{
+		Project:  req.Project,
+		PathBase: req.PathBase,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var comps []*logs.ListResponse_Component
+	if req.Project == "" {
+		// List the projects.
+		seen := make(map[cfgtypes.ProjectName]struct{})
+		for _, ls := range c.streams {
+			if _, ok := seen[ls.project]; !ok {
+				seen[ls.project] = struct{}{}
+				comps = append(comps, &logs.ListResponse_Component{
+					Name: string(ls.project),
+					Type: logs.ListResponse_Component_PROJECT,
+				})
+			}
+		}
+	} else {
+		project := cfgtypes.ProjectName(req.Project)
+		if err := project.Validate(); err != nil {
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid project %q: %s", req.Project, err)
+		}
+
+		var base []string
+		if req.PathBase != "" {
+			base = strings.Split(strings.Trim(req.PathBase, string(types.StreamNameSep)), string(types.StreamNameSep))
+		}
+
+		// A name may be both a stream and an intermediate path component.
+		seen := make(map[string]struct{})
+		for _, ls := range c.streams {
+			if ls.project != project {
+				continue
+			}
+
+			segs := strings.Split(string(ls.path), string(types.StreamNameSep))
+			if len(segs) <= len(base) || !hasSegmentPrefix(segs, base) {
+				continue
+			}
+
+			comp := logs.ListResponse_Component{
+				Name: segs[len(base)],
+				Type: logs.ListResponse_Component_PATH,
+			}
+			if len(segs) == len(base)+1 {
+				comp.Type = logs.ListResponse_Component_STREAM
+				if req.State {
+					comp.State = ls.logsState()
+					comp.Desc = ls.desc
+				}
+			} else if req.StreamOnly {
+				continue
+			}
+
+			key := comp.Type.String() + ":" + comp.Name
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				comps = append(comps, &comp)
+			}
+		}
+	}
+	sort.Sort(componentsByName(comps))
+
+	if offset < len(comps) {
+		comps = comps[offset:]
+	} else {
+		comps = nil
+	}
+	if len(comps) > limit {
+		comps = comps[:limit]
+		resp.Next = strconv.Itoa(offset + limit)
+	}
+	resp.Components = comps
+	return &resp, nil
+}
+
+// Search implements logs.LogsServer.
+//
+// The local Coordinator never archives its log streams, so rather than using a
+// search index, it scans every matching TEXT log stream's entries.
+func (c *Coordinator) Search(ctx context.Context, req *logs.SearchRequest) (*logs.SearchResponse, error) {
+	project := cfgtypes.ProjectName(req.Project)
+	if err := project.Validate(); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid project %q: %s", req.Project, err)
+	}
+	if len(search.Tokenize(req.Text)) == 0 {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "search `text` must contain a letter or digit")
+	}
+	if req.ContextLines < 0 || req.ContextLines > searchMaxContextLines {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "`context_lines` must be between 0 and %d",
+			searchMaxContextLines)
+	}
+
+	pq, err := parsePathQuery(req.Path)
+	if err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid query `path`: %s", err)
+	}
+	offset, err := parseNext(req.Next)
+	if err != nil {
+		return nil, err
+	}
+	limit := resultLimit(int(req.MaxResults), searchStreamLimit)
+
+	c.mu.Lock()
+	var streams []*logStream
+	for _, ls := range c.streams {
+		if ls.project == project && ls.desc.StreamType == logpb.StreamType_TEXT && pq.match(ls.path) {
+			streams = append(streams, ls)
+		}
+	}
+	c.mu.Unlock()
+	sort.Sort(newestFirst(streams))
+
+	resp := logs.SearchResponse{
+		Project: string(project),
+	}
+	if offset < len(streams) {
+		streams = streams[offset:]
+	} else {
+		streams = nil
+	}
+	if len(streams) > limit {
+		streams = streams[:limit]
+		resp.Next = strconv.Itoa(offset + limit)
+	}
+
+	for _, ls := range streams {
+		stream, err := c.searchStream(req, project, ls.path)
+		if err != nil {
+			log.Fields{
+				log.ErrorKey: err,
+				"path":       ls.path,
+			}.Errorf(ctx, "Failed to search log stream.")
+			return nil, grpcutil.Internal
+		}
+		if stream != nil {
+			resp.Streams = append(resp.Streams, stream)
+		}
+	}
+	return &resp, nil
+}
+
+// searchStream scans a single log stream for lines matching the request.
+//
+// If the log stream has no matching lines, nil will be returned.
+func (c *Coordinator) searchStream(req *logs.SearchRequest, project cfgtypes.ProjectName, path types.StreamPath) (
+	*logs.SearchResponse_Stream, error) {
+
+	type line struct {
+		streamIndex uint64
+		index       int
+		value       string
+	}
+	var lines []line
+
+	var ierr error
+	err := c.st.Get(storage.GetRequest{Project: project, Path: path}, func(e *storage.Entry) bool {
+		var le *logpb.LogEntry
+		if le, ierr = e.GetLogEntry(); ierr != nil {
+			return false
+		}
+		for i, l := range le.GetText().GetLines() {
+			lines = append(lines, line{le.StreamIndex, i, l.Value})
+		}
+		return true
+	})
+	switch {
+	case err == storage.ErrDoesNotExist:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	case ierr != nil:
+		return nil, ierr
+	}
+
+	values := func(lines []line) []string {
+		var v []string
+		for _, l := range lines {
+			v = append(v, l.value)
+		}
+		return v
+	}
+
+	stream := logs.SearchResponse_Stream{
+		Path: string(path),
+	}
+	ctxLines := int(req.ContextLines)
+	for i, l := range lines {
+		if !search.Match(l.value, req.Text) {
+			continue
+		}
+		if len(stream.Matches) >= searchMatchLimit {
+			stream.Truncated = true
+			break
+		}
+
+		start, end := i-ctxLines, i+1+ctxLines
+		if start < 0 {
+			start = 0
+		}
+		if end > len(lines) {
+			end = len(lines)
+		}
+		stream.Matches = append(stream.Matches, &logs.SearchResponse_Match{
+			StreamIndex: int64(l.streamIndex),
+			LineIndex:   int32(l.index),
+			Line:        l.value,
+			Before:      values(lines[start:i]),
+			After:       values(lines[i+1 : end]),
+		})
+	}
+
+	if len(stream.Matches) == 0 {
+		return nil, nil
+	}
+	return &stream, nil
+}
+
+func (ls *logStream) logsState() *logs.LogStreamState {
+	return &logs.LogStreamState{
+		ProtoVersion:  ls.protoVersion,
+		Created:       google.NewTimestamp(ls.created),
+		TerminalIndex: ls.terminalIndex,
+	}
+}
+
+// parseNext parses a Query, List, or Search request's "next" cursor, which is
+// the offset of the next result.
+func parseNext(next string) (int, error) {
+	if next == "" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(next)
+	if err != nil || v < 0 {
+		return 0, grpcutil.Errf(codes.InvalidArgument, "invalid `next` value")
+	}
+	return v, nil
+}
+
+// resultLimit returns the requested result count, v, constrained to a maximum
+// of max.
+func resultLimit(v, max int) int {
+	if v <= 0 || v > max {
+		return max
+	}
+	return v
+}
+
+func hasSegmentPrefix(segs, prefix []string) bool {
+	for i, p := range prefix {
+		if segs[i] != p {
+			return false
+		}
+	}
+	return true
+}
+
+// newestFirst sorts log streams by descending creation time, then by path.
+type newestFirst []*logStream
+
+func (s newestFirst) Len() int      { return len(s) }
+func (s newestFirst) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s newestFirst) Less(i, j int) bool {
+	if !s[i].created.Equal(s[j].created) {
+		return s[i].created.After(s[j].created)
+	}
+	return s[i].path < s[j].path
+}
+
+// componentsByName sorts list components by name, then by type.
+type componentsByName []*logs.ListResponse_Component
+
+func (s componentsByName) Len() int      { return len(s) }
+func (s componentsByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s componentsByName) Less(i, j int) bool {
+	if s[i].Name != s[j].Name {
+		return s[i].Name < s[j].Name
+	}
+	return s[i].Type < s[j].Type
+}
@@ -0,0 +1,102 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"fmt"
+
+	"github.com/luci/luci-go/logdog/common/types"
+)
+
+// pathQuery is a parsed log stream path query.
+//
+// Each of the path's prefix and name components may be empty, matching any
+// value, a literal stream name, or a glob. A glob segment of "*" matches
+// exactly one segment, and a glob segment of "**" matches zero or more
+// segments. This mirrors the query semantics of the production Coordinator.
+type pathQuery struct {
+	prefix []string
+	name   []string
+}
+
+func parsePathQuery(path string) (*pathQuery, error) {
+	prefix, name := types.StreamPath(path).Split()
+
+	var pq pathQuery
+	var err error
+	if pq.prefix, err = parseComponentQuery("prefix", prefix); err != nil {
+		return nil, err
+	}
+	if pq.name, err = parseComponentQuery("name", name); err != nil {
+		return nil, err
+	}
+	return &pq, nil
+}
+
+func parseComponentQuery(full string, value types.StreamName) ([]string, error) {
+	segments := value.Segments()
+
+	greedy := false
+	for i, seg := range segments {
+		switch seg {
+		case "*":
+
+		case "**":
+			if greedy {
+				return nil, fmt.Errorf("cannot have more than one greedy glob")
+			}
+			greedy = true
+
+		default:
+			if err := types.StreamName(seg).Validate(); err != nil {
+				return nil, fmt.Errorf("invalid %s component at index %d (%s): %s", full, i, seg, err)
+			}
+		}
+	}
+	return segments, nil
+}
+
+// match returns true if path matches the query.
+func (pq *pathQuery) match(path types.StreamPath) bool {
+	prefix, name := path.Split()
+	return matchSegments(pq.prefix, prefix.Segments()) && matchSegments(pq.name, name.Segments())
+}
+
+// matchSegments returns true if the segments match the glob segments in q. An
+// empty q matches everything.
+func matchSegments(q, segs []string) bool {
+	if len(q) == 0 {
+		return true
+	}
+	return globSegments(q, segs)
+}
+
+func globSegments(q, segs []string) bool {
+	for i, g := range q {
+		switch g {
+		case "**":
+			// Try consuming each possible number of segments. There is at most one
+			// greedy glob, so the remainder of q is matched non-greedily.
+			rest := q[i+1:]
+			for j := i; j <= len(segs); j++ {
+				if globSegments(rest, segs[j:]) {
+					return true
+				}
+			}
+			return false
+
+		case "*":
+			if i >= len(segs) {
+				return false
+			}
+
+		default:
+			if i >= len(segs) || segs[i] != g {
+				return false
+			}
+		}
+	}
+	return len(q) == len(segs)
+}
@@ -0,0 +1,72 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"github.com/luci/luci-go/common/clock"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/grpc/grpcutil"
+	registration "github.com/luci/luci-go/logdog/api/endpoints/coordinator/registration/v1"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+)
+
+// RegisterPrefix implements registration.RegistrationServer.
+//
+// The returned log bundle topic is always empty: bundles must be published
+// through the Coordinator's Bundles service.
+func (c *Coordinator) RegisterPrefix(ctx context.Context, req *registration.RegisterPrefixRequest) (
+	*registration.RegisterPrefixResponse, error) {
+
+	project := cfgtypes.ProjectName(req.Project)
+	if err := project.Validate(); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid project %q: %s", req.Project, err)
+	}
+	prefix := types.StreamName(req.Prefix)
+	if err := prefix.Validate(); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid prefix %q: %s", req.Prefix, err)
+	}
+
+	// Use the requested expiration, if it is shorter than our own.
+	expiration := c.prefixExpiration()
+	if d := google.DurationFromProto(req.Expiration); d > 0 && d < expiration {
+		expiration = d
+	}
+
+	secret, err := types.NewPrefixSecret()
+	if err != nil {
+		log.WithError(err).Errorf(ctx, "Failed to generate prefix secret.")
+		return nil, grpcutil.Internal
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := prefixKey{project, prefix}
+	if _, ok := c.prefixes[key]; ok {
+		return nil, grpcutil.AlreadyExists
+	}
+
+	now := clock.Now(ctx).UTC()
+	c.prefixes[key] = &logPrefix{
+		secret:     secret,
+		source:     req.SourceInfo,
+		created:    now,
+		expiration: now.Add(expiration),
+	}
+
+	log.Fields{
+		"project":    project,
+		"prefix":     prefix,
+		"expiration": expiration,
+	}.Infof(ctx, "Registered log stream prefix.")
+	return &registration.RegisterPrefixResponse{
+		Secret: []byte(secret),
+	}, nil
+}
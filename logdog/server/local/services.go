@@ -0,0 +1,276 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package local
+
+import (
+	"crypto/subtle"
+
+	"github.com/luci/luci-go/common/clock"
+	"github.com/luci/luci-go/common/errors"
+	log "github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/proto/google"
+	"github.com/luci/luci-go/grpc/grpcutil"
+	bundles "github.com/luci/luci-go/logdog/api/endpoints/coordinator/bundles/v1"
+	services "github.com/luci/luci-go/logdog/api/endpoints/coordinator/services/v1"
+	"github.com/luci/luci-go/logdog/api/logpb"
+	"github.com/luci/luci-go/logdog/common/types"
+	"github.com/luci/luci-go/luci_config/common/cfgtypes"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// GetConfig implements services.ServicesServer.
+//
+// The local Coordinator doesn't use a configuration service, so the response
+// is empty.
+func (c *Coordinator) GetConfig(ctx context.Context, req *empty.Empty) (*services.GetConfigResponse, error) {
+	return &services.GetConfigResponse{}, nil
+}
+
+// RegisterStream implements services.ServicesServer.
+func (c *Coordinator) RegisterStream(ctx context.Context, req *services.RegisterStreamRequest) (
+	*services.RegisterStreamResponse, error) {
+
+	project := cfgtypes.ProjectName(req.Project)
+	if err := project.Validate(); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid project %q: %s", req.Project, err)
+	}
+
+	if req.ProtoVersion != logpb.Version {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "unrecognized protobuf version: %q", req.ProtoVersion)
+	}
+	var desc logpb.LogStreamDescriptor
+	if err := proto.Unmarshal(req.Desc, &desc); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "failed to unmarshal descriptor: %s", err)
+	}
+	if err := desc.Validate(true); err != nil {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid log stream descriptor: %s", err)
+	}
+	path := desc.Path()
+	prefix, _ := path.Split()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Load our prefix. It must be registered, and not expired.
+	pfx := c.prefixes[prefixKey{project, prefix}]
+	if pfx == nil {
+		return nil, grpcutil.Errf(codes.FailedPrecondition, "prefix is not registered")
+	}
+	now := clock.Now(ctx).UTC()
+
+	ls := c.getStreamLocked(project, path)
+	if ls == nil {
+		if !now.Before(pfx.expiration) {
+			return nil, grpcutil.Errf(codes.FailedPrecondition, "prefix has expired")
+		}
+		if subtle.ConstantTimeCompare(pfx.secret, req.Secret) != 1 {
+			return nil, grpcutil.Errf(codes.InvalidArgument, "invalid secret")
+		}
+
+		ls = &logStream{
+			project:       project,
+			path:          path,
+			id:            streamID(path),
+			protoVersion:  req.ProtoVersion,
+			secret:        pfx.secret,
+			desc:          &desc,
+			descBytes:     req.Desc,
+			created:       now,
+			updated:       now,
+			terminalIndex: -1,
+		}
+		if len(desc.IndexedFields) > 0 {
+			ls.fields = make(map[string][]string, len(desc.IndexedFields))
+			for _, k := range desc.IndexedFields {
+				ls.fields[k] = nil
+			}
+		}
+		if req.TerminalIndex >= 0 {
+			ls.terminalIndex = req.TerminalIndex
+		}
+		c.streams[streamKey{project, ls.id}] = ls
+
+		log.Fields{
+			"project":       project,
+			"path":          path,
+			"id":            ls.id,
+			"terminalIndex": ls.terminalIndex,
+		}.Infof(ctx, "Registered log stream.")
+	} else if subtle.ConstantTimeCompare(ls.secret, req.Secret) != 1 {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "invalid secret")
+	}
+
+	return &services.RegisterStreamResponse{
+		Id:    ls.id,
+		State: ls.servicesState(),
+	}, nil
+}
+
+// LoadStream implements services.ServicesServer.
+func (c *Coordinator) LoadStream(ctx context.Context, req *services.LoadStreamRequest) (
+	*services.LoadStreamResponse, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ls := c.streams[streamKey{cfgtypes.ProjectName(req.Project), req.Id}]
+	if ls == nil {
+		return nil, grpcutil.Errf(codes.NotFound, "log stream was not found")
+	}
+
+	resp := services.LoadStreamResponse{
+		State: ls.servicesState(),
+		Age:   google.NewDuration(clock.Now(ctx).Sub(ls.updated)),
+	}
+	if req.Desc {
+		resp.Desc = ls.descBytes
+	}
+	return &resp, nil
+}
+
+// TerminateStream implements services.ServicesServer.
+func (c *Coordinator) TerminateStream(ctx context.Context, req *services.TerminateStreamRequest) (*empty.Empty, error) {
+	if req.TerminalIndex < 0 {
+		return nil, grpcutil.Errf(codes.InvalidArgument, "negative terminal index")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ls := c.streams[streamKey{cfgtypes.ProjectName(req.Project), req.Id}]
+	switch {
+	case ls == nil:
+		return nil, grpcutil.Errf(codes.NotFound, "log stream %q is not registered", req.Id)
+
+	case subtle.ConstantTimeCompare(ls.secret, req.Secret) != 1:
+		return nil, grpcutil.Errf(codes.InvalidArgument, "request secret doesn't match the stream secret")
+
+	case ls.terminalIndex >= 0:
+		// Succeed if this is non-conflicting (idempotent).
+		if ls.terminalIndex != req.TerminalIndex {
+			return nil, grpcutil.Errf(codes.FailedPrecondition, "log stream is incompatibly terminated")
+		}
+
+	default:
+		ls.terminalIndex = req.TerminalIndex
+		ls.updated = clock.Now(ctx).UTC()
+
+		log.Fields{
+			"project":       ls.project,
+			"path":          ls.path,
+			"terminalIndex": ls.terminalIndex,
+		}.Infof(ctx, "Terminated log stream.")
+	}
+	return &empty.Empty{}, nil
+}
+
+// IndexStreamFields implements services.ServicesServer.
+func (c *Coordinator) IndexStreamFields(ctx context.Context, req *services.IndexStreamFieldsRequest) (*empty.Empty, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ls := c.streams[streamKey{cfgtypes.ProjectName(req.Project), req.Id}]
+	switch {
+	case ls == nil:
+		return nil, grpcutil.Errf(codes.NotFound, "log stream %q is not registered", req.Id)
+
+	case subtle.ConstantTimeCompare(ls.secret, req.Secret) != 1:
+		return nil, grpcutil.Errf(codes.InvalidArgument, "request secret doesn't match the stream secret")
+	}
+
+	for _, f := range req.Fields {
+		values, ok := ls.fields[f.Key]
+		if !ok {
+			return nil, grpcutil.Errf(codes.InvalidArgument, "field %q is not indexed by this log stream", f.Key)
+		}
+		if len(f.Value) > types.MaxIndexedFieldValueSize || len(values) >= types.MaxIndexedFieldValues ||
+			hasValue(values, f.Value) {
+			continue
+		}
+		ls.fields[f.Key] = append(values, f.Value)
+	}
+	return &empty.Empty{}, nil
+}
+
+func hasValue(values []string, v string) bool {
+	for _, cur := range values {
+		if cur == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ArchiveStream implements services.ServicesServer.
+//
+// The local Coordinator doesn't archive log streams.
+func (c *Coordinator) ArchiveStream(ctx context.Context, req *services.ArchiveStreamRequest) (*empty.Empty, error) {
+	return nil, grpcutil.Errf(codes.Unimplemented, "log streams are not archived by the local Coordinator")
+}
+
+// Publish implements bundles.BundlesServer.
+//
+// The bundle is processed by the Coordinator's Collector before Publish
+// returns.
+func (c *Coordinator) Publish(ctx context.Context, req *bundles.PublishRequest) (*empty.Empty, error) {
+	if err := c.collector.Process(ctx, req.Data); err != nil {
+		log.WithError(err).Errorf(ctx, "Failed to process bundle.")
+		if errors.IsTransient(err) {
+			return nil, grpcutil.Unavailable
+		}
+		return nil, grpcutil.Errf(codes.InvalidArgument, "failed to process bundle: %s", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (ls *logStream) servicesState() *services.LogStreamState {
+	return &services.LogStreamState{
+		ProtoVersion:  ls.protoVersion,
+		Secret:        []byte(ls.secret),
+		TerminalIndex: ls.terminalIndex,
+	}
+}
+
+// servicesClient is a services.ServicesClient that calls a Coordinator's
+// services.ServicesServer methods directly. It is used by the Coordinator's
+// in-process Collector.
+type servicesClient struct {
+	c *Coordinator
+}
+
+func (sc servicesClient) GetConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (
+	*services.GetConfigResponse, error) {
+	return sc.c.GetConfig(ctx, in)
+}
+
+func (sc servicesClient) RegisterStream(ctx context.Context, in *services.RegisterStreamRequest, opts ...grpc.CallOption) (
+	*services.RegisterStreamResponse, error) {
+	return sc.c.RegisterStream(ctx, in)
+}
+
+func (sc servicesClient) LoadStream(ctx context.Context, in *services.LoadStreamRequest, opts ...grpc.CallOption) (
+	*services.LoadStreamResponse, error) {
+	return sc.c.LoadStream(ctx, in)
+}
+
+func (sc servicesClient) TerminateStream(ctx context.Context, in *services.TerminateStreamRequest, opts ...grpc.CallOption) (
+	*empty.Empty, error) {
+	return sc.c.TerminateStream(ctx, in)
+}
+
+func (sc servicesClient) IndexStreamFields(ctx context.Context, in *services.IndexStreamFieldsRequest, opts ...grpc.CallOption) (
+	*empty.Empty, error) {
+	return sc.c.IndexStreamFields(ctx, in)
+}
+
+func (sc servicesClient) ArchiveStream(ctx context.Context, in *services.ArchiveStreamRequest, opts ...grpc.CallOption) (
+	*empty.Empty, error) {
+	return sc.c.ArchiveStream(ctx, in)
+}
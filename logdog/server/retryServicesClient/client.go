@@ -76,6 +76,16 @@ func (c *client) TerminateStream(ctx context.Context, in *s.TerminateStreamReque
 	return
 }
 
+func (c *client) IndexStreamFields(ctx context.Context, in *s.IndexStreamFieldsRequest, opts ...grpc.CallOption) (
+	r *empty.Empty, err error) {
+	err = retry.Retry(ctx, c.f, func() (err error) {
+		r, err = c.c.IndexStreamFields(ctx, in, opts...)
+		err = grpcutil.WrapIfTransient(err)
+		return
+	}, callback(ctx, "indexing stream fields"))
+	return
+}
+
 func (c *client) ArchiveStream(ctx context.Context, in *s.ArchiveStreamRequest, opts ...grpc.CallOption) (
 	r *empty.Empty, err error) {
 	err = retry.Retry(ctx, c.f, func() (err error) {
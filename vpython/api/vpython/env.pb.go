@@ -12,6 +12,7 @@ It is generated from these files:
 
 It has these top-level messages:
 	Environment
+	Runtime
 	Pep425Tag
 	Spec
 */
@@ -38,6 +39,8 @@ type Environment struct {
 	Spec *Spec `protobuf:"bytes,1,opt,name=spec" json:"spec,omitempty"`
 	// The PEP425 tags that were probed for this Python environment.
 	Pep425Tag []*Pep425Tag `protobuf:"bytes,2,rep,name=pep425_tag,json=pep425Tag" json:"pep425_tag,omitempty"`
+	// The Python interpreter the environment was built with.
+	Runtime *Runtime `protobuf:"bytes,3,opt,name=runtime" json:"runtime,omitempty"`
 }
 
 func (m *Environment) Reset()                    { *m = Environment{} }
@@ -59,8 +62,54 @@ func (m *Environment) GetPep425Tag() []*Pep425Tag {
 	return nil
 }
 
+func (m *Environment) GetRuntime() *Runtime {
+	if m != nil {
+		return m.Runtime
+	}
+	return nil
+}
+
+// Runtime describes a system Python interpreter.
+type Runtime struct {
+	// The absolute path to the interpreter.
+	Path string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	// The version of the interpreter, "Major.Minor.Patch".
+	Version string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+	// The binary interface of the interpreter. It combines its implementation
+	// and version, ABI flags, machine and pointer size (e.g.,
+	// "cp27mu-x86_64-64").
+	Abi string `protobuf:"bytes,3,opt,name=abi" json:"abi,omitempty"`
+}
+
+func (m *Runtime) Reset()                    { *m = Runtime{} }
+func (m *Runtime) String() string            { return proto.CompactTextString(m) }
+func (*Runtime) ProtoMessage()               {}
+func (*Runtime) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Runtime) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *Runtime) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Runtime) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Environment)(nil), "vpython.Environment")
+	proto.RegisterType((*Runtime)(nil), "vpython.Runtime")
 }
 
 func init() {
@@ -68,16 +117,20 @@ func init() {
 }
 
 var fileDescriptor0 = []byte{
-	// 171 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4d, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x29, 0x4d, 0xce, 0x04, 0x13, 0xba, 0xe9, 0xf9,
-	0xfa, 0x65, 0x05, 0x95, 0x25, 0x19, 0xf9, 0x79, 0xfa, 0x89, 0x05, 0x99, 0x70, 0x76, 0x6a, 0x5e,
-	0x99, 0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x10, 0x3b, 0x54, 0x48, 0xca, 0x82, 0x14, 0xfd, 0x05,
-	0xa9, 0x05, 0x26, 0x46, 0xa6, 0x10, 0x23, 0xa4, 0xcc, 0x48, 0xd1, 0x59, 0x5c, 0x90, 0x9a, 0x0c,
-	0xd1, 0xa7, 0x94, 0xcc, 0xc5, 0xed, 0x9a, 0x57, 0x96, 0x59, 0x94, 0x9f, 0x97, 0x9b, 0x9a, 0x57,
-	0x22, 0xa4, 0xc8, 0xc5, 0x02, 0x92, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0xe2, 0xd5, 0x83,
-	0xea, 0xd0, 0x0b, 0x2e, 0x48, 0x4d, 0x0e, 0x02, 0x4b, 0x09, 0x19, 0x72, 0x71, 0x41, 0x6c, 0x8e,
-	0x2f, 0x49, 0x4c, 0x97, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x12, 0x82, 0x2b, 0x0c, 0x00, 0x4b,
-	0x85, 0x24, 0xa6, 0x07, 0x71, 0x16, 0xc0, 0x98, 0x49, 0x6c, 0x60, 0xbb, 0x8c, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0x12, 0xee, 0x6f, 0x1f, 0x01, 0x00, 0x00,
+	// 236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x8f, 0x41, 0x4b, 0xc4, 0x30,
+	0x10, 0x85, 0xe9, 0x76, 0xb1, 0x74, 0x8a, 0xb0, 0xcc, 0x29, 0xec, 0x69, 0xdd, 0xd3, 0x22, 0xd8,
+	0x62, 0x75, 0xc5, 0x3f, 0xe0, 0xc1, 0x9b, 0x44, 0xef, 0x92, 0x96, 0xd0, 0x06, 0x6c, 0x32, 0x74,
+	0xd3, 0x82, 0xbf, 0xc2, 0xbf, 0x2c, 0x3b, 0x69, 0x7b, 0xef, 0x25, 0xbc, 0xbc, 0xf7, 0xbe, 0x99,
+	0x04, 0xce, 0x8d, 0xf1, 0xed, 0x50, 0xe5, 0xb5, 0xeb, 0x8a, 0x9f, 0xa1, 0x36, 0x7c, 0x3c, 0x34,
+	0xae, 0x18, 0xe9, 0xd7, 0xb7, 0xce, 0x16, 0x8a, 0xcc, 0xa2, 0xb5, 0x1d, 0x73, 0xea, 0x9d, 0x77,
+	0x98, 0x4c, 0xd6, 0xfe, 0x75, 0x0d, 0x4f, 0x9a, 0x9e, 0xcb, 0x73, 0x18, 0xb1, 0x7f, 0x59, 0x43,
+	0x5e, 0x48, 0xd7, 0x81, 0x3b, 0xfe, 0x45, 0x90, 0xbd, 0xd9, 0xd1, 0xf4, 0xce, 0x76, 0xda, 0x7a,
+	0xbc, 0x83, 0xed, 0x35, 0x15, 0xd1, 0x21, 0x3a, 0x65, 0xe5, 0x6d, 0x3e, 0x21, 0xf9, 0x27, 0xe9,
+	0x5a, 0x72, 0x84, 0x8f, 0x00, 0x61, 0xf5, 0xb7, 0x57, 0x8d, 0xd8, 0x1c, 0xe2, 0x53, 0x56, 0xe2,
+	0x52, 0xfc, 0xe0, 0xe8, 0x4b, 0x35, 0x32, 0xa5, 0x59, 0xe2, 0x3d, 0x24, 0xfd, 0x60, 0xbd, 0xe9,
+	0xb4, 0x88, 0x79, 0xf0, 0x6e, 0xe9, 0xcb, 0xe0, 0xcb, 0xb9, 0x70, 0x7c, 0x87, 0x64, 0xf2, 0x10,
+	0x61, 0x4b, 0xca, 0xb7, 0xfc, 0x98, 0x54, 0xb2, 0x46, 0x01, 0xc9, 0xa8, 0xfb, 0x8b, 0x71, 0x56,
+	0x6c, 0xd8, 0x9e, 0xaf, 0xb8, 0x83, 0x58, 0x55, 0x86, 0x17, 0xa4, 0xf2, 0x2a, 0xab, 0x1b, 0xfe,
+	0xe3, 0xd3, 0xff, 0x00, 0xed, 0x3f, 0x4d, 0xf1, 0x97, 0x01, 0x00, 0x00,
 }
@@ -41,6 +41,42 @@ type Spec struct {
 	// set of PEP425 tags representing the systems that it wants to be verified
 	// against.
 	VerifyPep425Tag []*Pep425Tag `protobuf:"bytes,4,rep,name=verify_pep425_tag,json=verifyPep425Tag" json:"verify_pep425_tag,omitempty"`
+	// If true, the environment is created with the "venv" module of the Python
+	// standard library instead of the VirtualEnv package. This requires a
+	// Python 3 interpreter, and "virtualenv" must be empty.
+	StdlibVenv bool `protobuf:"varint,5,opt,name=stdlib_venv,json=stdlibVenv" json:"stdlib_venv,omitempty"`
+	// Interpreters to use instead of the default one.
+	//
+	// The first interpreter that matches the current platform, exists and
+	// satisfies "python_version" is used. If some interpreters match the current
+	// platform but none of them is usable, the environment can't be created. If
+	// none matches the current platform, the default interpreter is used.
+	Interpreter []*Spec_Interpreter `protobuf:"bytes,6,rep,name=interpreter" json:"interpreter,omitempty"`
+	// Locked wheels, generated by "vpython freeze".
+	//
+	// If one of the PEP425 tags of the environment matches a lock, the wheels of
+	// that lock are installed instead of the "wheel" entries, so the environment
+	// doesn't change when the versions of the "wheel" entries move.
+	Lock []*Spec_Lock `protobuf:"bytes,7,rep,name=lock" json:"lock,omitempty"`
+	// A local directory of wheel files to load the "wheel" entries from.
+	//
+	// It is used by package loaders that read wheels from a directory or a
+	// package index, instead of their configured directory or index. It is
+	// ignored by the other package loaders (e.g., CIPD). A relative path is
+	// relative to the directory of the specification file that declares it.
+	WheelDir string `protobuf:"bytes,8,opt,name=wheel_dir,json=wheelDir" json:"wheel_dir,omitempty"`
+	// Specification files to include, before this one.
+	//
+	// Each entry is the path of a specification file, relative to the directory of
+	// the file that includes it. An entry of the form ".../NAME" is the nearest file
+	// named NAME in that directory or one of its parents, other than the including
+	// file itself (e.g., ".../common.vpython" for a repository-wide specification).
+	//
+	// The included specifications are merged in order, then this one is merged on
+	// top of them. "wheel" entries replace included entries with the same name, and
+	// are added otherwise. The other fields replace the included values if they are
+	// set. The locks of included specifications are ignored.
+	Include []string `protobuf:"bytes,9,rep,name=include" json:"include,omitempty"`
 }
 
 func (m *Spec) Reset()                    { *m = Spec{} }
@@ -76,6 +112,41 @@ func (m *Spec) GetVerifyPep425Tag() []*Pep425Tag {
 	return nil
 }
 
+func (m *Spec) GetStdlibVenv() bool {
+	if m != nil {
+		return m.StdlibVenv
+	}
+	return false
+}
+
+func (m *Spec) GetInterpreter() []*Spec_Interpreter {
+	if m != nil {
+		return m.Interpreter
+	}
+	return nil
+}
+
+func (m *Spec) GetLock() []*Spec_Lock {
+	if m != nil {
+		return m.Lock
+	}
+	return nil
+}
+
+func (m *Spec) GetWheelDir() string {
+	if m != nil {
+		return m.WheelDir
+	}
+	return ""
+}
+
+func (m *Spec) GetInclude() []string {
+	if m != nil {
+		return m.Include
+	}
+	return nil
+}
+
 // A definition for a remote package. The type of package depends on the
 // configured package resolver.
 type Spec_Package struct {
@@ -109,9 +180,82 @@ func (m *Spec_Package) GetVersion() string {
 	return ""
 }
 
+// An alternative Python interpreter.
+type Spec_Interpreter struct {
+	// The platform this interpreter is used on, in the form "<os>-<arch>" with
+	// the Go names of the operating system and architecture (e.g.,
+	// "linux-amd64"). Shell-style wildcards are allowed (e.g., "windows-*").
+	//
+	// If empty, the interpreter is used on all platforms.
+	Platform string `protobuf:"bytes,1,opt,name=platform" json:"platform,omitempty"`
+	// The Python interpreter, either a name to look up in PATH (e.g.,
+	// "python3.6") or an absolute path.
+	Python string `protobuf:"bytes,2,opt,name=python" json:"python,omitempty"`
+}
+
+func (m *Spec_Interpreter) Reset()                    { *m = Spec_Interpreter{} }
+func (m *Spec_Interpreter) String() string            { return proto.CompactTextString(m) }
+func (*Spec_Interpreter) ProtoMessage()               {}
+func (*Spec_Interpreter) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0, 1} }
+
+func (m *Spec_Interpreter) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *Spec_Interpreter) GetPython() string {
+	if m != nil {
+		return m.Python
+	}
+	return ""
+}
+
+// The wheels of a specification, resolved for a PEP425 tag.
+type Spec_Lock struct {
+	// The PEP425 tag that the wheels were resolved for.
+	Pep425Tag *Pep425Tag `protobuf:"bytes,1,opt,name=pep425_tag,json=pep425Tag" json:"pep425_tag,omitempty"`
+	// The resolved wheels.
+	//
+	// - For CIPD, the name is the resolved package name, and the version is the
+	//   package instance ID.
+	Wheel []*Spec_Package `protobuf:"bytes,2,rep,name=wheel" json:"wheel,omitempty"`
+	// The resolved VirtualEnv package, if the specification uses one.
+	Virtualenv *Spec_Package `protobuf:"bytes,3,opt,name=virtualenv" json:"virtualenv,omitempty"`
+}
+
+func (m *Spec_Lock) Reset()                    { *m = Spec_Lock{} }
+func (m *Spec_Lock) String() string            { return proto.CompactTextString(m) }
+func (*Spec_Lock) ProtoMessage()               {}
+func (*Spec_Lock) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{0, 2} }
+
+func (m *Spec_Lock) GetPep425Tag() *Pep425Tag {
+	if m != nil {
+		return m.Pep425Tag
+	}
+	return nil
+}
+
+func (m *Spec_Lock) GetWheel() []*Spec_Package {
+	if m != nil {
+		return m.Wheel
+	}
+	return nil
+}
+
+func (m *Spec_Lock) GetVirtualenv() *Spec_Package {
+	if m != nil {
+		return m.Virtualenv
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Spec)(nil), "vpython.Spec")
 	proto.RegisterType((*Spec_Package)(nil), "vpython.Spec.Package")
+	proto.RegisterType((*Spec_Interpreter)(nil), "vpython.Spec.Interpreter")
+	proto.RegisterType((*Spec_Lock)(nil), "vpython.Spec.Lock")
 }
 
 func init() {
@@ -119,21 +263,30 @@ func init() {
 }
 
 var fileDescriptor2 = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4b, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0x29, 0x4d, 0xce, 0x04, 0x13, 0xba, 0xe9, 0xf9,
-	0xfa, 0x65, 0x05, 0x95, 0x25, 0x19, 0xf9, 0x79, 0xfa, 0x89, 0x05, 0x99, 0x70, 0x76, 0x71, 0x41,
-	0x6a, 0xb2, 0x5e, 0x41, 0x51, 0x7e, 0x49, 0xbe, 0x10, 0x3b, 0x54, 0x4c, 0xca, 0x82, 0x14, 0x03,
-	0x0a, 0x52, 0x0b, 0x4c, 0x8c, 0x4c, 0x21, 0x46, 0x28, 0x75, 0x31, 0x71, 0xb1, 0x04, 0x17, 0xa4,
-	0x26, 0x0b, 0xa9, 0x72, 0xf1, 0x41, 0xe4, 0xe3, 0xcb, 0x52, 0x8b, 0x8a, 0x33, 0xf3, 0xf3, 0x24,
-	0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x78, 0x21, 0xa2, 0x61, 0x10, 0x41, 0x21, 0x6d, 0x2e, 0xd6,
-	0xf2, 0x8c, 0xd4, 0xd4, 0x1c, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x51, 0x3d, 0xa8, 0xa9,
-	0x7a, 0x20, 0x43, 0xf4, 0x02, 0x12, 0x93, 0xb3, 0x13, 0xd3, 0x53, 0x83, 0x20, 0x6a, 0x84, 0x4c,
-	0xb9, 0xb8, 0xca, 0x32, 0x8b, 0x4a, 0x4a, 0x13, 0x73, 0x52, 0xf3, 0xca, 0x24, 0x98, 0x15, 0x18,
-	0x71, 0xeb, 0x40, 0x52, 0x28, 0x64, 0xc7, 0x25, 0x58, 0x96, 0x5a, 0x94, 0x99, 0x56, 0x19, 0x0f,
-	0x71, 0x6a, 0x7c, 0x49, 0x62, 0xba, 0x04, 0x0b, 0xd8, 0x3e, 0x21, 0xb8, 0xee, 0x00, 0xb0, 0x54,
-	0x48, 0x62, 0x7a, 0x10, 0x3f, 0x44, 0x31, 0x5c, 0x40, 0xca, 0x9c, 0x8b, 0x1d, 0x6a, 0xac, 0x90,
-	0x10, 0x17, 0x4b, 0x5e, 0x62, 0x6e, 0x2a, 0xd4, 0x2f, 0x60, 0xb6, 0x90, 0x04, 0x17, 0x3b, 0xcc,
-	0x8b, 0x4c, 0x60, 0x61, 0x18, 0x37, 0x89, 0x0d, 0x1c, 0x26, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xb5, 0x8f, 0xa2, 0xff, 0x90, 0x01, 0x00, 0x00,
+	// 396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0x41, 0x0b, 0xd3, 0x30,
+	0x14, 0xa6, 0x5b, 0xb7, 0xb6, 0xaf, 0xa8, 0x18, 0x50, 0x62, 0x3d, 0x58, 0x04, 0xa5, 0x20, 0x76,
+	0x38, 0x9d, 0x0a, 0x82, 0x20, 0x78, 0x11, 0x3c, 0x8c, 0x2a, 0xbb, 0x96, 0x2c, 0xcb, 0xba, 0xb0,
+	0xae, 0x09, 0x59, 0x5a, 0xd9, 0x8f, 0xf1, 0x0f, 0xf8, 0x2b, 0xa5, 0x49, 0xdb, 0x75, 0x82, 0x07,
+	0x2f, 0x5e, 0xc2, 0x7b, 0xdf, 0x7b, 0xef, 0x7b, 0x5f, 0xbe, 0x04, 0xde, 0x16, 0x5c, 0x1f, 0xea,
+	0x6d, 0x4a, 0xc5, 0x69, 0x51, 0xd6, 0x94, 0x9b, 0xe3, 0x65, 0x21, 0x16, 0x8d, 0xbc, 0xe8, 0x83,
+	0xa8, 0x16, 0x44, 0xf2, 0x21, 0x3e, 0x4b, 0x46, 0x53, 0xa9, 0x84, 0x16, 0xc8, 0xeb, 0xb0, 0xe8,
+	0xfd, 0xbf, 0x10, 0x48, 0x26, 0xdf, 0x2c, 0x57, 0x96, 0xe2, 0xe9, 0xaf, 0x19, 0xb8, 0xdf, 0x24,
+	0xa3, 0xe8, 0x19, 0xdc, 0xb5, 0xf5, 0xbc, 0x61, 0xea, 0xcc, 0x45, 0x85, 0x9d, 0xd8, 0x49, 0x82,
+	0xec, 0x8e, 0x45, 0x37, 0x16, 0x44, 0x2f, 0x60, 0xf6, 0xe3, 0xc0, 0x58, 0x89, 0x27, 0xf1, 0x34,
+	0x09, 0x97, 0x0f, 0xd2, 0x8e, 0x35, 0x6d, 0x49, 0xd2, 0x35, 0xa1, 0x47, 0x52, 0xb0, 0xcc, 0xf6,
+	0xa0, 0x15, 0x40, 0xc3, 0x95, 0xae, 0x49, 0xc9, 0xaa, 0x06, 0x4f, 0x63, 0xe7, 0xef, 0x13, 0xa3,
+	0x46, 0xf4, 0x11, 0xee, 0x37, 0x4c, 0xf1, 0xfd, 0x25, 0xb7, 0x52, 0x73, 0x4d, 0x0a, 0xec, 0x9a,
+	0x7d, 0x68, 0x98, 0x5e, 0x9b, 0xd2, 0x77, 0x52, 0x64, 0xf7, 0x6c, 0xf3, 0x00, 0xa0, 0x27, 0x10,
+	0x9e, 0xf5, 0xae, 0xe4, 0xdb, 0xbc, 0x69, 0xf7, 0xce, 0x62, 0x27, 0xf1, 0x33, 0xb0, 0xd0, 0xa6,
+	0x5d, 0xf0, 0x01, 0x42, 0x5e, 0x69, 0xa6, 0xa4, 0x62, 0x9a, 0x29, 0x3c, 0x37, 0xd4, 0x8f, 0x6e,
+	0x85, 0x7d, 0xb9, 0x36, 0x64, 0xe3, 0x6e, 0xf4, 0x1c, 0xdc, 0x52, 0xd0, 0x23, 0xf6, 0xfe, 0x10,
+	0x64, 0xa6, 0xbe, 0x0a, 0x7a, 0xcc, 0x4c, 0x1d, 0x3d, 0x86, 0xc0, 0xb8, 0x90, 0xef, 0xb8, 0xc2,
+	0xbe, 0xf1, 0xd2, 0x37, 0xc0, 0x67, 0xae, 0x10, 0x06, 0x8f, 0x57, 0xb4, 0xac, 0x77, 0x0c, 0x07,
+	0xf1, 0x34, 0x09, 0xb2, 0x3e, 0x8d, 0xde, 0x81, 0xd7, 0x79, 0x82, 0x10, 0xb8, 0x15, 0x39, 0xb1,
+	0xee, 0x21, 0x4c, 0xdc, 0x0e, 0xf6, 0xef, 0x33, 0x31, 0x70, 0x9f, 0x46, 0x9f, 0x20, 0x1c, 0x69,
+	0x46, 0x11, 0xf8, 0xb2, 0x24, 0x7a, 0x2f, 0xd4, 0xa9, 0x23, 0x18, 0x72, 0xf4, 0x10, 0xe6, 0x56,
+	0x74, 0xc7, 0xd1, 0x65, 0xd1, 0x4f, 0x07, 0xdc, 0xf6, 0x06, 0xe8, 0x15, 0xc0, 0xc8, 0x7a, 0x27,
+	0x76, 0x6e, 0x6e, 0x7a, 0xb5, 0x3e, 0x90, 0x7d, 0xf8, 0x3f, 0x3e, 0xc6, 0x76, 0x6e, 0xfe, 0xec,
+	0xeb, 0xdf, 0x03, 0x00, 0x4a, 0x27, 0xbb, 0x05, 0x30, 0x03, 0x00, 0x00,
 }
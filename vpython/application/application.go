@@ -82,6 +82,12 @@ type Config struct {
 	// See venv.Config's MaxScriptPathLen.
 	MaxScriptPathLen int
 
+	// ShareWheels, if true, installs wheels into a store shared by all
+	// environments instead of into each environment.
+	//
+	// See venv.Config's ShareWheels.
+	ShareWheels bool
+
 	// WithVerificationConfig, if not nil, runs the supplied callback with
 	// a Config instance to use for verification and the set of default
 	// verification scenarios.
@@ -117,6 +123,9 @@ func (a *application) mainDev(c context.Context, args []string) error {
 			subcommands.CmdHelp,
 			subcommandInstall,
 			subcommandVerify,
+			subcommandFreeze,
+			subcommandSpecDump,
+			subcommandDoctor,
 			subcommandDelete,
 		},
 	}
@@ -176,7 +185,7 @@ func (a *application) mainImpl(c context.Context, args []string) error {
 	// If an spec path was manually specified, load and use it.
 	if a.specPath != "" {
 		var err error
-		if a.opts.EnvConfig.Spec, err = spec.Load(a.specPath); err != nil {
+		if a.opts.EnvConfig.Spec, a.opts.SpecProvenance, err = spec.LoadWithProvenance(a.specPath); err != nil {
 			return errors.Annotate(err).Reason("failed to load specification file (-spec) from: %(path)s").
 				D("path", a.specPath).
 				Err()
@@ -264,6 +273,7 @@ func (cfg *Config) Main(c context.Context) int {
 				PruneThreshold:    cfg.PruneThreshold,
 				MaxPrunesPerSweep: cfg.MaxPrunesPerSweep,
 				MaxScriptPathLen:  cfg.MaxScriptPathLen,
+				ShareWheels:       cfg.ShareWheels,
 				Loader:            cfg.PackageLoader,
 			},
 			WaitForEnv: true,
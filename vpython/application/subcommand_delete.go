@@ -69,6 +69,12 @@ func (cr *deleteCommandRun) Run(app subcommands.Application, args []string, env
 			return err
 		}
 
+		// Remove the shared wheels of the deleted environments.
+		if err := a.opts.EnvConfig.PruneWheelStore(c); err != nil {
+			logging.WithError(err).Errorf(c, "Failed to prune the shared wheel store.")
+			failures++
+		}
+
 		if failures > 0 {
 			return errors.Reason("failed to delete %(count)d environment(s)").
 				D("count", failures).
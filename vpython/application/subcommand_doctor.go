@@ -0,0 +1,141 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/vpython/venv"
+
+	"github.com/luci/luci-go/common/cli"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/system/environ"
+)
+
+var subcommandDoctor = &subcommands.Command{
+	UsageLine: "doctor [-json]",
+	ShortDesc: "diagnoses the environment of a spec",
+	LongDesc: "resolves the spec and the Python interpreter, and diagnoses their environment without " +
+		"creating or modifying it: its stamp, its installed distributions versus the requested wheels, " +
+		"foreign sys.path entries (e.g., from PYTHONPATH) and held locks. Exits with 1 if problems are found.",
+	Advanced: false,
+	CommandRun: func() subcommands.CommandRun {
+		cr := &doctorCommandRun{}
+		cr.Flags.BoolVar(&cr.json, "json", false,
+			"Emit the diagnosis as JSON instead of human-readable text.")
+		return cr
+	},
+}
+
+type doctorCommandRun struct {
+	subcommands.CommandRunBase
+
+	json bool
+}
+
+func (cr *doctorCommandRun) Run(app subcommands.Application, args []string, env subcommands.Env) int {
+	c := cli.GetContext(app, cr, env)
+	a := getApplication(c, args)
+
+	return run(c, func(c context.Context) error {
+		if err := a.opts.ResolveSpec(c); err != nil {
+			return errors.Annotate(err).Reason("failed to resolve specification").Err()
+		}
+
+		e := a.opts.Environ
+		if e.Len() == 0 {
+			e = environ.System()
+		}
+		d, err := venv.Diagnose(c, a.opts.EnvConfig, e)
+		if err != nil {
+			return errors.Annotate(err).Reason("failed to diagnose environment").Err()
+		}
+
+		if cr.json {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			err = enc.Encode(d)
+		} else {
+			err = writeDiagnosis(os.Stdout, d)
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(d.Problems) > 0 {
+			return ReturnCodeError(1)
+		}
+		return nil
+	})
+}
+
+// writeDiagnosis writes a human-readable form of d to w.
+func writeDiagnosis(w io.Writer, d *venv.Diagnosis) error {
+	var lines []string
+	add := func(format string, args ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	if d.Name != "" {
+		add("Environment: %s", d.Name)
+		add("  Root: %s", d.Root)
+		add("  Complete: %t", d.Complete)
+		add("  Python: %s", d.Python)
+		if rt := d.Runtime; rt != nil {
+			add("  Runtime: %s (version %s, ABI %s)", rt.Path, rt.Version, rt.Abi)
+		}
+		if len(d.Pep425Tags) > 0 {
+			add("  PEP425 tags: %s", strings.Join(d.Pep425Tags, ", "))
+		}
+	}
+
+	if len(d.Wheels) > 0 {
+		add("Wheels:")
+		for _, dw := range d.Wheels {
+			installed := "NOT INSTALLED"
+			if dist := dw.Distribution; dist != nil {
+				installed = fmt.Sprintf("%s %s", dist.Name, dist.Version)
+			}
+			add("  %s@%s: %s", dw.Name, dw.Version, installed)
+		}
+	}
+
+	if len(d.UnrequestedDistributions) > 0 {
+		add("Unrequested distributions:")
+		for _, dist := range d.UnrequestedDistributions {
+			add("  %s %s", dist.Name, dist.Version)
+		}
+	}
+
+	if len(d.Locks) > 0 {
+		add("Locks:")
+		for _, dl := range d.Locks {
+			state := "free"
+			if dl.Held {
+				state = "HELD"
+			}
+			add("  %s: %s", dl.Path, state)
+		}
+	}
+
+	if len(d.Problems) > 0 {
+		add("Problems:")
+		for _, p := range d.Problems {
+			add("  - %s", p)
+		}
+	} else {
+		add("No problems found.")
+	}
+
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
@@ -0,0 +1,88 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package application
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/vpython/api/vpython"
+	"github.com/luci/luci-go/vpython/spec"
+
+	"github.com/luci/luci-go/common/cli"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+)
+
+var subcommandFreeze = &subcommands.Command{
+	UsageLine: "freeze [-output path]",
+	ShortDesc: "locks the wheels of a spec to their resolved packages",
+	LongDesc: "resolves the wheels of a spec for all of the configured verification architectures, and " +
+		"emits a locked spec. A locked spec next to a spec file (e.g., \"test.py.vpython" + spec.LockSuffix +
+		"\") is used instead of it.",
+	Advanced: false,
+	CommandRun: func() subcommands.CommandRun {
+		cr := &freezeCommandRun{}
+		cr.Flags.StringVar(&cr.output, "output", "",
+			"Path to write the locked spec to. Default is STDOUT.")
+		return cr
+	},
+}
+
+type freezeCommandRun struct {
+	subcommands.CommandRunBase
+
+	output string
+}
+
+func (cr *freezeCommandRun) Run(app subcommands.Application, args []string, env subcommands.Env) int {
+	c := cli.GetContext(app, cr, env)
+	a := getApplication(c, args)
+
+	return run(c, func(c context.Context) error {
+		if err := a.opts.ResolveSpec(c); err != nil {
+			return errors.Annotate(err).Reason("failed to resolve specification").Err()
+		}
+		s := a.opts.EnvConfig.Spec.Clone()
+		if err := spec.Normalize(s, &a.opts.EnvConfig.Package); err != nil {
+			return errors.Annotate(err).Reason("failed to normalize specification").Err()
+		}
+
+		// Resolve against the verification scenarios, like "verify" does.
+		freeze := func(resolve spec.ResolveFunc, tags []*vpython.Pep425Tag) error {
+			if len(s.VerifyPep425Tag) > 0 {
+				tags = s.VerifyPep425Tag
+			}
+			return spec.Freeze(c, s, tags, resolve)
+		}
+		var err error
+		if a.WithVerificationConfig != nil {
+			err = a.WithVerificationConfig(c, func(cfg Config, verificationScenarios []*vpython.Pep425Tag) error {
+				return freeze(cfg.PackageLoader.Resolve, verificationScenarios)
+			})
+		} else {
+			err = freeze(a.opts.EnvConfig.Loader.Resolve, nil)
+		}
+		if err != nil {
+			return errors.Annotate(err).Reason("failed to freeze specification").Err()
+		}
+
+		renderedSpec := spec.Render(s)
+		if cr.output == "" {
+			_, err := os.Stdout.WriteString(renderedSpec)
+			return err
+		}
+		if err := ioutil.WriteFile(cr.output, []byte(renderedSpec), 0644); err != nil {
+			return errors.Annotate(err).Reason("failed to write locked spec to: %(path)s").
+				D("path", cr.output).
+				Err()
+		}
+		logging.Infof(c, "Wrote locked spec for %d PEP425 tag(s) to: %s", len(s.Lock), cr.output)
+		return nil
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package application
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/maruel/subcommands"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/vpython/api/vpython"
+	"github.com/luci/luci-go/vpython/spec"
+
+	"github.com/luci/luci-go/common/cli"
+	"github.com/luci/luci-go/common/errors"
+)
+
+var subcommandSpecDump = &subcommands.Command{
+	UsageLine: "spec-dump",
+	ShortDesc: "prints the merged specification",
+	LongDesc: "prints the specification that would be used, with its includes merged, followed by " +
+		"the specification file that each of its wheels comes from.",
+	Advanced: false,
+	CommandRun: func() subcommands.CommandRun {
+		return &specDumpCommandRun{}
+	},
+}
+
+type specDumpCommandRun struct {
+	subcommands.CommandRunBase
+}
+
+func (cr *specDumpCommandRun) Run(app subcommands.Application, args []string, env subcommands.Env) int {
+	c := cli.GetContext(app, cr, env)
+	a := getApplication(c, args)
+
+	return run(c, func(c context.Context) error {
+		if err := a.opts.ResolveSpec(c); err != nil {
+			return errors.Annotate(err).Reason("failed to resolve specification").Err()
+		}
+
+		_, err := os.Stdout.WriteString(renderSpecDump(a.opts.EnvConfig.Spec, a.opts.SpecProvenance))
+		return err
+	})
+}
+
+// renderSpecDump renders s, followed by a comment block listing the
+// specification file that each of its wheels comes from. The result is still a
+// valid specification.
+func renderSpecDump(s *vpython.Spec, prov spec.Provenance) string {
+	var buf bytes.Buffer
+	buf.WriteString(spec.Render(s))
+	if len(s.Wheel) == 0 {
+		return buf.String()
+	}
+
+	buf.WriteString("\n# Wheel provenance:\n")
+	for _, w := range s.Wheel {
+		origin := prov[w.Name]
+		if origin == "" {
+			origin = "(unknown)"
+		}
+		fmt.Fprintf(&buf, "#   %s@%s: %s\n", w.Name, w.Version, origin)
+	}
+	return buf.String()
+}
@@ -68,12 +68,15 @@ func (pl *PackageLoader) Resolve(c context.Context, e *vpython.Environment) erro
 		}
 	}
 
-	// Create a single package list. Our VirtualEnv will be index 0 (need
-	// this so we can back-port it into its VirtualEnv property).
+	// Create a single package list. Our VirtualEnv, if any, will be index 0
+	// (need this so we can back-port it into its VirtualEnv property).
+	// Environments using the standard library "venv" module have none.
 	//
 	// These will be updated to their resolved values in-place.
-	packages := make([]*vpython.Spec_Package, 1, 1+len(spec.Wheel))
-	packages[0] = spec.Virtualenv
+	packages := make([]*vpython.Spec_Package, 0, 1+len(spec.Wheel))
+	if spec.Virtualenv != nil {
+		packages = append(packages, spec.Virtualenv)
+	}
 	packages = append(packages, spec.Wheel...)
 
 	// Generate CIPD client options. If no root is provided, use a temporary root.
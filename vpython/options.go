@@ -32,6 +32,11 @@ type Options struct {
 	// The empty value is a valid default spec.Loader.
 	SpecLoader spec.Loader
 
+	// SpecProvenance, if not nil, is the provenance of the wheels of
+	// EnvConfig.Spec. ResolveSpec sets it when it loads the specification from a
+	// file.
+	SpecProvenance spec.Provenance
+
 	// Args are the arguments to forward to the Python process.
 	Args []string
 
@@ -130,7 +135,7 @@ func (o *Options) ResolveSpec(c context.Context) error {
 
 	// If it's a script, try resolving from filesystem first.
 	if isScriptTarget {
-		spec, err := o.SpecLoader.LoadForScript(c, script.Path, isModule)
+		spec, prov, err := o.SpecLoader.LoadForScriptWithProvenance(c, script.Path, isModule)
 		if err != nil {
 			return errors.Annotate(err).Reason("failed to load spec for script: %(path)s").
 				D("path", cmd.Target).
@@ -138,14 +143,14 @@ func (o *Options) ResolveSpec(c context.Context) error {
 				Err()
 		}
 		if spec != nil {
-			o.EnvConfig.Spec = spec
+			o.EnvConfig.Spec, o.SpecProvenance = spec, prov
 			return nil
 		}
 	}
 
 	// Do we have a spec file in the environment?
 	if v, ok := o.Environ.Get(EnvironmentStampPathENV); ok {
-		if o.EnvConfig.Spec, err = spec.Load(v); err != nil {
+		if o.EnvConfig.Spec, o.SpecProvenance, err = spec.LoadWithProvenance(v); err != nil {
 			return errors.Annotate(err).Reason("failed to load environment-supplied spec from: %(path)s").
 				D("path", v).
 				Err()
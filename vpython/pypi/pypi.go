@@ -0,0 +1,457 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package pypi implements a venv.PackageLoader that loads wheels from a local
+// directory or from a PEP 503 "simple" package index:
+// https://www.python.org/dev/peps/pep-0503/
+package pypi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+	"golang.org/x/net/html"
+
+	"github.com/luci/luci-go/vpython/api/vpython"
+	"github.com/luci/luci-go/vpython/venv"
+	"github.com/luci/luci-go/vpython/wheel"
+
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+)
+
+// hashPrefix is the prefix of the version of resolved wheels. It is followed by
+// the hex-encoded SHA256 of the wheel file.
+const hashPrefix = "sha256:"
+
+// PackageLoader is an implementation of venv.PackageLoader that loads wheels
+// from a local directory or from a PEP 503 "simple" package index.
+//
+// Wheels that use this loader use the distribution name as their Name and the
+// exact distribution version as their Version. They are resolved to the wheel
+// file that best matches the environment's PEP425 tags: its Name becomes the
+// absolute path or URL of the file, and its Version the SHA256 of its content,
+// which is verified when the wheel is installed. Since resolved packages are
+// part of the environment's fingerprint, environments are rebuilt when a wheel
+// file changes.
+//
+// Other packages (i.e., the VirtualEnv package) are loaded by Base.
+type PackageLoader struct {
+	// Dir, if not empty, is the local directory containing the wheel files. It
+	// is used instead of IndexURL.
+	//
+	// A specification's "wheel_dir" overrides both Dir and IndexURL. It is
+	// resolved relative to its specification file when that file is loaded.
+	Dir string
+
+	// IndexURL is the base URL of a PEP 503 "simple" package index (e.g.,
+	// "https://pypi.org/simple").
+	IndexURL string
+
+	// Client is the HTTP client to use to query IndexURL. If nil,
+	// http.DefaultClient will be used.
+	Client *http.Client
+
+	// Base, if not nil, is the PackageLoader to use for packages that aren't
+	// wheels. It is required for environments that use a VirtualEnv package.
+	Base venv.PackageLoader
+}
+
+var _ venv.PackageLoader = (*PackageLoader)(nil)
+
+// Resolve implements venv.PackageLoader.
+//
+// The wheels of the environment's specification are updated in-place to the
+// location and hash of the best matching wheel file. Wheels that were already
+// resolved (e.g., by a locked specification) are left untouched.
+func (pl *PackageLoader) Resolve(c context.Context, e *vpython.Environment) error {
+	spec := e.Spec
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Virtualenv != nil {
+		if pl.Base == nil {
+			return errors.New("no base package loader for the VirtualEnv package")
+		}
+		be := e.Clone()
+		be.Spec.Wheel = nil
+		if err := pl.Base.Resolve(c, be); err != nil {
+			return errors.Annotate(err).Reason("failed to resolve the VirtualEnv package").Err()
+		}
+		spec.Virtualenv = be.Spec.Virtualenv
+	}
+
+	if len(spec.Wheel) == 0 {
+		return nil
+	}
+	src, err := pl.sourceFor(spec)
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range spec.Wheel {
+		if strings.HasPrefix(pkg.Version, hashPrefix) {
+			continue
+		}
+
+		files, err := src.list(c, pkg.Name)
+		if err != nil {
+			return errors.Annotate(err).Reason("failed to list wheels for %(name)q").
+				D("name", pkg.Name).
+				Err()
+		}
+		f := bestMatch(files, pkg.Version, e.Pep425Tag)
+		if f == nil {
+			return errors.Reason("no wheel for %(name)q at version %(version)q matches the PEP425 tags").
+				D("name", pkg.Name).
+				D("version", pkg.Version).
+				D("files", len(files)).
+				Err()
+		}
+
+		if f.sha256 == "" {
+			if f.sha256, err = pl.hashLocation(c, f.location); err != nil {
+				return errors.Annotate(err).Reason("failed to hash wheel %(location)s").
+					D("location", f.location).
+					Err()
+			}
+		}
+
+		logging.Fields{
+			"name":    pkg.Name,
+			"version": pkg.Version,
+		}.Debugf(c, "Resolved wheel to: %s", f.location)
+		pkg.Name = f.location
+		pkg.Version = hashPrefix + f.sha256
+	}
+	return nil
+}
+
+// Ensure implements venv.PackageLoader.
+//
+// Resolved wheels are copied or downloaded into root, and their hash is
+// verified. The other packages are installed by Base.
+func (pl *PackageLoader) Ensure(c context.Context, root string, packages []*vpython.Spec_Package) error {
+	var other []*vpython.Spec_Package
+	for _, pkg := range packages {
+		if !strings.HasPrefix(pkg.Version, hashPrefix) {
+			other = append(other, pkg)
+			continue
+		}
+		if err := pl.fetch(c, root, pkg.Name, strings.TrimPrefix(pkg.Version, hashPrefix)); err != nil {
+			return errors.Annotate(err).Reason("failed to install wheel %(location)s").
+				D("location", pkg.Name).
+				Err()
+		}
+	}
+
+	if len(other) == 0 {
+		return nil
+	}
+	if pl.Base == nil {
+		return errors.New("no base package loader for non-wheel packages")
+	}
+	return pl.Base.Ensure(c, root, other)
+}
+
+// wheelFile is a wheel file offered by a source.
+type wheelFile struct {
+	name wheel.Name
+	// location is the absolute path or the URL of the file.
+	location string
+	// sha256 is the hex-encoded SHA256 of the file, if known.
+	sha256 string
+}
+
+type wheelFileSlice []*wheelFile
+
+func (s wheelFileSlice) Len() int           { return len(s) }
+func (s wheelFileSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s wheelFileSlice) Less(i, j int) bool { return s[i].name.String() < s[j].name.String() }
+
+// source lists the wheel files of a distribution.
+type source interface {
+	list(c context.Context, dist string) ([]*wheelFile, error)
+}
+
+func (pl *PackageLoader) sourceFor(spec *vpython.Spec) (source, error) {
+	dir := pl.Dir
+	if spec.WheelDir != "" {
+		dir = spec.WheelDir
+	}
+	switch {
+	case dir != "":
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, errors.Annotate(err).Reason("failed to get absolute path of: %(dir)s").
+				D("dir", dir).
+				Err()
+		}
+		return dirSource(abs), nil
+
+	case pl.IndexURL != "":
+		return &indexSource{pl, strings.TrimSuffix(pl.IndexURL, "/")}, nil
+
+	default:
+		return nil, errors.New("no wheel directory or package index configured")
+	}
+}
+
+// dirSource is a local directory of wheel files.
+type dirSource string
+
+func (s dirSource) list(c context.Context, dist string) ([]*wheelFile, error) {
+	infos, err := ioutil.ReadDir(string(s))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*wheelFile
+	for _, fi := range infos {
+		if fi.IsDir() {
+			continue
+		}
+		if f := parseWheelFile(fi.Name(), dist); f != nil {
+			f.location = filepath.Join(string(s), fi.Name())
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
+
+// indexSource is a PEP 503 "simple" package index.
+type indexSource struct {
+	pl      *PackageLoader
+	baseURL string
+}
+
+func (s *indexSource) list(c context.Context, dist string) ([]*wheelFile, error) {
+	pageURL, err := url.Parse(s.baseURL + "/" + wheel.NormalizeName(dist) + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ctxhttp.Get(c, s.pl.client(), pageURL.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Reason("GET %(url)s returned HTTP %(status)d").
+			D("url", pageURL.String()).
+			D("status", resp.StatusCode).
+			Err()
+	}
+
+	links, err := parseLinks(resp.Body)
+	if err != nil {
+		return nil, errors.Annotate(err).Reason("failed to parse index page").Err()
+	}
+
+	var files []*wheelFile
+	for _, href := range links {
+		u, err := pageURL.Parse(href)
+		if err != nil {
+			continue
+		}
+		f := parseWheelFile(path.Base(u.Path), dist)
+		if f == nil {
+			continue
+		}
+		// The fragment can carry the hash of the file: "#<hashname>=<value>".
+		if frag := strings.SplitN(u.Fragment, "=", 2); len(frag) == 2 && frag[0] == "sha256" {
+			f.sha256 = strings.ToLower(frag[1])
+		}
+		u.Fragment = ""
+		f.location = u.String()
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// parseLinks returns the "href" of the anchors of an HTML page.
+func parseLinks(r io.Reader) ([]string, error) {
+	var links []string
+	z := html.NewTokenizer(r)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if err := z.Err(); err != io.EOF {
+				return nil, err
+			}
+			return links, nil
+
+		case html.StartTagToken:
+			name, hasAttr := z.TagName()
+			if string(name) != "a" {
+				continue
+			}
+			for hasAttr {
+				var key, val []byte
+				key, val, hasAttr = z.TagAttr()
+				if string(key) == "href" {
+					links = append(links, string(val))
+				}
+			}
+		}
+	}
+}
+
+// parseWheelFile returns the wheelFile of a file name if it is a wheel of the
+// named distribution, or nil if it isn't.
+func parseWheelFile(base, dist string) *wheelFile {
+	if !strings.HasSuffix(base, ".whl") {
+		return nil
+	}
+	name, err := wheel.ParseName(base)
+	if err != nil || wheel.NormalizeName(name.Distribution) != wheel.NormalizeName(dist) {
+		return nil
+	}
+	return &wheelFile{name: name}
+}
+
+// bestMatch returns the wheel file of the given version that supports the most
+// preferred of the supplied PEP425 tags, or nil if there is none.
+//
+// The tags are ordered by preference, as returned by "pip".
+func bestMatch(files []*wheelFile, version string, tags []*vpython.Pep425Tag) *wheelFile {
+	// Sort by name, so that the choice among equivalent files is deterministic.
+	sorted := make(wheelFileSlice, 0, len(files))
+	for _, f := range files {
+		if f.name.Version == version {
+			sorted = append(sorted, f)
+		}
+	}
+	sort.Sort(sorted)
+
+	var best *wheelFile
+	bestRank := len(tags)
+	for _, f := range sorted {
+		for rank, tag := range tags[:bestRank] {
+			if supports(f.name, tag) {
+				best, bestRank = f, rank
+				break
+			}
+		}
+	}
+	return best
+}
+
+// supports returns true if a wheel supports a PEP425 tag. Wheel tags can be
+// compressed sets (e.g., "py2.py3").
+func supports(name wheel.Name, tag *vpython.Pep425Tag) bool {
+	has := func(set, v string) bool {
+		for _, s := range strings.Split(set, ".") {
+			if s == v {
+				return true
+			}
+		}
+		return false
+	}
+	return has(name.PythonTag, tag.Version) && has(name.ABITag, tag.Abi) && has(name.PlatformTag, tag.Arch)
+}
+
+func (pl *PackageLoader) client() *http.Client {
+	if pl.Client != nil {
+		return pl.Client
+	}
+	return http.DefaultClient
+}
+
+// open opens the file at location, a local path or a URL.
+func (pl *PackageLoader) open(c context.Context, location string) (io.ReadCloser, error) {
+	if !isURL(location) {
+		return os.Open(location)
+	}
+	resp, err := ctxhttp.Get(c, pl.client(), location)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.Reason("GET %(url)s returned HTTP %(status)d").
+			D("url", location).
+			D("status", resp.StatusCode).
+			Err()
+	}
+	return resp.Body, nil
+}
+
+// hashLocation returns the hex-encoded SHA256 of the file at location.
+func (pl *PackageLoader) hashLocation(c context.Context, location string) (string, error) {
+	r, err := pl.open(c, location)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// fetch copies the wheel file at location into root, verifying its hash.
+func (pl *PackageLoader) fetch(c context.Context, root, location, sha256Hex string) error {
+	base := filepath.Base(location)
+	if isURL(location) {
+		u, err := url.Parse(location)
+		if err != nil {
+			return err
+		}
+		base = path.Base(u.Path)
+	}
+	if _, err := wheel.ParseName(base); err != nil {
+		return errors.Annotate(err).Reason("invalid wheel file name %(name)q").
+			D("name", base).
+			Err()
+	}
+
+	r, err := pl.open(c, location)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	dst := filepath.Join(root, base)
+	fd, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(fd, h), r)
+	if cerr := fd.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		if got := hex.EncodeToString(h.Sum(nil)); got != sha256Hex {
+			err = errors.Reason("hash mismatch: expected %(expected)s, got %(got)s").
+				D("expected", sha256Hex).
+				D("got", got).
+				Err()
+		}
+	}
+	if err != nil {
+		os.Remove(dst)
+		return err
+	}
+	logging.Debugf(c, "Installed wheel %s into: %s", location, dst)
+	return nil
+}
+
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
@@ -0,0 +1,223 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package pypi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/vpython/api/vpython"
+
+	"github.com/luci/luci-go/common/testing/testfs"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func hashOf(content string) string {
+	h := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(h[:])
+}
+
+// testBaseLoader resolves and installs non-wheel packages by name.
+type testBaseLoader struct {
+	ensured []*vpython.Spec_Package
+}
+
+func (l *testBaseLoader) Resolve(c context.Context, e *vpython.Environment) error {
+	if len(e.Spec.Wheel) > 0 {
+		return fmt.Errorf("unexpected wheels")
+	}
+	e.Spec.Virtualenv.Version = "resolved"
+	return nil
+}
+
+func (l *testBaseLoader) Ensure(c context.Context, root string, packages []*vpython.Spec_Package) error {
+	l.ensured = append(l.ensured, packages...)
+	return nil
+}
+
+func TestPackageLoader(t *testing.T) {
+	t.Parallel()
+
+	linux := &vpython.Pep425Tag{Version: "cp27", Abi: "cp27mu", Arch: "linux_x86_64"}
+	pure := &vpython.Pep425Tag{Version: "py2", Abi: "none", Arch: "any"}
+	tags := []*vpython.Pep425Tag{linux, pure}
+
+	files := map[string]string{
+		"Foo_Bar-1.0-py2.py3-none-any.whl":         "foo pure",
+		"Foo_Bar-1.0-cp27-cp27mu-linux_x86_64.whl": "foo linux",
+		"Foo_Bar-2.0-py2.py3-none-any.whl":         "foo 2",
+		"baz-1.0-py3-none-any.whl":                 "baz py3",
+		"Foo_Bar-1.0.tar.gz":                       "foo sdist",
+	}
+
+	Convey(`Testing the PyPI package loader`, t, testfs.MustWithTempDir(t, "TestPackageLoader", func(tdir string) {
+		c := context.Background()
+
+		wheelDir := filepath.Join(tdir, "wheels")
+		layout := map[string]string{}
+		for name, content := range files {
+			layout[filepath.Join("wheels", name)] = content
+		}
+		So(testfs.Build(tdir, layout), ShouldBeNil)
+
+		root := filepath.Join(tdir, "root")
+		So(testfs.Build(root, map[string]string{".keep": ""}), ShouldBeNil)
+
+		env := func(wheels ...*vpython.Spec_Package) *vpython.Environment {
+			return &vpython.Environment{
+				Spec:      &vpython.Spec{Wheel: wheels},
+				Pep425Tag: tags,
+			}
+		}
+
+		Convey(`With a local directory`, func() {
+			pl := PackageLoader{Dir: wheelDir}
+
+			Convey(`Resolves to the best matching wheel`, func() {
+				e := env(&vpython.Spec_Package{Name: "foo-bar", Version: "1.0"})
+				So(pl.Resolve(c, e), ShouldBeNil)
+				So(e.Spec.Wheel[0], ShouldResemble, &vpython.Spec_Package{
+					Name:    filepath.Join(wheelDir, "Foo_Bar-1.0-cp27-cp27mu-linux_x86_64.whl"),
+					Version: "sha256:" + hashOf("foo linux"),
+				})
+
+				// Resolving again is a noop.
+				resolved := *e.Spec.Wheel[0]
+				So(pl.Resolve(c, e), ShouldBeNil)
+				So(e.Spec.Wheel[0], ShouldResemble, &resolved)
+
+				Convey(`Installs it, verifying its hash`, func() {
+					So(pl.Ensure(c, root, e.Spec.Wheel), ShouldBeNil)
+					data, err := ioutil.ReadFile(filepath.Join(root, "Foo_Bar-1.0-cp27-cp27mu-linux_x86_64.whl"))
+					So(err, ShouldBeNil)
+					So(string(data), ShouldEqual, "foo linux")
+				})
+
+				Convey(`Fails to install it if it changed`, func() {
+					So(ioutil.WriteFile(e.Spec.Wheel[0].Name, []byte("changed"), 0644), ShouldBeNil)
+					So(pl.Ensure(c, root, e.Spec.Wheel), ShouldErrLike, "hash mismatch")
+					_, err := ioutil.ReadFile(filepath.Join(root, "Foo_Bar-1.0-cp27-cp27mu-linux_x86_64.whl"))
+					So(err, ShouldNotBeNil)
+				})
+			})
+
+			Convey(`Falls back to less preferred tags`, func() {
+				e := env(&vpython.Spec_Package{Name: "foo_bar", Version: "2.0"})
+				So(pl.Resolve(c, e), ShouldBeNil)
+				So(e.Spec.Wheel[0].Name, ShouldEqual, filepath.Join(wheelDir, "Foo_Bar-2.0-py2.py3-none-any.whl"))
+			})
+
+			Convey(`Fails if no wheel matches`, func() {
+				e := env(&vpython.Spec_Package{Name: "baz", Version: "1.0"})
+				So(pl.Resolve(c, e), ShouldErrLike, "matches the PEP425 tags")
+
+				e = env(&vpython.Spec_Package{Name: "foo-bar", Version: "3.0"})
+				So(pl.Resolve(c, e), ShouldErrLike, "matches the PEP425 tags")
+			})
+
+			Convey(`Uses the spec's wheel directory`, func() {
+				otherDir := filepath.Join(tdir, "other")
+				So(testfs.Build(otherDir, map[string]string{
+					"Foo_Bar-1.0-py2-none-any.whl": "other foo",
+				}), ShouldBeNil)
+
+				e := env(&vpython.Spec_Package{Name: "foo-bar", Version: "1.0"})
+				e.Spec.WheelDir = otherDir
+				So(pl.Resolve(c, e), ShouldBeNil)
+				So(e.Spec.Wheel[0], ShouldResemble, &vpython.Spec_Package{
+					Name:    filepath.Join(otherDir, "Foo_Bar-1.0-py2-none-any.whl"),
+					Version: "sha256:" + hashOf("other foo"),
+				})
+			})
+
+			Convey(`Uses the base loader for other packages`, func() {
+				e := env(&vpython.Spec_Package{Name: "foo-bar", Version: "1.0"})
+				e.Spec.Virtualenv = &vpython.Spec_Package{Name: "virtualenv", Version: "latest"}
+				So(pl.Resolve(c, e), ShouldErrLike, "no base package loader")
+
+				base := testBaseLoader{}
+				pl.Base = &base
+				So(pl.Resolve(c, e), ShouldBeNil)
+				So(e.Spec.Virtualenv.Version, ShouldEqual, "resolved")
+
+				packages := append([]*vpython.Spec_Package{e.Spec.Virtualenv}, e.Spec.Wheel...)
+				So(pl.Ensure(c, root, packages), ShouldBeNil)
+				So(base.ensured, ShouldResemble, []*vpython.Spec_Package{e.Spec.Virtualenv})
+			})
+		})
+
+		Convey(`With a package index`, func() {
+			var requests []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.URL.Path)
+				switch r.URL.Path {
+				case "/simple/foo-bar/":
+					fmt.Fprintf(w, `<!DOCTYPE html><html><body>
+<a href="../../files/Foo_Bar-1.0.tar.gz">Foo_Bar-1.0.tar.gz</a>
+<a href="../../files/Foo_Bar-1.0-py2.py3-none-any.whl#sha256=%s">Foo_Bar-1.0-py2.py3-none-any.whl</a>
+<a href="/files/Foo_Bar-2.0-py2.py3-none-any.whl#md5=0123">Foo_Bar-2.0-py2.py3-none-any.whl</a>
+</body></html>`, hashOf(files["Foo_Bar-1.0-py2.py3-none-any.whl"]))
+
+				case "/files/Foo_Bar-1.0-py2.py3-none-any.whl", "/files/Foo_Bar-2.0-py2.py3-none-any.whl":
+					content, err := ioutil.ReadFile(filepath.Join(wheelDir, filepath.Base(r.URL.Path)))
+					if err != nil {
+						http.Error(w, err.Error(), http.StatusInternalServerError)
+						return
+					}
+					w.Write(content)
+
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer srv.Close()
+
+			pl := PackageLoader{IndexURL: srv.URL + "/simple/"}
+
+			Convey(`Uses the hash of the index`, func() {
+				e := env(&vpython.Spec_Package{Name: "Foo.Bar", Version: "1.0"})
+				So(pl.Resolve(c, e), ShouldBeNil)
+				So(e.Spec.Wheel[0], ShouldResemble, &vpython.Spec_Package{
+					Name:    srv.URL + "/files/Foo_Bar-1.0-py2.py3-none-any.whl",
+					Version: "sha256:" + hashOf("foo pure"),
+				})
+				So(requests, ShouldResemble, []string{"/simple/foo-bar/"})
+
+				So(pl.Ensure(c, root, e.Spec.Wheel), ShouldBeNil)
+				data, err := ioutil.ReadFile(filepath.Join(root, "Foo_Bar-1.0-py2.py3-none-any.whl"))
+				So(err, ShouldBeNil)
+				So(string(data), ShouldEqual, "foo pure")
+			})
+
+			Convey(`Hashes the wheel if the index has no SHA256`, func() {
+				e := env(&vpython.Spec_Package{Name: "foo-bar", Version: "2.0"})
+				So(pl.Resolve(c, e), ShouldBeNil)
+				So(e.Spec.Wheel[0].Version, ShouldEqual, "sha256:"+hashOf("foo 2"))
+				So(requests, ShouldResemble, []string{"/simple/foo-bar/", "/files/Foo_Bar-2.0-py2.py3-none-any.whl"})
+			})
+
+			Convey(`Fails for unknown distributions`, func() {
+				e := env(&vpython.Spec_Package{Name: "unknown", Version: "1.0"})
+				So(pl.Resolve(c, e), ShouldErrLike, "HTTP 404")
+			})
+		})
+
+		Convey(`Fails without a directory or an index`, func() {
+			pl := PackageLoader{}
+			So(pl.Resolve(c, env(&vpython.Spec_Package{Name: "foo-bar", Version: "1.0"})),
+				ShouldErrLike, "no wheel directory or package index")
+		})
+	}))
+}
@@ -5,6 +5,7 @@
 package python
 
 import (
+	"os"
 	"os/exec"
 
 	"github.com/luci/luci-go/common/errors"
@@ -32,10 +33,18 @@ func Find(c context.Context, vers Version) (*Interpreter, error) {
 	}
 	searches = append(searches, pv.PythonBase())
 
-	for _, s := range searches {
+	return FindIn(c, vers, searches...)
+}
+
+// FindIn returns the first of the supplied Python interpreters that exists and
+// matches the supplied version.
+//
+// Each interpreter is either a name to look up in PATH or a path.
+func FindIn(c context.Context, vers Version, pythons ...string) (*Interpreter, error) {
+	for _, s := range pythons {
 		p, err := exec.LookPath(s)
 		if err != nil {
-			if e, ok := err.(*exec.Error); ok && e.Err == exec.ErrNotFound {
+			if e, ok := err.(*exec.Error); ok && (e.Err == exec.ErrNotFound || os.IsNotExist(e.Err)) {
 				// Not found is okay.
 				continue
 			}
@@ -27,6 +27,11 @@ type Interpreter struct {
 	cachedVersion   *Version
 	cachedVersionMu sync.Mutex
 
+	// cachedABI is the cached ABI string for this interpreter. It is populated
+	// on the first GetABI call.
+	cachedABI   string
+	cachedABIMu sync.Mutex
+
 	// testCommandHook, if not nil, is called on generated Command results prior
 	// to returning them.
 	testCommandHook func(*exec.Cmd)
@@ -85,6 +90,56 @@ func (i *Interpreter) GetVersion(c context.Context) (v Version, err error) {
 	return
 }
 
+// abiScript prints the implementation and version, the ABI flags, the machine
+// and the pointer size of the interpreter. Python 2 has no "sys.abiflags", so
+// they are computed like PEP 3149 does.
+const abiScript = `import platform, struct, sys, sysconfig
+impl = {'CPython': 'cp', 'PyPy': 'pp'}.get(
+    platform.python_implementation(), platform.python_implementation().lower())
+flags = getattr(sys, 'abiflags', None)
+if flags is None:
+  flags = ''
+  if hasattr(sys, 'gettotalrefcount'):
+    flags += 'd'
+  if sysconfig.get_config_var('WITH_PYMALLOC'):
+    flags += 'm'
+  if sys.maxunicode == 0x10ffff:
+    flags += 'u'
+sys.stdout.write('%s%d%d%s-%s-%d' % (impl, sys.version_info[0], sys.version_info[1],
+    flags, platform.machine(), struct.calcsize('P') * 8))
+`
+
+// GetABI returns a string describing the binary interface of the interpreter.
+//
+// It combines the implementation and version, the ABI flags, the machine and
+// the pointer size of the interpreter (e.g., "cp27mu-x86_64-64"). Two
+// interpreters with the same ABI string can share compiled extensions.
+func (i *Interpreter) GetABI(c context.Context) (string, error) {
+	i.cachedABIMu.Lock()
+	defer i.cachedABIMu.Unlock()
+
+	if i.cachedABI != "" {
+		return i.cachedABI, nil
+	}
+
+	cmd := i.IsolatedCommand(c, "-c", abiScript)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", errors.Annotate(err).Reason("failed to probe the ABI of %(python)s").
+			D("python", i.Python).
+			Err()
+	}
+	abi := strings.TrimSpace(string(out))
+	if abi == "" {
+		return "", errors.Reason("empty ABI output from %(python)s").
+			D("python", i.Python).
+			Err()
+	}
+
+	i.cachedABI = abi
+	return abi, nil
+}
+
 func parseVersionOutput(output string) (Version, error) {
 	// Expected output:
 	// Python X.Y.Z
@@ -195,3 +195,46 @@ func TestInterpreterGetVersion(t *testing.T) {
 		}
 	})
 }
+
+func TestInterpreterGetABI(t *testing.T) {
+	t.Parallel()
+
+	// Like TestInterpreterGetVersion, our test binary dumps the ABI string.
+	self, err := os.Executable()
+	if err != nil {
+		t.Fatalf("failed to get executable: %s", err)
+	}
+	abiString := ""
+
+	Convey(`Testing Interpreter.GetABI`, t, func() {
+		c := context.Background()
+
+		i := Interpreter{
+			Python: self,
+
+			testCommandHook: func(cmd *exec.Cmd) {
+				var env environ.Env
+				env.Set(testGetVersionENV, abiString)
+				cmd.Env = env.Sorted()
+			},
+		}
+
+		Convey(`Can read the ABI string, and caches it`, func() {
+			abiString = "cp27mu-x86_64-64\n"
+			abi, err := i.GetABI(c)
+			So(err, ShouldBeNil)
+			So(abi, ShouldEqual, "cp27mu-x86_64-64")
+
+			abiString = "cp36m-x86_64-64"
+			abi, err = i.GetABI(c)
+			So(err, ShouldBeNil)
+			So(abi, ShouldEqual, "cp27mu-x86_64-64")
+		})
+
+		Convey(`Will fail on empty output`, func() {
+			abiString = ""
+			_, err := i.GetABI(c)
+			So(err, ShouldErrLike, "empty ABI output")
+		})
+	})
+}
@@ -0,0 +1,201 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package spec
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/luci/luci-go/vpython/api/vpython"
+
+	"github.com/luci/luci-go/common/errors"
+)
+
+// IncludeWalkPrefix is the prefix of an "include" entry that names a file to
+// find by walking up from the including file's directory towards filesystem
+// root, instead of a relative path (e.g., ".../common.vpython").
+const IncludeWalkPrefix = ".../"
+
+// Provenance maps the name of each wheel of a merged specification to the path
+// of the specification file that defined it.
+type Provenance map[string]string
+
+// LoadWithProvenance loads the specification file at path, like Load, and also
+// returns the provenance of its wheels.
+func LoadWithProvenance(path string) (*vpython.Spec, Provenance, error) {
+	var r includeResolver
+	return r.loadFile(path)
+}
+
+// includeResolver loads specification files and merges their includes.
+type includeResolver struct {
+	// stack is the absolute paths of the specification files that are being
+	// loaded, used to detect include cycles.
+	stack []string
+}
+
+func (r *includeResolver) loadFile(path string) (*vpython.Spec, Provenance, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, errors.Annotate(err).Reason("failed to load file from: %(path)s").
+			D("path", path).
+			Err()
+	}
+
+	spec, err := Parse(string(content))
+	if err != nil {
+		return nil, nil, errors.Annotate(err).Err()
+	}
+	return r.resolve(spec, path)
+}
+
+// resolve returns the merged specification of spec, which was loaded from the
+// file at origin, and its includes.
+//
+// spec's own entries are merged on top of those of its includes.
+func (r *includeResolver) resolve(spec *vpython.Spec, origin string) (*vpython.Spec, Provenance, error) {
+	absOrigin, err := filepath.Abs(origin)
+	if err != nil {
+		return nil, nil, errors.Annotate(err).Reason("failed to get absolute path of: %(path)s").
+			D("path", origin).
+			Err()
+	}
+	for _, path := range r.stack {
+		if path == absOrigin {
+			return nil, nil, errors.Reason("include cycle through: %(path)s").
+				D("path", origin).
+				Err()
+		}
+	}
+	r.stack = append(r.stack, absOrigin)
+	defer func() { r.stack = r.stack[:len(r.stack)-1] }()
+
+	merged, prov := &vpython.Spec{}, Provenance{}
+	for _, include := range spec.Include {
+		path, err := resolveIncludePath(absOrigin, include)
+		if err != nil {
+			return nil, nil, errors.Annotate(err).Reason("failed to resolve include %(include)q of: %(path)s").
+				D("include", include).
+				D("path", origin).
+				Err()
+		}
+
+		inc, incProv, err := r.loadFile(path)
+		if err != nil {
+			return nil, nil, errors.Annotate(err).Reason("failed to load include %(include)q of: %(path)s").
+				D("include", include).
+				D("path", origin).
+				Err()
+		}
+		inc.Lock = nil
+		mergeSpec(merged, prov, inc, incProv)
+	}
+
+	own := *spec
+	own.Include = nil
+	if own.WheelDir != "" {
+		own.WheelDir = resolveWheelDir(absOrigin, own.WheelDir)
+	}
+	ownProv := make(Provenance, len(own.Wheel))
+	for _, w := range own.Wheel {
+		ownProv[w.Name] = origin
+	}
+	mergeSpec(merged, prov, &own, ownProv)
+	return merged, prov, nil
+}
+
+// mergeSpec merges the entries of src on top of those of dst, and the
+// provenance of src's wheels into prov.
+func mergeSpec(dst *vpython.Spec, prov Provenance, src *vpython.Spec, srcProv Provenance) {
+	if src.PythonVersion != "" {
+		dst.PythonVersion = src.PythonVersion
+	}
+	if src.Virtualenv != nil {
+		dst.Virtualenv = src.Virtualenv
+	}
+	if len(src.VerifyPep425Tag) > 0 {
+		dst.VerifyPep425Tag = src.VerifyPep425Tag
+	}
+	if src.StdlibVenv {
+		dst.StdlibVenv = true
+	}
+	if len(src.Interpreter) > 0 {
+		dst.Interpreter = src.Interpreter
+	}
+	if len(src.Lock) > 0 {
+		dst.Lock = src.Lock
+	}
+	if src.WheelDir != "" {
+		dst.WheelDir = src.WheelDir
+	}
+
+	for _, w := range src.Wheel {
+		replaced := false
+		for i, dw := range dst.Wheel {
+			if dw.Name == w.Name {
+				dst.Wheel[i], replaced = w, true
+				break
+			}
+		}
+		if !replaced {
+			dst.Wheel = append(dst.Wheel, w)
+		}
+		prov[w.Name] = srcProv[w.Name]
+	}
+}
+
+// resolveWheelDir returns the path of the "wheel_dir" entry of the
+// specification file at origin, an absolute path.
+func resolveWheelDir(origin, wheelDir string) string {
+	path := filepath.FromSlash(wheelDir)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(origin), path)
+	}
+	return path
+}
+
+// resolveIncludePath returns the path of the specification file that the
+// "include" entry of the specification file at origin, an absolute path, names.
+func resolveIncludePath(origin, include string) (string, error) {
+	dir := filepath.Dir(origin)
+	if !strings.HasPrefix(include, IncludeWalkPrefix) {
+		path := filepath.FromSlash(include)
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		return path, nil
+	}
+
+	name := filepath.FromSlash(strings.TrimPrefix(include, IncludeWalkPrefix))
+	if name == "" {
+		return "", errors.New("no file name to walk for")
+	}
+
+	// Walk until we hit root.
+	prevDir := ""
+	for prevDir != dir {
+		path := filepath.Join(dir, name)
+		if path != origin {
+			switch st, err := os.Stat(path); {
+			case err == nil && !st.IsDir():
+				return path, nil
+
+			case err != nil && !os.IsNotExist(err):
+				return "", errors.Annotate(err).Reason("failed to stat spec file at: %(path)s").
+					D("path", path).
+					Err()
+			}
+		}
+
+		// Walk up a directory.
+		dir, prevDir = filepath.Dir(dir), dir
+	}
+	return "", errors.Reason("no file named %(name)q in %(dir)s or its parents").
+		D("name", name).
+		D("dir", filepath.Dir(origin)).
+		Err()
+}
@@ -0,0 +1,133 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package spec
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/luci/luci-go/common/testing/testfs"
+	"github.com/luci/luci-go/vpython/api/vpython"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLoadWithProvenance(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Test LoadWithProvenance`, t, testfs.MustWithTempDir(t, "TestLoadWithProvenance", func(tdir string) {
+		makePath := func(path string) string {
+			return filepath.Join(tdir, filepath.FromSlash(path))
+		}
+		mustBuild := func(layout map[string]string) {
+			if err := testfs.Build(tdir, layout); err != nil {
+				panic(err)
+			}
+		}
+
+		Convey(`Merges includes in order, then the including spec`, func() {
+			mustBuild(map[string]string{
+				"base/a.vpython": `python_version: "2.7"
+					wheel: <name: "foo" version: "1">
+					wheel: <name: "bar" version: "1">
+					verify_pep425_tag: <version: "cp27" abi: "cp27mu" arch: "linux_x86_64">`,
+				"base/b.vpython": `wheel: <name: "bar" version: "2">
+					lock: <pep425_tag: <version: "cp27" abi: "cp27mu" arch: "linux_x86_64">>`,
+				"spec.vpython": `include: "base/a.vpython"
+					include: "base/b.vpython"
+					python_version: "2.7.12"
+					wheel: <name: "baz" version: "3">`,
+			})
+
+			spec, prov, err := LoadWithProvenance(makePath("spec.vpython"))
+			So(err, ShouldBeNil)
+			So(spec, ShouldResemble, &vpython.Spec{
+				PythonVersion: "2.7.12",
+				Wheel: []*vpython.Spec_Package{
+					{Name: "foo", Version: "1"},
+					{Name: "bar", Version: "2"},
+					{Name: "baz", Version: "3"},
+				},
+				VerifyPep425Tag: []*vpython.Pep425Tag{
+					{Version: "cp27", Abi: "cp27mu", Arch: "linux_x86_64"},
+				},
+			})
+			So(prov, ShouldResemble, Provenance{
+				"foo": makePath("base/a.vpython"),
+				"bar": makePath("base/b.vpython"),
+				"baz": makePath("spec.vpython"),
+			})
+
+			// Load returns the same merged spec.
+			loaded, err := Load(makePath("spec.vpython"))
+			So(err, ShouldBeNil)
+			So(loaded, ShouldResemble, spec)
+		})
+
+		Convey(`Walks up to find a named include, skipping the including file`, func() {
+			mustBuild(map[string]string{
+				"common.vpython":         `wheel: <name: "foo" version: "1">`,
+				"foo/common.vpython":     `include: ".../common.vpython" wheel: <name: "bar" version: "2">`,
+				"foo/bar/script.vpython": `include: ".../common.vpython"`,
+			})
+
+			spec, prov, err := LoadWithProvenance(makePath("foo/bar/script.vpython"))
+			So(err, ShouldBeNil)
+			So(spec.Wheel, ShouldResemble, []*vpython.Spec_Package{
+				{Name: "foo", Version: "1"},
+				{Name: "bar", Version: "2"},
+			})
+			So(prov, ShouldResemble, Provenance{
+				"foo": makePath("common.vpython"),
+				"bar": makePath("foo/common.vpython"),
+			})
+		})
+
+		Convey(`Resolves wheel_dir relative to the spec file that declares it`, func() {
+			mustBuild(map[string]string{
+				"base/common.vpython": `wheel_dir: "wheels"`,
+				"foo/spec.vpython":    `include: "../base/common.vpython"`,
+				"bar/spec.vpython":    `include: "../base/common.vpython" wheel_dir: "../bar_wheels"`,
+			})
+
+			spec, err := Load(makePath("foo/spec.vpython"))
+			So(err, ShouldBeNil)
+			So(spec.WheelDir, ShouldEqual, makePath("base/wheels"))
+
+			spec, err = Load(makePath("bar/spec.vpython"))
+			So(err, ShouldBeNil)
+			So(spec.WheelDir, ShouldEqual, makePath("bar_wheels"))
+		})
+
+		Convey(`Fails if a named include can't be found`, func() {
+			mustBuild(map[string]string{
+				"spec.vpython": `include: ".../nonexistent.vpython"`,
+			})
+
+			_, _, err := LoadWithProvenance(makePath("spec.vpython"))
+			So(err, ShouldErrLike, `no file named "nonexistent.vpython"`)
+		})
+
+		Convey(`Fails on include cycles`, func() {
+			mustBuild(map[string]string{
+				"a.vpython": `include: "b.vpython"`,
+				"b.vpython": `include: "a.vpython"`,
+			})
+
+			_, _, err := LoadWithProvenance(makePath("a.vpython"))
+			So(err, ShouldErrLike, "include cycle through")
+		})
+
+		Convey(`Fails on bad includes`, func() {
+			mustBuild(map[string]string{
+				"spec.vpython": `include: "missing.vpython"`,
+			})
+
+			_, _, err := LoadWithProvenance(makePath("spec.vpython"))
+			So(err, ShouldErrLike, "failed to load file from")
+		})
+	}))
+}
@@ -6,7 +6,6 @@ package spec
 
 import (
 	"bufio"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -26,6 +25,14 @@ import (
 // See LoadForScript for more information.
 const Suffix = ".vpython"
 
+// LockSuffix is the filesystem suffix of a locked specification file, which is
+// generated by "vpython freeze" next to the specification file whose name it
+// extends (e.g., "test.py.vpython.lock").
+//
+// If a locked specification file exists, it is loaded instead of its
+// specification file.
+const LockSuffix = ".lock"
+
 // CommonName is the name of the "common" specification file.
 //
 // If a script doesn't explicitly specific a specification file, "vpython" will
@@ -42,19 +49,12 @@ const (
 )
 
 // Load loads an specification file text protobuf from the supplied path.
+//
+// The specification files that it includes are loaded and merged into the
+// returned specification.
 func Load(path string) (*vpython.Spec, error) {
-	content, err := ioutil.ReadFile(path)
-	if err != nil {
-		return nil, errors.Annotate(err).Reason("failed to load file from: %(path)s").
-			D("path", path).
-			Err()
-	}
-
-	spec, err := Parse(string(content))
-	if err != nil {
-		return nil, errors.Annotate(err).Err()
-	}
-	return spec, nil
+	spec, _, err := LoadWithProvenance(path)
+	return spec, err
 }
 
 // Parse loads a specification message from a content string.
@@ -128,7 +128,8 @@ type Loader struct {
 // The embedded specification is a text protobuf embedded within the file. To
 // parse it, the file is scanned line-by-line for a beginning and ending guard.
 // The content between those guards is minimally processed, then interpreted as
-// a text protobuf.
+// a text protobuf. If the file has several guarded regions, they are merged in
+// order, as if each included the previous ones.
 //
 //	[VPYTHON:BEGIN]
 //	wheel {
@@ -159,22 +160,39 @@ type Loader struct {
 // script's location, looking for a file named CommonName. If it finds one, it
 // will use that as the specification file. This enables scripts to implicitly
 // share an specification.
+//
+// Includes
+// ========
+//
+// Whichever way it is found, the specification files that a specification
+// includes are loaded and merged into it. Includes are relative to the
+// directory of the file that includes them, including for inline
+// specifications.
 func (l *Loader) LoadForScript(c context.Context, path string, isModule bool) (*vpython.Spec, error) {
+	spec, _, err := l.LoadForScriptWithProvenance(c, path, isModule)
+	return spec, err
+}
+
+// LoadForScriptWithProvenance loads a spec file for the specified script, like
+// LoadForScript, and also returns the provenance of its wheels.
+func (l *Loader) LoadForScriptWithProvenance(c context.Context, path string, isModule bool) (
+	*vpython.Spec, Provenance, error) {
+
 	// Partner File: Try loading the spec from an adjacent file.
 	specPath, err := l.findForScript(path, isModule)
 	if err != nil {
-		return nil, errors.Annotate(err).Reason("failed to scan for filesystem spec").Err()
+		return nil, nil, errors.Annotate(err).Reason("failed to scan for filesystem spec").Err()
 	}
 	if specPath != "" {
-		switch sp, err := Load(specPath); {
+		switch sp, prov, err := loadPreferringLock(c, specPath); {
 		case err != nil:
-			return nil, errors.Annotate(err).Reason("failed to load specification file").
+			return nil, nil, errors.Annotate(err).Reason("failed to load specification file").
 				D("specPath", specPath).
 				Err()
 
 		case sp != nil:
 			logging.Infof(c, "Loaded specification from: %s", specPath)
-			return sp, nil
+			return sp, prov, nil
 		}
 	}
 
@@ -184,34 +202,51 @@ func (l *Loader) LoadForScript(c context.Context, path string, isModule bool) (*
 		// Module.
 		mainScript = filepath.Join(mainScript, "__main__.py")
 	}
-	switch sp, err := l.parseFrom(mainScript); {
+	switch sp, prov, err := l.parseFrom(mainScript); {
 	case err != nil:
-		return nil, errors.Annotate(err).Reason("failed to parse inline spec from: %(script)s").
+		return nil, nil, errors.Annotate(err).Reason("failed to parse inline spec from: %(script)s").
 			D("script", mainScript).
 			Err()
 
 	case sp != nil:
 		logging.Infof(c, "Loaded inline spec from: %s", mainScript)
-		return sp, nil
+		return sp, prov, nil
 	}
 
 	// Common: Try and identify a common specification file.
 	switch path, err := l.findCommonWalkingFrom(filepath.Dir(mainScript)); {
 	case err != nil:
-		return nil, err
+		return nil, nil, err
 
 	case path != "":
-		spec, err := Load(path)
+		spec, prov, err := loadPreferringLock(c, path)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		logging.Infof(c, "Loaded common spec from: %s", path)
-		return spec, nil
+		return spec, prov, nil
 	}
 
 	// Couldn't identify a specification file.
-	return nil, nil
+	return nil, nil, nil
+}
+
+// loadPreferringLock loads the specification file at path, or its locked
+// specification file if there is one.
+func loadPreferringLock(c context.Context, path string) (*vpython.Spec, Provenance, error) {
+	lockPath := path + LockSuffix
+	switch st, err := os.Stat(lockPath); {
+	case err == nil && !st.IsDir():
+		logging.Debugf(c, "Using locked specification: %s", lockPath)
+		return LoadWithProvenance(lockPath)
+
+	case err != nil && !os.IsNotExist(err):
+		return nil, nil, errors.Annotate(err).Reason("failed to check for locked spec file at: %(path)s").
+			D("path", lockPath).
+			Err()
+	}
+	return LoadWithProvenance(path)
 }
 
 func (l *Loader) findForScript(path string, isModule bool) (string, error) {
@@ -264,10 +299,13 @@ func (l *Loader) findForScript(path string, isModule bool) (string, error) {
 	}
 }
 
-func (l *Loader) parseFrom(path string) (*vpython.Spec, error) {
+// parseFrom parses the inline specification regions of the file at path, and
+// merges them in order. If the file has no inline specification, parseFrom
+// returns a nil spec.
+func (l *Loader) parseFrom(path string) (*vpython.Spec, Provenance, error) {
 	fd, err := os.Open(path)
 	if err != nil {
-		return nil, errors.Annotate(err).Reason("failed to open file").Err()
+		return nil, nil, errors.Annotate(err).Reason("failed to open file").Err()
 	}
 	defer fd.Close()
 
@@ -284,9 +322,9 @@ func (l *Loader) parseFrom(path string) (*vpython.Spec, error) {
 
 	s := bufio.NewScanner(fd)
 	var (
+		regions   []string
 		content   []string
 		beginLine string
-		endLine   string
 		inRegion  = false
 	)
 	for s.Scan() {
@@ -296,25 +334,59 @@ func (l *Loader) parseFrom(path string) (*vpython.Spec, error) {
 			beginLine = line
 		} else {
 			if strings.HasSuffix(line, endGuard) {
-				// Finished processing.
-				endLine = line
-				break
+				// Finished processing this region.
+				if len(content) > 0 {
+					regions = append(regions, trimInlinePrefix(content, beginLine, line, beginGuard, endGuard))
+				}
+				content, inRegion = nil, false
+				continue
 			}
 			content = append(content, line)
 		}
 	}
 	if err := s.Err(); err != nil {
-		return nil, errors.Annotate(err).Reason("error scanning file").Err()
+		return nil, nil, errors.Annotate(err).Reason("error scanning file").Err()
+	}
+	if len(content) > 0 {
+		return nil, nil, errors.New("unterminated inline spec file")
 	}
-	if len(content) == 0 {
-		return nil, nil
+	if len(regions) == 0 {
+		return nil, nil, nil
 	}
-	if endLine == "" {
-		return nil, errors.New("unterminated inline spec file")
+
+	// Process the resulting regions. Each of them is merged on top of the
+	// previous ones.
+	var (
+		r            includeResolver
+		merged, prov = &vpython.Spec{}, Provenance{}
+	)
+	for i, region := range regions {
+		spec, err := Parse(region)
+		if err != nil {
+			return nil, nil, errors.Annotate(err).Reason("failed to parse spec file from: %(path)s").
+				D("path", path).
+				D("region", i).
+				Err()
+		}
+
+		spec, regionProv, err := r.resolve(spec, path)
+		if err != nil {
+			return nil, nil, errors.Annotate(err).Err()
+		}
+		if i == 0 {
+			merged, prov = spec, regionProv
+			continue
+		}
+		mergeSpec(merged, prov, spec, regionProv)
 	}
+	return merged, prov, nil
+}
 
-	// If we have a common begin/end prefix, trim it from each content line that
-	// also has it.
+// trimInlinePrefix joins the content lines of an inline specification region.
+//
+// If its begin and end lines have a common prefix, it is trimmed from each
+// content line that also has it.
+func trimInlinePrefix(content []string, beginLine, endLine, beginGuard, endGuard string) string {
 	prefix := beginLine[:len(beginLine)-len(beginGuard)]
 	if endLine[:len(endLine)-len(endGuard)] != prefix {
 		prefix = ""
@@ -334,15 +406,7 @@ func (l *Loader) parseFrom(path string) (*vpython.Spec, error) {
 			content[i] = line
 		}
 	}
-
-	// Process the resulting file.
-	spec, err := Parse(strings.Join(content, "\n"))
-	if err != nil {
-		return nil, errors.Annotate(err).Reason("failed to parse spec file from: %(path)s").
-			D("path", path).
-			Err()
-	}
-	return spec, nil
+	return strings.Join(content, "\n")
 }
 
 func (l *Loader) findCommonWalkingFrom(startDir string) (string, error) {
@@ -93,6 +93,27 @@ func TestLoadForScript(t *testing.T) {
 			So(spec, ShouldResemble, goodSpec)
 		})
 
+		Convey(`Layout: individual file with a locked spec file`, func() {
+			lockedSpec := goodSpec.Clone()
+			lockedSpec.Lock = []*vpython.Spec_Lock{
+				{
+					Pep425Tag: &vpython.Pep425Tag{Version: "cp34", Abi: "cp34m", Arch: "linux_x86_64"},
+					Wheel: []*vpython.Spec_Package{
+						{Name: "foo/bar", Version: "1111111111111111111111111111111111111111"},
+						{Name: "baz/qux", Version: "2222222222222222222222222222222222222222"},
+					},
+				},
+			}
+			mustBuild(map[string]string{
+				"pants.py":              "PANTS!",
+				"pants.py.vpython":      goodSpecData,
+				"pants.py.vpython.lock": proto.MarshalTextString(lockedSpec),
+			})
+			spec, err := l.LoadForScript(c, makePath("pants.py"), false)
+			So(err, ShouldBeNil)
+			So(spec, ShouldResemble, lockedSpec)
+		})
+
 		Convey(`Layout: individual file with a bad spec file`, func() {
 			mustBuild(map[string]string{
 				"pants.py":         "PANTS!",
@@ -219,6 +240,40 @@ func TestLoadForScript(t *testing.T) {
 			So(err, ShouldErrLike, "unterminated inline spec file")
 		})
 
+		Convey(`Layout: individual file with several inline specs and an include`, func() {
+			mustBuild(map[string]string{
+				"common.vpython": goodSpecData,
+				"foo/pants.py": strings.Join([]string{
+					"#!/usr/bin/env vpython",
+					"",
+					"# [VPYTHON:BEGIN]",
+					`# include: ".../common.vpython"`,
+					`# wheel: <name: "foo/bar" version: "3">`,
+					"# [VPYTHON:END]",
+					"",
+					"# [VPYTHON:BEGIN]",
+					`# wheel: <name: "pants/shirt" version: "4">`,
+					"# [VPYTHON:END]",
+				}, "\n"),
+			})
+
+			spec, prov, err := l.LoadForScriptWithProvenance(c, makePath("foo/pants.py"), false)
+			So(err, ShouldBeNil)
+			So(spec, ShouldResemble, &vpython.Spec{
+				PythonVersion: "3.4.0",
+				Wheel: []*vpython.Spec_Package{
+					{Name: "foo/bar", Version: "3"},
+					{Name: "baz/qux", Version: "2"},
+					{Name: "pants/shirt", Version: "4"},
+				},
+			})
+			So(prov, ShouldResemble, Provenance{
+				"foo/bar":     makePath("foo/pants.py"),
+				"baz/qux":     makePath("common.vpython"),
+				"pants/shirt": makePath("foo/pants.py"),
+			})
+		})
+
 		Convey(`Layout: individual file with a common spec`, func() {
 			mustBuild(map[string]string{
 				"foo/bar/baz.py":      "main",
@@ -231,6 +286,18 @@ func TestLoadForScript(t *testing.T) {
 			So(spec, ShouldResemble, goodSpec)
 		})
 
+		Convey(`Layout: individual file with a bad locked common spec`, func() {
+			mustBuild(map[string]string{
+				"foo/bar/baz.py":      "main",
+				"foo/bar/__init__.py": "",
+				"common.vpython":      goodSpecData,
+				"common.vpython.lock": badSpecData,
+			})
+
+			_, err := l.LoadForScript(c, makePath("foo/bar/baz.py"), false)
+			So(err, ShouldErrLike, "failed to unmarshal vpython.Spec")
+		})
+
 		Convey(`Layout: individual file with a common spec behind a barrier`, func() {
 			mustBuild(map[string]string{
 				"foo/bar/baz.py":      "main",
@@ -0,0 +1,110 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package spec
+
+import (
+	"sort"
+
+	"github.com/luci/luci-go/vpython/api/vpython"
+
+	"github.com/luci/luci-go/common/errors"
+
+	"golang.org/x/net/context"
+)
+
+// ResolveFunc resolves the packages of an Environment's Spec in place, for the
+// Environment's PEP425 tags.
+//
+// venv.PackageLoader's Resolve method is a ResolveFunc.
+type ResolveFunc func(context.Context, *vpython.Environment) error
+
+// Freeze resolves the wheels and the VirtualEnv package of the supplied "spec"
+// for each of the supplied PEP425 tags, and replaces the locks of "spec" with
+// the results.
+//
+// The "wheel" entries of "spec" are left untouched, so that the locked spec
+// can be frozen again when they change.
+func Freeze(c context.Context, spec *vpython.Spec, tags []*vpython.Pep425Tag, resolve ResolveFunc) error {
+	if len(tags) == 0 {
+		return errors.New("no PEP425 tags to freeze the specification for")
+	}
+
+	unlocked := spec.Clone()
+	unlocked.Lock = nil
+
+	locks := make([]*vpython.Spec_Lock, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		if _, ok := seen[tag.TagString()]; ok {
+			continue
+		}
+		seen[tag.TagString()] = struct{}{}
+
+		e := vpython.Environment{
+			Spec:      unlocked.Clone(),
+			Pep425Tag: []*vpython.Pep425Tag{tag},
+		}
+		if err := resolve(c, &e); err != nil {
+			return errors.Annotate(err).Reason("failed to resolve wheels for %(tag)q").
+				D("tag", tag.TagString()).
+				Err()
+		}
+		locks = append(locks, &vpython.Spec_Lock{
+			Pep425Tag:  tag,
+			Wheel:      e.Spec.Wheel,
+			Virtualenv: e.Spec.Virtualenv,
+		})
+	}
+
+	spec.Lock = locks
+	return nil
+}
+
+// ApplyLock replaces the wheels and the VirtualEnv package of the supplied
+// "spec" with those of its lock for the first of the supplied PEP425 tags that
+// has one. The locks are removed
+// from "spec", so that a locked spec and the spec it was frozen from share
+// their environments.
+//
+// The applied lock is returned. If none of the tags has a lock, the wheels are
+// left untouched and nil is returned.
+func ApplyLock(spec *vpython.Spec, tags []*vpython.Pep425Tag) *vpython.Spec_Lock {
+	locks := spec.Lock
+	spec.Lock = nil
+
+	for _, tag := range tags {
+		for _, lock := range locks {
+			if lt := lock.Pep425Tag; lt != nil && lt.TagString() == tag.TagString() {
+				spec.Wheel = lock.Wheel
+				if lock.Virtualenv != nil {
+					spec.Virtualenv = lock.Virtualenv
+				}
+				return lock
+			}
+		}
+	}
+	return nil
+}
+
+func normalizeLocks(spec *vpython.Spec) error {
+	seen := make(map[string]struct{}, len(spec.Lock))
+	for i, lock := range spec.Lock {
+		if lock.Pep425Tag.IsZero() {
+			return errors.Reason("lock entry #%(index)d has no PEP425 tag").
+				D("index", i).
+				Err()
+		}
+		tag := lock.Pep425Tag.TagString()
+		if _, ok := seen[tag]; ok {
+			return errors.Reason("duplicate lock entries for PEP425 tag %(tag)q").
+				D("tag", tag).
+				Err()
+		}
+		seen[tag] = struct{}{}
+
+		sort.Sort(specPackageSlice(lock.Wheel))
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package spec
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/luci/luci-go/vpython/api/vpython"
+
+	"golang.org/x/net/context"
+
+	. "github.com/luci/luci-go/common/testing/assertions"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFreezeAndApplyLock(t *testing.T) {
+	t.Parallel()
+
+	linux := &vpython.Pep425Tag{Version: "cp27", Abi: "cp27mu", Arch: "manylinux1_x86_64"}
+	mac := &vpython.Pep425Tag{Version: "cp27", Abi: "cp27m", Arch: "macosx_10_10_x86_64"}
+	other := &vpython.Pep425Tag{Version: "cp27", Abi: "none", Arch: "any"}
+
+	// resolve pins each package to a fake instance ID derived from the tag.
+	resolve := func(c context.Context, e *vpython.Environment) error {
+		tag := e.Pep425Tag[0]
+		if tag.Abi == "none" {
+			return fmt.Errorf("no wheels for %s", tag.TagString())
+		}
+		for _, w := range append(e.Spec.Wheel, e.Spec.Virtualenv) {
+			w.Name = fmt.Sprintf("%s/%s", w.Name, tag.Arch)
+			w.Version = fmt.Sprintf("%s@%s", w.Version, tag.Abi)
+		}
+		return nil
+	}
+
+	Convey(`Test locked specifications`, t, func() {
+		c := context.Background()
+
+		spec := vpython.Spec{
+			Wheel: []*vpython.Spec_Package{
+				{Name: "foo", Version: "latest"},
+				{Name: "bar", Version: "version:1"},
+			},
+			Virtualenv: &vpython.Spec_Package{Name: "virtualenv", Version: "latest"},
+		}
+
+		Convey(`Freeze locks the wheels and the VirtualEnv package for each tag`, func() {
+			So(Freeze(c, &spec, []*vpython.Pep425Tag{linux, mac, linux}, resolve), ShouldBeNil)
+			So(spec.Wheel, ShouldResemble, []*vpython.Spec_Package{
+				{Name: "foo", Version: "latest"},
+				{Name: "bar", Version: "version:1"},
+			})
+			So(spec.Virtualenv, ShouldResemble, &vpython.Spec_Package{Name: "virtualenv", Version: "latest"})
+			So(spec.Lock, ShouldResemble, []*vpython.Spec_Lock{
+				{
+					Pep425Tag: linux,
+					Wheel: []*vpython.Spec_Package{
+						{Name: "foo/manylinux1_x86_64", Version: "latest@cp27mu"},
+						{Name: "bar/manylinux1_x86_64", Version: "version:1@cp27mu"},
+					},
+					Virtualenv: &vpython.Spec_Package{Name: "virtualenv/manylinux1_x86_64", Version: "latest@cp27mu"},
+				},
+				{
+					Pep425Tag: mac,
+					Wheel: []*vpython.Spec_Package{
+						{Name: "foo/macosx_10_10_x86_64", Version: "latest@cp27m"},
+						{Name: "bar/macosx_10_10_x86_64", Version: "version:1@cp27m"},
+					},
+					Virtualenv: &vpython.Spec_Package{Name: "virtualenv/macosx_10_10_x86_64", Version: "latest@cp27m"},
+				},
+			})
+			So(Normalize(&spec, nil), ShouldBeNil)
+
+			Convey(`ApplyLock uses the lock of the first matching tag`, func() {
+				lock := ApplyLock(&spec, []*vpython.Pep425Tag{other, mac, linux})
+				So(lock, ShouldNotBeNil)
+				So(lock.Pep425Tag, ShouldResemble, mac)
+				So(spec.Lock, ShouldBeNil)
+				So(spec.Wheel, ShouldResemble, []*vpython.Spec_Package{
+					{Name: "bar/macosx_10_10_x86_64", Version: "version:1@cp27m"},
+					{Name: "foo/macosx_10_10_x86_64", Version: "latest@cp27m"},
+				})
+				So(spec.Virtualenv, ShouldResemble, &vpython.Spec_Package{
+					Name: "virtualenv/macosx_10_10_x86_64", Version: "latest@cp27m"})
+			})
+
+			Convey(`ApplyLock leaves the wheels if no tag matches`, func() {
+				So(ApplyLock(&spec, []*vpython.Pep425Tag{other}), ShouldBeNil)
+				So(spec.Lock, ShouldBeNil)
+				So(spec.Wheel, ShouldResemble, []*vpython.Spec_Package{
+					{Name: "bar", Version: "version:1"},
+					{Name: "foo", Version: "latest"},
+				})
+				So(spec.Virtualenv, ShouldResemble, &vpython.Spec_Package{Name: "virtualenv", Version: "latest"})
+			})
+		})
+
+		Convey(`Freeze fails if a tag can't be resolved`, func() {
+			So(Freeze(c, &spec, []*vpython.Pep425Tag{linux, other}, resolve), ShouldErrLike, "no wheels for")
+			So(spec.Lock, ShouldBeNil)
+		})
+
+		Convey(`Freeze fails without tags`, func() {
+			So(Freeze(c, &spec, nil, resolve), ShouldErrLike, "no PEP425 tags")
+		})
+
+		Convey(`Normalize rejects invalid locks`, func() {
+			spec.Lock = []*vpython.Spec_Lock{{}}
+			So(Normalize(&spec, nil), ShouldErrLike, "has no PEP425 tag")
+
+			spec.Lock = []*vpython.Spec_Lock{{Pep425Tag: linux}, {Pep425Tag: linux}}
+			So(Normalize(&spec, nil), ShouldErrLike, "duplicate lock entries")
+		})
+	})
+}
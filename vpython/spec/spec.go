@@ -8,6 +8,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"path"
 	"sort"
 
 	"github.com/luci/luci-go/vpython/api/vpython"
@@ -24,10 +25,34 @@ func Render(spec *vpython.Spec) string { return proto.MarshalTextString(spec) }
 // Normalize normalizes the specification Message such that two messages
 // with identical meaning will have identical representation.
 func Normalize(spec *vpython.Spec, defaultVENVPackage *vpython.Spec_Package) error {
-	if spec.Virtualenv == nil {
+	if len(spec.Include) > 0 {
+		// Includes are merged when a specification is loaded.
+		return errors.New("a specification's includes must be merged before it is normalized")
+	}
+
+	if spec.StdlibVenv {
+		// The standard library "venv" module doesn't use a VirtualEnv package.
+		if spec.Virtualenv != nil {
+			return errors.New("a VirtualEnv package can't be used with stdlib_venv")
+		}
+	} else if spec.Virtualenv == nil {
 		spec.Virtualenv = defaultVENVPackage
 	}
 
+	for i, interp := range spec.Interpreter {
+		if interp.Python == "" {
+			return errors.Reason("interpreter entry #%(index)d has no Python").
+				D("index", i).
+				Err()
+		}
+		if _, err := path.Match(interp.Platform, ""); err != nil {
+			return errors.Annotate(err).Reason("invalid platform pattern %(platform)q in interpreter entry #%(index)d").
+				D("platform", interp.Platform).
+				D("index", i).
+				Err()
+		}
+	}
+
 	sort.Sort(specPackageSlice(spec.Wheel))
 
 	// No duplicate packages. Since we're sorted, we can just check for no
@@ -40,15 +65,42 @@ func Normalize(spec *vpython.Spec, defaultVENVPackage *vpython.Spec_Package) err
 		}
 	}
 
-	return nil
+	return normalizeLocks(spec)
+}
+
+// Interpreters returns the Python interpreters that the supplied "spec"
+// declares for platform, in order of preference.
+//
+// The platform is "<os>-<arch>" (e.g., "linux-amd64"). If the returned list is
+// empty, the default system interpreter should be used.
+func Interpreters(spec *vpython.Spec, platform string) ([]string, error) {
+	var pythons []string
+	for _, interp := range spec.Interpreter {
+		if interp.Platform != "" {
+			switch matched, err := path.Match(interp.Platform, platform); {
+			case err != nil:
+				return nil, errors.Annotate(err).Reason("invalid platform pattern %(platform)q").
+					D("platform", interp.Platform).
+					Err()
+			case !matched:
+				continue
+			}
+		}
+		pythons = append(pythons, interp.Python)
+	}
+	return pythons, nil
 }
 
 // Hash hashes the contents of the supplied "spec" and returns the result as
 // a hex-encoded string.
 //
+// If rt is not nil, the version and ABI of the Python runtime are factored into
+// the hash, so that environments built by different interpreters don't
+// collide.
+//
 // If not empty, the contents of extra are prefixed to hash string. This can
 // be used to factor additional influences into the spec hash.
-func Hash(spec *vpython.Spec, extra string) string {
+func Hash(spec *vpython.Spec, rt *vpython.Runtime, extra string) string {
 	data, err := proto.Marshal(spec)
 	if err != nil {
 		panic(fmt.Errorf("failed to marshal proto: %v", err))
@@ -65,6 +117,9 @@ func Hash(spec *vpython.Spec, extra string) string {
 		mustWrite(fmt.Fprintf(hash, "%s:", extra))
 	}
 	mustWrite(fmt.Fprintf(hash, "%s:", vpython.Version))
+	if rt != nil {
+		mustWrite(fmt.Fprintf(hash, "%s:%s:", rt.Version, rt.Abi))
+	}
 	mustWrite(hash.Write(data))
 	return hex.EncodeToString(hash.Sum(nil))
 }
@@ -37,8 +37,39 @@ func TestNormalizeAndHash(t *testing.T) {
 				Wheel: []*vpython.Spec_Package{pkgBar, pkgBaz, pkgFoo},
 			})
 
-			So(Hash(&spec, ""), ShouldEqual, "b4221081c43e8319ceb71a2e9d3bd83701b726a0976380feac4d04825226f935")
-			So(Hash(&spec, "extra"), ShouldEqual, "01a8d5f5a6f7b2cd91ce6f2b5fefb931828e28b90d0fb07a271597eaa4f6c547")
+			So(Hash(&spec, nil, ""), ShouldEqual, "b4221081c43e8319ceb71a2e9d3bd83701b726a0976380feac4d04825226f935")
+			So(Hash(&spec, nil, "extra"), ShouldEqual, "01a8d5f5a6f7b2cd91ce6f2b5fefb931828e28b90d0fb07a271597eaa4f6c547")
+		})
+
+		Convey(`Will hash the runtime version and ABI.`, func() {
+			So(Normalize(&spec, pkgFoo), ShouldBeNil)
+
+			py27 := &vpython.Runtime{Path: "/usr/bin/python", Version: "2.7.13", Abi: "cp27mu-x86_64-64"}
+			py27Other := &vpython.Runtime{Path: "/opt/bin/python", Version: "2.7.13", Abi: "cp27mu-x86_64-64"}
+			py27Narrow := &vpython.Runtime{Path: "/usr/bin/python", Version: "2.7.13", Abi: "cp27m-x86_64-64"}
+			py36 := &vpython.Runtime{Path: "/usr/bin/python", Version: "3.6.1", Abi: "cp36m-x86_64-64"}
+
+			So(Hash(&spec, py27, ""), ShouldNotEqual, Hash(&spec, nil, ""))
+			So(Hash(&spec, py27, ""), ShouldEqual, Hash(&spec, py27Other, ""))
+			So(Hash(&spec, py27, ""), ShouldNotEqual, Hash(&spec, py27Narrow, ""))
+			So(Hash(&spec, py27, ""), ShouldNotEqual, Hash(&spec, py36, ""))
+		})
+
+		Convey(`Will not add a VirtualEnv package to a stdlib venv spec.`, func() {
+			spec.StdlibVenv = true
+			So(Normalize(&spec, pkgFoo), ShouldBeNil)
+			So(spec.Virtualenv, ShouldBeNil)
+
+			spec.Virtualenv = pkgBar
+			So(Normalize(&spec, pkgFoo), ShouldErrLike, "can't be used with stdlib_venv")
+		})
+
+		Convey(`Will fail to normalize invalid interpreters.`, func() {
+			spec.Interpreter = []*vpython.Spec_Interpreter{{Platform: "linux-*"}}
+			So(Normalize(&spec, pkgFoo), ShouldErrLike, "has no Python")
+
+			spec.Interpreter = []*vpython.Spec_Interpreter{{Platform: "linux-[", Python: "python3"}}
+			So(Normalize(&spec, pkgFoo), ShouldErrLike, "invalid platform pattern")
 		})
 
 		Convey(`Will fail to normalize if there are duplicate wheels.`, func() {
@@ -53,3 +84,38 @@ func TestNormalizeAndHash(t *testing.T) {
 		})
 	})
 }
+
+func TestInterpreters(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Test interpreter selection`, t, func() {
+		spec := vpython.Spec{
+			Interpreter: []*vpython.Spec_Interpreter{
+				{Platform: "linux-*", Python: "/opt/python3/bin/python3"},
+				{Platform: "darwin-amd64", Python: "python3.6"},
+				{Python: "python3"},
+			},
+		}
+
+		Convey(`Returns the matching interpreters in order`, func() {
+			pythons, err := Interpreters(&spec, "linux-arm64")
+			So(err, ShouldBeNil)
+			So(pythons, ShouldResemble, []string{"/opt/python3/bin/python3", "python3"})
+
+			pythons, err = Interpreters(&spec, "darwin-amd64")
+			So(err, ShouldBeNil)
+			So(pythons, ShouldResemble, []string{"python3.6", "python3"})
+
+			pythons, err = Interpreters(&spec, "windows-386")
+			So(err, ShouldBeNil)
+			So(pythons, ShouldResemble, []string{"python3"})
+		})
+
+		Convey(`Returns nothing if no interpreter matches`, func() {
+			spec.Interpreter = spec.Interpreter[:2]
+			pythons, err := Interpreters(&spec, "windows-386")
+			So(err, ShouldBeNil)
+			So(pythons, ShouldBeNil)
+		})
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"time"
 	"unicode/utf8"
 
@@ -42,6 +43,11 @@ type Config struct {
 	// based on the Spec and the current PATH.
 	Python string
 
+	// Platform is the "<os>-<arch>" platform used to choose among the
+	// interpreters declared by the Spec. If empty, the current platform will be
+	// used.
+	Platform string
+
 	// Spec is the specification file to use to construct the VirtualEnv. If
 	// nil, or if fields are missing, they will be filled in by probing the system
 	// PATH.
@@ -70,6 +76,16 @@ type Config struct {
 	// what is allowed by the operating system.
 	MaxScriptPathLen int
 
+	// ShareWheels, if true, installs wheels into a content-addressed store in
+	// BaseDir that is shared by all environments, instead of installing a copy of
+	// each wheel into each environment. Store entries are keyed by the hash of
+	// the wheel file and the environment's PEP425 tag, and environments reference
+	// them through a ".pth" file. Pruning removes the entries that are no longer
+	// referenced.
+	//
+	// Scripts installed by shared wheels aren't added to environments' BinDir.
+	ShareWheels bool
+
 	// si is the system Python interpreter. It is resolved during
 	// "resolvePythonInterpreter".
 	si *python.Interpreter
@@ -96,12 +112,14 @@ func (cfg *Config) WithoutWheels() *Config {
 	clone := *cfg
 	clone.Spec = clone.Spec.Clone()
 	clone.Spec.Wheel = nil
+	clone.Spec.Lock = nil
 	return &clone
 }
 
-// HasWheels returns true if this environment declares wheel dependencies.
+// HasWheels returns true if this environment declares wheel dependencies,
+// directly or through the locks of its specification.
 func (cfg *Config) HasWheels() bool {
-	return cfg.Spec != nil && len(cfg.Spec.Wheel) > 0
+	return cfg.Spec != nil && (len(cfg.Spec.Wheel) > 0 || len(cfg.Spec.Lock) > 0)
 }
 
 // makeEnv processes the config, validating and, where appropriate, populating
@@ -113,6 +131,22 @@ func (cfg *Config) HasWheels() bool {
 // The returned Env instance may or may not actually exist. Setup must be called
 // prior to using it.
 func (cfg *Config) makeEnv(c context.Context, e *vpython.Environment) (*Env, error) {
+	env, err := cfg.resolveEnv(c, e)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that our base directory exists.
+	if err := filesystem.MakeDirs(cfg.BaseDir); err != nil {
+		return nil, errors.Annotate(err).Reason("could not create environment root: %(root)s").
+			D("root", cfg.BaseDir).
+			Err()
+	}
+	return env, nil
+}
+
+// resolveEnv is like makeEnv, but doesn't create anything on disk.
+func (cfg *Config) resolveEnv(c context.Context, e *vpython.Environment) (*Env, error) {
 	// We MUST have a package loader.
 	if cfg.Loader == nil {
 		return nil, errors.New("no package loader provided")
@@ -152,8 +186,9 @@ func (cfg *Config) makeEnv(c context.Context, e *vpython.Environment) (*Env, err
 		return nil, errors.Annotate(err).Reason("invalid specification").Err()
 	}
 
-	// Choose our VirtualEnv package.
-	if cfg.Spec.Virtualenv == nil {
+	// Choose our VirtualEnv package. Environments using the standard library
+	// "venv" module don't need one.
+	if !cfg.Spec.StdlibVenv && cfg.Spec.Virtualenv == nil {
 		cfg.Spec.Virtualenv = &cfg.Package
 	}
 
@@ -161,30 +196,34 @@ func (cfg *Config) makeEnv(c context.Context, e *vpython.Environment) (*Env, err
 	e = e.Clone()
 	e.Spec = cfg.Spec.Clone()
 
+	// If the specification is locked, use the wheels locked for our PEP425 tags.
+	if len(e.Spec.Lock) > 0 {
+		if lock := spec.ApplyLock(e.Spec, e.Pep425Tag); lock != nil {
+			logging.Debugf(c, "Using wheels locked for PEP425 tag: %s", lock.Pep425Tag.TagString())
+		} else if len(e.Pep425Tag) > 0 {
+			logging.Warningf(c, "Specification has no lock for this system's PEP425 tags; resolving its wheels.")
+		}
+	}
+
 	if err := cfg.Loader.Resolve(c, e); err != nil {
 		return nil, errors.Annotate(err).Reason("failed to resolve packages").Err()
 	}
 
-	if err := cfg.resolvePythonInterpreter(c, e.Spec); err != nil {
+	rt, err := cfg.resolvePythonInterpreter(c, e.Spec)
+	if err != nil {
 		return nil, errors.Annotate(err).Reason("failed to resolve system Python interpreter").Err()
 	}
-
-	// Ensure that our base directory exists.
-	if err := filesystem.MakeDirs(cfg.BaseDir); err != nil {
-		return nil, errors.Annotate(err).Reason("could not create environment root: %(root)s").
-			D("root", cfg.BaseDir).
-			Err()
-	}
+	e.Runtime = rt
 
 	// Generate our environment name based on the deterministic hash of its
-	// fully-resolved specification.
-	return cfg.envForName(cfg.envNameForSpec(e.Spec), e), nil
+	// fully-resolved specification and of the interpreter's runtime.
+	return cfg.envForName(cfg.envNameForSpec(e.Spec, e.Runtime), e), nil
 }
 
 // EnvName returns the VirtualEnv environment name for the environment that cfg
 // describes.
-func (cfg *Config) envNameForSpec(s *vpython.Spec) string {
-	name := spec.Hash(s, EnvironmentVersion)
+func (cfg *Config) envNameForSpec(s *vpython.Spec, rt *vpython.Runtime) string {
+	name := spec.Hash(s, rt, EnvironmentVersion)
 	if cfg.MaxHashLen > 0 && len(name) > cfg.MaxHashLen {
 		name = name[:cfg.MaxHashLen]
 	}
@@ -219,10 +258,16 @@ func (cfg *Config) envForName(name string, e *vpython.Environment) *Env {
 	}
 }
 
-func (cfg *Config) resolvePythonInterpreter(c context.Context, s *vpython.Spec) error {
+// resolvePythonInterpreter resolves the system Python interpreter and returns
+// its runtime.
+//
+// If cfg doesn't specify an interpreter, the interpreters declared by the
+// specification for cfg's platform are tried in order. If it declares none, one
+// is found in PATH.
+func (cfg *Config) resolvePythonInterpreter(c context.Context, s *vpython.Spec) (*vpython.Runtime, error) {
 	specVers, err := python.ParseVersion(s.PythonVersion)
 	if err != nil {
-		return errors.Annotate(err).Reason("failed to parse Python version from: %(value)q").
+		return nil, errors.Annotate(err).Reason("failed to parse Python version from: %(value)q").
 			D("value", s.PythonVersion).
 			Err()
 	}
@@ -230,8 +275,24 @@ func (cfg *Config) resolvePythonInterpreter(c context.Context, s *vpython.Spec)
 	if cfg.Python == "" {
 		// No explicitly-specified Python path. Determine one based on the
 		// specification.
-		if cfg.si, err = python.Find(c, specVers); err != nil {
-			return errors.Annotate(err).Reason("could not find Python for: %(vers)s").
+		platform := cfg.Platform
+		if platform == "" {
+			platform = runtime.GOOS + "-" + runtime.GOARCH
+		}
+		pythons, err := spec.Interpreters(s, platform)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(pythons) > 0 {
+			if cfg.si, err = python.FindIn(c, specVers, pythons...); err != nil {
+				return nil, errors.Annotate(err).Reason("none of the interpreters for %(platform)s is usable: %(pythons)q").
+					D("platform", platform).
+					D("pythons", pythons).
+					Err()
+			}
+		} else if cfg.si, err = python.Find(c, specVers); err != nil {
+			return nil, errors.Annotate(err).Reason("could not find Python for: %(vers)s").
 				D("vers", specVers).
 				Err()
 		}
@@ -246,26 +307,42 @@ func (cfg *Config) resolvePythonInterpreter(c context.Context, s *vpython.Spec)
 	// expected.
 	interpreterVers, err := cfg.si.GetVersion(c)
 	if err != nil {
-		return errors.Annotate(err).Reason("failed to determine Python version for: %(python)s").
+		return nil, errors.Annotate(err).Reason("failed to determine Python version for: %(python)s").
 			D("python", cfg.Python).
 			Err()
 	}
 	if !specVers.IsSatisfiedBy(interpreterVers) {
-		return errors.Reason("supplied Python version (%(supplied)s) doesn't match specification (%(spec)s)").
+		return nil, errors.Reason("supplied Python version (%(supplied)s) doesn't match specification (%(spec)s)").
 			D("supplied", interpreterVers).
 			D("spec", specVers).
 			Err()
 	}
+	if s.StdlibVenv && interpreterVers.Major < 3 {
+		return nil, errors.Reason("the standard library venv module requires Python 3, not %(supplied)s").
+			D("supplied", interpreterVers).
+			Err()
+	}
 	s.PythonVersion = interpreterVers.String()
 
+	abi, err := cfg.si.GetABI(c)
+	if err != nil {
+		return nil, errors.Annotate(err).Reason("failed to determine Python ABI for: %(python)s").
+			D("python", cfg.Python).
+			Err()
+	}
+
 	// Resolve to absolute path.
 	if err := filesystem.AbsPath(&cfg.Python); err != nil {
-		return errors.Annotate(err).Reason("could not get absolute path for: %(python)s").
+		return nil, errors.Annotate(err).Reason("could not get absolute path for: %(python)s").
 			D("python", cfg.Python).
 			Err()
 	}
-	logging.Debugf(c, "Resolved system Python interpreter (%s): %s", s.PythonVersion, cfg.Python)
-	return nil
+	logging.Debugf(c, "Resolved system Python interpreter (%s, %s): %s", s.PythonVersion, abi, cfg.Python)
+	return &vpython.Runtime{
+		Path:    cfg.Python,
+		Version: s.PythonVersion,
+		Abi:     abi,
+	}, nil
 }
 
 func (cfg *Config) systemInterpreter() *python.Interpreter { return cfg.si }
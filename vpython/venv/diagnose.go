@@ -0,0 +1,334 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package venv
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/danjacques/gofslock/fslock"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/vpython/api/vpython"
+	"github.com/luci/luci-go/vpython/wheel"
+
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/system/environ"
+)
+
+// Diagnosis is the result of the diagnostics of the environment that a Config
+// describes. It is generated by Diagnose.
+type Diagnosis struct {
+	// Name is the name of the environment.
+	Name string `json:"name"`
+	// Root is the environment's root directory.
+	Root string `json:"root"`
+	// Python is the path of the system Python interpreter that the environment
+	// is created from.
+	Python string `json:"python"`
+	// Runtime is the runtime of the system Python interpreter.
+	Runtime *vpython.Runtime `json:"runtime,omitempty"`
+	// Pep425Tags are the PEP425 tags of the system.
+	Pep425Tags []string `json:"pep425_tags,omitempty"`
+
+	// Complete is true if the environment exists and is complete.
+	Complete bool `json:"complete"`
+
+	// Wheels are the requested wheels, resolved, and the installed
+	// distributions that they match.
+	Wheels []*DiagnosedWheel `json:"wheels,omitempty"`
+	// UnrequestedDistributions are the installed distributions that don't match
+	// any requested wheel.
+	UnrequestedDistributions []*Distribution `json:"unrequested_distributions,omitempty"`
+
+	// ForeignPaths are the "sys.path" entries of the environment's interpreter
+	// that come neither from the environment nor from the system interpreter,
+	// when it runs with the diagnosed process environment.
+	ForeignPaths []string `json:"foreign_paths,omitempty"`
+
+	// Locks are the lock files in the Config's BaseDir.
+	Locks []*DiagnosedLock `json:"locks,omitempty"`
+
+	// Problems are human-readable descriptions of the problems found.
+	Problems []string `json:"problems,omitempty"`
+}
+
+// Distribution is a Python distribution installed in an environment.
+type Distribution struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// DiagnosedWheel is a requested wheel, and the installed distribution that it
+// matches.
+type DiagnosedWheel struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+
+	// Distribution is the installed distribution of the wheel, or nil if none
+	// matches it.
+	Distribution *Distribution `json:"distribution,omitempty"`
+}
+
+// DiagnosedLock is a lock file in an environment's BaseDir.
+type DiagnosedLock struct {
+	Path string `json:"path"`
+
+	// Held is true if another process holds the lock exclusively, creating,
+	// deleting or pruning what it protects.
+	Held bool `json:"held"`
+}
+
+func (d *Diagnosis) addProblem(format string, args ...interface{}) {
+	d.Problems = append(d.Problems, fmt.Sprintf(format, args...))
+}
+
+// Diagnose diagnoses the environment that cfg describes, without creating or
+// modifying it or anything else in cfg's BaseDir.
+//
+// The specification and the system interpreter are resolved like With does,
+// and the environment's stamp is checked against them. The environment's
+// installed distributions are compared to the requested wheels, and its
+// interpreter is run with env to find the "sys.path" entries that come from
+// elsewhere (e.g., PYTHONPATH). Finally, the lock files in cfg's BaseDir are
+// probed, with non-blocking shared locks, to find the ones that are held.
+//
+// An error is returned if the environment can't be resolved. The problems of
+// the environment itself are reported in the returned Diagnosis.
+func Diagnose(c context.Context, cfg Config, env environ.Env) (*Diagnosis, error) {
+	d := Diagnosis{}
+
+	// Use the empty probe environment's runtime data, like With does. If it
+	// doesn't exist, we can't resolve the environment without creating it.
+	var probe *vpython.Environment
+	if cfg.HasWheels() {
+		emptyEnv, err := cfg.WithoutWheels().resolveEnv(c, nil)
+		if err != nil {
+			return nil, errors.Annotate(err).Reason("failed to initialize empty probe environment").Err()
+		}
+		if err := emptyEnv.AssertCompleteAndLoad(); err != nil {
+			d.addProblem("The probe environment %s is not usable (%s); run vpython to create it.", emptyEnv.Root, err)
+			d.diagnoseLocks(emptyEnv.Config.BaseDir)
+			return &d, nil
+		}
+		probe = emptyEnv.Environment
+	}
+
+	e, err := cfg.resolveEnv(c, probe)
+	if err != nil {
+		return nil, err
+	}
+	want := e.Environment
+
+	d.Name, d.Root = e.Name, e.Root
+	d.Python = e.Config.systemInterpreter().Python
+	d.Runtime = want.Runtime
+	for _, tag := range want.Pep425Tag {
+		d.Pep425Tags = append(d.Pep425Tags, tag.TagString())
+	}
+
+	switch err := e.AssertCompleteAndLoad(); {
+	case err == ErrNotComplete:
+		d.addProblem("The environment %s does not exist or is not complete; run vpython to create it.", e.Root)
+
+	case err != nil:
+		d.addProblem("The environment stamp can't be loaded: %s", err)
+
+	default:
+		d.Complete = true
+		if !proto.Equal(e.Environment.Spec, want.Spec) {
+			d.addProblem("The environment stamp's specification does not match the resolved specification.")
+		}
+		if !sameRuntime(e.Environment.Runtime, want.Runtime) {
+			d.addProblem("The environment stamp's runtime (%s) does not match the system interpreter's runtime (%s).",
+				proto.CompactTextString(e.Environment.Runtime), proto.CompactTextString(want.Runtime))
+		}
+
+		if err := d.diagnoseDistributions(c, e, want.Spec.Wheel); err != nil {
+			d.addProblem("The installed distributions can't be listed: %s", err)
+		}
+		if err := d.diagnoseSysPath(c, e, env); err != nil {
+			d.addProblem("The interpreter's \"sys.path\" can't be loaded: %s", err)
+		}
+	}
+
+	for _, k := range []string{"PYTHONPATH", "PYTHONHOME"} {
+		if v, ok := env.Get(k); ok && v != "" {
+			d.addProblem("%s is set (%q). vpython ignores it, but processes using the environment's interpreter "+
+				"directly don't.", k, v)
+		}
+	}
+
+	d.diagnoseLocks(e.Config.BaseDir)
+	return &d, nil
+}
+
+// sameRuntime returns true if a and b have the same version and ABI.
+//
+// Their paths aren't compared: like the environment name, this allows using
+// the environment with any interpreter of the same runtime.
+func sameRuntime(a, b *vpython.Runtime) bool {
+	return a.GetVersion() == b.GetVersion() && a.GetAbi() == b.GetAbi()
+}
+
+// listDistributionsScript prints the distributions that are installed in the
+// environment as a JSON list of [name, version] pairs.
+const listDistributionsScript = `import json, sys
+try:
+  import importlib.metadata as md
+  dists = [(d.metadata['Name'], d.version) for d in md.distributions()]
+except ImportError:
+  import pkg_resources
+  dists = [(d.project_name, d.version) for d in pkg_resources.working_set]
+sys.stdout.write(json.dumps(sorted(d for d in dists if d[0])))
+`
+
+func (d *Diagnosis) diagnoseDistributions(c context.Context, e *Env, wheels []*vpython.Spec_Package) error {
+	out, err := e.Interpreter().IsolatedCommand(c, "-c", listDistributionsScript).Output()
+	if err != nil {
+		return err
+	}
+	var pairs [][2]string
+	if err := json.Unmarshal(out, &pairs); err != nil {
+		return errors.Annotate(err).Reason("failed to parse distributions").Err()
+	}
+
+	dists := make(map[string]*Distribution, len(pairs))
+	for _, p := range pairs {
+		dists[wheel.NormalizeName(p[0])] = &Distribution{Name: p[0], Version: p[1]}
+	}
+
+	matched := make(map[string]struct{}, len(wheels))
+	for _, w := range wheels {
+		dw := DiagnosedWheel{Name: w.Name, Version: w.Version}
+		for _, name := range wheelDistributionNames(w) {
+			if dist := dists[name]; dist != nil {
+				dw.Distribution = dist
+				matched[name] = struct{}{}
+				break
+			}
+		}
+		if dw.Distribution == nil {
+			d.addProblem("No installed distribution matches wheel %s@%s.", w.Name, w.Version)
+		}
+		d.Wheels = append(d.Wheels, &dw)
+	}
+
+	for _, p := range pairs {
+		if _, ok := matched[wheel.NormalizeName(p[0])]; !ok {
+			d.UnrequestedDistributions = append(d.UnrequestedDistributions, dists[wheel.NormalizeName(p[0])])
+		}
+	}
+	return nil
+}
+
+// wheelDistributionNames returns the normalized names of the distributions that
+// a resolved wheel package may install.
+//
+// If the package is a wheel file, it is the distribution of that file.
+// Otherwise, the package's name is a path (e.g., a CIPD package name such as
+// "infra/python/wheels/numpy/linux-amd64_cp27_cp27mu"), and any of its
+// components may be the distribution.
+func wheelDistributionNames(w *vpython.Spec_Package) []string {
+	base := path.Base(filepath.ToSlash(w.Name))
+	if name, err := wheel.ParseName(base); err == nil {
+		return []string{wheel.NormalizeName(name.Distribution)}
+	}
+
+	parts := strings.Split(w.Name, "/")
+	names := make([]string, 0, len(parts))
+	for i := len(parts) - 1; i >= 0; i-- {
+		if parts[i] != "" {
+			names = append(names, wheel.NormalizeName(parts[i]))
+		}
+	}
+	return names
+}
+
+// sysPathScript prints the interpreter's "sys.path", and the prefixes of the
+// system interpreter that the environment is based on, as JSON.
+const sysPathScript = `import json, sys
+prefixes = set([sys.prefix, sys.exec_prefix])
+for name in ('real_prefix', 'base_prefix', 'base_exec_prefix'):
+  if hasattr(sys, name):
+    prefixes.add(getattr(sys, name))
+sys.stdout.write(json.dumps({'path': sys.path, 'prefixes': sorted(prefixes)}))
+`
+
+func (d *Diagnosis) diagnoseSysPath(c context.Context, e *Env, env environ.Env) error {
+	// Run the interpreter like a process using it directly would, without the
+	// isolation flags of IsolatedCommand.
+	cmd := exec.CommandContext(c, e.Python, "-c", sysPathScript)
+	cmd.Env = env.Sorted()
+	out, err := cmd.Output()
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		Path     []string `json:"path"`
+		Prefixes []string `json:"prefixes"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		return errors.Annotate(err).Reason("failed to parse sys.path").Err()
+	}
+
+	allowed := append(result.Prefixes, e.Root, e.Config.wheelStoreDir())
+	for _, entry := range result.Path {
+		// The empty entry is the script's directory, or the working directory.
+		if entry == "" || hasPathPrefix(entry, allowed) {
+			continue
+		}
+		d.ForeignPaths = append(d.ForeignPaths, entry)
+	}
+	if len(d.ForeignPaths) > 0 {
+		d.addProblem("The environment's interpreter imports from %d foreign path(s): %s",
+			len(d.ForeignPaths), strings.Join(d.ForeignPaths, ", "))
+	}
+	return nil
+}
+
+func hasPathPrefix(path string, prefixes []string) bool {
+	path = filepath.Clean(path)
+	for _, prefix := range prefixes {
+		prefix = filepath.Clean(prefix)
+		if path == prefix || strings.HasPrefix(path, prefix+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// diagnoseLocks probes the lock files in baseDir.
+func (d *Diagnosis) diagnoseLocks(baseDir string) {
+	paths, err := filepath.Glob(filepath.Join(baseDir, ".*.lock"))
+	if err != nil {
+		d.addProblem("The lock files can't be listed: %s", err)
+		return
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		dl := DiagnosedLock{Path: path}
+		switch h, err := fslock.LockShared(path); err {
+		case nil:
+			h.Unlock()
+
+		case fslock.ErrLockHeld:
+			dl.Held = true
+			d.addProblem("Lock %s is held by another process.", path)
+
+		default:
+			d.addProblem("Lock %s can't be probed: %s", path, err)
+		}
+		d.Locks = append(d.Locks, &dl)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package venv
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/danjacques/gofslock/fslock"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/vpython/api/vpython"
+
+	"github.com/luci/luci-go/common/system/environ"
+	"github.com/luci/luci-go/common/testing/testfs"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiagnose(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Wheel distribution names`, t, func() {
+		So(wheelDistributionNames(&vpython.Spec_Package{
+			Name: "/path/to/wheels/Foo_Bar-1.0-py2.py3-none-any.whl",
+		}), ShouldResemble, []string{"foo-bar"})
+
+		So(wheelDistributionNames(&vpython.Spec_Package{
+			Name: "infra/python/wheels/six-py2_py3",
+		}), ShouldResemble, []string{"six-py2-py3", "wheels", "python", "infra"})
+	})
+
+	Convey(`Path prefixes`, t, func() {
+		prefixes := []string{filepath.FromSlash("/usr"), filepath.FromSlash("/env/root/")}
+		So(hasPathPrefix(filepath.FromSlash("/usr/lib/python2.7"), prefixes), ShouldBeTrue)
+		So(hasPathPrefix(filepath.FromSlash("/env/root"), prefixes), ShouldBeTrue)
+		So(hasPathPrefix(filepath.FromSlash("/usrlocal/lib"), prefixes), ShouldBeFalse)
+		So(hasPathPrefix(filepath.FromSlash("/home/user/src"), prefixes), ShouldBeFalse)
+	})
+
+	Convey(`Runtimes of interpreters at different paths are the same`, t, func() {
+		rt := &vpython.Runtime{Path: "/usr/bin/python2.7", Version: "2.7.12", Abi: "cp27mu-x86_64-64"}
+		So(sameRuntime(rt, &vpython.Runtime{Path: "/usr/local/bin/python2.7", Version: "2.7.12", Abi: "cp27mu-x86_64-64"}),
+			ShouldBeTrue)
+		So(sameRuntime(rt, &vpython.Runtime{Path: "/usr/bin/python2.7", Version: "2.7.13", Abi: "cp27mu-x86_64-64"}),
+			ShouldBeFalse)
+		So(sameRuntime(rt, &vpython.Runtime{Path: "/usr/bin/python2.7", Version: "2.7.12", Abi: "cp27m-x86_64-64"}),
+			ShouldBeFalse)
+		So(sameRuntime(rt, nil), ShouldBeFalse)
+	})
+
+	Convey(`Diagnosing locks`, t, testfs.MustWithTempDir(t, "TestDiagnose", func(tdir string) {
+		So(testfs.Build(tdir, map[string]string{
+			".env1.lock":   "",
+			".env2.lock":   "",
+			"env1/foo.txt": "",
+		}), ShouldBeNil)
+
+		var d Diagnosis
+		err := fslock.With(filepath.Join(tdir, ".env2.lock"), func() error {
+			d.diagnoseLocks(tdir)
+			return nil
+		})
+		So(err, ShouldBeNil)
+		So(d.Locks, ShouldResemble, []*DiagnosedLock{
+			{Path: filepath.Join(tdir, ".env1.lock")},
+			{Path: filepath.Join(tdir, ".env2.lock"), Held: true},
+		})
+		So(d.Problems, ShouldHaveLength, 1)
+	}))
+
+	Convey(`Diagnose doesn't create the environment root`, t, testfs.MustWithTempDir(t, "TestDiagnose", func(tdir string) {
+		cfg := Config{
+			BaseDir: filepath.Join(tdir, "base"),
+			Package: vpython.Spec_Package{Name: "foo/bar/virtualenv", Version: "unresolved"},
+			Loader:  &testingLoader{},
+			Spec: &vpython.Spec{
+				Wheel: []*vpython.Spec_Package{{Name: "foo/bar/shirt", Version: "unresolved"}},
+			},
+		}
+
+		// Diagnose may fail to find a system Python interpreter, but it must not
+		// create anything either way.
+		if d, err := Diagnose(context.Background(), cfg, environ.New(nil)); err == nil {
+			So(d.Complete, ShouldBeFalse)
+			So(d.Problems, ShouldNotBeEmpty)
+		}
+		So(cfg.BaseDir, shouldNotExist)
+	}))
+}
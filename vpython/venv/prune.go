@@ -50,7 +50,7 @@ func prune(c context.Context, cfg *Config, exempt stringset.Set) error {
 	)
 
 	// We need to cancel if we hit our prune limit.
-	c, cancelFunc := context.WithCancel(c)
+	iterC, cancelFunc := context.WithCancel(c)
 	defer cancelFunc()
 
 	// Iterate over all VirtualEnv directories, regardless of their completion
@@ -60,7 +60,7 @@ func prune(c context.Context, cfg *Config, exempt stringset.Set) error {
 		// this directory simultaneously.
 		Shuffle: true,
 	}
-	err := it.ForEach(c, cfg, func(c context.Context, e *Env) error {
+	err := it.ForEach(iterC, cfg, func(c context.Context, e *Env) error {
 		if exempt != nil && exempt.Has(e.Name) {
 			logging.Debugf(c, "Not pruning currently in-use environment: %s", e.Name)
 			return nil
@@ -98,6 +98,11 @@ func prune(c context.Context, cfg *Config, exempt stringset.Set) error {
 	}
 
 	logging.Infof(c, "Pruned %d environment(s)%s with %d error(s)", totalPruned, hitLimitStr, len(allErrs))
+
+	// Collect the shared wheels that are no longer referenced.
+	if err := pruneWheelStore(c, cfg); err != nil {
+		allErrs = append(allErrs, errors.Annotate(err).Reason("failed to prune shared wheel store").Err())
+	}
 	if len(allErrs) > 0 {
 		return allErrs
 	}
@@ -0,0 +1,305 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package venv
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/danjacques/gofslock/fslock"
+	"golang.org/x/net/context"
+
+	"github.com/luci/luci-go/vpython/wheel"
+
+	"github.com/luci/luci-go/common/data/stringset"
+	"github.com/luci/luci-go/common/errors"
+	"github.com/luci/luci-go/common/logging"
+	"github.com/luci/luci-go/common/system/filesystem"
+)
+
+const (
+	// wheelStoreName is the name of the shared wheel store directory in the
+	// Config's BaseDir. It is hidden, so it isn't mistaken for an environment.
+	wheelStoreName = ".wheels"
+
+	// wheelStoreLockName is the name of the shared wheel store lock file in the
+	// Config's BaseDir.
+	//
+	// Environments hold it shared while they add and reference store entries,
+	// and pruning holds it exclusively while it collects unreferenced entries.
+	wheelStoreLockName = ".wheels.lock"
+
+	// wheelRefsName is the name of the file in an environment's root listing
+	// the store entries that it references, one per line.
+	wheelRefsName = "shared_wheels.txt"
+
+	// wheelPthName is the name of the ".pth" file in an environment's
+	// site-packages directory that adds its store entries as site directories,
+	// so that the ".pth" files that they contain are processed too.
+	wheelPthName = "vpython-shared-wheels.pth"
+
+	// wheelStoreKeyLen is the number of hash characters of a store entry name.
+	wheelStoreKeyLen = 32
+)
+
+func (cfg *Config) wheelStoreDir() string { return filepath.Join(cfg.BaseDir, wheelStoreName) }
+
+func (cfg *Config) wheelStoreLockPath() string { return filepath.Join(cfg.BaseDir, wheelStoreLockName) }
+
+// wheelStoreKey returns the name of the store entry of a wheel file, installed
+// for a PEP425 tag.
+func wheelStoreKey(tag, wheelHash string) string {
+	h := sha256.Sum256([]byte(tag + ":" + wheelHash))
+	return hex.EncodeToString(h[:])[:wheelStoreKeyLen]
+}
+
+// installSharedWheels installs the wheels in pkgDir into the shared wheel
+// store, and references them from the environment.
+//
+// Each wheel is installed once per PEP425 tag into its own store entry. The
+// environment references its entries through a ".pth" file in its
+// site-packages directory, and lists them in its wheelRefsName file so that
+// pruning doesn't collect them. Scripts installed by the wheels aren't added to
+// the environment's BinDir.
+func (e *Env) installSharedWheels(c context.Context, pkgDir string) error {
+	wheels, err := wheel.ScanDir(pkgDir)
+	if err != nil {
+		return errors.Annotate(err).Reason("failed to load wheels").Err()
+	}
+	tag := e.Environment.Pep425Tag[0].TagString()
+
+	sitePackages, err := e.sitePackagesDir(c)
+	if err != nil {
+		return err
+	}
+
+	storeDir := e.Config.wheelStoreDir()
+	if err := filesystem.MakeDirs(storeDir); err != nil {
+		return errors.Annotate(err).Reason("failed to create wheel store").Err()
+	}
+
+	return fslock.WithSharedBlocking(e.Config.wheelStoreLockPath(), blocker(c), func() error {
+		keys := make([]string, len(wheels))
+		for i, w := range wheels {
+			path := filepath.Join(pkgDir, w.String())
+			wheelHash, err := hashFile(path)
+			if err != nil {
+				return errors.Annotate(err).Reason("failed to hash wheel: %(path)s").
+					D("path", path).
+					Err()
+			}
+
+			keys[i] = wheelStoreKey(tag, wheelHash)
+			if err := e.ensureStoreEntry(c, keys[i], path); err != nil {
+				return errors.Annotate(err).Reason("failed to add wheel %(wheel)s to the store").
+					D("wheel", w.String()).
+					Err()
+			}
+		}
+
+		// Reference our entries before using them.
+		var refs, pth []string
+		for _, key := range keys {
+			refs = append(refs, key+"\n")
+			pth = append(pth, fmt.Sprintf("import site; site.addsitedir(%q)\n", filepath.Join(storeDir, key)))
+		}
+		if err := ioutil.WriteFile(filepath.Join(e.Root, wheelRefsName), []byte(strings.Join(refs, "")), 0644); err != nil {
+			return errors.Annotate(err).Reason("failed to write shared wheel references").Err()
+		}
+		pthPath := filepath.Join(sitePackages, wheelPthName)
+		if err := ioutil.WriteFile(pthPath, []byte(strings.Join(pth, "")), 0644); err != nil {
+			return errors.Annotate(err).Reason("failed to write shared wheel path file: %(path)s").
+				D("path", pthPath).
+				Err()
+		}
+		logging.Debugf(c, "Referenced %d shared wheel(s) from: %s", len(keys), pthPath)
+		return nil
+	})
+}
+
+// ensureStoreEntry installs the wheel at path into the store entry named key,
+// if it doesn't exist yet.
+//
+// It must be called while holding the store lock.
+func (e *Env) ensureStoreEntry(c context.Context, key, path string) error {
+	storeDir := e.Config.wheelStoreDir()
+	entryDir := filepath.Join(storeDir, key)
+	if _, err := os.Stat(entryDir); err == nil {
+		logging.Debugf(c, "Using shared wheel %s: %s", filepath.Base(path), entryDir)
+		return nil
+	}
+
+	// Install into a temporary directory, then move it into place, so that
+	// entries always have all of their files.
+	tmpDir, err := ioutil.TempDir(storeDir, fmt.Sprintf(".%s.tmp", key))
+	if err != nil {
+		return err
+	}
+	defer filesystem.RemoveAll(tmpDir)
+
+	cmd := e.Interpreter().IsolatedCommand(c,
+		"-m", "pip",
+		"install",
+		"--no-deps",
+		"--no-compile",
+		"--no-index",
+		"--target", tmpDir,
+		path)
+	attachOutputForLogging(c, logging.Debug, cmd)
+	if err := cmd.Run(); err != nil {
+		return errors.Annotate(err).Reason("failed to install wheel").Err()
+	}
+
+	if err := os.Rename(tmpDir, entryDir); err != nil {
+		// Another process may have installed the same entry.
+		if _, serr := os.Stat(entryDir); serr == nil {
+			return nil
+		}
+		return errors.Annotate(err).Reason("failed to move store entry into place").Err()
+	}
+
+	// Compile the entry in place, so the bytecode refers to its final path.
+	// Python compiles whatever is missing on its own, so this is best-effort.
+	cmd = e.Interpreter().IsolatedCommand(c,
+		"-m", "compileall",
+		"-q",
+		"-d", entryDir,
+		entryDir)
+	attachOutputForLogging(c, logging.Debug, cmd)
+	if err := cmd.Run(); err != nil {
+		logging.WithError(err).Warningf(c, "Failed to compile shared wheel %s.", filepath.Base(path))
+	}
+
+	if !e.Config.testLeaveReadWrite {
+		if err := filesystem.MakeReadOnly(entryDir, func(path string) bool { return path != entryDir }); err != nil {
+			return errors.Annotate(err).Reason("failed to mark store entry read-only").Err()
+		}
+	}
+	logging.Debugf(c, "Installed shared wheel %s: %s", filepath.Base(path), entryDir)
+	return nil
+}
+
+// sitePackagesDir returns the site-packages directory of the environment.
+func (e *Env) sitePackagesDir(c context.Context) (string, error) {
+	const script = `import sys, sysconfig; sys.stdout.write(sysconfig.get_paths()['purelib'])`
+	cmd := e.Interpreter().IsolatedCommand(c, "-c", script)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", errors.Annotate(err).Reason("failed to get the site-packages directory").Err()
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// PruneWheelStore removes the entries of the shared wheel store that no
+// environment references.
+func (cfg *Config) PruneWheelStore(c context.Context) error {
+	if err := pruneWheelStore(c, cfg); err != nil {
+		return errors.Annotate(err).Err()
+	}
+	return nil
+}
+
+// pruneWheelStore removes the entries of the shared wheel store that no
+// environment references.
+//
+// It runs while holding the store lock exclusively. If the lock is held, the
+// store isn't pruned.
+func pruneWheelStore(c context.Context, cfg *Config) error {
+	storeDir := cfg.wheelStoreDir()
+	if _, err := os.Stat(storeDir); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Annotate(err).Reason("failed to stat wheel store").Err()
+	}
+
+	err := fslock.With(cfg.wheelStoreLockPath(), func() error {
+		// Collect the references of all of the environments, complete or not.
+		refs := stringset.New(0)
+		var it Iterator
+		err := it.ForEach(c, cfg, func(c context.Context, e *Env) error {
+			keys, err := readWheelRefs(filepath.Join(e.Root, wheelRefsName))
+			if err != nil {
+				return errors.Annotate(err).Reason("failed to read shared wheel references of: %(name)s").
+					D("name", e.Name).
+					Err()
+			}
+			for _, key := range keys {
+				refs.Add(key)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		removed := 0
+		err = iterDir(c, storeDir, func(fileInfos []os.FileInfo) error {
+			for _, fi := range fileInfos {
+				if refs.Has(fi.Name()) {
+					continue
+				}
+				path := filepath.Join(storeDir, fi.Name())
+				if err := filesystem.RemoveAll(path); err != nil {
+					return errors.Annotate(err).Reason("failed to remove store entry: %(path)s").
+						D("path", path).
+						Err()
+				}
+				removed++
+			}
+			return nil
+		})
+		logging.Debugf(c, "Removed %d unreferenced shared wheel(s).", removed)
+		return err
+	})
+	if err == fslock.ErrLockHeld {
+		logging.Debugf(c, "Wheel store is in use; not pruning it.")
+		return nil
+	}
+	return err
+}
+
+// readWheelRefs reads an environment's wheelRefsName file. A missing file has
+// no references.
+func readWheelRefs(path string) ([]string, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer fd.Close()
+
+	var keys []string
+	s := bufio.NewScanner(fd)
+	for s.Scan() {
+		if key := strings.TrimSpace(s.Text()); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys, s.Err()
+}
+
+func hashFile(path string) (string, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer fd.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, fd); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package venv
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/danjacques/gofslock/fslock"
+
+	"github.com/luci/luci-go/common/testing/testfs"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWheelStore(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Testing the shared wheel store`, t, testfs.MustWithTempDir(t, "TestWheelStore", func(tdir string) {
+		c := testContext()
+		cfg := Config{BaseDir: tdir}
+
+		keyA := wheelStoreKey("cp27-cp27mu-manylinux1_x86_64", "aaaa")
+		keyB := wheelStoreKey("cp27-cp27mu-manylinux1_x86_64", "bbbb")
+		keyC := wheelStoreKey("cp27-cp27m-macosx_10_10_x86_64", "aaaa")
+
+		Convey(`Keys depend on the wheel hash and the PEP425 tag`, func() {
+			So(len(keyA), ShouldEqual, wheelStoreKeyLen)
+			So(keyA, ShouldEqual, wheelStoreKey("cp27-cp27mu-manylinux1_x86_64", "aaaa"))
+			So(keyA, ShouldNotEqual, keyB)
+			So(keyA, ShouldNotEqual, keyC)
+		})
+
+		Convey(`Pruning removes the unreferenced entries`, func() {
+			So(testfs.Build(tdir, map[string]string{
+				"env1/" + wheelRefsName:                 keyA + "\n",
+				"env2/" + wheelRefsName:                 keyA + "\n" + keyB + "\n",
+				"env3/complete.flag":                    "",
+				wheelStoreName + "/" + keyA + "/a.py":   "",
+				wheelStoreName + "/" + keyB + "/b.py":   "",
+				wheelStoreName + "/" + keyC + "/c.py":   "",
+				wheelStoreName + "/." + keyC + ".tmp/x": "",
+			}), ShouldBeNil)
+
+			listStore := func() []string {
+				infos, err := ioutil.ReadDir(filepath.Join(tdir, wheelStoreName))
+				So(err, ShouldBeNil)
+				names := make([]string, len(infos))
+				for i, fi := range infos {
+					names[i] = fi.Name()
+				}
+				return names
+			}
+
+			So(cfg.PruneWheelStore(c), ShouldBeNil)
+			expected := []string{keyA, keyB}
+			sort.Strings(expected)
+			So(listStore(), ShouldResemble, expected)
+
+			Convey(`Unless the store is in use`, func() {
+				So(testfs.Build(tdir, map[string]string{
+					"env2/" + wheelRefsName: keyA + "\n",
+				}), ShouldBeNil)
+
+				lock, err := fslock.LockShared(cfg.wheelStoreLockPath())
+				So(err, ShouldBeNil)
+				So(cfg.PruneWheelStore(c), ShouldBeNil)
+				So(lock.Unlock(), ShouldBeNil)
+				So(listStore(), ShouldResemble, expected)
+
+				So(cfg.PruneWheelStore(c), ShouldBeNil)
+				So(listStore(), ShouldResemble, []string{keyA})
+			})
+		})
+
+		Convey(`Pruning does nothing without a store`, func() {
+			So(cfg.PruneWheelStore(c), ShouldBeNil)
+		})
+	}))
+}
@@ -99,6 +99,19 @@ func With(c context.Context, cfg Config, blocking bool, fn func(context.Context,
 	// Track which VirtualEnv we use so we can exempt them from pruning.
 	usedEnvs := stringset.New(2)
 
+	env, err := cfg.probeAndMakeEnv(c, blocking, usedEnvs)
+	if err != nil {
+		return err
+	}
+	usedEnvs.Add(env.Name)
+	return env.withImpl(c, blocking, usedEnvs, fn)
+}
+
+// probeAndMakeEnv returns the Env that cfg describes, resolved with the runtime
+// data of the local system.
+//
+// The names of the environments that it uses are added to usedEnvs.
+func (cfg *Config) probeAndMakeEnv(c context.Context, blocking bool, usedEnvs stringset.Set) (*Env, error) {
 	// Start with an empty VirtualEnv. We will use this to probe the local
 	// system.
 	//
@@ -112,10 +125,10 @@ func With(c context.Context, cfg Config, blocking bool, fn func(context.Context,
 		// full environment initialization.
 		emptyEnv, err := cfg.WithoutWheels().makeEnv(c, nil)
 		if err != nil {
-			return errors.Annotate(err).Reason("failed to initialize empty probe environment").Err()
+			return nil, errors.Annotate(err).Reason("failed to initialize empty probe environment").Err()
 		}
 		if err := emptyEnv.ensure(c, blocking); err != nil {
-			return errors.Annotate(err).Reason("failed to create empty probe environment").Err()
+			return nil, errors.Annotate(err).Reason("failed to create empty probe environment").Err()
 		}
 
 		usedEnvs.Add(emptyEnv.Name)
@@ -123,12 +136,7 @@ func With(c context.Context, cfg Config, blocking bool, fn func(context.Context,
 	}
 
 	// Run the real config, now with runtime data.
-	env, err := cfg.makeEnv(c, e)
-	if err != nil {
-		return err
-	}
-	usedEnvs.Add(env.Name)
-	return env.withImpl(c, blocking, usedEnvs, fn)
+	return cfg.makeEnv(c, e)
 }
 
 // Delete removes all resources consumed by an environment.
@@ -137,13 +145,17 @@ func With(c context.Context, cfg Config, blocking bool, fn func(context.Context,
 // is not in use prior to deletion. This is non-blocking, and an error will be
 // returned if the lock could not be acquired.
 //
+// The environment is resolved like With does, probing the local system first,
+// so that its name matches the environment that With uses.
+//
 // If deletion fails, a wrapped error will be returned.
 func Delete(c context.Context, cfg Config) error {
-	// Attempt to acquire the environment's lock.
-	e, err := cfg.makeEnv(c, nil)
+	e, err := cfg.probeAndMakeEnv(c, false, stringset.New(1))
 	if err != nil {
 		return err
 	}
+
+	// Attempt to acquire the environment's lock.
 	return e.Delete(c)
 }
 
@@ -425,9 +437,12 @@ func (e *Env) createLocked(c context.Context) error {
 	}
 	logging.Infof(c, "Using virtual environment root: %s", e.Root)
 
-	// Build our package list. Always install our base VirtualEnv package.
-	packages := make([]*vpython.Spec_Package, 1, 1+len(e.Environment.Spec.Wheel))
-	packages[0] = e.Environment.Spec.Virtualenv
+	// Build our package list. Install our base VirtualEnv package, unless the
+	// environment is created by the standard library "venv" module.
+	packages := make([]*vpython.Spec_Package, 0, 1+len(e.Environment.Spec.Wheel))
+	if vePkg := e.Environment.Spec.Virtualenv; vePkg != nil {
+		packages = append(packages, vePkg)
+	}
 	packages = append(packages, e.Environment.Spec.Wheel...)
 
 	// Create a directory to bootstrap VirtualEnv from.
@@ -463,8 +478,12 @@ func (e *Env) createLocked(c context.Context) error {
 
 		// Install our wheel files.
 		if len(e.Environment.Spec.Wheel) > 0 {
-			// Install wheels into our VirtualEnv.
-			if err := e.installWheels(c, bootstrapDir, pkgDir); err != nil {
+			// Install wheels into our VirtualEnv, or into the shared wheel store.
+			if e.Config.ShareWheels && len(e.Environment.Pep425Tag) > 0 {
+				if err := e.installSharedWheels(c, pkgDir); err != nil {
+					return errors.Annotate(err).Reason("failed to install shared wheels").Err()
+				}
+			} else if err := e.installWheels(c, bootstrapDir, pkgDir); err != nil {
 				return errors.Annotate(err).Reason("failed to install wheels").Err()
 			}
 		}
@@ -500,6 +519,10 @@ func (e *Env) downloadPackages(c context.Context, dst string, packages []*vpytho
 }
 
 func (e *Env) installVirtualEnv(c context.Context, pkgDir string) error {
+	if e.Environment.Spec.StdlibVenv {
+		return e.installStdlibVenv(c)
+	}
+
 	// Create our VirtualEnv package staging sub-directory underneath of root.
 	bsDir := filepath.Join(e.Root, ".virtualenv")
 	if err := filesystem.MakeDirs(bsDir); err != nil {
@@ -531,18 +554,44 @@ func (e *Env) installVirtualEnv(c context.Context, pkgDir string) error {
 	return nil
 }
 
+// installStdlibVenv creates the environment using the Python 3 standard library
+// "venv" module, which installs "pip" from the interpreter's own bundle.
+func (e *Env) installStdlibVenv(c context.Context) error {
+	logging.Debugf(c, "Creating stdlib venv at: %s", e.Root)
+	cmd := e.Config.systemInterpreter().IsolatedCommand(c,
+		"-m", "venv",
+		e.Root)
+	attachOutputForLogging(c, logging.Debug, cmd)
+	if err := cmd.Run(); err != nil {
+		return errors.Annotate(err).Reason("failed to create stdlib venv").Err()
+	}
+	return nil
+}
+
 // getPEP425Tags calls Python's pip.pep425tags package to retrieve the tags.
 //
+// Newer versions of "pip" moved that package, or replaced it with the vendored
+// "packaging.tags" module, so these are used when it is missing.
+//
 // This must be run while "pip" is installed in the VirtualEnv.
 func (e *Env) getPEP425Tags(c context.Context) ([]*vpython.Pep425Tag, error) {
 	// This script will return a list of 3-entry lists:
 	// [0]: version (e.g., "cp27")
 	// [1]: abi (e.g., "cp27mu", "none")
 	// [2]: arch (e.g., "x86_64", "armv7l", "any")
-	const script = `import json;` +
-		`import pip.pep425tags;` +
-		`import sys;` +
-		`sys.stdout.write(json.dumps(pip.pep425tags.get_supported()))`
+	const script = `import json, sys
+try:
+  from pip import pep425tags
+  tags = pep425tags.get_supported()
+except ImportError:
+  try:
+    from pip._internal import pep425tags
+    tags = pep425tags.get_supported()
+  except ImportError:
+    from pip._vendor.packaging import tags as ptags
+    tags = [(t.interpreter, t.abi, t.platform) for t in ptags.sys_tags()]
+sys.stdout.write(json.dumps(tags))
+`
 	type pep425TagEntry []string
 
 	cmd := e.Interpreter().IsolatedCommand(c, "-c", script)
@@ -604,14 +653,21 @@ func (e *Env) installWheels(c context.Context, bootstrapDir, pkgDir string) erro
 		return errors.Annotate(err).Reason("failed to render requirements file").Err()
 	}
 
-	cmd := e.Interpreter().IsolatedCommand(c,
+	args := []string{
 		"-m", "pip",
 		"install",
-		"--use-wheel",
+	}
+	if !e.Environment.Spec.StdlibVenv {
+		// Newer versions of "pip", bundled with Python 3, always use wheels and
+		// reject this flag.
+		args = append(args, "--use-wheel")
+	}
+	args = append(args,
 		"--compile",
 		"--no-index",
 		"--find-links", pkgDir,
 		"--requirement", reqPath)
+	cmd := e.Interpreter().IsolatedCommand(c, args...)
 	attachOutputForLogging(c, logging.Debug, cmd)
 	if err := cmd.Run(); err != nil {
 		return errors.Annotate(err).Reason("failed to install wheels").Err()
@@ -82,7 +82,8 @@ func TestResolvePythonInterpreter(t *testing.T) {
 		if python27 != nil {
 			Convey(`When Python 2.7 is requested, it gets resolved.`, func() {
 				s.PythonVersion = "2.7"
-				So(cfg.resolvePythonInterpreter(c, &s), ShouldBeNil)
+				_, err := cfg.resolvePythonInterpreter(c, &s)
+				So(err, ShouldBeNil)
 				So(cfg.Python, ShouldEqual, python27.py.Python)
 
 				vers, err := python.ParseVersion(s.PythonVersion)
@@ -93,7 +94,15 @@ func TestResolvePythonInterpreter(t *testing.T) {
 			Convey(`Fails when Python 9999 is requested, but a Python 2 interpreter is forced.`, func() {
 				cfg.Python = python27.py.Python
 				s.PythonVersion = "9999"
-				So(cfg.resolvePythonInterpreter(c, &s), ShouldErrLike, "doesn't match specification")
+				_, err := cfg.resolvePythonInterpreter(c, &s)
+				So(err, ShouldErrLike, "doesn't match specification")
+			})
+
+			Convey(`Fails when a stdlib venv is requested, but a Python 2 interpreter is forced.`, func() {
+				cfg.Python = python27.py.Python
+				s.StdlibVenv = true
+				_, err := cfg.resolvePythonInterpreter(c, &s)
+				So(err, ShouldErrLike, "requires Python 3")
 			})
 		}
 
@@ -101,7 +110,8 @@ func TestResolvePythonInterpreter(t *testing.T) {
 		if pythonGeneric != nil && python27 != nil {
 			// Our generic Python resolves to a known version, so we can proceed.
 			Convey(`When no Python version is specified, spec resolves to generic.`, func() {
-				So(cfg.resolvePythonInterpreter(c, &s), ShouldBeNil)
+				_, err := cfg.resolvePythonInterpreter(c, &s)
+				So(err, ShouldBeNil)
 				So(cfg.Python, ShouldEqual, pythonGeneric.py.Python)
 
 				vers, err := python.ParseVersion(s.PythonVersion)
@@ -114,7 +124,8 @@ func TestResolvePythonInterpreter(t *testing.T) {
 		if python3 != nil {
 			Convey(`When Python 3 is requested, it gets resolved.`, func() {
 				s.PythonVersion = "3"
-				So(cfg.resolvePythonInterpreter(c, &s), ShouldBeNil)
+				_, err := cfg.resolvePythonInterpreter(c, &s)
+				So(err, ShouldBeNil)
 				So(cfg.Python, ShouldEqual, python3.py.Python)
 
 				vers, err := python.ParseVersion(s.PythonVersion)
@@ -122,10 +133,49 @@ func TestResolvePythonInterpreter(t *testing.T) {
 				So(vers.IsSatisfiedBy(python3.version), ShouldBeTrue)
 			})
 
+			Convey(`Returns the runtime of the resolved interpreter.`, func() {
+				s.PythonVersion = "3"
+				rt, err := cfg.resolvePythonInterpreter(c, &s)
+				So(err, ShouldBeNil)
+				So(rt.Path, ShouldEqual, python3.py.Python)
+				So(rt.Version, ShouldEqual, s.PythonVersion)
+				So(rt.Abi, ShouldStartWith, fmt.Sprintf("cp%d%d", python3.version.Major, python3.version.Minor))
+			})
+
+			Convey(`Uses the first usable interpreter declared for the platform.`, func() {
+				cfg.Platform = "test-platform"
+				s.PythonVersion = "3"
+				s.Interpreter = []*vpython.Spec_Interpreter{
+					{Platform: "other-*", Python: "python2.7"},
+					{Platform: "test-*", Python: filepath.Join("nonexistent", "python3")},
+					{Python: python3.py.Python},
+				}
+				_, err := cfg.resolvePythonInterpreter(c, &s)
+				So(err, ShouldBeNil)
+				So(cfg.Python, ShouldEqual, python3.py.Python)
+			})
+
+			Convey(`Fails if none of the interpreters declared for the platform is usable.`, func() {
+				cfg.Platform = "test-platform"
+				s.Interpreter = []*vpython.Spec_Interpreter{
+					{Platform: "test-*", Python: filepath.Join("nonexistent", "python3")},
+				}
+				_, err := cfg.resolvePythonInterpreter(c, &s)
+				So(err, ShouldErrLike, "none of the interpreters")
+			})
+
+			Convey(`Resolves Python 3 for a stdlib venv.`, func() {
+				s.PythonVersion = "3"
+				s.StdlibVenv = true
+				_, err := cfg.resolvePythonInterpreter(c, &s)
+				So(err, ShouldBeNil)
+			})
+
 			Convey(`Fails when Python 9999 is requested, but a Python 3 interpreter is forced.`, func() {
 				cfg.Python = python3.py.Python
 				s.PythonVersion = "9999"
-				So(cfg.resolvePythonInterpreter(c, &s), ShouldErrLike, "doesn't match specification")
+				_, err := cfg.resolvePythonInterpreter(c, &s)
+				So(err, ShouldErrLike, "doesn't match specification")
 			})
 		}
 	})
@@ -209,6 +259,32 @@ func testVirtualEnvWith(t *testing.T, ri *resolvedInterpreter) {
 			So(v.lockPath, shouldNotExist)
 		})
 
+		Convey(`Testing Setup with shared wheels`, func() {
+			config.ShareWheels = true
+			storeDir := config.wheelStoreDir()
+
+			err := With(c, config, false, func(c context.Context, v *Env) error {
+				// Both wheels are imported from their store entries.
+				testScriptPath := filepath.Join(testDataDir, "setup_check.py")
+				checkOut := filepath.Join(tdir, "output.json")
+				cmd := v.Interpreter().IsolatedCommand(c, testScriptPath, "--json-output", checkOut)
+				So(cmd.Run(), ShouldBeNil)
+
+				var m setupCheckManifest
+				So(loadJSON(checkOut, &m), ShouldBeNil)
+				So(m.Interpreter, ShouldStartWith, v.Root)
+				So(m.Pants, ShouldStartWith, storeDir)
+				So(m.Shirt, ShouldStartWith, storeDir)
+				So(filepath.Dir(filepath.Dir(m.Pants)), ShouldNotEqual, filepath.Dir(filepath.Dir(m.Shirt)))
+
+				keys, err := readWheelRefs(filepath.Join(v.Root, wheelRefsName))
+				So(err, ShouldBeNil)
+				So(keys, ShouldHaveLength, len(config.Spec.Wheel))
+				return nil
+			})
+			So(err, ShouldBeNil)
+		})
+
 		Convey(`Testing new environment setup race`, func() {
 			const workers = 4
 
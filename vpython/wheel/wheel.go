@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/luci/luci-go/common/errors"
@@ -44,6 +45,14 @@ func (wn *Name) String() string {
 	return strings.Join(parts, "-") + ".whl"
 }
 
+var nameSeparatorRe = regexp.MustCompile(`[-_.]+`)
+
+// NormalizeName returns the normalized form of a distribution name, as defined
+// by PEP 503.
+func NormalizeName(name string) string {
+	return strings.ToLower(nameSeparatorRe.ReplaceAllString(name, "-"))
+}
+
 // ParseName parses a wheel Name from its filename.
 func ParseName(v string) (wn Name, err error) {
 	base := strings.TrimSuffix(v, ".whl")
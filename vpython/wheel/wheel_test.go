@@ -180,3 +180,16 @@ func TestWriteRequirementsFile(t *testing.T) {
 				"cryptography==1.4\n"))
 		}))
 }
+
+func TestNormalizeName(t *testing.T) {
+	t.Parallel()
+
+	Convey(`NormalizeName follows PEP 503`, t, func() {
+		for _, name := range []string{"Foo-Bar", "foo_bar", "FOO.bar", "foo__-.bar"} {
+			So(NormalizeName(name), ShouldEqual, "foo-bar")
+		}
+		So(NormalizeName("foo-bar"), ShouldEqual, "foo-bar")
+		So(NormalizeName("foobar"), ShouldEqual, "foobar")
+		So(NormalizeName("Foo2-Bar_3"), ShouldEqual, "foo2-bar-3")
+	})
+}